@@ -12,6 +12,16 @@ CREATE SEQUENCE IF NOT EXISTS template_invite_id_sequence START 1 INCREMENT 1;
 CREATE SEQUENCE IF NOT EXISTS workspace_id_sequence START 1 INCREMENT 1;
 CREATE SEQUENCE IF NOT EXISTS workspace_member_id_sequence START 1 INCREMENT 1;
 CREATE SEQUENCE IF NOT EXISTS workspace_invite_id_sequence START 1 INCREMENT 1;
+CREATE SEQUENCE IF NOT EXISTS checklist_run_id_sequence START 1 INCREMENT 1;
+CREATE SEQUENCE IF NOT EXISTS checklist_run_step_id_sequence START 1 INCREMENT 1;
+CREATE SEQUENCE IF NOT EXISTS checklist_run_step_row_id_sequence START 1 INCREMENT 1;
+CREATE SEQUENCE IF NOT EXISTS checklist_activity_id_sequence START 1 INCREMENT 1;
+CREATE SEQUENCE IF NOT EXISTS workspace_activity_id_sequence START 1 INCREMENT 1;
+CREATE SEQUENCE IF NOT EXISTS checklist_item_history_id_sequence START 1 INCREMENT 1;
+CREATE SEQUENCE IF NOT EXISTS checklist_item_deletion_group_id_sequence START 1 INCREMENT 1;
+CREATE SEQUENCE IF NOT EXISTS checklist_section_id_sequence START 1 INCREMENT 1;
+CREATE SEQUENCE IF NOT EXISTS checklist_item_comment_id_sequence START 1 INCREMENT 1;
+CREATE SEQUENCE IF NOT EXISTS checklist_event_id_sequence START 1 INCREMENT 1;
 
 -- Users & sessions
 CREATE TABLE IF NOT EXISTS app_user (
@@ -83,21 +93,40 @@ CREATE INDEX IF NOT EXISTS idx_workspace_invite_active ON workspace_invite(works
 
 -- Checklists
 CREATE TABLE IF NOT EXISTS CHECKLIST (
-    ID           BIGINT PRIMARY KEY,
-    OWNER        VARCHAR(255) NOT NULL,
+    ID            BIGINT PRIMARY KEY,
+    OWNER         VARCHAR(255) NOT NULL,
+    NAME          VARCHAR(255) NOT NULL,
+    workspace_id  BIGINT REFERENCES workspace(id) ON DELETE SET NULL,
+    ARCHIVED_AT   TIMESTAMP NULL,
+    ORDERING_MODE VARCHAR(20) NOT NULL DEFAULT 'SINK_COMPLETED'
+);
+
+-- Named groups of items within a checklist. Items without a section are shown before all sections.
+CREATE TABLE IF NOT EXISTS CHECKLIST_SECTION (
+    ID           BIGINT PRIMARY KEY DEFAULT NEXTVAL('checklist_section_id_sequence'),
+    CHECKLIST_ID BIGINT NOT NULL REFERENCES CHECKLIST(ID) ON DELETE CASCADE,
     NAME         VARCHAR(255) NOT NULL,
-    workspace_id BIGINT REFERENCES workspace(id) ON DELETE SET NULL
+    POSITION     DOUBLE PRECISION NOT NULL DEFAULT 0,
+    COLLAPSED    BOOLEAN NOT NULL DEFAULT FALSE,
+    CREATED_AT   TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
 );
 
+CREATE INDEX IF NOT EXISTS idx_checklist_section_checklist ON CHECKLIST_SECTION(CHECKLIST_ID, POSITION);
+
 CREATE TABLE IF NOT EXISTS CHECKLIST_ITEM (
     CHECKLIST_ITEM_ID        BIGINT PRIMARY KEY,
     CHECKLIST_ID             BIGINT NOT NULL,
     CHECKLIST_ITEM_NAME      VARCHAR(255) NOT NULL,
     CHECKLIST_ITEM_COMPLETED BOOLEAN NOT NULL DEFAULT FALSE,
-    POSITION                 DOUBLE PRECISION NOT NULL DEFAULT 0,
+    POSITION                 TEXT COLLATE "C" NOT NULL, -- Fractional key, compared byte by byte
     UPDATED_AT               TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
     DELETED_AT               TIMESTAMP NULL,
     DELETED_BY               VARCHAR(255) NULL,
+    DELETION_GROUP_ID        BIGINT NULL,
+    CHECKLIST_ITEM_COMPLETED_BY VARCHAR(255) NULL,
+    CHECKLIST_ITEM_COMPLETED_AT TIMESTAMP NULL,
+    SECTION_ID               BIGINT NULL REFERENCES CHECKLIST_SECTION(ID) ON DELETE SET NULL,
+    NOTES                    TEXT NULL,
     FOREIGN KEY (CHECKLIST_ID) REFERENCES CHECKLIST(ID) ON DELETE CASCADE
 );
 
@@ -106,21 +135,29 @@ CREATE TABLE IF NOT EXISTS CHECKLIST_ITEM_ROW (
     CHECKLIST_ITEM_ID            BIGINT NOT NULL,
     CHECKLIST_ITEM_ROW_NAME      VARCHAR(255) NOT NULL,
     CHECKLIST_ITEM_ROW_COMPLETED BOOLEAN NOT NULL DEFAULT FALSE,
+    CHECKLIST_ITEM_ROW_COMPLETED_BY VARCHAR(255) NULL,
+    CHECKLIST_ITEM_ROW_COMPLETED_AT TIMESTAMP NULL,
+    CHECKLIST_ITEM_ROW_POSITION  TEXT COLLATE "C" NOT NULL,
     FOREIGN KEY (CHECKLIST_ITEM_ID) REFERENCES CHECKLIST_ITEM(CHECKLIST_ITEM_ID) ON DELETE CASCADE
 );
 
+-- Items are numbered within their section. Completed items sink below open ones unless the checklist
+-- keeps items in place.
 CREATE OR REPLACE VIEW CHECKLIST_ITEMS_ORDERED_VIEW AS
 SELECT
-    CHECKLIST_ID,
-    CHECKLIST_ITEM_ID,
-    CHECKLIST_ITEM_NAME,
-    CHECKLIST_ITEM_COMPLETED,
-    POSITION,
+    ci.CHECKLIST_ID,
+    ci.CHECKLIST_ITEM_ID,
+    ci.CHECKLIST_ITEM_NAME,
+    ci.CHECKLIST_ITEM_COMPLETED,
+    ci.POSITION,
     ROW_NUMBER() OVER (
-        PARTITION BY CHECKLIST_ID
-        ORDER BY CHECKLIST_ITEM_COMPLETED ASC, POSITION ASC
-    ) AS ORDER_NUMBER
-FROM CHECKLIST_ITEM;
+        PARTITION BY ci.CHECKLIST_ID, ci.SECTION_ID
+        ORDER BY CASE WHEN c.ORDERING_MODE = 'KEEP_IN_PLACE' THEN FALSE ELSE ci.CHECKLIST_ITEM_COMPLETED END ASC,
+                 ci.POSITION ASC
+    ) AS ORDER_NUMBER,
+    ci.SECTION_ID
+FROM CHECKLIST_ITEM ci
+JOIN CHECKLIST c ON c.ID = ci.CHECKLIST_ID;
 
 CREATE TABLE IF NOT EXISTS CHECKLIST_SHARE (
     ID                  BIGINT PRIMARY KEY DEFAULT NEXTVAL('checklist_share_id_sequence'),
@@ -148,18 +185,113 @@ CREATE TABLE IF NOT EXISTS CHECKLIST_INVITE (
 
 CREATE INDEX IF NOT EXISTS idx_checklist_workspace     ON CHECKLIST(workspace_id) WHERE workspace_id IS NOT NULL;
 CREATE INDEX IF NOT EXISTS idx_checklist_item_position ON CHECKLIST_ITEM(CHECKLIST_ID, CHECKLIST_ITEM_COMPLETED, POSITION);
+CREATE INDEX IF NOT EXISTS idx_checklist_item_row_position ON CHECKLIST_ITEM_ROW(CHECKLIST_ITEM_ID, CHECKLIST_ITEM_ROW_POSITION);
 CREATE INDEX IF NOT EXISTS idx_checklist_item_active   ON CHECKLIST_ITEM(CHECKLIST_ID, DELETED_AT) WHERE DELETED_AT IS NULL;
 CREATE INDEX IF NOT EXISTS idx_checklist_item_deleted  ON CHECKLIST_ITEM(DELETED_AT) WHERE DELETED_AT IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_checklist_item_deletion_group ON CHECKLIST_ITEM(CHECKLIST_ID, DELETION_GROUP_ID) WHERE DELETION_GROUP_ID IS NOT NULL;
 CREATE INDEX IF NOT EXISTS idx_checklist_invite_token  ON CHECKLIST_INVITE(INVITE_TOKEN);
 CREATE INDEX IF NOT EXISTS idx_checklist_invite_active ON CHECKLIST_INVITE(CHECKLIST_ID, CLAIMED_AT, EXPIRES_AT)
     WHERE CLAIMED_AT IS NULL;
 
+-- Checklist runs (execution history)
+CREATE TABLE IF NOT EXISTS CHECKLIST_RUN (
+    ID                    BIGINT PRIMARY KEY DEFAULT NEXTVAL('checklist_run_id_sequence'),
+    CHECKLIST_ID          BIGINT NOT NULL REFERENCES CHECKLIST(ID) ON DELETE CASCADE,
+    STARTED_BY            VARCHAR(255) NOT NULL,
+    STARTED_AT            TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FINISHED_BY           VARCHAR(255) NULL,
+    FINISHED_AT           TIMESTAMP NULL,
+    DURATION_SECONDS      BIGINT NULL,
+    COMPLETION_PERCENTAGE DOUBLE PRECISION NULL
+);
+
+CREATE TABLE IF NOT EXISTS CHECKLIST_RUN_STEP (
+    ID             BIGINT PRIMARY KEY DEFAULT NEXTVAL('checklist_run_step_id_sequence'),
+    RUN_ID         BIGINT NOT NULL REFERENCES CHECKLIST_RUN(ID) ON DELETE CASCADE,
+    SOURCE_ITEM_ID BIGINT NULL,
+    NAME           VARCHAR(255) NOT NULL,
+    ORDER_NUMBER   INT NOT NULL,
+    STATUS         VARCHAR(20) NOT NULL DEFAULT 'PENDING' CHECK (STATUS IN ('PENDING', 'COMPLETED', 'NOT_APPLICABLE')),
+    CHECKED_BY     VARCHAR(255) NULL,
+    CHECKED_AT     TIMESTAMP NULL
+);
+
+CREATE TABLE IF NOT EXISTS CHECKLIST_RUN_STEP_ROW (
+    ID            BIGINT PRIMARY KEY DEFAULT NEXTVAL('checklist_run_step_row_id_sequence'),
+    STEP_ID       BIGINT NOT NULL REFERENCES CHECKLIST_RUN_STEP(ID) ON DELETE CASCADE,
+    SOURCE_ROW_ID BIGINT NULL,
+    NAME          VARCHAR(255) NOT NULL,
+    STATUS        VARCHAR(20) NOT NULL DEFAULT 'PENDING' CHECK (STATUS IN ('PENDING', 'COMPLETED', 'NOT_APPLICABLE')),
+    CHECKED_BY    VARCHAR(255) NULL,
+    CHECKED_AT    TIMESTAMP NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_checklist_run_checklist   ON CHECKLIST_RUN(CHECKLIST_ID, STARTED_AT DESC);
+CREATE INDEX IF NOT EXISTS idx_checklist_run_step_run    ON CHECKLIST_RUN_STEP(RUN_ID);
+CREATE INDEX IF NOT EXISTS idx_checklist_run_step_row    ON CHECKLIST_RUN_STEP_ROW(STEP_ID);
+
+-- Threaded comments on checklist items. Comments are soft-deleted together with their item and restored
+-- with it when they share the item's deletion timestamp.
+CREATE TABLE IF NOT EXISTS CHECKLIST_ITEM_COMMENT (
+    ID                BIGINT PRIMARY KEY DEFAULT NEXTVAL('checklist_item_comment_id_sequence'),
+    CHECKLIST_ITEM_ID BIGINT NOT NULL REFERENCES CHECKLIST_ITEM(CHECKLIST_ITEM_ID) ON DELETE CASCADE,
+    PARENT_ID         BIGINT NULL REFERENCES CHECKLIST_ITEM_COMMENT(ID) ON DELETE CASCADE,
+    AUTHOR            VARCHAR(255) NOT NULL,
+    CONTENT           TEXT NOT NULL,
+    CREATED_AT        TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    EDITED_AT         TIMESTAMP NULL,
+    DELETED_AT        TIMESTAMP NULL
+);
+
+CREATE TABLE IF NOT EXISTS CHECKLIST_ITEM_COMMENT_MENTION (
+    COMMENT_ID BIGINT NOT NULL REFERENCES CHECKLIST_ITEM_COMMENT(ID) ON DELETE CASCADE,
+    USER_ID    VARCHAR(255) NOT NULL REFERENCES app_user(user_id) ON DELETE CASCADE,
+    PRIMARY KEY (COMMENT_ID, USER_ID)
+);
+
+CREATE INDEX IF NOT EXISTS idx_checklist_item_comment_item ON CHECKLIST_ITEM_COMMENT(CHECKLIST_ITEM_ID, CREATED_AT);
+
+-- Checklist activity log (append-only)
+CREATE TABLE IF NOT EXISTS CHECKLIST_ACTIVITY (
+    ID             BIGINT PRIMARY KEY DEFAULT NEXTVAL('checklist_activity_id_sequence'),
+    CHECKLIST_ID   BIGINT NOT NULL REFERENCES CHECKLIST(ID) ON DELETE CASCADE,
+    ITEM_ID        BIGINT NULL,
+    ACTION         VARCHAR(50) NOT NULL,
+    ACTOR          VARCHAR(255) NOT NULL,
+    CREATED_AT     TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    BEFORE_SUMMARY TEXT NULL,
+    AFTER_SUMMARY  TEXT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_checklist_activity_checklist ON CHECKLIST_ACTIVITY(CHECKLIST_ID, ID DESC);
+CREATE INDEX IF NOT EXISTS idx_checklist_activity_created   ON CHECKLIST_ACTIVITY(CREATED_AT);
+
+-- Checklist change history (state of an item after every change)
+CREATE TABLE IF NOT EXISTS CHECKLIST_ITEM_HISTORY (
+    ID                BIGINT PRIMARY KEY DEFAULT NEXTVAL('checklist_item_history_id_sequence'),
+    CHECKLIST_ID      BIGINT NOT NULL REFERENCES CHECKLIST(ID) ON DELETE CASCADE,
+    CHECKLIST_ITEM_ID BIGINT NOT NULL,
+    RECORDED_AT       TIMESTAMP NOT NULL DEFAULT clock_timestamp(),
+    DELETED           BOOLEAN NOT NULL,
+    NAME              TEXT NOT NULL,
+    COMPLETED         BOOLEAN NOT NULL,
+    COMPLETED_BY      VARCHAR(255) NULL,
+    COMPLETED_AT      TIMESTAMP NULL,
+    POSITION          TEXT COLLATE "C" NOT NULL,
+    SECTION_ID        BIGINT NULL,
+    ROWS              JSONB NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_checklist_item_history_checklist ON CHECKLIST_ITEM_HISTORY(CHECKLIST_ID, RECORDED_AT);
+CREATE INDEX IF NOT EXISTS idx_checklist_item_history_item      ON CHECKLIST_ITEM_HISTORY(CHECKLIST_ITEM_ID, RECORDED_AT DESC, ID DESC);
+
 -- Templates
 CREATE TABLE IF NOT EXISTS TEMPLATE (
     ID          BIGINT PRIMARY KEY DEFAULT NEXTVAL('template_id_sequence'),
     USER_ID     VARCHAR(255) NOT NULL REFERENCES app_user(user_id) ON DELETE CASCADE,
     NAME        VARCHAR(255) NOT NULL,
     DESCRIPTION TEXT,
+    NOTES       TEXT,
     CREATED_AT  TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
     UPDATED_AT  TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
 );
@@ -168,7 +300,7 @@ CREATE TABLE IF NOT EXISTS TEMPLATE_ROW (
     ID          BIGINT PRIMARY KEY DEFAULT NEXTVAL('template_row_id_sequence'),
     TEMPLATE_ID BIGINT NOT NULL REFERENCES TEMPLATE(ID) ON DELETE CASCADE,
     NAME        VARCHAR(255) NOT NULL,
-    POSITION    DOUBLE PRECISION NOT NULL,
+    POSITION    TEXT COLLATE "C" NOT NULL,
     CREATED_AT  TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
     UPDATED_AT  TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
 );
@@ -211,6 +343,55 @@ CREATE INDEX IF NOT EXISTS idx_template_invite_active  ON TEMPLATE_INVITE(TEMPLA
 CREATE INDEX IF NOT EXISTS idx_template_share_user     ON TEMPLATE_SHARE(SHARED_WITH_USER_ID);
 CREATE INDEX IF NOT EXISTS idx_tw_workspace            ON template_workspace(workspace_id);
 
+-- Workspace activity feed (append-only)
+CREATE TABLE IF NOT EXISTS workspace_activity (
+    id              BIGINT PRIMARY KEY DEFAULT NEXTVAL('workspace_activity_id_sequence'),
+    workspace_id    BIGINT NOT NULL REFERENCES workspace(id) ON DELETE CASCADE,
+    event_type      VARCHAR(50) NOT NULL,
+    actor           VARCHAR(255) NOT NULL,
+    subject_user_id VARCHAR(255) NULL,
+    checklist_id    BIGINT NULL,
+    template_id     BIGINT NULL,
+    invite_id       BIGINT NULL,
+    summary         TEXT NULL,
+    created_at      TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_workspace_activity_workspace ON workspace_activity(workspace_id, id DESC);
+CREATE INDEX IF NOT EXISTS idx_workspace_activity_created   ON workspace_activity(created_at);
+
+-- Outbox of checklist events shared by all instances; the postgres SSE broker notifies listeners with the event id
+CREATE TABLE IF NOT EXISTS CHECKLIST_EVENT (
+    ID           BIGINT PRIMARY KEY DEFAULT NEXTVAL('checklist_event_id_sequence'),
+    CHECKLIST_ID BIGINT NOT NULL,
+    EVENT_ID     BIGINT NOT NULL,
+    CLIENT_ID    VARCHAR(255) NOT NULL,
+    EVENT_TYPE   VARCHAR(50) NOT NULL,
+    PAYLOAD      JSONB NOT NULL,
+    -- Users whose accessible checklists changed with the event
+    ACCESS_CHANGED_FOR TEXT[] NULL,
+    CREATED_AT   TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_checklist_event_created ON CHECKLIST_EVENT(CREATED_AT);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_checklist_event_checklist ON CHECKLIST_EVENT(CHECKLIST_ID, EVENT_ID);
+
+-- Last event id of each checklist, which numbers the events of the checklist for replay
+CREATE TABLE IF NOT EXISTS CHECKLIST_EVENT_COUNTER (
+    CHECKLIST_ID  BIGINT PRIMARY KEY,
+    LAST_EVENT_ID BIGINT NOT NULL
+);
+
+-- Pending position rebalances; any instance runs them once RUN_AFTER has passed
+CREATE TABLE IF NOT EXISTS CHECKLIST_REBALANCE (
+    CHECKLIST_ID BIGINT PRIMARY KEY REFERENCES CHECKLIST(ID) ON DELETE CASCADE,
+    -- First request since the last rebalance, which bounds how long later requests can postpone it
+    REQUESTED_AT TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    RUN_AFTER    TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_checklist_rebalance_run_after ON CHECKLIST_REBALANCE(RUN_AFTER);
+
 -- Background job coordination
 CREATE TABLE IF NOT EXISTS job_lock (
     job_name   VARCHAR(100) PRIMARY KEY,
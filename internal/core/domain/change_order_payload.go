@@ -5,12 +5,14 @@ type ChangeOrderRequest struct {
 	ChecklistId     uint
 	ChecklistItemId uint
 	SortOrder       SortOrder
+	SectionId       *uint // Section the item moves to: nil keeps the current one, 0 moves it out of all sections
 }
 
 type ChangeOrderResponse struct {
 	OrderNumber     uint
 	ChecklistItemId uint
 	ChecklistId     uint
-	Position        float64
+	SectionId       *uint
+	Position        string
 	RebalanceNeeded bool
 }
@@ -1,11 +1,43 @@
 package domain
 
+import (
+	"strings"
+	"time"
+)
+
+// ChecklistOrderingMode controls where an item goes when its completion is toggled
+type ChecklistOrderingMode string
+
+const (
+	// OrderingModeSinkCompleted moves completed items below the open ones (default)
+	OrderingModeSinkCompleted ChecklistOrderingMode = "SINK_COMPLETED"
+	// OrderingModeKeepInPlace keeps items at their position regardless of completion, for procedural lists
+	OrderingModeKeepInPlace ChecklistOrderingMode = "KEEP_IN_PLACE"
+)
+
+func NewChecklistOrderingMode(value string) (ChecklistOrderingMode, Error) {
+	mode := ChecklistOrderingMode(strings.ToUpper(value))
+	switch mode {
+	case OrderingModeSinkCompleted, OrderingModeKeepInPlace:
+		return mode, nil
+	default:
+		return "", NewError("Ordering mode can only be SINK_COMPLETED or KEEP_IN_PLACE", 400)
+	}
+}
+
+// SinksCompleted reports whether completed items are kept in their own section after the open ones
+func (m ChecklistOrderingMode) SinksCompleted() bool {
+	return m != OrderingModeKeepInPlace
+}
+
 type Checklist struct {
 	Id             uint
 	Name           string
 	Owner          string
 	WorkspaceId    *uint
+	OrderingMode   ChecklistOrderingMode
 	ChecklistItems []ChecklistItem
 	SharedWith     []string // List of user IDs this checklist is shared with
 	Stats          ChecklistStats
+	ArchivedAt     *time.Time // Set when the checklist was merged into another one; archived checklists are read-only
 }
@@ -0,0 +1,82 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+type ChecklistActivityAction string
+
+const (
+	ActivityChecklistCreated ChecklistActivityAction = "CHECKLIST_CREATED"
+	ActivityChecklistRenamed ChecklistActivityAction = "CHECKLIST_RENAMED"
+	ActivityChecklistMerged  ChecklistActivityAction = "CHECKLIST_MERGED"
+	ActivityChecklistSplit   ChecklistActivityAction = "CHECKLIST_SPLIT"
+	ActivityItemCreated      ChecklistActivityAction = "ITEM_CREATED"
+	ActivityItemUpdated      ChecklistActivityAction = "ITEM_UPDATED"
+	ActivityItemToggled      ChecklistActivityAction = "ITEM_TOGGLED"
+	ActivityItemReordered    ChecklistActivityAction = "ITEM_REORDERED"
+	ActivityItemDeleted      ChecklistActivityAction = "ITEM_DELETED"
+	ActivityItemRestored     ChecklistActivityAction = "ITEM_RESTORED"
+	ActivityItemMovedIn      ChecklistActivityAction = "ITEM_MOVED_IN"
+	ActivityItemMovedOut     ChecklistActivityAction = "ITEM_MOVED_OUT"
+	ActivityItemCopied       ChecklistActivityAction = "ITEM_COPIED"
+	ActivityRowAdded         ChecklistActivityAction = "ROW_ADDED"
+	ActivityRowDeleted       ChecklistActivityAction = "ROW_DELETED"
+	ActivityShareAdded       ChecklistActivityAction = "SHARE_ADDED"
+	ActivityShareRemoved     ChecklistActivityAction = "SHARE_REMOVED"
+)
+
+const (
+	DefaultActivityPageSize = 50
+	MaxActivityPageSize     = 200
+)
+
+// ChecklistActivity is a single append-only entry of the checklist activity log
+type ChecklistActivity struct {
+	Id          uint
+	ChecklistId uint
+	ItemId      *uint
+	Action      ChecklistActivityAction
+	Actor       string
+	CreatedAt   time.Time
+	Before      *string // Human readable summary of the state before the change (nil = not applicable)
+	After       *string // Human readable summary of the state after the change (nil = not applicable)
+}
+
+// ChecklistActivityPage is a page of activity entries ordered from newest to oldest.
+// NextCursor is the value to pass as "before" to fetch the next page (nil = no more entries).
+type ChecklistActivityPage struct {
+	Entries    []ChecklistActivity
+	NextCursor *uint
+}
+
+// NormalizeActivityPageSize clamps the requested page size into the allowed range
+func NormalizeActivityPageSize(limit *int) int {
+	if limit == nil || *limit <= 0 {
+		return DefaultActivityPageSize
+	}
+	if *limit > MaxActivityPageSize {
+		return MaxActivityPageSize
+	}
+	return *limit
+}
+
+// SummarizeChecklistItem returns the before/after summary stored for an item
+func SummarizeChecklistItem(item ChecklistItem) string {
+	summary := fmt.Sprintf("name=%q, completed=%t", item.Name, item.Completed)
+	if len(item.Rows) == 0 {
+		return summary
+	}
+	rows := make([]string, 0, len(item.Rows))
+	for _, row := range item.Rows {
+		rows = append(rows, SummarizeChecklistItemRow(row))
+	}
+	return summary + ", rows=[" + strings.Join(rows, "; ") + "]"
+}
+
+// SummarizeChecklistItemRow returns the before/after summary stored for an item row
+func SummarizeChecklistItemRow(row ChecklistItemRow) string {
+	return fmt.Sprintf("name=%q, completed=%t", row.Name, row.Completed)
+}
@@ -0,0 +1,10 @@
+package domain
+
+// ChecklistCloneOptions controls how a checklist is duplicated.
+// Name and WorkspaceId default to "<source name> (copy)" and no workspace when nil.
+type ChecklistCloneOptions struct {
+	Name            *string
+	WorkspaceId     *uint
+	ResetCompletion bool // Clone items and rows as not completed
+	IncludeShares   bool // Share the clone with the same users as the source checklist
+}
@@ -0,0 +1,109 @@
+package domain
+
+import "time"
+
+// ChecklistState is the state of a checklist as it was at AsOf.
+// RecordedAt is when the last change before AsOf was recorded (nil = no history before AsOf).
+type ChecklistState struct {
+	ChecklistId uint
+	AsOf        time.Time
+	RecordedAt  *time.Time
+	Items       []ChecklistItem
+}
+
+type ChecklistItemChangeField string
+
+const (
+	ItemChangeName      ChecklistItemChangeField = "NAME"
+	ItemChangeCompleted ChecklistItemChangeField = "COMPLETED"
+	ItemChangeOrder     ChecklistItemChangeField = "ORDER"
+	ItemChangeRows      ChecklistItemChangeField = "ROWS"
+)
+
+// ChecklistItemChange describes an item present in both states whose content differs
+type ChecklistItemChange struct {
+	ItemId uint
+	Before ChecklistItem
+	After  ChecklistItem
+	Fields []ChecklistItemChangeField
+}
+
+// ChecklistStateDiff describes how a checklist changed between two states
+type ChecklistStateDiff struct {
+	ChecklistId uint
+	From        ChecklistState
+	To          ChecklistState
+	Added       []ChecklistItem
+	Removed     []ChecklistItem
+	Changed     []ChecklistItemChange
+}
+
+// DiffChecklistStates compares two states of the same checklist item by item
+func DiffChecklistStates(from ChecklistState, to ChecklistState) ChecklistStateDiff {
+	diff := ChecklistStateDiff{
+		ChecklistId: to.ChecklistId,
+		From:        from,
+		To:          to,
+		Added:       []ChecklistItem{},
+		Removed:     []ChecklistItem{},
+		Changed:     []ChecklistItemChange{},
+	}
+
+	fromItems := make(map[uint]ChecklistItem, len(from.Items))
+	for _, item := range from.Items {
+		fromItems[item.Id] = item
+	}
+	toItemIds := make(map[uint]bool, len(to.Items))
+
+	for _, after := range to.Items {
+		toItemIds[after.Id] = true
+		before, existed := fromItems[after.Id]
+		if !existed {
+			diff.Added = append(diff.Added, after)
+			continue
+		}
+		if fields := changedItemFields(before, after); len(fields) > 0 {
+			diff.Changed = append(diff.Changed, ChecklistItemChange{ItemId: after.Id, Before: before, After: after, Fields: fields})
+		}
+	}
+	for _, before := range from.Items {
+		if !toItemIds[before.Id] {
+			diff.Removed = append(diff.Removed, before)
+		}
+	}
+	return diff
+}
+
+func changedItemFields(before ChecklistItem, after ChecklistItem) []ChecklistItemChangeField {
+	var fields []ChecklistItemChangeField
+	if before.Name != after.Name {
+		fields = append(fields, ItemChangeName)
+	}
+	if before.Completed != after.Completed {
+		fields = append(fields, ItemChangeCompleted)
+	}
+	if before.OrderNumber != after.OrderNumber {
+		fields = append(fields, ItemChangeOrder)
+	}
+	if rowsDiffer(before.Rows, after.Rows) {
+		fields = append(fields, ItemChangeRows)
+	}
+	return fields
+}
+
+func rowsDiffer(before []ChecklistItemRow, after []ChecklistItemRow) bool {
+	if len(before) != len(after) {
+		return true
+	}
+	beforeRows := make(map[uint]ChecklistItemRow, len(before))
+	for _, row := range before {
+		beforeRows[row.Id] = row
+	}
+	for _, row := range after {
+		previous, existed := beforeRows[row.Id]
+		if !existed || previous.Name != row.Name || previous.Completed != row.Completed {
+			return true
+		}
+	}
+	return false
+}
@@ -8,22 +8,28 @@ type ChecklistItem struct {
 	Completed   bool
 	Rows        []ChecklistItemRow
 	OrderNumber uint
-	Position    float64
+	Position    string     // Fractional key, see PositionBetween
 	DeletedAt   *time.Time // Soft delete timestamp (nil = active)
 	DeletedBy   string     // User ID who deleted (for audit)
+	CompletedBy *string    // User ID who completed the item (nil = not completed)
+	CompletedAt *time.Time // Completion timestamp (nil = not completed)
+	SectionId   *uint      // Checklist section the item belongs to (nil = no section)
+	Notes       *string    // Optional markdown notes (nil = no notes)
 }
 
-// Gap algorithm constants
-const (
-	DefaultGapSize    = 1000.0
-	MinGapThreshold   = 0.001
-	FirstItemPosition = 1000.0
-)
-
 type ChecklistItemRow struct {
-	Id        uint
-	Name      string
-	Completed bool
+	Id          uint
+	Name        string
+	Completed   bool
+	Position    string     // Fractional key, rows are shown in position order
+	CompletedBy *string    // User ID who completed the row (nil = not completed)
+	CompletedAt *time.Time // Completion timestamp (nil = not completed)
+}
+
+// ChecklistItemToggleResult carries the toggled item and the completion state it had before the toggle
+type ChecklistItemToggleResult struct {
+	Item         ChecklistItem
+	WasCompleted bool
 }
 
 // ChecklistItemRowDeletionResult contains information about a row deletion operation
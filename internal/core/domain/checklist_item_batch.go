@@ -0,0 +1,46 @@
+package domain
+
+import "strings"
+
+// ChecklistItemBatchOperationType identifies a single operation inside a batch item update
+type ChecklistItemBatchOperationType string
+
+const (
+	BatchOperationToggle  ChecklistItemBatchOperationType = "TOGGLE"
+	BatchOperationRename  ChecklistItemBatchOperationType = "RENAME"
+	BatchOperationDelete  ChecklistItemBatchOperationType = "DELETE"
+	BatchOperationRestore ChecklistItemBatchOperationType = "RESTORE"
+	BatchOperationMove    ChecklistItemBatchOperationType = "MOVE"
+)
+
+// MaxBatchOperations is the maximum number of operations accepted in one batch
+const MaxBatchOperations = 100
+
+func NewChecklistItemBatchOperationType(value string) (ChecklistItemBatchOperationType, Error) {
+	operationType := ChecklistItemBatchOperationType(strings.ToUpper(value))
+	switch operationType {
+	case BatchOperationToggle, BatchOperationRename, BatchOperationDelete, BatchOperationRestore, BatchOperationMove:
+		return operationType, nil
+	default:
+		return "", NewError("Unknown batch operation type: "+value, 400)
+	}
+}
+
+// ChecklistItemBatchOperation is one change applied to an item as part of a batch.
+// Only the field matching the operation type is read: Completed for TOGGLE, Name for RENAME
+// and OrderNumber for MOVE.
+type ChecklistItemBatchOperation struct {
+	Type        ChecklistItemBatchOperationType
+	ItemId      uint
+	Completed   *bool
+	Name        *string
+	OrderNumber *uint
+}
+
+// ChecklistItemBatchResult describes the outcome of an applied batch
+type ChecklistItemBatchResult struct {
+	ChecklistId     uint
+	Items           []ChecklistItem // Final state of every touched item that is not deleted
+	DeletedItemIds  []uint          // Items that are soft-deleted after the batch
+	RebalanceNeeded bool
+}
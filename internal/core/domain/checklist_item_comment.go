@@ -0,0 +1,34 @@
+package domain
+
+import "time"
+
+// ChecklistItemComment is a comment on a checklist item. Replies reference the comment they answer through
+// ParentId, which lets clients build threads from the flat list of an item's comments.
+type ChecklistItemComment struct {
+	Id          uint
+	ChecklistId uint
+	ItemId      uint
+	ParentId    *uint // Comment this comment replies to (nil = starts a thread)
+	Author      string
+	AuthorName  *string
+	Content     string
+	Mentions    []ChecklistItemCommentMention
+	CreatedAt   time.Time
+	EditedAt    *time.Time // Last edit by the author (nil = never edited)
+	Deleted     bool       // Deleted comments are only listed while they still have replies, without content
+}
+
+// ChecklistItemCommentMention is a user with access to the checklist mentioned in a comment
+type ChecklistItemCommentMention struct {
+	UserId string
+	Name   *string
+}
+
+// MentionedUserIds returns the ids of the users mentioned in the comment
+func (c ChecklistItemComment) MentionedUserIds() []string {
+	userIds := make([]string, 0, len(c.Mentions))
+	for _, mention := range c.Mentions {
+		userIds = append(userIds, mention.UserId)
+	}
+	return userIds
+}
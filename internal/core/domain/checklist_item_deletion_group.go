@@ -0,0 +1,9 @@
+package domain
+
+// ChecklistItemDeletionGroup is a set of items soft-deleted together by a single action,
+// such as clearing completed items, that can be restored together
+type ChecklistItemDeletionGroup struct {
+	Id          uint // Zero when the action did not delete anything
+	ChecklistId uint
+	ItemIds     []uint
+}
@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"regexp"
+	"strings"
+)
+
+// ChecklistItemSortKey selects what a one-shot sort of a checklist orders its items by
+type ChecklistItemSortKey string
+
+const (
+	// ItemSortByName orders items by name using the ICU collation of the requested locale
+	ItemSortByName ChecklistItemSortKey = "NAME"
+	// ItemSortByCreatedAt orders items by id, which follows the order they were created in
+	ItemSortByCreatedAt ChecklistItemSortKey = "CREATED_AT"
+)
+
+func NewChecklistItemSortKey(value string) (ChecklistItemSortKey, Error) {
+	key := ChecklistItemSortKey(strings.ToUpper(value))
+	switch key {
+	case ItemSortByName, ItemSortByCreatedAt:
+		return key, nil
+	default:
+		return "", NewError("Sort key can only be NAME or CREATED_AT", 400)
+	}
+}
+
+// sortLocalePattern matches BCP 47 language tags such as "et", "de-AT" or "sr-Latn-RS"
+var sortLocalePattern = regexp.MustCompile(`^[A-Za-z]{2,3}(-[A-Za-z0-9]{2,8})*$`)
+
+// NewChecklistItemSortLocale validates a BCP 47 language tag and writes it the way ICU collations are named:
+// a lowercase language, a titlecase script and an uppercase region ("sr-latn-rs" becomes "sr-Latn-RS").
+// An empty tag stays empty and selects the root collation.
+func NewChecklistItemSortLocale(value string) (string, Error) {
+	if value == "" {
+		return "", nil
+	}
+	if !sortLocalePattern.MatchString(value) {
+		return "", NewError("Locale must be a language tag such as \"en\" or \"de-AT\"", 400)
+	}
+	subtags := strings.Split(value, "-")
+	subtags[0] = strings.ToLower(subtags[0])
+	for i, subtag := range subtags[1:] {
+		switch {
+		case len(subtag) == 4 && i == 0:
+			subtags[i+1] = strings.ToUpper(subtag[:1]) + strings.ToLower(subtag[1:])
+		case len(subtag) == 2 || (len(subtag) == 3 && subtag[0] >= '0' && subtag[0] <= '9'):
+			subtags[i+1] = strings.ToUpper(subtag)
+		default:
+			subtags[i+1] = strings.ToLower(subtag)
+		}
+	}
+	return strings.Join(subtags, "-"), nil
+}
+
+// ChecklistItemSortRequest rewrites the positions of all active items of a checklist in the requested order.
+// Completed items stay in their own section unless the checklist keeps items in place.
+type ChecklistItemSortRequest struct {
+	ChecklistId uint
+	SortBy      ChecklistItemSortKey
+	SortOrder   SortOrder
+	Locale      string // BCP 47 language tag whose collation sorts names; empty = root collation
+}
@@ -0,0 +1,33 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewChecklistItemSortLocale(t *testing.T) {
+	tests := []struct {
+		value, expected string
+	}{
+		{"", ""},
+		{"en", "en"},
+		{"DE-at", "de-AT"},
+		{"zh-hant-tw", "zh-Hant-TW"},
+		{"es-419", "es-419"},
+	}
+	for _, tt := range tests {
+		locale, err := NewChecklistItemSortLocale(tt.value)
+		require.Nil(t, err, "locale %q", tt.value)
+		assert.Equal(t, tt.expected, locale)
+	}
+}
+
+func TestNewChecklistItemSortLocale_Invalid(t *testing.T) {
+	for _, value := range []string{"e", "en_US", `en" COLLATE "C`, "en-", "english"} {
+		_, err := NewChecklistItemSortLocale(value)
+		require.NotNil(t, err, "locale %q", value)
+		assert.Equal(t, 400, err.ResponseCode())
+	}
+}
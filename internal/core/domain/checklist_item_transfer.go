@@ -0,0 +1,17 @@
+package domain
+
+// ChecklistItemTransferRequest describes moving or copying an item with its rows into another checklist.
+// OrderNumber is the 1-based position in the item's completion section of the target checklist;
+// nil places the item at the front, like a newly created item.
+type ChecklistItemTransferRequest struct {
+	SourceChecklistId uint
+	ChecklistItemId   uint
+	TargetChecklistId uint
+	OrderNumber       *uint
+}
+
+// ChecklistItemTransferResult carries the item as it exists in the target checklist after a move or copy
+type ChecklistItemTransferResult struct {
+	Item            ChecklistItem
+	RebalanceNeeded bool
+}
@@ -0,0 +1,29 @@
+package domain
+
+import "strings"
+
+// NormalizeItemName returns the form of an item or row name used to detect duplicates when
+// checklists are merged: case-insensitive and ignoring surrounding and repeated whitespace
+func NormalizeItemName(name string) string {
+	return strings.ToLower(strings.Join(strings.Fields(name), " "))
+}
+
+// ChecklistMergeResult describes the outcome of merging a source checklist into a target checklist
+type ChecklistMergeResult struct {
+	TargetChecklistId uint
+	SourceChecklistId uint
+	Items             []ChecklistItem // Final state of every target item that was moved in or received rows
+}
+
+// ChecklistSplitRequest selects the items that are moved out of a checklist into a new one
+type ChecklistSplitRequest struct {
+	Name    string
+	ItemIds []uint
+}
+
+// ChecklistSplitResult describes the checklist created by a split and the items moved into it
+type ChecklistSplitResult struct {
+	SourceChecklistId uint
+	Checklist         Checklist
+	Items             []ChecklistItem
+}
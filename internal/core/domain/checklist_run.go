@@ -0,0 +1,82 @@
+package domain
+
+import (
+	"strings"
+	"time"
+)
+
+type ChecklistRunStepStatus string
+
+const (
+	RunStepPending       ChecklistRunStepStatus = "PENDING"
+	RunStepCompleted     ChecklistRunStepStatus = "COMPLETED"
+	RunStepNotApplicable ChecklistRunStepStatus = "NOT_APPLICABLE"
+)
+
+func NewChecklistRunStepStatus(value string) (ChecklistRunStepStatus, Error) {
+	status := ChecklistRunStepStatus(strings.ToUpper(value))
+	switch status {
+	case RunStepPending, RunStepCompleted, RunStepNotApplicable:
+		return status, nil
+	default:
+		return "", NewError("Status can only be PENDING, COMPLETED or NOT_APPLICABLE", 400)
+	}
+}
+
+// ChecklistRun is a single execution of a checklist. Items and rows are copied
+// into the run when it is started, so the source checklist stays untouched.
+type ChecklistRun struct {
+	Id                   uint
+	ChecklistId          uint
+	StartedBy            string
+	StartedAt            time.Time
+	FinishedBy           *string
+	FinishedAt           *time.Time
+	DurationSeconds      *int64   // set when the run is finished
+	CompletionPercentage *float64 // set when the run is finished
+	Steps                []ChecklistRunStep
+}
+
+func (r ChecklistRun) IsFinished() bool {
+	return r.FinishedAt != nil
+}
+
+type ChecklistRunStep struct {
+	Id           uint
+	SourceItemId *uint // the item the step was copied from; it may have been deleted since
+	Name         string
+	OrderNumber  uint
+	Status       ChecklistRunStepStatus
+	CheckedBy    *string
+	CheckedAt    *time.Time
+	Rows         []ChecklistRunStepRow
+}
+
+type ChecklistRunStepRow struct {
+	Id          uint
+	SourceRowId *uint
+	Name        string
+	Status      ChecklistRunStepStatus
+	CheckedBy   *string
+	CheckedAt   *time.Time
+}
+
+// CalculateRunCompletion returns the percentage of completed steps, ignoring
+// steps marked as not applicable. A run with only N/A steps counts as complete.
+func CalculateRunCompletion(steps []ChecklistRunStep) float64 {
+	applicable := 0
+	completed := 0
+	for _, step := range steps {
+		switch step.Status {
+		case RunStepNotApplicable:
+			continue
+		case RunStepCompleted:
+			completed++
+		}
+		applicable++
+	}
+	if applicable == 0 {
+		return 100
+	}
+	return float64(completed) * 100 / float64(applicable)
+}
@@ -0,0 +1,35 @@
+package domain
+
+// ChecklistSection is a named group of items within a checklist, e.g. "Produce" or "Before departure".
+// Items without a section are shown before the first section. Order numbers and completed items sinking
+// to the bottom apply within each section.
+// Gap algorithm constants of section positions. A checklist has few sections, so they keep numeric positions
+// and are renumbered right away when a gap gets too small.
+const (
+	DefaultGapSize       = 1000.0
+	MinGapThreshold      = 0.001
+	FirstSectionPosition = 1000.0
+)
+
+type ChecklistSection struct {
+	Id          uint
+	ChecklistId uint
+	Name        string
+	Position    float64
+	OrderNumber uint
+	Collapsed   bool
+}
+
+// ChangeSectionOrderRequest moves a section to a new 1-based place among the sections of a checklist
+type ChangeSectionOrderRequest struct {
+	ChecklistId    uint
+	SectionId      uint
+	NewOrderNumber uint
+}
+
+// ChecklistSectionDeletionResult describes a deleted section and the items that were moved out of it
+type ChecklistSectionDeletionResult struct {
+	ChecklistId uint
+	SectionId   uint
+	ItemIds     []uint // Items that now belong to no section, appended after the existing unsectioned items
+}
@@ -17,6 +17,12 @@ type (
 var UserIdContextKey = userIdContextKey{}
 var HashedUserIdContextKey = hashedUserIdContextKey{}
 
+// NewSystemContext returns a context for changes the server makes on its own, e.g. position rebalancing. Its
+// client id is empty, so the events published with it reach every client.
+func NewSystemContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, ClientIdContextKey, "")
+}
+
 // GetUserIdFromContext returns the real user ID (for database operations)
 func GetUserIdFromContext(ctx context.Context) (string, Error) {
 	userIdValue := ctx.Value(UserIdContextKey)
@@ -0,0 +1,255 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Positions of items, item rows and template rows are fractional keys: strings that sort in the wanted order
+// when compared byte by byte (COLLATE "C" in the database). A key always fits between two other keys, so
+// moving or inserting an item only writes the item itself.
+//
+// A key is an integer part followed by a fraction, both in base 62 digits. The head character of the
+// integer part encodes its length ('a' = 1 digit, 'b' = 2 digits, ..., 'A'-'Z' for negative integers), so
+// appending and prepending grow keys logarithmically. Inserting between two neighbours extends the fraction.
+// The fraction never ends in the zero digit, otherwise no key would fit right after it.
+const positionDigits = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+// smallestPositionInteger is the lowest integer part, before which keys cannot be prepended without a fraction
+var smallestPositionInteger = "A" + strings.Repeat(positionDigits[:1], 26)
+
+// PositionCompactionLength is the key length above which the positions of a checklist are rewritten to short
+// keys. Long keys only appear after many moves into the same gap; they keep working, compaction just saves space.
+const PositionCompactionLength = 32
+
+// PositionBetween returns a key that sorts after before and ahead of after. An empty before means the start of
+// the list, an empty after the end of it.
+func PositionBetween(before, after string) (string, error) {
+	if before != "" {
+		if err := validatePosition(before); err != nil {
+			return "", err
+		}
+	}
+	if after != "" {
+		if err := validatePosition(after); err != nil {
+			return "", err
+		}
+	}
+	if before != "" && after != "" && before >= after {
+		return "", fmt.Errorf("position %q is not before %q", before, after)
+	}
+
+	if before == "" {
+		if after == "" {
+			return "a" + positionDigits[:1], nil
+		}
+		integer := positionInteger(after)
+		if integer == smallestPositionInteger {
+			return integer + positionMidpoint("", after[len(integer):]), nil
+		}
+		if integer < after {
+			return integer, nil
+		}
+		return decrementPositionInteger(integer)
+	}
+
+	integer := positionInteger(before)
+	fraction := before[len(integer):]
+	if after == "" {
+		next, err := incrementPositionInteger(integer)
+		if err != nil {
+			return integer + positionMidpoint(fraction, ""), nil
+		}
+		return next, nil
+	}
+
+	if afterInteger := positionInteger(after); integer == afterInteger {
+		return integer + positionMidpoint(fraction, after[len(afterInteger):]), nil
+	}
+	next, err := incrementPositionInteger(integer)
+	if err != nil {
+		return "", err
+	}
+	if next < after {
+		return next, nil
+	}
+	return integer + positionMidpoint(fraction, ""), nil
+}
+
+// PositionsBetween returns n ascending keys between before and after, spread so that the keys stay short
+func PositionsBetween(before, after string, n int) ([]string, error) {
+	switch {
+	case n <= 0:
+		return []string{}, nil
+	case n == 1:
+		position, err := PositionBetween(before, after)
+		return []string{position}, err
+	case after == "":
+		positions := make([]string, 0, n)
+		for range n {
+			position, err := PositionBetween(before, after)
+			if err != nil {
+				return nil, err
+			}
+			positions = append(positions, position)
+			before = position
+		}
+		return positions, nil
+	case before == "":
+		positions := make([]string, n)
+		for i := n - 1; i >= 0; i-- {
+			position, err := PositionBetween(before, after)
+			if err != nil {
+				return nil, err
+			}
+			positions[i] = position
+			after = position
+		}
+		return positions, nil
+	}
+
+	mid := n / 2
+	middle, err := PositionBetween(before, after)
+	if err != nil {
+		return nil, err
+	}
+	head, err := PositionsBetween(before, middle, mid)
+	if err != nil {
+		return nil, err
+	}
+	tail, err := PositionsBetween(middle, after, n-mid-1)
+	if err != nil {
+		return nil, err
+	}
+	return append(append(head, middle), tail...), nil
+}
+
+func validatePosition(position string) error {
+	if position == smallestPositionInteger {
+		return fmt.Errorf("invalid position %q", position)
+	}
+	length, err := positionIntegerLength(position[0])
+	if err != nil {
+		return err
+	}
+	if length > len(position) || strings.Trim(position[1:], positionDigits) != "" {
+		return fmt.Errorf("invalid position %q", position)
+	}
+	if len(position) > length && position[len(position)-1] == positionDigits[0] {
+		return fmt.Errorf("invalid position %q: fraction ends in zero", position)
+	}
+	return nil
+}
+
+func positionIntegerLength(head byte) (int, error) {
+	switch {
+	case head >= 'a' && head <= 'z':
+		return int(head-'a') + 2, nil
+	case head >= 'A' && head <= 'Z':
+		return int('Z'-head) + 2, nil
+	}
+	return 0, fmt.Errorf("invalid position head %q", head)
+}
+
+// positionInteger returns the integer part of a validated key
+func positionInteger(position string) string {
+	length, _ := positionIntegerLength(position[0])
+	return position[:length]
+}
+
+func incrementPositionInteger(integer string) (string, error) {
+	head, digits := integer[0], []byte(integer[1:])
+	for i := len(digits) - 1; i >= 0; i-- {
+		if d := strings.IndexByte(positionDigits, digits[i]) + 1; d < len(positionDigits) {
+			digits[i] = positionDigits[d]
+			return string(head) + string(digits), nil
+		}
+		digits[i] = positionDigits[0]
+	}
+	// every digit carried over: the integer gets one digit longer (or shorter for negative integers)
+	switch head {
+	case 'Z':
+		return "a" + positionDigits[:1], nil
+	case 'z':
+		return "", errors.New("position integer cannot be incremented")
+	}
+	head++
+	if head > 'a' {
+		digits = append(digits, positionDigits[0])
+	} else {
+		digits = digits[:len(digits)-1]
+	}
+	return string(head) + string(digits), nil
+}
+
+func decrementPositionInteger(integer string) (string, error) {
+	last := positionDigits[len(positionDigits)-1]
+	head, digits := integer[0], []byte(integer[1:])
+	for i := len(digits) - 1; i >= 0; i-- {
+		if d := strings.IndexByte(positionDigits, digits[i]) - 1; d >= 0 {
+			digits[i] = positionDigits[d]
+			return string(head) + string(digits), nil
+		}
+		digits[i] = last
+	}
+	switch head {
+	case 'a':
+		return "Z" + string(last), nil
+	case 'A':
+		return "", errors.New("position integer cannot be decremented")
+	}
+	head--
+	if head < 'Z' {
+		digits = append(digits, last)
+	} else {
+		digits = digits[:len(digits)-1]
+	}
+	return string(head) + string(digits), nil
+}
+
+// positionMidpoint returns a fraction between two fractions; an empty after means the end of the range
+func positionMidpoint(before, after string) string {
+	if after != "" {
+		// skip the common prefix, a missing digit of before counts as zero
+		n := 0
+		for n < len(after) && positionDigitAt(before, n) == after[n] {
+			n++
+		}
+		if n > 0 {
+			rest := ""
+			if n < len(before) {
+				rest = before[n:]
+			}
+			return after[:n] + positionMidpoint(rest, after[n:])
+		}
+	}
+
+	digitBefore := 0
+	if before != "" {
+		digitBefore = strings.IndexByte(positionDigits, before[0])
+	}
+	digitAfter := len(positionDigits)
+	if after != "" {
+		digitAfter = strings.IndexByte(positionDigits, after[0])
+	}
+	if digitAfter-digitBefore > 1 {
+		return string(positionDigits[(digitBefore+digitAfter+1)/2])
+	}
+	// consecutive digits: after with its first digit is still above before, or the fraction has to grow
+	if len(after) > 1 {
+		return after[:1]
+	}
+	rest := ""
+	if before != "" {
+		rest = before[1:]
+	}
+	return string(positionDigits[digitBefore]) + positionMidpoint(rest, "")
+}
+
+func positionDigitAt(fraction string, i int) byte {
+	if i < len(fraction) {
+		return fraction[i]
+	}
+	return positionDigits[0]
+}
@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPositionBetween(t *testing.T) {
+	tests := []struct {
+		before, after, expected string
+	}{
+		{"", "", "a0"},
+		{"a0", "", "a1"},
+		{"az", "", "b00"},
+		{"", "a0", "Zz"},
+		{"", "a0V", "a0"},
+		{"a0", "a1", "a0V"},
+		{"a0", "a0V", "a0G"},
+		{"a0V", "a1", "a0l"},
+		{"a1", "a2", "a1V"},
+		{"a0z", "a1", "a0zV"},
+	}
+	for _, tt := range tests {
+		position, err := PositionBetween(tt.before, tt.after)
+		require.NoError(t, err)
+		assert.Equal(t, tt.expected, position, "between %q and %q", tt.before, tt.after)
+	}
+}
+
+func TestPositionBetween_RejectsInvalidBounds(t *testing.T) {
+	for _, bounds := range [][2]string{{"a1", "a0"}, {"a0", "a0"}, {"a00", ""}, {"", "b0"}, {"a-", ""}} {
+		_, err := PositionBetween(bounds[0], bounds[1])
+		assert.Error(t, err, "between %q and %q", bounds[0], bounds[1])
+	}
+}
+
+func TestPositionBetween_RepeatedInsertsIntoTheSameGap(t *testing.T) {
+	// Inserting again and again right after the same item never runs out of room
+	before, after := "a0", "a1"
+	for range 1000 {
+		position, err := PositionBetween(before, after)
+		require.NoError(t, err)
+		require.Less(t, before, position)
+		require.Less(t, position, after)
+		after = position
+	}
+	assert.Less(t, len(after), 200)
+}
+
+func TestPositionBetween_AppendingKeepsKeysShort(t *testing.T) {
+	position := ""
+	for range 10000 {
+		next, err := PositionBetween(position, "")
+		require.NoError(t, err)
+		require.Less(t, position, next)
+		position = next
+	}
+	assert.Equal(t, "c1aH", position)
+}
+
+func TestPositionsBetween(t *testing.T) {
+	for _, bounds := range [][2]string{{"", ""}, {"a0", ""}, {"", "a0"}, {"a0", "a1"}} {
+		positions, err := PositionsBetween(bounds[0], bounds[1], 100)
+		require.NoError(t, err)
+		require.Len(t, positions, 100)
+		assert.True(t, slices.IsSorted(positions))
+		assert.Len(t, slices.Compact(slices.Clone(positions)), 100)
+		if bounds[0] != "" {
+			assert.Less(t, bounds[0], positions[0])
+		}
+		if bounds[1] != "" {
+			assert.Less(t, positions[99], bounds[1])
+		}
+	}
+}
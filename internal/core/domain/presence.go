@@ -0,0 +1,9 @@
+package domain
+
+// ChecklistViewer is a user with at least one open event stream of a checklist. Users who view a checklist
+// from several clients are listed once.
+type ChecklistViewer struct {
+	UserId        string  `json:"userId"`
+	Name          *string `json:"name"`
+	EditingItemId *uint   `json:"editingItemId"` // Item the user is editing, until the hint expires
+}
@@ -1,16 +1,50 @@
 package domain
 
+import "encoding/json"
+
 const (
-	EventTypeChecklistItemCreated     = "checklistItemCreated"
-	EventTypeChecklistItemUpdated     = "checklistItemUpdated"
-	EventTypeChecklistItemToggled     = "checklistItemToggled"
-	EventTypeChecklistItemReordered   = "checklistItemReordered"
-	EventTypeChecklistItemDeleted     = "checklistItemDeleted"
-	EventTypeChecklistItemSoftDeleted = "checklistItemSoftDeleted" // Soft delete (undo possible)
-	EventTypeChecklistItemRestored    = "checklistItemRestored"    // Undo soft delete
-	EventTypeChecklistItemRowDeleted  = "checklistItemRowDeleted"
-	EventTypeChecklistItemRowAdded    = "checklistItemRowAdded"
-	EventTypeBufferOverflow           = "bufferOverflow"
+	EventTypeChecklistItemCreated        = "checklistItemCreated"
+	EventTypeChecklistItemUpdated        = "checklistItemUpdated"
+	EventTypeChecklistItemToggled        = "checklistItemToggled"
+	EventTypeChecklistItemReordered      = "checklistItemReordered"
+	EventTypeChecklistItemDeleted        = "checklistItemDeleted"
+	EventTypeChecklistItemSoftDeleted    = "checklistItemSoftDeleted" // Soft delete (undo possible)
+	EventTypeChecklistItemRestored       = "checklistItemRestored"    // Undo soft delete
+	EventTypeChecklistItemRowDeleted     = "checklistItemRowDeleted"
+	EventTypeChecklistItemRowAdded       = "checklistItemRowAdded"
+	EventTypeChecklistItemsBatchUpdated  = "checklistItemsBatchUpdated" // Several items changed in one batch
+	EventTypeChecklistItemsSorted        = "checklistItemsSorted"       // All items were re-sorted at once
+	EventTypeChecklistMerged             = "checklistMerged"            // Checklist was merged into another one and archived
+	EventTypeChecklistSplit              = "checklistSplit"             // Items were split out into a new checklist
+	EventTypeChecklistSectionCreated     = "checklistSectionCreated"
+	EventTypeChecklistSectionUpdated     = "checklistSectionUpdated" // Renamed, collapsed or expanded
+	EventTypeChecklistSectionDeleted     = "checklistSectionDeleted"
+	EventTypeChecklistSectionReordered   = "checklistSectionReordered"
+	EventTypeChecklistItemCommentCreated = "checklistItemCommentCreated"
+	EventTypeChecklistItemCommentUpdated = "checklistItemCommentUpdated"
+	EventTypeChecklistItemCommentDeleted = "checklistItemCommentDeleted"
+	EventTypeResyncRequired              = "resyncRequired" // Missed events are no longer in the replay log
+	// EventTypeChecklistItemsOrderSnapshot replaces several reorders that a slow client had not received yet
+	EventTypeChecklistItemsOrderSnapshot = "checklistItemsOrderSnapshot"
+	// EventTypeChecklistItemPositionsRebalanced is sent after positions were rewritten to short keys, the order is unchanged
+	EventTypeChecklistItemPositionsRebalanced = "checklistItemPositionsRebalanced"
+
+	// Checklist-level events, delivered on the user stream
+	EventTypeChecklistCreated       = "checklistCreated"
+	EventTypeChecklistUpdated       = "checklistUpdated" // Renamed
+	EventTypeChecklistMoved         = "checklistMoved"   // Moved to another workspace or out of workspaces
+	EventTypeChecklistDeleted       = "checklistDeleted"
+	EventTypeChecklistStatsChanged  = "checklistStatsChanged"
+	EventTypeChecklistAccessGranted = "checklistAccessGranted" // Shared with the user or through a workspace
+	EventTypeChecklistAccessRevoked = "checklistAccessRevoked"
+	// EventTypeAccessChanged only makes the brokers reload which checklists the users can access, it is not
+	// sent to clients
+	EventTypeAccessChanged = "accessChanged"
+
+	// Presence events are sent to the viewers of a checklist; they have no id and are not replayed
+	EventTypePresenceJoined  = "presenceJoined"  // First stream of a user opened
+	EventTypePresenceLeft    = "presenceLeft"    // Last stream of a user closed
+	EventTypePresenceEditing = "presenceEditing" // Editing hint set, cleared or expired
 )
 
 type ChecklistItemToggledEventPayload struct {
@@ -19,9 +53,10 @@ type ChecklistItemToggledEventPayload struct {
 }
 
 type ChecklistItemReorderedEventPayload struct {
-	ItemId         uint `json:"itemId"`
-	NewOrderNumber uint `json:"newOrderNumber"`
-	OrderChanged   bool `json:"orderChanged"`
+	ItemId         uint  `json:"itemId"`
+	NewOrderNumber uint  `json:"newOrderNumber"`
+	OrderChanged   bool  `json:"orderChanged"`
+	SectionId      *uint `json:"sectionId"`
 }
 
 type ChecklistItemDeletedEventPayload struct {
@@ -48,11 +83,133 @@ type ChecklistItemRowDeletedPayload struct {
 	ItemId uint `json:"itemId"`
 }
 
-type BufferOverflowEventPayload struct {
+// ChecklistItemsBatchUpdatedEventPayload is sent once for a whole batch of item operations
+type ChecklistItemsBatchUpdatedEventPayload struct {
+	Items          []ChecklistItem `json:"items"`
+	DeletedItemIds []uint          `json:"deletedItemIds"`
+}
+
+// ChecklistItemPositionsRebalancedEventPayload carries the new position of every active item by item id
+type ChecklistItemPositionsRebalancedEventPayload struct {
+	Positions map[uint]string `json:"positions"`
+}
+
+// ChecklistItemsSortedEventPayload is sent once after a sort and carries the full new order of the items
+type ChecklistItemsSortedEventPayload struct {
+	ItemIds []uint `json:"itemIds"`
+}
+
+// ChecklistItemsOrderSnapshotEventPayload carries the current position of every active item
+type ChecklistItemsOrderSnapshotEventPayload struct {
+	Items []ChecklistItemOrder `json:"items"`
+}
+
+// ChecklistItemOrder is the position of an item within its section
+type ChecklistItemOrder struct {
+	ItemId      uint  `json:"itemId"`
+	SectionId   *uint `json:"sectionId"`
+	OrderNumber uint  `json:"orderNumber"`
+}
+
+// ChecklistMergedEventPayload is sent to the archived source checklist of a merge
+type ChecklistMergedEventPayload struct {
+	TargetChecklistId uint `json:"targetChecklistId"`
+}
+
+// ChecklistSplitEventPayload is sent to the source checklist when items are split out of it
+type ChecklistSplitEventPayload struct {
+	NewChecklistId uint   `json:"newChecklistId"`
+	ItemIds        []uint `json:"itemIds"`
+}
+
+// ChecklistSectionDeletedEventPayload is sent when a section is deleted. Its items are not deleted but
+// moved to the end of the items without a section.
+type ChecklistSectionDeletedEventPayload struct {
+	SectionId uint   `json:"sectionId"`
+	ItemIds   []uint `json:"itemIds"`
+}
+
+type ChecklistSectionReorderedEventPayload struct {
+	SectionId      uint `json:"sectionId"`
+	NewOrderNumber uint `json:"newOrderNumber"`
+}
+
+type ChecklistItemCommentDeletedEventPayload struct {
+	ItemId    uint `json:"itemId"`
+	CommentId uint `json:"commentId"`
+}
+
+// ResyncRequiredEventPayload is sent on reconnect when the events missed by the client can no longer be
+// replayed and the checklist has to be reloaded
+type ResyncRequiredEventPayload struct {
 	Message string `json:"message"`
 }
 
+// ChecklistEventPayload is sent when a checklist is created, renamed or moved
+type ChecklistEventPayload struct {
+	Name        string `json:"name"`
+	WorkspaceId *uint  `json:"workspaceId"`
+}
+
+type ChecklistDeletedEventPayload struct {
+	ChecklistId uint `json:"checklistId"`
+}
+
+type ChecklistStatsChangedEventPayload struct {
+	TotalItems     uint `json:"totalItems"`
+	CompletedItems uint `json:"completedItems"`
+}
+
+// ChecklistAccessEventPayload is sent to a user stream when the user gains or loses access to a checklist, and
+// ends the checklist streams of a user who lost access
+type ChecklistAccessEventPayload struct {
+	ChecklistId uint `json:"checklistId"`
+}
+
+// PresenceLeftEventPayload is sent when the last stream of a user on a checklist is closed
+type PresenceLeftEventPayload struct {
+	UserId string `json:"userId"`
+}
+
+// PresenceEditingEventPayload is sent when a user starts editing an item or stops (ItemId nil)
+type PresenceEditingEventPayload struct {
+	UserId string `json:"userId"`
+	ItemId *uint  `json:"itemId"`
+}
+
 type ChecklistItemUpdatesEvent struct {
-	EventType string
-	Payload   any
+	// Id increases with every event of a checklist and is sent as the SSE event id (0 = not replayable)
+	Id          uint64
+	ChecklistId uint
+	EventType   string
+	Payload     any
+	// AccessChangedFor lists the users whose accessible checklists changed with this event, so that their
+	// user streams are updated before the event is delivered
+	AccessChangedFor []string
+}
+
+// ChecklistEventRecord is a checklist event as it is shared between application instances. ClientId is the
+// client that caused the event, which does not receive it back.
+type ChecklistEventRecord struct {
+	Id          uint64
+	ChecklistId uint
+	EventId     uint64 // Id of the event within its checklist
+	ClientId    string
+	EventType   string
+	Payload     json.RawMessage
+	// AccessChangedFor is the same as in ChecklistItemUpdatesEvent
+	AccessChangedFor []string
+}
+
+// ChecklistPresenceRecord is a presence change shared between application instances. EventType is one of the
+// presence event types; a joined record is also repeated while the user keeps viewing the checklist on the
+// instance. Presence records are not stored.
+type ChecklistPresenceRecord struct {
+	InstanceId  string  `json:"instanceId"`
+	ChecklistId uint    `json:"checklistId"`
+	ClientId    string  `json:"clientId,omitempty"`
+	EventType   string  `json:"eventType"`
+	UserId      string  `json:"userId"`
+	Name        *string `json:"name,omitempty"`
+	ItemId      *uint   `json:"itemId,omitempty"`
 }
@@ -7,6 +7,7 @@ type Template struct {
 	UserId      string
 	Name        string
 	Description *string
+	Notes       *string // Markdown notes given to the item created from the template
 	WorkspaceIds []uint
 	Rows        []TemplateRow
 	CreatedAt   time.Time
@@ -18,7 +19,7 @@ type TemplateRow struct {
 	Id         uint
 	TemplateId uint
 	Name       string
-	Position   float64
+	Position   string
 	CreatedAt  time.Time
 	UpdatedAt  time.Time
 }
@@ -20,14 +20,19 @@ type ExportedChecklistItem struct {
 	Id          uint
 	Name        string
 	Completed   bool
+	CompletedBy *string
+	CompletedAt *time.Time
+	Notes       *string
 	OrderNumber int
 	Rows        []ExportedChecklistItemRow
 }
 
 type ExportedChecklistItemRow struct {
-	Id        uint
-	Name      string
-	Completed bool
+	Id          uint
+	Name        string
+	Completed   bool
+	CompletedBy *string
+	CompletedAt *time.Time
 }
 
 type ExportedChecklistShare struct {
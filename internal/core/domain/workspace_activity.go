@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+type WorkspaceActivityEventType string
+
+const (
+	WorkspaceMemberAdded        WorkspaceActivityEventType = "MEMBER_ADDED"
+	WorkspaceMemberRemoved      WorkspaceActivityEventType = "MEMBER_REMOVED"
+	WorkspaceMemberLeft         WorkspaceActivityEventType = "MEMBER_LEFT"
+	WorkspaceInviteCreated      WorkspaceActivityEventType = "INVITE_CREATED"
+	WorkspaceInviteClaimed      WorkspaceActivityEventType = "INVITE_CLAIMED"
+	WorkspaceChecklistMovedIn   WorkspaceActivityEventType = "CHECKLIST_MOVED_IN"
+	WorkspaceChecklistMovedOut  WorkspaceActivityEventType = "CHECKLIST_MOVED_OUT"
+	WorkspaceTemplateAssigned   WorkspaceActivityEventType = "TEMPLATE_ASSIGNED"
+	WorkspaceTemplateUnassigned WorkspaceActivityEventType = "TEMPLATE_UNASSIGNED"
+)
+
+func NewWorkspaceActivityEventType(value string) (WorkspaceActivityEventType, Error) {
+	switch eventType := WorkspaceActivityEventType(value); eventType {
+	case WorkspaceMemberAdded, WorkspaceMemberRemoved, WorkspaceMemberLeft,
+		WorkspaceInviteCreated, WorkspaceInviteClaimed,
+		WorkspaceChecklistMovedIn, WorkspaceChecklistMovedOut,
+		WorkspaceTemplateAssigned, WorkspaceTemplateUnassigned:
+		return eventType, nil
+	default:
+		return "", NewError(fmt.Sprintf("Unknown workspace event type %q", value), 400)
+	}
+}
+
+// WorkspaceActivity is a single append-only entry of the workspace activity feed.
+// Only the reference matching the event type is set (member events use SubjectUserId and so on).
+type WorkspaceActivity struct {
+	Id            uint
+	WorkspaceId   uint
+	EventType     WorkspaceActivityEventType
+	Actor         string
+	SubjectUserId *string
+	ChecklistId   *uint
+	TemplateId    *uint
+	InviteId      *uint
+	Summary       *string
+	CreatedAt     time.Time
+}
+
+// WorkspaceActivityFilter narrows the feed down; nil fields are not filtered on
+type WorkspaceActivityFilter struct {
+	EventType *WorkspaceActivityEventType
+	Actor     *string
+}
+
+// WorkspaceActivityPage is a page of feed entries ordered from newest to oldest.
+// NextCursor is the value to pass as "before" to fetch the next page (nil = no more entries).
+type WorkspaceActivityPage struct {
+	Entries    []WorkspaceActivity
+	NextCursor *uint
+}
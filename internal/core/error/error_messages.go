@@ -47,3 +47,11 @@ func NewInvalidInviteTokenError() domain.Error {
 func NewWorkspaceNotFoundError(workspaceId uint) domain.Error {
 	return domain.NewError(fmt.Sprintf("Workspace(id=%d) not found", workspaceId), 404)
 }
+
+func NewChecklistRunNotFoundError(runId uint) domain.Error {
+	return domain.NewError(fmt.Sprintf("Run(id=%d) not found", runId), 404)
+}
+
+func NewChecklistArchivedError(checklistId uint) domain.Error {
+	return domain.NewError(fmt.Sprintf("Checklist(id=%d) is archived and can no longer be changed", checklistId), 400)
+}
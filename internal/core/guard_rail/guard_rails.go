@@ -11,6 +11,8 @@ import (
 
 type IChecklistOwnershipChecker interface {
 	HasAccessToChecklist(ctx context.Context, checklistId uint) domain.Error
+	// CanModifyChecklist verifies access like HasAccessToChecklist and that the checklist is not archived
+	CanModifyChecklist(ctx context.Context, checklistId uint) domain.Error
 	IsChecklistOwner(ctx context.Context, checklistId uint) domain.Error
 }
 
@@ -36,6 +38,22 @@ func (service *checklistOwnershipCheckerService) HasAccessToChecklist(ctx contex
 	return nil
 }
 
+func (service *checklistOwnershipCheckerService) CanModifyChecklist(ctx context.Context, checklistId uint) domain.Error {
+	if err := service.HasAccessToChecklist(ctx, checklistId); err != nil {
+		return err
+	}
+
+	checklist, err := service.repository.FindChecklistById(ctx, checklistId)
+	if err != nil {
+		return err
+	} else if checklist == nil {
+		return error.NewChecklistNotFoundError(checklistId)
+	} else if checklist.ArchivedAt != nil {
+		return error.NewChecklistArchivedError(checklistId)
+	}
+	return nil
+}
+
 func (service *checklistOwnershipCheckerService) IsChecklistOwner(ctx context.Context, checklistId uint) domain.Error {
 	userId, err := domain.GetUserIdFromContext(ctx)
 	if err != nil {
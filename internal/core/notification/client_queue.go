@@ -0,0 +1,278 @@
+package notification
+
+import (
+	"context"
+	"expvar"
+	"log"
+	"sync"
+	"time"
+
+	"com.raunlo.checklist/internal/core/domain"
+)
+
+// Policies for clients that fall further behind than the buffer size
+const (
+	// SlowClientDisconnect closes the stream; the client reconnects with Last-Event-ID and gets the missed
+	// events replayed
+	SlowClientDisconnect = "disconnect"
+	// SlowClientResync drops the queued events and sends resyncRequired, so the client reloads the checklist
+	// without reconnecting
+	SlowClientResync = "resync"
+)
+
+// DefaultClientBufferSize is the number of events a client can fall behind when no buffer size is configured
+const DefaultClientBufferSize = 10
+
+// drainTimeout is how long the last events of an ended subscription wait for the client before they are dropped
+const drainTimeout = 10 * time.Second
+
+// ClientQueueConfiguration controls how events wait for clients that read them slower than they are published
+type ClientQueueConfiguration struct {
+	// BufferSize is the number of queued events per client after coalescing (0 = DefaultClientBufferSize)
+	BufferSize int
+	// SlowClientPolicy is SlowClientDisconnect (default) or SlowClientResync
+	SlowClientPolicy string
+}
+
+// Metrics of the event queues, published through expvar as "sse" on the metrics port
+var (
+	queueMetrics       = expvar.NewMap("sse")
+	eventsCoalesced    = new(expvar.Int)
+	eventsDropped      = new(expvar.Int)
+	slowClientsHandled = new(expvar.Int)
+)
+
+func init() {
+	queueMetrics.Set("eventsCoalesced", eventsCoalesced)
+	queueMetrics.Set("eventsDropped", eventsDropped)
+	queueMetrics.Set("slowClients", slowClientsHandled)
+}
+
+// clientChannel queues the events of one client and hands them to ch as fast as the client reads them. While
+// the client is behind, events that supersede queued ones are coalesced: updates of an item collapse to the
+// latest one and reorders collapse into one order snapshot. Only when the queue is still full after that the
+// slow client policy applies.
+type clientChannel struct {
+	ch        chan domain.ChecklistItemUpdatesEvent
+	config    ClientQueueConfiguration
+	loadOrder func(ctx context.Context, checklistId uint) ([]domain.ChecklistItemOrder, error)
+
+	mu      sync.Mutex
+	pending []domain.ChecklistItemUpdatesEvent
+	closed  bool // no further events are accepted
+	ending  bool // the queued events are still handed out before ch is closed
+	wake    chan struct{}
+	done    chan struct{}
+
+	closeOnce sync.Once
+	// userId and userName identify the viewer for presence (userId empty = not shown)
+	userId   string
+	userName *string
+}
+
+func newClientChannel(config ClientQueueConfiguration,
+	loadOrder func(ctx context.Context, checklistId uint) ([]domain.ChecklistItemOrder, error)) *clientChannel {
+	if config.BufferSize <= 0 {
+		config.BufferSize = DefaultClientBufferSize
+	}
+	cc := &clientChannel{
+		ch:        make(chan domain.ChecklistItemUpdatesEvent),
+		config:    config,
+		loadOrder: loadOrder,
+		wake:      make(chan struct{}, 1),
+		done:      make(chan struct{}),
+	}
+	go cc.pump()
+	return cc
+}
+
+// Close stops the channel and drops the queued events
+func (cc *clientChannel) Close() {
+	cc.closeOnce.Do(func() {
+		cc.mu.Lock()
+		cc.closed = true
+		cc.pending = nil
+		cc.mu.Unlock()
+		close(cc.done)
+	})
+}
+
+// CloseAfterPending stops accepting events and closes ch once the queued events were read, so that the last
+// event tells the client why its stream ends
+func (cc *clientChannel) CloseAfterPending() {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	if cc.closed {
+		return
+	}
+	cc.closed = true
+	cc.ending = true
+	cc.signal()
+}
+
+// Send queues the event. It returns false when the event was not queued because the channel is closed or the
+// client is too far behind and has to be disconnected.
+func (cc *clientChannel) Send(event domain.ChecklistItemUpdatesEvent) bool {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	if cc.closed {
+		return false
+	}
+
+	var coalesced int
+	cc.pending, coalesced = coalesce(cc.pending, event)
+	eventsCoalesced.Add(int64(coalesced))
+	if len(cc.pending) > cc.config.BufferSize {
+		slowClientsHandled.Add(1)
+		eventsDropped.Add(int64(len(cc.pending)))
+		if cc.config.SlowClientPolicy != SlowClientResync {
+			cc.pending = nil
+			return false
+		}
+		cc.pending = []domain.ChecklistItemUpdatesEvent{newResyncRequiredEvent(event.ChecklistId)}
+	}
+	cc.signal()
+	return true
+}
+
+// signal wakes the pump. Must be called with mu held.
+func (cc *clientChannel) signal() {
+	select {
+	case cc.wake <- struct{}{}:
+	default:
+	}
+}
+
+// pump hands the queued events to ch until the channel is closed
+func (cc *clientChannel) pump() {
+	defer close(cc.ch)
+	var drain <-chan time.Time
+	for {
+		event, ok := cc.next()
+		if !ok {
+			return
+		}
+		if event.EventType == domain.EventTypeChecklistItemsOrderSnapshot && event.Payload == nil {
+			event = cc.resolveOrderSnapshot(event)
+		}
+		if drain == nil && cc.isEnding() {
+			drain = time.After(drainTimeout)
+		}
+		select {
+		case cc.ch <- event:
+		case <-cc.done:
+			return
+		case <-drain:
+			return
+		}
+	}
+}
+
+// next waits for the oldest queued event; false means the channel is done
+func (cc *clientChannel) next() (domain.ChecklistItemUpdatesEvent, bool) {
+	for {
+		cc.mu.Lock()
+		if len(cc.pending) > 0 {
+			event := cc.pending[0]
+			cc.pending = cc.pending[1:]
+			cc.mu.Unlock()
+			return event, true
+		}
+		finished := cc.closed
+		cc.mu.Unlock()
+		if finished {
+			return domain.ChecklistItemUpdatesEvent{}, false
+		}
+		select {
+		case <-cc.wake:
+		case <-cc.done:
+			return domain.ChecklistItemUpdatesEvent{}, false
+		}
+	}
+}
+
+func (cc *clientChannel) isEnding() bool {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	return cc.ending
+}
+
+// resolveOrderSnapshot loads the current order of the items when the snapshot is handed out, so that it
+// reflects every reorder it replaced. The client reloads the checklist when the order cannot be loaded.
+func (cc *clientChannel) resolveOrderSnapshot(event domain.ChecklistItemUpdatesEvent) domain.ChecklistItemUpdatesEvent {
+	items, err := cc.loadOrder(context.Background(), event.ChecklistId)
+	if err != nil {
+		log.Printf("sse: could not load item order of checklist %d: %v", event.ChecklistId, err)
+		resync := newResyncRequiredEvent(event.ChecklistId)
+		resync.Id = event.Id
+		return resync
+	}
+	event.Payload = domain.ChecklistItemsOrderSnapshotEventPayload{Items: items}
+	return event
+}
+
+// coalesce adds the event to the queue, replacing the queued events it supersedes, and returns how many
+// events were saved
+func coalesce(pending []domain.ChecklistItemUpdatesEvent, event domain.ChecklistItemUpdatesEvent) ([]domain.ChecklistItemUpdatesEvent, int) {
+	if len(pending) == 0 {
+		return append(pending, event), 0
+	}
+	switch event.EventType {
+	case domain.EventTypeChecklistItemUpdated:
+		item, ok := event.Payload.(domain.ChecklistItem)
+		if !ok {
+			break
+		}
+		for i, queued := range pending {
+			queuedItem, ok := queued.Payload.(domain.ChecklistItem)
+			if !ok || queued.ChecklistId != event.ChecklistId || queuedItem.Id != item.Id {
+				continue
+			}
+			switch queued.EventType {
+			case domain.EventTypeChecklistItemCreated:
+				// the client has not seen the item yet, it is created with its latest state. The event keeps its
+				// id, ids handed to the client must not go backwards.
+				pending[i].Payload = item
+				return pending, 1
+			case domain.EventTypeChecklistItemUpdated:
+				return append(removeEvent(pending, i), event), 1
+			}
+		}
+	case domain.EventTypeChecklistItemReordered, domain.EventTypeChecklistItemsSorted,
+		domain.EventTypeChecklistItemsOrderSnapshot:
+		kept := pending[:0]
+		for _, queued := range pending {
+			if queued.ChecklistId == event.ChecklistId && isOrderEvent(queued.EventType) {
+				continue
+			}
+			kept = append(kept, queued)
+		}
+		if replaced := len(pending) - len(kept); replaced > 0 {
+			return append(kept, domain.ChecklistItemUpdatesEvent{
+				Id:          event.Id,
+				ChecklistId: event.ChecklistId,
+				EventType:   domain.EventTypeChecklistItemsOrderSnapshot,
+			}), replaced
+		}
+	}
+	return append(pending, event), 0
+}
+
+func isOrderEvent(eventType string) bool {
+	return eventType == domain.EventTypeChecklistItemReordered || eventType == domain.EventTypeChecklistItemsSorted ||
+		eventType == domain.EventTypeChecklistItemsOrderSnapshot
+}
+
+func removeEvent(pending []domain.ChecklistItemUpdatesEvent, i int) []domain.ChecklistItemUpdatesEvent {
+	return append(pending[:i], pending[i+1:]...)
+}
+
+func newResyncRequiredEvent(checklistId uint) domain.ChecklistItemUpdatesEvent {
+	return domain.ChecklistItemUpdatesEvent{
+		ChecklistId: checklistId,
+		EventType:   domain.EventTypeResyncRequired,
+		Payload: domain.ResyncRequiredEventPayload{
+			Message: "Too many events were missed, please reload the checklist",
+		},
+	}
+}
@@ -0,0 +1,96 @@
+package notification
+
+import (
+	"testing"
+
+	"com.raunlo.checklist/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// newStoppedClientChannel returns a client channel whose pump is not running yet, so that events stay queued
+func newStoppedClientChannel(config ClientQueueConfiguration, repo *mockChecklistRepository) *clientChannel {
+	return &clientChannel{
+		ch:        make(chan domain.ChecklistItemUpdatesEvent),
+		config:    config,
+		loadOrder: (&broker{checklistRepository: repo}).loadItemOrder,
+		wake:      make(chan struct{}, 1),
+		done:      make(chan struct{}),
+	}
+}
+
+func itemEvent(id uint64, eventType string, itemId uint, name string) domain.ChecklistItemUpdatesEvent {
+	return domain.ChecklistItemUpdatesEvent{
+		Id:          id,
+		ChecklistId: 100,
+		EventType:   eventType,
+		Payload:     domain.ChecklistItem{Id: itemId, Name: name},
+	}
+}
+
+func TestClientChannel_Send_CoalescesItemUpdates(t *testing.T) {
+	cc := newStoppedClientChannel(ClientQueueConfiguration{BufferSize: 10}, new(mockChecklistRepository))
+
+	assert.True(t, cc.Send(itemEvent(1, domain.EventTypeChecklistItemCreated, 7, "Milk")))
+	assert.True(t, cc.Send(itemEvent(2, domain.EventTypeChecklistItemUpdated, 8, "Bread")))
+	assert.True(t, cc.Send(itemEvent(3, domain.EventTypeChecklistItemUpdated, 7, "Oat milk")))
+	assert.True(t, cc.Send(itemEvent(4, domain.EventTypeChecklistItemUpdated, 8, "Rye bread")))
+	go cc.pump()
+
+	// the created item keeps its id and carries the latest state
+	assert.Equal(t, itemEvent(1, domain.EventTypeChecklistItemCreated, 7, "Oat milk"), receiveEvent(t, cc.ch))
+	assert.Equal(t, itemEvent(4, domain.EventTypeChecklistItemUpdated, 8, "Rye bread"), receiveEvent(t, cc.ch))
+	cc.CloseAfterPending()
+	assertClosed(t, cc.ch)
+}
+
+func TestClientChannel_Send_CoalescesReordersIntoOrderSnapshot(t *testing.T) {
+	repo := new(mockChecklistRepository)
+	order := []domain.ChecklistItemOrder{{ItemId: 2, OrderNumber: 1}, {ItemId: 1, OrderNumber: 2}}
+	repo.On("FindChecklistItemOrder", mock.Anything, uint(100)).Return(order, nil).Once()
+	cc := newStoppedClientChannel(ClientQueueConfiguration{BufferSize: 10}, repo)
+
+	assert.True(t, cc.Send(domain.ChecklistItemUpdatesEvent{
+		Id: 1, ChecklistId: 100, EventType: domain.EventTypeChecklistItemReordered,
+		Payload: domain.ChecklistItemReorderedEventPayload{ItemId: 1, NewOrderNumber: 2},
+	}))
+	assert.True(t, cc.Send(itemEvent(2, domain.EventTypeChecklistItemUpdated, 3, "Eggs")))
+	assert.True(t, cc.Send(domain.ChecklistItemUpdatesEvent{
+		Id: 3, ChecklistId: 100, EventType: domain.EventTypeChecklistItemsSorted,
+		Payload: domain.ChecklistItemsSortedEventPayload{ItemIds: []uint{2, 1}},
+	}))
+	go cc.pump()
+
+	assert.Equal(t, itemEvent(2, domain.EventTypeChecklistItemUpdated, 3, "Eggs"), receiveEvent(t, cc.ch))
+	assert.Equal(t, domain.ChecklistItemUpdatesEvent{
+		Id: 3, ChecklistId: 100, EventType: domain.EventTypeChecklistItemsOrderSnapshot,
+		Payload: domain.ChecklistItemsOrderSnapshotEventPayload{Items: order},
+	}, receiveEvent(t, cc.ch))
+	repo.AssertExpectations(t)
+	cc.Close()
+}
+
+func TestClientChannel_Send_SlowClientPolicy(t *testing.T) {
+	t.Run("disconnect", func(t *testing.T) {
+		cc := newStoppedClientChannel(ClientQueueConfiguration{BufferSize: 2}, new(mockChecklistRepository))
+		assert.True(t, cc.Send(itemEvent(1, domain.EventTypeChecklistItemDeleted, 1, "")))
+		assert.True(t, cc.Send(itemEvent(2, domain.EventTypeChecklistItemDeleted, 2, "")))
+		assert.False(t, cc.Send(itemEvent(3, domain.EventTypeChecklistItemDeleted, 3, "")))
+		assert.Empty(t, cc.pending)
+	})
+
+	t.Run("resync", func(t *testing.T) {
+		cc := newStoppedClientChannel(ClientQueueConfiguration{BufferSize: 2, SlowClientPolicy: SlowClientResync},
+			new(mockChecklistRepository))
+		for id := uint64(1); id <= 3; id++ {
+			assert.True(t, cc.Send(itemEvent(id, domain.EventTypeChecklistItemDeleted, uint(id), "")))
+		}
+		go cc.pump()
+
+		assert.Equal(t, newResyncRequiredEvent(100), receiveEvent(t, cc.ch))
+		assert.True(t, cc.Send(itemEvent(4, domain.EventTypeChecklistItemDeleted, 4, "")))
+		assert.Equal(t, uint64(4), receiveEvent(t, cc.ch).Id)
+		cc.Close()
+		assertClosed(t, cc.ch)
+	})
+}
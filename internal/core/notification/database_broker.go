@@ -0,0 +1,320 @@
+package notification
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"com.raunlo.checklist/internal/core/domain"
+	guardrail "com.raunlo.checklist/internal/core/guard_rail"
+	"com.raunlo.checklist/internal/core/repository"
+)
+
+const (
+	// listenerRetryDelay is how long the database broker waits before listening again after losing its connection
+	listenerRetryDelay = 5 * time.Second
+	// presenceHeartbeatInterval is how often an instance announces its viewers again
+	presenceHeartbeatInterval = 20 * time.Second
+	// presenceExpiry is how long the viewers of another instance are kept without being announced again, e.g.
+	// after their instance stopped
+	presenceExpiry = 3 * presenceHeartbeatInterval
+	// presenceQueueSize is the number of presence changes waiting to be published; more are dropped
+	presenceQueueSize = 256
+	// catchUpWindow is how many database ids before the last handled event are read again after a reconnect.
+	// The ids of all checklists come from one sequence, so an event of another checklist that was still being
+	// saved can commit after the last handled event with a lower id.
+	catchUpWindow = 1000
+)
+
+// databaseBroker shares events between application instances through the database. Clients subscribe to the
+// local broker of their instance; every published event is saved to the database and delivered by each
+// instance, including the one it was published on, to its own subscribers. Presence changes are sent through
+// the database without being stored, so every instance knows the viewers of the others.
+type databaseBroker struct {
+	local      *broker
+	repository repository.IChecklistEventRepository
+	// lastEventId is the highest database id of the handled events, firstEventId the id of the first one, and
+	// handledEventIds the last handled event id of each checklist. Listening resumes from them after a
+	// reconnect. Only the listener goroutine touches them.
+	lastEventId     uint64
+	firstEventId    uint64
+	handledEventIds map[uint]uint64
+	// instanceId tells the presence changes of this instance apart from those of the others
+	instanceId string
+	presence   chan domain.ChecklistPresenceRecord
+}
+
+// NewDatabaseBroker creates a broker for running several instances and starts listening for events
+func NewDatabaseBroker(guardrail guardrail.IChecklistOwnershipChecker, checklistRepository repository.IChecklistRepository,
+	userRepository repository.IUserRepository, repository repository.IChecklistEventRepository,
+	queueConfiguration ClientQueueConfiguration) IBroker {
+	b := &databaseBroker{
+		local: &broker{
+			checklistGuardrail:  guardrail,
+			checklistRepository: checklistRepository,
+			userRepository:      userRepository,
+			queueConfiguration:  queueConfiguration,
+		},
+		repository: repository,
+		instanceId: newInstanceId(),
+		presence:   make(chan domain.ChecklistPresenceRecord, presenceQueueSize),
+	}
+	b.local.presence.publish = b.queuePresence
+	go b.listen(context.Background())
+	go b.publishPresence(context.Background())
+	go b.announceViewers(context.Background())
+	return b
+}
+
+// newInstanceId returns a random id for this application instance
+func newInstanceId() string {
+	id := make([]byte, 8)
+	_, _ = rand.Read(id)
+	return hex.EncodeToString(id)
+}
+
+func (b *databaseBroker) Subscribe(ctx context.Context, checklistId uint) (chan domain.ChecklistItemUpdatesEvent, error) {
+	return b.local.Subscribe(ctx, checklistId)
+}
+
+func (b *databaseBroker) Unsubscribe(ctx context.Context, checklistId uint) error {
+	return b.local.Unsubscribe(ctx, checklistId)
+}
+
+func (b *databaseBroker) SubscribeUser(ctx context.Context) (chan domain.ChecklistItemUpdatesEvent, error) {
+	return b.local.SubscribeUser(ctx)
+}
+
+func (b *databaseBroker) UnsubscribeUser(ctx context.Context) error {
+	return b.local.UnsubscribeUser(ctx)
+}
+
+func (b *databaseBroker) Viewers(ctx context.Context, checklistId uint) ([]domain.ChecklistViewer, error) {
+	return b.local.Viewers(ctx, checklistId)
+}
+
+func (b *databaseBroker) SetEditingItem(ctx context.Context, checklistId uint, itemId *uint) error {
+	return b.local.SetEditingItem(ctx, checklistId, itemId)
+}
+
+// Publish saves the event in the background. The events of a checklist are saved one after another in the
+// order Publish was called, so the database numbers them in that order. When the event cannot be saved it is
+// still delivered to the clients of this instance, but without an id since it cannot be replayed.
+func (b *databaseBroker) Publish(ctx context.Context, checklistId uint, event domain.ChecklistItemUpdatesEvent) {
+	clientIdFromContext := ctx.Value(domain.ClientIdContextKey)
+	if clientIdFromContext == nil {
+		log.Print("sse: no clientId in context, skipping publish")
+		return
+	}
+	clientId := clientIdFromContext.(string)
+	b.local.publishes.add(checklistId, func() {
+		payload, err := json.Marshal(event.Payload)
+		if err != nil {
+			log.Printf("sse: could not encode %s event: %v", event.EventType, err)
+			return
+		}
+		saveErr := b.repository.SaveEvent(context.WithoutCancel(ctx), domain.ChecklistEventRecord{
+			ChecklistId:      checklistId,
+			ClientId:         clientId,
+			EventType:        event.EventType,
+			Payload:          payload,
+			AccessChangedFor: event.AccessChangedFor,
+		}, ReplayLogSize)
+		if saveErr != nil {
+			log.Printf("sse: %v, delivering to this instance only", saveErr)
+			b.local.deliver(checklistId, clientId, event)
+		}
+	})
+}
+
+// listen delivers the events of all instances until the context is cancelled, reconnecting after errors
+func (b *databaseBroker) listen(ctx context.Context) {
+	for {
+		err := b.repository.ListenForEvents(ctx, b.resumeAfterId(), b.handleEvent, b.handlePresence)
+		if ctx.Err() != nil {
+			return
+		}
+		log.Printf("sse: %v, listening again in %s", err, listenerRetryDelay)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(listenerRetryDelay):
+		}
+	}
+}
+
+// resumeAfterId is the database id after which the stored events are read again when listening resumes: a
+// window before the last handled event, but not before the first one, since older events were stored before
+// this instance started listening. Zero on the first connection.
+func (b *databaseBroker) resumeAfterId() uint64 {
+	if b.lastEventId <= catchUpWindow {
+		return b.firstEventId
+	}
+	return max(b.lastEventId-catchUpWindow, b.firstEventId)
+}
+
+// handleEvent delivers an event unless it was handled before. The events of a checklist are committed and
+// notified in the order of their event ids, so the event id of the last handled event tells which were.
+func (b *databaseBroker) handleEvent(record domain.ChecklistEventRecord) {
+	if record.EventId <= b.handledEventIds[record.ChecklistId] {
+		return
+	}
+	if b.handledEventIds == nil {
+		b.handledEventIds = map[uint]uint64{}
+	}
+	b.handledEventIds[record.ChecklistId] = record.EventId
+	if b.firstEventId == 0 {
+		b.firstEventId = record.Id
+	}
+	b.lastEventId = max(b.lastEventId, record.Id)
+	payload, err := decodeEventPayload(record.EventType, record.Payload)
+	if err != nil {
+		log.Printf("sse: skipping event(id=%d): %v", record.Id, err)
+		return
+	}
+	b.local.deliver(record.ChecklistId, record.ClientId, domain.ChecklistItemUpdatesEvent{
+		Id:               record.EventId,
+		EventType:        record.EventType,
+		Payload:          payload,
+		AccessChangedFor: record.AccessChangedFor,
+	})
+}
+
+// handlePresence applies the presence changes of the other instances
+func (b *databaseBroker) handlePresence(record domain.ChecklistPresenceRecord) {
+	if record.InstanceId == b.instanceId {
+		return
+	}
+	b.local.applyRemotePresence(record, time.Now())
+}
+
+// queuePresence queues a presence change of this instance for publishing. Presence is not replayed, so a
+// change is dropped rather than blocking when the queue is full.
+func (b *databaseBroker) queuePresence(record domain.ChecklistPresenceRecord) {
+	record.InstanceId = b.instanceId
+	select {
+	case b.presence <- record:
+	default:
+		log.Printf("sse: presence queue is full, dropping %s of checklist(id=%d)", record.EventType, record.ChecklistId)
+	}
+}
+
+// publishPresence publishes the queued presence changes in order until the context is cancelled
+func (b *databaseBroker) publishPresence(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case record := <-b.presence:
+			if err := b.repository.PublishPresence(ctx, record); err != nil {
+				log.Printf("sse: %v", err)
+			}
+		}
+	}
+}
+
+// announceViewers repeats the viewers of this instance for the other instances and drops the viewers of
+// instances that stopped announcing theirs, until the context is cancelled
+func (b *databaseBroker) announceViewers(ctx context.Context) {
+	ticker := time.NewTicker(presenceHeartbeatInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			for _, record := range b.local.localViewers() {
+				b.queuePresence(record)
+			}
+			b.local.expireRemoteViewers(now.Add(-presenceExpiry))
+		}
+	}
+}
+
+func (b *databaseBroker) Replay(ctx context.Context, checklistId uint, lastEventId uint64) ([]domain.ChecklistItemUpdatesEvent, bool, error) {
+	records, checklistLastEventId, err := b.repository.FindEventsAfter(ctx, checklistId, lastEventId)
+	if err != nil {
+		return nil, false, err
+	}
+	if lastEventId > checklistLastEventId {
+		return nil, false, nil
+	}
+	if len(records) < int(checklistLastEventId-lastEventId) {
+		// the oldest missed events were trimmed from the replay log or purged by retention
+		return nil, false, nil
+	}
+
+	events := make([]domain.ChecklistItemUpdatesEvent, 0, len(records))
+	for _, record := range records {
+		payload, err := decodeEventPayload(record.EventType, record.Payload)
+		if err != nil {
+			return nil, false, err
+		}
+		events = append(events, domain.ChecklistItemUpdatesEvent{
+			Id:        record.EventId,
+			EventType: record.EventType,
+			Payload:   payload,
+		})
+	}
+	return events, true, nil
+}
+
+// decodeEventPayload restores the payload type that the notification service published for the event type
+func decodeEventPayload(eventType string, data json.RawMessage) (any, error) {
+	switch eventType {
+	case domain.EventTypeChecklistItemCreated, domain.EventTypeChecklistItemUpdated:
+		return decodePayload[domain.ChecklistItem](data)
+	case domain.EventTypeChecklistItemReordered:
+		return decodePayload[domain.ChecklistItemReorderedEventPayload](data)
+	case domain.EventTypeChecklistItemDeleted:
+		return decodePayload[domain.ChecklistItemDeletedEventPayload](data)
+	case domain.EventTypeChecklistItemSoftDeleted:
+		return decodePayload[domain.ChecklistItemSoftDeletedEventPayload](data)
+	case domain.EventTypeChecklistItemRestored:
+		return decodePayload[domain.ChecklistItemRestoredEventPayload](data)
+	case domain.EventTypeChecklistItemRowAdded:
+		return decodePayload[domain.ChecklistItemRowAddedPayload](data)
+	case domain.EventTypeChecklistItemRowDeleted:
+		return decodePayload[domain.ChecklistItemRowDeletedPayload](data)
+	case domain.EventTypeChecklistItemsBatchUpdated:
+		return decodePayload[domain.ChecklistItemsBatchUpdatedEventPayload](data)
+	case domain.EventTypeChecklistItemsSorted:
+		return decodePayload[domain.ChecklistItemsSortedEventPayload](data)
+	case domain.EventTypeChecklistItemPositionsRebalanced:
+		return decodePayload[domain.ChecklistItemPositionsRebalancedEventPayload](data)
+	case domain.EventTypeChecklistMerged:
+		return decodePayload[domain.ChecklistMergedEventPayload](data)
+	case domain.EventTypeChecklistSplit:
+		return decodePayload[domain.ChecklistSplitEventPayload](data)
+	case domain.EventTypeChecklistSectionCreated, domain.EventTypeChecklistSectionUpdated:
+		return decodePayload[domain.ChecklistSection](data)
+	case domain.EventTypeChecklistSectionDeleted:
+		return decodePayload[domain.ChecklistSectionDeletedEventPayload](data)
+	case domain.EventTypeChecklistSectionReordered:
+		return decodePayload[domain.ChecklistSectionReorderedEventPayload](data)
+	case domain.EventTypeChecklistItemCommentCreated, domain.EventTypeChecklistItemCommentUpdated:
+		return decodePayload[domain.ChecklistItemComment](data)
+	case domain.EventTypeChecklistItemCommentDeleted:
+		return decodePayload[domain.ChecklistItemCommentDeletedEventPayload](data)
+	case domain.EventTypeChecklistCreated, domain.EventTypeChecklistUpdated, domain.EventTypeChecklistMoved:
+		return decodePayload[domain.ChecklistEventPayload](data)
+	case domain.EventTypeChecklistDeleted:
+		return decodePayload[domain.ChecklistDeletedEventPayload](data)
+	case domain.EventTypeAccessChanged:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("unknown event type %q", eventType)
+	}
+}
+
+func decodePayload[T any](data json.RawMessage) (any, error) {
+	var payload T
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
@@ -0,0 +1,348 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"com.raunlo.checklist/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// mockChecklistEventRepository uses testify's mock for repository.IChecklistEventRepository.
+type mockChecklistEventRepository struct {
+	mock.Mock
+}
+
+func (m *mockChecklistEventRepository) SaveEvent(ctx context.Context, event domain.ChecklistEventRecord, replayLogSize int) domain.Error {
+	args := m.Called(ctx, event, replayLogSize)
+	if arg := args.Get(0); arg != nil {
+		return arg.(domain.Error)
+	}
+	return nil
+}
+
+func (m *mockChecklistEventRepository) FindEventsAfter(ctx context.Context, checklistId uint, afterEventId uint64) ([]domain.ChecklistEventRecord, uint64, domain.Error) {
+	args := m.Called(ctx, checklistId, afterEventId)
+	var events []domain.ChecklistEventRecord
+	var err domain.Error
+	if arg := args.Get(0); arg != nil {
+		events = arg.([]domain.ChecklistEventRecord)
+	}
+	if arg := args.Get(2); arg != nil {
+		err = arg.(domain.Error)
+	}
+	return events, args.Get(1).(uint64), err
+}
+
+func (m *mockChecklistEventRepository) ListenForEvents(ctx context.Context, afterId uint64, handler func(event domain.ChecklistEventRecord),
+	presenceHandler func(record domain.ChecklistPresenceRecord)) domain.Error {
+	args := m.Called(ctx, afterId, handler, presenceHandler)
+	if arg := args.Get(0); arg != nil {
+		return arg.(domain.Error)
+	}
+	return nil
+}
+
+func (m *mockChecklistEventRepository) PublishPresence(ctx context.Context, record domain.ChecklistPresenceRecord) domain.Error {
+	args := m.Called(ctx, record)
+	if arg := args.Get(0); arg != nil {
+		return arg.(domain.Error)
+	}
+	return nil
+}
+
+func (m *mockChecklistEventRepository) PurgeEventsOlderThan(ctx context.Context, retentionPeriod time.Duration) (int64, domain.Error) {
+	args := m.Called(ctx, retentionPeriod)
+	var err domain.Error
+	if arg := args.Get(1); arg != nil {
+		err = arg.(domain.Error)
+	}
+	return args.Get(0).(int64), err
+}
+
+// allowAllGuardrail gives every user access to every checklist.
+type allowAllGuardrail struct{}
+
+func (allowAllGuardrail) HasAccessToChecklist(ctx context.Context, checklistId uint) domain.Error {
+	return nil
+}
+
+func (allowAllGuardrail) CanModifyChecklist(ctx context.Context, checklistId uint) domain.Error {
+	return nil
+}
+
+func (allowAllGuardrail) IsChecklistOwner(ctx context.Context, checklistId uint) domain.Error {
+	return nil
+}
+
+func clientContext(clientId string) context.Context {
+	return context.WithValue(context.Background(), domain.ClientIdContextKey, clientId)
+}
+
+func newTestDatabaseBroker(repo *mockChecklistEventRepository) *databaseBroker {
+	return &databaseBroker{
+		local:      &broker{checklistGuardrail: allowAllGuardrail{}, checklistRepository: new(mockChecklistRepository)},
+		repository: repo,
+	}
+}
+
+func TestDatabaseBroker_HandleEvent_DeliversToOtherClients(t *testing.T) {
+	b := newTestDatabaseBroker(new(mockChecklistEventRepository))
+	origin, err := b.Subscribe(clientContext("client-a"), 100)
+	assert.NoError(t, err)
+	other, err := b.Subscribe(clientContext("client-b"), 100)
+	assert.NoError(t, err)
+
+	notes := "Oat milk"
+	item := domain.ChecklistItem{Id: 7, Name: "Milk", OrderNumber: 2, Position: "a1", Notes: &notes}
+	payload, _ := json.Marshal(item)
+	b.handleEvent(domain.ChecklistEventRecord{
+		Id:          1,
+		ChecklistId: 100,
+		EventId:     5,
+		ClientId:    "client-a",
+		EventType:   domain.EventTypeChecklistItemUpdated,
+		Payload:     payload,
+	})
+
+	event := receiveEvent(t, other)
+	assert.Equal(t, uint64(5), event.Id)
+	assert.Equal(t, domain.EventTypeChecklistItemUpdated, event.EventType)
+	assert.Equal(t, item, event.Payload)
+	assertNoEvent(t, origin, "originating client must not receive its own event")
+}
+
+func TestDatabaseBroker_HandleEvent_SkipsUnknownEventType(t *testing.T) {
+	b := newTestDatabaseBroker(new(mockChecklistEventRepository))
+	ch, err := b.Subscribe(clientContext("client-b"), 100)
+	assert.NoError(t, err)
+
+	b.handleEvent(domain.ChecklistEventRecord{Id: 1, ChecklistId: 100, ClientId: "client-a", EventType: "unknown", Payload: []byte("{}")})
+
+	assertNoEvent(t, ch)
+}
+
+func TestDatabaseBroker_HandleEvent_DoesNotWaitForAccessQueries(t *testing.T) {
+	b := newTestDatabaseBroker(new(mockChecklistEventRepository))
+	b.local = newPresenceTestBroker()
+	checklistRepo := b.local.checklistRepository.(*mockChecklistRepository)
+	release := make(chan time.Time)
+	defer close(release)
+	checklistRepo.On("FindAccessibleChecklistIds", mock.Anything, "user-a").WaitUntil(release).Return([]uint{}, nil)
+	viewer, err := b.Subscribe(userContext("user-b", "client-b"), 100)
+	assert.NoError(t, err)
+	_, err = b.Subscribe(userContext("user-a", "client-a"), 100)
+	assert.NoError(t, err)
+	receiveEvent(t, viewer) // user-a joined
+
+	handled := make(chan struct{})
+	go func() {
+		b.handleEvent(domain.ChecklistEventRecord{Id: 1, ChecklistId: 100, EventId: 1, ClientId: "client-b",
+			EventType: domain.EventTypeAccessChanged, Payload: []byte("{}"), AccessChangedFor: []string{"user-a"}})
+		close(handled)
+	}()
+
+	select {
+	case <-handled:
+	case <-time.After(time.Second):
+		t.Fatal("the listener must not wait for the access of the subscriptions to be checked")
+	}
+}
+
+func TestDatabaseBroker_Listen_ResumesAfterLastHandledEvent(t *testing.T) {
+	repo := new(mockChecklistEventRepository)
+	b := newTestDatabaseBroker(repo)
+	b.handleEvent(domain.ChecklistEventRecord{Id: 21, ChecklistId: 100, EventId: 5, ClientId: "client-a",
+		EventType: domain.EventTypeChecklistItemDeleted, Payload: []byte(`{"itemId":7}`)})
+	b.handleEvent(domain.ChecklistEventRecord{Id: 20, ChecklistId: 200, EventId: 3, ClientId: "client-a",
+		EventType: domain.EventTypeChecklistItemDeleted, Payload: []byte(`{"itemId":8}`)})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	// the first handled event is the oldest one this instance has to read again
+	repo.On("ListenForEvents", ctx, uint64(21), mock.Anything, mock.Anything).Return(nil).Once()
+
+	b.listen(ctx)
+
+	repo.AssertExpectations(t)
+}
+
+func TestDatabaseBroker_Listen_ReadsWindowBeforeLastHandledEventAgain(t *testing.T) {
+	repo := new(mockChecklistEventRepository)
+	b := newTestDatabaseBroker(repo)
+	b.handleEvent(domain.ChecklistEventRecord{Id: 10, ChecklistId: 100, EventId: 1, ClientId: "client-a",
+		EventType: domain.EventTypeChecklistItemDeleted, Payload: []byte(`{"itemId":7}`)})
+	b.handleEvent(domain.ChecklistEventRecord{Id: 5000, ChecklistId: 100, EventId: 2, ClientId: "client-a",
+		EventType: domain.EventTypeChecklistItemDeleted, Payload: []byte(`{"itemId":8}`)})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	repo.On("ListenForEvents", ctx, uint64(5000-catchUpWindow), mock.Anything, mock.Anything).Return(nil).Once()
+
+	b.listen(ctx)
+
+	repo.AssertExpectations(t)
+}
+
+func TestDatabaseBroker_HandleEvent_SkipsEventsHandledBefore(t *testing.T) {
+	b := newTestDatabaseBroker(new(mockChecklistEventRepository))
+	ch, err := b.Subscribe(clientContext("client-b"), 100)
+	assert.NoError(t, err)
+	event := domain.ChecklistEventRecord{Id: 21, ChecklistId: 100, EventId: 5, ClientId: "client-a",
+		EventType: domain.EventTypeChecklistItemDeleted, Payload: []byte(`{"itemId":7}`)}
+
+	b.handleEvent(event)
+	assert.Equal(t, uint64(5), receiveEvent(t, ch).Id)
+
+	// read again from the catch-up window after a reconnect
+	b.handleEvent(event)
+	assertNoEvent(t, ch)
+
+	// an event of another checklist committed later with a lower id is still delivered
+	other, err := b.Subscribe(clientContext("client-b"), 200)
+	assert.NoError(t, err)
+	b.handleEvent(domain.ChecklistEventRecord{Id: 20, ChecklistId: 200, EventId: 3, ClientId: "client-a",
+		EventType: domain.EventTypeChecklistItemDeleted, Payload: []byte(`{"itemId":8}`)})
+	assert.Equal(t, uint64(3), receiveEvent(t, other).Id)
+}
+
+func TestDatabaseBroker_Publish_DeliversLocallyWhenEventCannotBeSaved(t *testing.T) {
+	repo := new(mockChecklistEventRepository)
+	b := newTestDatabaseBroker(repo)
+	ch, err := b.Subscribe(clientContext("client-b"), 100)
+	assert.NoError(t, err)
+
+	repo.On("SaveEvent", mock.Anything, mock.MatchedBy(func(event domain.ChecklistEventRecord) bool {
+		return event.ChecklistId == 100 && event.ClientId == "client-a" &&
+			event.EventType == domain.EventTypeChecklistItemDeleted && string(event.Payload) == `{"itemId":7}`
+	}), ReplayLogSize).Return(domain.NewError("connection refused", 500))
+
+	b.Publish(clientContext("client-a"), 100, domain.ChecklistItemUpdatesEvent{
+		EventType: domain.EventTypeChecklistItemDeleted,
+		Payload:   domain.ChecklistItemDeletedEventPayload{ItemId: 7},
+	})
+
+	select {
+	case event := <-ch:
+		assert.Equal(t, domain.ChecklistItemDeletedEventPayload{ItemId: 7}, event.Payload)
+	case <-time.After(time.Second):
+		t.Fatal("expected event to be delivered locally")
+	}
+	repo.AssertExpectations(t)
+}
+
+func TestDatabaseBroker_Replay_ReturnsMissedEvents(t *testing.T) {
+	repo := new(mockChecklistEventRepository)
+	b := newTestDatabaseBroker(repo)
+	repo.On("FindEventsAfter", mock.Anything, uint(100), uint64(3)).Return([]domain.ChecklistEventRecord{
+		{Id: 20, ChecklistId: 100, EventId: 4, ClientId: "client-a", EventType: domain.EventTypeChecklistItemDeleted, Payload: []byte(`{"itemId":7}`)},
+		{Id: 21, ChecklistId: 100, EventId: 5, ClientId: "client-a", EventType: domain.EventTypeChecklistItemsSorted, Payload: []byte(`{"itemIds":[2,1]}`)},
+	}, uint64(5), nil)
+
+	events, complete, err := b.Replay(context.Background(), 100, 3)
+
+	assert.NoError(t, err)
+	assert.True(t, complete)
+	assert.Equal(t, []domain.ChecklistItemUpdatesEvent{
+		{Id: 4, EventType: domain.EventTypeChecklistItemDeleted, Payload: domain.ChecklistItemDeletedEventPayload{ItemId: 7}},
+		{Id: 5, EventType: domain.EventTypeChecklistItemsSorted, Payload: domain.ChecklistItemsSortedEventPayload{ItemIds: []uint{2, 1}}},
+	}, events)
+}
+
+func TestDatabaseBroker_Replay_GapLargerThanLog(t *testing.T) {
+	repo := new(mockChecklistEventRepository)
+	b := newTestDatabaseBroker(repo)
+	repo.On("FindEventsAfter", mock.Anything, uint(100), uint64(3)).Return([]domain.ChecklistEventRecord{
+		{Id: 21, ChecklistId: 100, EventId: 5, ClientId: "client-a", EventType: domain.EventTypeChecklistItemsSorted, Payload: []byte(`{"itemIds":[2,1]}`)},
+	}, uint64(5), nil)
+
+	events, complete, err := b.Replay(context.Background(), 100, 3)
+
+	assert.NoError(t, err)
+	assert.False(t, complete)
+	assert.Empty(t, events)
+}
+
+// newPresenceTestDatabaseBroker creates a broker of one instance; connectPresence links the instances
+func newPresenceTestDatabaseBroker(instanceId string) *databaseBroker {
+	return &databaseBroker{local: newPresenceTestBroker(), instanceId: instanceId}
+}
+
+// connectPresence makes the presence changes of each instance reach the other one, like the database does
+func connectPresence(first, second *databaseBroker) {
+	first.local.presence.publish = func(record domain.ChecklistPresenceRecord) {
+		record.InstanceId = first.instanceId
+		second.handlePresence(record)
+	}
+	second.local.presence.publish = func(record domain.ChecklistPresenceRecord) {
+		record.InstanceId = second.instanceId
+		first.handlePresence(record)
+	}
+}
+
+func TestDatabaseBroker_Presence_SharedBetweenInstances(t *testing.T) {
+	first, second := newPresenceTestDatabaseBroker("instance-1"), newPresenceTestDatabaseBroker("instance-2")
+	connectPresence(first, second)
+	watcher, err := second.Subscribe(userContext("user-b", "client-b"), 100)
+	assert.NoError(t, err)
+
+	editor, err := first.Subscribe(userContext("user-a", "client-a"), 100)
+	assert.NoError(t, err)
+	joined := receiveEvent(t, watcher)
+	assert.Equal(t, domain.EventTypePresenceJoined, joined.EventType)
+	assert.Equal(t, domain.ChecklistViewer{UserId: "user-a", Name: new("Anna")}, joined.Payload)
+
+	viewers, err := second.Viewers(context.Background(), 100)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []domain.ChecklistViewer{
+		{UserId: "user-b", Name: new("Bert")},
+		{UserId: "user-a", Name: new("Anna")},
+	}, viewers)
+
+	// the editing hint of user-a reaches the instance that does not hold the stream of user-a
+	assert.NoError(t, second.SetEditingItem(userContext("user-a", "client-a"), 100, new(uint(7))))
+	editing := receiveEvent(t, watcher)
+	assert.Equal(t, domain.PresenceEditingEventPayload{UserId: "user-a", ItemId: new(uint(7))}, editing.Payload)
+	assertNoEvent(t, editor, "the editing client does not get its own hint back")
+	viewers, err = first.Viewers(context.Background(), 100)
+	assert.NoError(t, err)
+	assert.Contains(t, viewers, domain.ChecklistViewer{UserId: "user-a", Name: new("Anna"), EditingItemId: new(uint(7))})
+
+	assert.NoError(t, first.Unsubscribe(userContext("user-a", "client-a"), 100))
+	left := receiveEvent(t, watcher)
+	assert.Equal(t, domain.PresenceLeftEventPayload{UserId: "user-a"}, left.Payload)
+	assert.Error(t, second.SetEditingItem(userContext("user-a", "client-a"), 100, new(uint(7))), "user-a no longer views the checklist")
+}
+
+func TestDatabaseBroker_Presence_ExpiresViewersOfStoppedInstances(t *testing.T) {
+	b := newPresenceTestDatabaseBroker("instance-1")
+	watcher, err := b.Subscribe(userContext("user-b", "client-b"), 100)
+	assert.NoError(t, err)
+	seenAt := time.Now()
+	b.local.applyRemotePresence(domain.ChecklistPresenceRecord{InstanceId: "instance-2", ChecklistId: 100,
+		EventType: domain.EventTypePresenceJoined, UserId: "user-a", Name: new("Anna")}, seenAt)
+	receiveEvent(t, watcher) // joined
+
+	b.local.expireRemoteViewers(seenAt)
+	assertNoEvent(t, watcher, "announced recently enough")
+
+	b.local.expireRemoteViewers(seenAt.Add(time.Second))
+	left := receiveEvent(t, watcher)
+	assert.Equal(t, domain.PresenceLeftEventPayload{UserId: "user-a"}, left.Payload)
+	assert.Empty(t, b.local.presence.remote)
+}
+
+func TestDatabaseBroker_HandlePresence_SkipsOwnChanges(t *testing.T) {
+	b := newPresenceTestDatabaseBroker("instance-1")
+	watcher, err := b.Subscribe(userContext("user-b", "client-b"), 100)
+	assert.NoError(t, err)
+
+	b.handlePresence(domain.ChecklistPresenceRecord{InstanceId: "instance-1", ChecklistId: 100,
+		EventType: domain.EventTypePresenceJoined, UserId: "user-a"})
+
+	assertNoEvent(t, watcher)
+	assert.Empty(t, b.local.presence.remote)
+}
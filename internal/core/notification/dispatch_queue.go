@@ -0,0 +1,39 @@
+package notification
+
+import "sync"
+
+// dispatchQueue runs tasks in the background, one after another per checklist and in the order they were
+// added. A goroutine drains the tasks of a checklist while it has any; the zero value is ready to use.
+type dispatchQueue struct {
+	mu      sync.Mutex
+	pending map[uint][]func() // checklistId -> tasks not yet run; a key is present while its goroutine runs
+}
+
+// add queues the task after the earlier tasks of the checklist
+func (q *dispatchQueue) add(checklistId uint, task func()) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.pending == nil {
+		q.pending = map[uint][]func(){}
+	}
+	tasks, draining := q.pending[checklistId]
+	q.pending[checklistId] = append(tasks, task)
+	if !draining {
+		go q.drain(checklistId)
+	}
+}
+
+func (q *dispatchQueue) drain(checklistId uint) {
+	for {
+		q.mu.Lock()
+		tasks := q.pending[checklistId]
+		if len(tasks) == 0 {
+			delete(q.pending, checklistId)
+			q.mu.Unlock()
+			return
+		}
+		q.pending[checklistId] = tasks[1:]
+		q.mu.Unlock()
+		tasks[0]()
+	}
+}
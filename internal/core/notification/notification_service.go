@@ -4,10 +4,13 @@ import (
 	"context"
 	"errors"
 	"log"
+	"slices"
 	"sync"
+	"time"
 
 	"com.raunlo.checklist/internal/core/domain"
 	guardrail "com.raunlo.checklist/internal/core/guard_rail"
+	"com.raunlo.checklist/internal/core/repository"
 )
 
 type INotificationService interface {
@@ -19,6 +22,28 @@ type INotificationService interface {
 	NotifyItemRowAdded(ctx context.Context, checklistId uint, itemId uint, row domain.ChecklistItemRow)
 	NotifyItemRowDeleted(ctx context.Context, checklistId uint, itemId uint, rowId uint)
 	NotifyItemReordered(ctx context.Context, request domain.ChangeOrderRequest, resp domain.ChangeOrderResponse)
+	NotifyItemsBatchUpdated(ctx context.Context, checklistId uint, result domain.ChecklistItemBatchResult)
+	NotifyItemsSorted(ctx context.Context, checklistId uint, itemIds []uint)
+	// NotifyPositionsRebalanced takes the new positions of the active items by item id
+	NotifyPositionsRebalanced(ctx context.Context, checklistId uint, positions map[uint]string)
+	NotifyChecklistsMerged(ctx context.Context, result domain.ChecklistMergeResult)
+	NotifyChecklistSplit(ctx context.Context, result domain.ChecklistSplitResult)
+	NotifySectionCreated(ctx context.Context, section domain.ChecklistSection)
+	NotifySectionUpdated(ctx context.Context, section domain.ChecklistSection)
+	NotifySectionDeleted(ctx context.Context, result domain.ChecklistSectionDeletionResult)
+	NotifySectionReordered(ctx context.Context, section domain.ChecklistSection)
+	NotifyCommentCreated(ctx context.Context, comment domain.ChecklistItemComment)
+	NotifyCommentUpdated(ctx context.Context, comment domain.ChecklistItemComment)
+	NotifyCommentDeleted(ctx context.Context, checklistId uint, itemId uint, commentId uint)
+	// NotifyChecklistCreated takes the users with access to the new checklist, whose user streams start covering it
+	NotifyChecklistCreated(ctx context.Context, checklist domain.Checklist, userIds []string)
+	NotifyChecklistUpdated(ctx context.Context, checklist domain.Checklist)
+	// NotifyChecklistMoved takes the users with access after the move; users who lost access are found by the broker
+	NotifyChecklistMoved(ctx context.Context, checklist domain.Checklist, userIds []string)
+	NotifyChecklistDeleted(ctx context.Context, checklistId uint)
+	// NotifyAccessChanged updates the user streams of users who gained or lost access to checklists through a
+	// share or a workspace membership
+	NotifyAccessChanged(ctx context.Context, userIds []string)
 }
 
 type notificationService struct {
@@ -93,57 +118,264 @@ func (n *notificationService) NotifyItemReordered(ctx context.Context, request d
 			ItemId:         request.ChecklistItemId,
 			NewOrderNumber: resp.OrderNumber,
 			OrderChanged:   true,
+			SectionId:      resp.SectionId,
 		},
 	})
 }
 
+// NotifyItemsBatchUpdated publishes a single event for all items touched by a batch, so that large
+// batches do not flood the per-client buffer with one event per item
+func (n *notificationService) NotifyItemsBatchUpdated(ctx context.Context, checklistId uint, result domain.ChecklistItemBatchResult) {
+	n.broker.Publish(ctx, checklistId, domain.ChecklistItemUpdatesEvent{
+		EventType: domain.EventTypeChecklistItemsBatchUpdated,
+		Payload: domain.ChecklistItemsBatchUpdatedEventPayload{
+			Items:          result.Items,
+			DeletedItemIds: result.DeletedItemIds,
+		},
+	})
+}
+
+// NotifyItemsSorted publishes the full new order after a sort instead of one reorder event per item
+func (n *notificationService) NotifyItemsSorted(ctx context.Context, checklistId uint, itemIds []uint) {
+	n.broker.Publish(ctx, checklistId, domain.ChecklistItemUpdatesEvent{
+		EventType: domain.EventTypeChecklistItemsSorted,
+		Payload:   domain.ChecklistItemsSortedEventPayload{ItemIds: itemIds},
+	})
+}
+
+// NotifyPositionsRebalanced publishes all positions after a rebalance, so that clients computing positions for
+// optimistic reorders do not keep using the old ones
+func (n *notificationService) NotifyPositionsRebalanced(ctx context.Context, checklistId uint, positions map[uint]string) {
+	n.broker.Publish(ctx, checklistId, domain.ChecklistItemUpdatesEvent{
+		EventType: domain.EventTypeChecklistItemPositionsRebalanced,
+		Payload:   domain.ChecklistItemPositionsRebalancedEventPayload{Positions: positions},
+	})
+}
+
+// NotifyChecklistsMerged tells the target checklist which items were moved in or combined, and the
+// source checklist where its items went, so clients viewing it can follow along
+func (n *notificationService) NotifyChecklistsMerged(ctx context.Context, result domain.ChecklistMergeResult) {
+	n.NotifyItemsBatchUpdated(ctx, result.TargetChecklistId, domain.ChecklistItemBatchResult{
+		ChecklistId:    result.TargetChecklistId,
+		Items:          result.Items,
+		DeletedItemIds: []uint{},
+	})
+	n.broker.Publish(ctx, result.SourceChecklistId, domain.ChecklistItemUpdatesEvent{
+		EventType: domain.EventTypeChecklistMerged,
+		Payload:   domain.ChecklistMergedEventPayload{TargetChecklistId: result.TargetChecklistId},
+	})
+}
+
+// NotifyChecklistSplit tells the source checklist which items left and the new checklist what it received
+func (n *notificationService) NotifyChecklistSplit(ctx context.Context, result domain.ChecklistSplitResult) {
+	itemIds := make([]uint, 0, len(result.Items))
+	for _, item := range result.Items {
+		itemIds = append(itemIds, item.Id)
+	}
+	n.broker.Publish(ctx, result.SourceChecklistId, domain.ChecklistItemUpdatesEvent{
+		EventType: domain.EventTypeChecklistSplit,
+		Payload: domain.ChecklistSplitEventPayload{
+			NewChecklistId: result.Checklist.Id,
+			ItemIds:        itemIds,
+		},
+	})
+	n.NotifyItemsBatchUpdated(ctx, result.Checklist.Id, domain.ChecklistItemBatchResult{
+		ChecklistId:    result.Checklist.Id,
+		Items:          result.Items,
+		DeletedItemIds: []uint{},
+	})
+}
+
+func (n *notificationService) NotifySectionCreated(ctx context.Context, section domain.ChecklistSection) {
+	n.broker.Publish(ctx, section.ChecklistId, domain.ChecklistItemUpdatesEvent{
+		EventType: domain.EventTypeChecklistSectionCreated,
+		Payload:   section,
+	})
+}
+
+func (n *notificationService) NotifySectionUpdated(ctx context.Context, section domain.ChecklistSection) {
+	n.broker.Publish(ctx, section.ChecklistId, domain.ChecklistItemUpdatesEvent{
+		EventType: domain.EventTypeChecklistSectionUpdated,
+		Payload:   section,
+	})
+}
+
+// NotifySectionDeleted also carries the items that were moved out of the section, so clients can move them
+// without reloading the checklist
+func (n *notificationService) NotifySectionDeleted(ctx context.Context, result domain.ChecklistSectionDeletionResult) {
+	n.broker.Publish(ctx, result.ChecklistId, domain.ChecklistItemUpdatesEvent{
+		EventType: domain.EventTypeChecklistSectionDeleted,
+		Payload: domain.ChecklistSectionDeletedEventPayload{
+			SectionId: result.SectionId,
+			ItemIds:   result.ItemIds,
+		},
+	})
+}
+
+func (n *notificationService) NotifySectionReordered(ctx context.Context, section domain.ChecklistSection) {
+	n.broker.Publish(ctx, section.ChecklistId, domain.ChecklistItemUpdatesEvent{
+		EventType: domain.EventTypeChecklistSectionReordered,
+		Payload: domain.ChecklistSectionReorderedEventPayload{
+			SectionId:      section.Id,
+			NewOrderNumber: section.OrderNumber,
+		},
+	})
+}
+
+func (n *notificationService) NotifyCommentCreated(ctx context.Context, comment domain.ChecklistItemComment) {
+	n.broker.Publish(ctx, comment.ChecklistId, domain.ChecklistItemUpdatesEvent{
+		EventType: domain.EventTypeChecklistItemCommentCreated,
+		Payload:   comment,
+	})
+}
+
+func (n *notificationService) NotifyCommentUpdated(ctx context.Context, comment domain.ChecklistItemComment) {
+	n.broker.Publish(ctx, comment.ChecklistId, domain.ChecklistItemUpdatesEvent{
+		EventType: domain.EventTypeChecklistItemCommentUpdated,
+		Payload:   comment,
+	})
+}
+
+func (n *notificationService) NotifyCommentDeleted(ctx context.Context, checklistId uint, itemId uint, commentId uint) {
+	n.broker.Publish(ctx, checklistId, domain.ChecklistItemUpdatesEvent{
+		EventType: domain.EventTypeChecklistItemCommentDeleted,
+		Payload:   domain.ChecklistItemCommentDeletedEventPayload{ItemId: itemId, CommentId: commentId},
+	})
+}
+
+func (n *notificationService) NotifyChecklistCreated(ctx context.Context, checklist domain.Checklist, userIds []string) {
+	n.broker.Publish(ctx, checklist.Id, domain.ChecklistItemUpdatesEvent{
+		EventType:        domain.EventTypeChecklistCreated,
+		Payload:          domain.ChecklistEventPayload{Name: checklist.Name, WorkspaceId: checklist.WorkspaceId},
+		AccessChangedFor: userIds,
+	})
+}
+
+func (n *notificationService) NotifyChecklistUpdated(ctx context.Context, checklist domain.Checklist) {
+	n.broker.Publish(ctx, checklist.Id, domain.ChecklistItemUpdatesEvent{
+		EventType: domain.EventTypeChecklistUpdated,
+		Payload:   domain.ChecklistEventPayload{Name: checklist.Name, WorkspaceId: checklist.WorkspaceId},
+	})
+}
+
+func (n *notificationService) NotifyChecklistMoved(ctx context.Context, checklist domain.Checklist, userIds []string) {
+	n.broker.Publish(ctx, checklist.Id, domain.ChecklistItemUpdatesEvent{
+		EventType:        domain.EventTypeChecklistMoved,
+		Payload:          domain.ChecklistEventPayload{Name: checklist.Name, WorkspaceId: checklist.WorkspaceId},
+		AccessChangedFor: userIds,
+	})
+}
+
+func (n *notificationService) NotifyChecklistDeleted(ctx context.Context, checklistId uint) {
+	n.broker.Publish(ctx, checklistId, domain.ChecklistItemUpdatesEvent{
+		EventType: domain.EventTypeChecklistDeleted,
+		Payload:   domain.ChecklistDeletedEventPayload{ChecklistId: checklistId},
+	})
+}
+
+// NotifyAccessChanged is published without a checklist (id 0), since a membership change can affect many
+// checklists at once
+func (n *notificationService) NotifyAccessChanged(ctx context.Context, userIds []string) {
+	if len(userIds) == 0 {
+		return
+	}
+	n.broker.Publish(ctx, 0, domain.ChecklistItemUpdatesEvent{
+		EventType:        domain.EventTypeAccessChanged,
+		AccessChangedFor: userIds,
+	})
+}
+
 type IBroker interface {
 	// Subscribe registers a new client and returns a channel to receive messages.
 	Subscribe(ctx context.Context, checklistId uint) (chan domain.ChecklistItemUpdatesEvent, error)
 	// Unsubscribe removes a client channel.
 	Unsubscribe(ctx context.Context, checklistId uint) error
-	// Publish sends a message to all subscribed clients for a checklistId. Non-blocking runs in a goroutine.
+	// Publish sends a message to all subscribed clients for a checklistId. Non-blocking; the events of a
+	// checklist are numbered and delivered in the order of the calls.
 	Publish(ctx context.Context, checklistId uint, event domain.ChecklistItemUpdatesEvent)
+	// Replay returns the events of a checklist published after lastEventId, oldest first. The boolean is false
+	// when some of these events are no longer in the replay log. Call it after Subscribe, so that no event
+	// falls between the replayed events and the subscription.
+	Replay(ctx context.Context, checklistId uint, lastEventId uint64) ([]domain.ChecklistItemUpdatesEvent, bool, error)
+	// SubscribeUser registers a user stream, which receives the checklist-level events of every checklist the
+	// user can access, tagged with the checklist id
+	SubscribeUser(ctx context.Context) (chan domain.ChecklistItemUpdatesEvent, error)
+	// UnsubscribeUser removes a user stream.
+	UnsubscribeUser(ctx context.Context) error
+	// Viewers returns the users subscribed to the checklist, each listed once with the item they are editing
+	Viewers(ctx context.Context, checklistId uint) ([]domain.ChecklistViewer, error)
+	// SetEditingItem sets or clears (nil) the item the user of a subscribed client is editing. The hint expires
+	// after EditingHintTTL unless it is sent again.
+	SetEditingItem(ctx context.Context, checklistId uint, itemId *uint) error
 }
 
-// clientChannel wraps a channel with close-once semantics to prevent double-close panics
-type clientChannel struct {
-	ch        chan domain.ChecklistItemUpdatesEvent
-	closeOnce sync.Once
-	closed    bool
-}
+// ReplayLogSize is the number of recent events of a checklist that can be replayed to reconnecting clients
+const ReplayLogSize = 100
 
-func (cc *clientChannel) Close() {
-	cc.closeOnce.Do(func() {
-		cc.closed = true
-		close(cc.ch)
-	})
+// eventLogIdleTTL is how long the events of a checklist without subscribers are kept after its last event
+const eventLogIdleTTL = 10 * time.Minute
+
+// eventLog numbers the events of one checklist and keeps the most recent ones for replay
+type eventLog struct {
+	mu          sync.Mutex
+	lastEventId uint64
+	events      []domain.ChecklistItemUpdatesEvent // oldest first, at most ReplayLogSize
+	publishedAt time.Time
 }
 
-func (cc *clientChannel) Send(event domain.ChecklistItemUpdatesEvent) bool {
-	if cc.closed {
-		return false
+// since returns the logged events after lastEventId, or false when some of them were already dropped
+func (l *eventLog) since(lastEventId uint64) ([]domain.ChecklistItemUpdatesEvent, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	// an id ahead of the log was handed out before a restart
+	if lastEventId > l.lastEventId {
+		return nil, false
 	}
-	select {
-	case cc.ch <- event:
-		return true
-	default:
-		return false
+	oldestEventId := l.lastEventId - uint64(len(l.events)) + 1
+	if lastEventId+1 < oldestEventId {
+		return nil, false
 	}
+	return append([]domain.ChecklistItemUpdatesEvent{}, l.events[lastEventId+1-oldestEventId:]...), true
 }
 
 type broker struct {
 	// map of checklistIds to *sync.Map of client channels
-	clients            sync.Map // key: uint -> value: *sync.Map (key: clientId string -> value: *clientChannel)
-	checklistGuardrail guardrail.IChecklistOwnershipChecker
+	clients             sync.Map // key: uint -> value: *sync.Map (key: clientId string -> value: *clientChannel)
+	logs                sync.Map // key: uint -> value: *eventLog
+	users               userSubscriptions
+	presence            presence
+	publishes           dispatchQueue // events waiting to be numbered and delivered
+	followUps           dispatchQueue // access and stats updates that follow delivered events
+	checklistGuardrail  guardrail.IChecklistOwnershipChecker
+	checklistRepository repository.IChecklistRepository
+	userRepository      repository.IUserRepository
+	queueConfiguration  ClientQueueConfiguration
 }
 
-func NewBroker(guardrail guardrail.IChecklistOwnershipChecker) IBroker {
+func NewBroker(guardrail guardrail.IChecklistOwnershipChecker, checklistRepository repository.IChecklistRepository,
+	userRepository repository.IUserRepository, queueConfiguration ClientQueueConfiguration) IBroker {
 	return &broker{
-		checklistGuardrail: guardrail,
+		checklistGuardrail:  guardrail,
+		checklistRepository: checklistRepository,
+		userRepository:      userRepository,
+		queueConfiguration:  queueConfiguration,
 	}
 }
 
+func (b *broker) newClientChannel() *clientChannel {
+	return newClientChannel(b.queueConfiguration, b.loadItemOrder)
+}
+
+// loadItemOrder fills the order snapshots of the client queues
+func (b *broker) loadItemOrder(ctx context.Context, checklistId uint) ([]domain.ChecklistItemOrder, error) {
+	items, err := b.checklistRepository.FindChecklistItemOrder(ctx, checklistId)
+	if err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 // Subscribe registers a client and returns a channel to receive messages
 func (b *broker) Subscribe(ctx context.Context, checklistId uint) (chan domain.ChecklistItemUpdatesEvent, error) {
 	if err := b.checklistGuardrail.HasAccessToChecklist(ctx, checklistId); err != nil {
@@ -154,22 +386,41 @@ func (b *broker) Subscribe(ctx context.Context, checklistId uint) (chan domain.C
 		return nil, errors.New("ClientID not found")
 	}
 
+	cc := b.newClientChannel()
+	if userId, err := domain.GetUserIdFromContext(ctx); err == nil {
+		cc.userId = userId
+		cc.userName = b.findUserName(ctx, userId)
+	}
+
 	// Close any existing channel for this client before creating a new one
 	newInner := &sync.Map{}
 	actual, _ := b.clients.LoadOrStore(checklistId, newInner)
 	inner := actual.(*sync.Map)
 
+	b.presence.mu.Lock()
+	localJoin := cc.userId != "" && !hasViewer(inner, cc.userId)
+	joined := localJoin && !b.presence.hasRemoteViewerLocked(checklistId, cc.userId)
 	// If there's an existing channel for this client, close it first
 	if existing, loaded := inner.Load(clientId); loaded {
-		if cc, ok := existing.(*clientChannel); ok {
-			cc.Close()
+		if existingChannel, ok := existing.(*clientChannel); ok {
+			existingChannel.Close()
 		}
 	}
+	inner.Store(clientId, cc)
+	if localJoin {
+		b.presence.publishLocked(domain.ChecklistPresenceRecord{
+			ChecklistId: checklistId,
+			ClientId:    clientId.(string),
+			EventType:   domain.EventTypePresenceJoined,
+			UserId:      cc.userId,
+			Name:        cc.userName,
+		})
+	}
+	b.presence.mu.Unlock()
 
-	cc := &clientChannel{
-		ch: make(chan domain.ChecklistItemUpdatesEvent, 10),
+	if joined {
+		b.sendPresence(checklistId, clientId.(string), newPresenceJoinedEvent(cc.userId, cc.userName))
 	}
-	inner.Store(clientId, cc)
 	return cc.ch, nil
 }
 
@@ -185,51 +436,285 @@ func (b *broker) Unsubscribe(ctx context.Context, checklistId uint) error {
 	}
 	inner := val.(*sync.Map)
 	// Remove the channel from the inner map and close it safely
-	if existing, loaded := inner.LoadAndDelete(clientId); loaded {
+	if existing, loaded := inner.Load(clientId); loaded {
 		if cc, ok := existing.(*clientChannel); ok {
+			if b.removeClient(checklistId, inner, clientId, cc) {
+				b.sendPresence(checklistId, clientId.(string), newPresenceLeftEvent(cc.userId))
+			}
 			cc.Close()
 		}
 	}
 	return nil
 }
 
-// Publish sends message to the broker (non-blocking). If out buffer is full, event is dropped.
+// Publish sends message to the broker (non-blocking). The event is numbered and logged for replay. The events
+// of a checklist are queued, so they are numbered in the order Publish was called.
 func (b *broker) Publish(ctx context.Context, checklistId uint, event domain.ChecklistItemUpdatesEvent) {
-	go func() {
-		clientIdFromContext := ctx.Value(domain.ClientIdContextKey)
-		if clientIdFromContext == nil {
-			log.Print("sse: no clientId in context, skipping publish")
-			return
+	clientIdFromContext := ctx.Value(domain.ClientIdContextKey)
+	if clientIdFromContext == nil {
+		log.Print("sse: no clientId in context, skipping publish")
+		return
+	}
+	b.publishes.add(checklistId, func() {
+		actual, _ := b.logs.LoadOrStore(checklistId, &eventLog{})
+		checklistLog := actual.(*eventLog)
+
+		// Numbering and delivering under the same lock keeps the events of a checklist in order
+		checklistLog.mu.Lock()
+		defer checklistLog.mu.Unlock()
+		checklistLog.lastEventId++
+		checklistLog.publishedAt = time.Now()
+		event.Id = checklistLog.lastEventId
+		checklistLog.events = append(checklistLog.events, event)
+		if len(checklistLog.events) > ReplayLogSize {
+			checklistLog.events = checklistLog.events[len(checklistLog.events)-ReplayLogSize:]
+		}
+		b.deliver(checklistId, clientIdFromContext.(string), event)
+		if event.EventType == domain.EventTypeChecklistDeleted {
+			// no further events follow
+			b.logs.Delete(checklistId)
 		}
-		val, ok := b.clients.Load(checklistId)
+	})
+}
+
+// releaseIdleLog drops the logged events of a checklist that has had no subscribers and no events for
+// eventLogIdleTTL, and checks again later when there were events since. The last event id is kept so that ids
+// are never handed out twice; clients reconnecting after the release get resyncRequired.
+func (b *broker) releaseIdleLog(checklistId uint) {
+	val, ok := b.logs.Load(checklistId)
+	if !ok || b.hasSubscribers(checklistId) {
+		return
+	}
+	checklistLog := val.(*eventLog)
+	checklistLog.mu.Lock()
+	defer checklistLog.mu.Unlock()
+	if idle := time.Since(checklistLog.publishedAt); idle < eventLogIdleTTL {
+		time.AfterFunc(eventLogIdleTTL-idle, func() { b.releaseIdleLog(checklistId) })
+		return
+	}
+	checklistLog.events = nil
+}
+
+// hasSubscribers reports whether a client of this instance is subscribed to the checklist
+func (b *broker) hasSubscribers(checklistId uint) bool {
+	val, ok := b.clients.Load(checklistId)
+	if !ok {
+		return false
+	}
+	found := false
+	val.(*sync.Map).Range(func(_ any, _ any) bool {
+		found = true
+		return false
+	})
+	return found
+}
+
+func (b *broker) Replay(ctx context.Context, checklistId uint, lastEventId uint64) ([]domain.ChecklistItemUpdatesEvent, bool, error) {
+	val, ok := b.logs.Load(checklistId)
+	if !ok {
+		// nothing was published since this instance started
+		return nil, lastEventId == 0, nil
+	}
+	events, complete := val.(*eventLog).since(lastEventId)
+	return events, complete, nil
+}
+
+// deliver sends the event to the clients of this instance that are subscribed to the checklist, except the
+// client that caused it. A client that is too far behind even after coalescing is disconnected; it reconnects
+// with the id of the last event it received and gets the missed events replayed. Subscriptions that lose access with the event are
+// closed after it was sent.
+//
+// Revoking subscriptions and updating the user streams query the database, so they are queued to run after the
+// caller has released the checklist log, and outside the listener goroutine of the database broker. The queue
+// keeps them in the order of the events of the checklist.
+func (b *broker) deliver(checklistId uint, originClientId string, event domain.ChecklistItemUpdatesEvent) {
+	event.ChecklistId = checklistId
+	if b.hasFollowUps(checklistId, event) {
+		defer b.followUps.add(checklistId, func() {
+			b.revokeSubscriptions(checklistId, event)
+			b.deliverToUsers(checklistId, originClientId, event)
+		})
+	}
+
+	val, ok := b.clients.Load(checklistId)
+	if !ok {
+		return
+	}
+	inner := val.(*sync.Map)
+	var leftUserIds []string
+	inner.Range(func(clientId any, v any) bool {
+		cc, ok := v.(*clientChannel)
 		if !ok {
-			return
+			return true
+		}
+		// Skip sending to the originating client
+		if originClientId == clientId.(string) {
+			return true
 		}
-		inner := val.(*sync.Map)
-		inner.Range(func(clientId any, v any) bool {
-			cc, ok := v.(*clientChannel)
-			if !ok {
-				return true
+		// Use the safe Send method which handles closed channels
+		if !cc.Send(event) {
+			log.Printf("sse: client %v fell too far behind, closing stream", clientId)
+			if b.removeClient(checklistId, inner, clientId, cc) {
+				leftUserIds = append(leftUserIds, cc.userId)
 			}
-			// Skip sending to the originating client
-			if clientIdFromContext == clientId.(string) {
-				return true
+			cc.Close()
+		}
+		return true
+	})
+	for _, userId := range leftUserIds {
+		b.sendPresence(checklistId, "", newPresenceLeftEvent(userId))
+	}
+}
+
+// hasFollowUps reports whether the event changes subscriptions or has user streams to reach
+func (b *broker) hasFollowUps(checklistId uint, event domain.ChecklistItemUpdatesEvent) bool {
+	return len(event.AccessChangedFor) > 0 || event.EventType == domain.EventTypeChecklistMoved ||
+		event.EventType == domain.EventTypeChecklistDeleted || b.users.hasChecklist(checklistId)
+}
+
+// SubscribeUser registers a user stream covering the checklists the user owns, was shared or can access
+// through a workspace
+func (b *broker) SubscribeUser(ctx context.Context) (chan domain.ChecklistItemUpdatesEvent, error) {
+	userId, err := domain.GetUserIdFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	clientId := ctx.Value(domain.ClientIdContextKey)
+	if clientId == nil {
+		return nil, errors.New("ClientID not found")
+	}
+	checklistIds, findErr := b.checklistRepository.FindAccessibleChecklistIds(ctx, userId)
+	if findErr != nil {
+		return nil, findErr
+	}
+
+	sub := &userSubscription{
+		cc:       b.newClientChannel(),
+		userId:   userId,
+		clientId: clientId.(string),
+	}
+	b.users.add(sub, checklistIds)
+	return sub.cc.ch, nil
+}
+
+// UnsubscribeUser removes the user stream of the client
+func (b *broker) UnsubscribeUser(ctx context.Context) error {
+	userId, err := domain.GetUserIdFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	clientId := ctx.Value(domain.ClientIdContextKey)
+	if clientId == nil {
+		return errors.New("clientId not found in context")
+	}
+	if sub, ok := b.users.find(userId, clientId.(string)); ok && b.users.remove(sub) {
+		sub.cc.Close()
+	}
+	return nil
+}
+
+// deliverToUsers sends checklist-level events to the user streams covering the checklist. When the event
+// changes who can access checklists, the streams of the affected users are updated first: a user who gains
+// access to the checklist already receives the event and a user who loses access still receives it.
+func (b *broker) deliverToUsers(checklistId uint, originClientId string, event domain.ChecklistItemUpdatesEvent) {
+	accessChangedFor := event.AccessChangedFor
+	// user streams are not replayed
+	event.Id = 0
+	event.AccessChangedFor = nil
+
+	recipients := b.users.forChecklist(checklistId)
+	if len(accessChangedFor) > 0 || event.EventType == domain.EventTypeChecklistMoved ||
+		event.EventType == domain.EventTypeChecklistDeleted {
+		userIds := make([]string, 0, len(accessChangedFor)+len(recipients))
+		userIds = append(userIds, accessChangedFor...)
+		for _, sub := range recipients {
+			userIds = append(userIds, sub.userId)
+		}
+		b.refreshUserStreams(checklistId, userIds)
+		for _, sub := range b.users.forChecklist(checklistId) {
+			if !slices.Contains(recipients, sub) {
+				recipients = append(recipients, sub)
 			}
-			// Use the safe Send method which handles closed channels
-			if !cc.Send(event) {
-				// Buffer is full or channel closed, try overflow notification
-				log.Printf("sse: buffer full for client %v, sending overflow notification", clientId)
-				overflowEvent := domain.ChecklistItemUpdatesEvent{
-					EventType: domain.EventTypeBufferOverflow,
-					Payload: domain.BufferOverflowEventPayload{
-						Message: "Event buffer full, please refresh to ensure data consistency",
-					},
+		}
+	}
+
+	if isUserStreamEvent(event.EventType) {
+		for _, sub := range recipients {
+			if sub.clientId != originClientId {
+				b.sendToUser(sub, event)
+			}
+		}
+	}
+	if changesChecklistStats(event.EventType) && b.users.hasChecklist(checklistId) {
+		b.sendChecklistStats(checklistId)
+	}
+}
+
+// refreshUserStreams reloads the accessible checklists of the users and tells their streams about the
+// checklists they gained or lost, except the checklist of the event that caused the refresh
+func (b *broker) refreshUserStreams(checklistId uint, userIds []string) {
+	slices.Sort(userIds)
+	for _, userId := range slices.Compact(userIds) {
+		subs := b.users.forUser(userId)
+		if len(subs) == 0 {
+			continue
+		}
+		checklistIds, err := b.checklistRepository.FindAccessibleChecklistIds(context.Background(), userId)
+		if err != nil {
+			log.Printf("sse: could not refresh user streams: %v", err)
+			continue
+		}
+		for _, sub := range subs {
+			granted, revoked := b.users.setChecklists(sub, checklistIds)
+			for _, id := range granted {
+				if id != checklistId {
+					b.sendToUser(sub, newChecklistAccessEvent(id, domain.EventTypeChecklistAccessGranted))
 				}
-				if !cc.Send(overflowEvent) {
-					log.Printf("sse: dropping overflow notification for client %v, client too slow or disconnected", clientId)
+			}
+			for _, id := range revoked {
+				if id != checklistId {
+					b.sendToUser(sub, newChecklistAccessEvent(id, domain.EventTypeChecklistAccessRevoked))
 				}
 			}
-			return true
-		})
-	}()
+		}
+	}
+}
+
+// sendChecklistStats sends the current item counts of the checklist to every user stream covering it,
+// including the stream of the client that changed the items
+func (b *broker) sendChecklistStats(checklistId uint) {
+	stats, err := b.checklistRepository.FindChecklistStats(context.Background(), checklistId)
+	if err != nil {
+		log.Printf("sse: could not send checklist stats: %v", err)
+		return
+	}
+	event := domain.ChecklistItemUpdatesEvent{
+		ChecklistId: checklistId,
+		EventType:   domain.EventTypeChecklistStatsChanged,
+		Payload: domain.ChecklistStatsChangedEventPayload{
+			TotalItems:     stats.TotalItems,
+			CompletedItems: stats.CompletedItems,
+		},
+	}
+	for _, sub := range b.users.forChecklist(checklistId) {
+		b.sendToUser(sub, event)
+	}
+}
+
+// sendToUser disconnects a user stream that is too far behind; the client reloads its overview on reconnect
+func (b *broker) sendToUser(sub *userSubscription, event domain.ChecklistItemUpdatesEvent) {
+	if !sub.cc.Send(event) {
+		log.Printf("sse: user stream of client %s fell too far behind, closing stream", sub.clientId)
+		if b.users.remove(sub) {
+			sub.cc.Close()
+		}
+	}
+}
+
+func newChecklistAccessEvent(checklistId uint, eventType string) domain.ChecklistItemUpdatesEvent {
+	return domain.ChecklistItemUpdatesEvent{
+		ChecklistId: checklistId,
+		EventType:   eventType,
+		Payload:     domain.ChecklistAccessEventPayload{ChecklistId: checklistId},
+	}
 }
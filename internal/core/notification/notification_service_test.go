@@ -0,0 +1,146 @@
+package notification
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"com.raunlo.checklist/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func receiveEvent(t *testing.T, ch chan domain.ChecklistItemUpdatesEvent) domain.ChecklistItemUpdatesEvent {
+	t.Helper()
+	select {
+	case event := <-ch:
+		return event
+	case <-time.After(time.Second):
+		t.Fatal("expected event")
+		return domain.ChecklistItemUpdatesEvent{}
+	}
+}
+
+// assertNoEvent fails when an event arrives shortly after the call; events reach the channel asynchronously
+func assertNoEvent(t *testing.T, ch chan domain.ChecklistItemUpdatesEvent, msgAndArgs ...any) {
+	t.Helper()
+	select {
+	case event := <-ch:
+		assert.Fail(t, "unexpected "+event.EventType+" event", msgAndArgs...)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestBroker_Publish_NumbersEventsPerChecklist(t *testing.T) {
+	b := NewBroker(allowAllGuardrail{}, new(mockChecklistRepository), nil, ClientQueueConfiguration{})
+	ch, err := b.Subscribe(clientContext("client-b"), 100)
+	assert.NoError(t, err)
+
+	for itemId := uint(1); itemId <= 3; itemId++ {
+		b.Publish(clientContext("client-a"), 100, domain.ChecklistItemUpdatesEvent{
+			EventType: domain.EventTypeChecklistItemDeleted,
+			Payload:   domain.ChecklistItemDeletedEventPayload{ItemId: itemId},
+		})
+		assert.Equal(t, uint64(itemId), receiveEvent(t, ch).Id)
+	}
+
+	events, complete, err := b.Replay(context.Background(), 100, 1)
+	assert.NoError(t, err)
+	assert.True(t, complete)
+	assert.Equal(t, []domain.ChecklistItemUpdatesEvent{
+		{Id: 2, EventType: domain.EventTypeChecklistItemDeleted, Payload: domain.ChecklistItemDeletedEventPayload{ItemId: 2}},
+		{Id: 3, EventType: domain.EventTypeChecklistItemDeleted, Payload: domain.ChecklistItemDeletedEventPayload{ItemId: 3}},
+	}, events)
+}
+
+func TestBroker_Publish_NumbersEventsInCallOrder(t *testing.T) {
+	b := NewBroker(allowAllGuardrail{}, new(mockChecklistRepository), nil, ClientQueueConfiguration{BufferSize: 20})
+	ch, err := b.Subscribe(clientContext("client-b"), 100)
+	assert.NoError(t, err)
+
+	for itemId := uint(1); itemId <= 20; itemId++ {
+		b.Publish(clientContext("client-a"), 100, domain.ChecklistItemUpdatesEvent{
+			EventType: domain.EventTypeChecklistItemDeleted,
+			Payload:   domain.ChecklistItemDeletedEventPayload{ItemId: itemId},
+		})
+	}
+
+	for itemId := uint(1); itemId <= 20; itemId++ {
+		event := receiveEvent(t, ch)
+		assert.Equal(t, uint64(itemId), event.Id)
+		assert.Equal(t, domain.ChecklistItemDeletedEventPayload{ItemId: itemId}, event.Payload)
+	}
+}
+
+func TestEventLog_Since(t *testing.T) {
+	log := &eventLog{lastEventId: ReplayLogSize + 10}
+	for eventId := uint64(11); eventId <= ReplayLogSize+10; eventId++ {
+		log.events = append(log.events, domain.ChecklistItemUpdatesEvent{Id: eventId})
+	}
+
+	events, complete := log.since(10)
+	assert.True(t, complete)
+	assert.Len(t, events, ReplayLogSize)
+
+	events, complete = log.since(ReplayLogSize + 10)
+	assert.True(t, complete)
+	assert.Empty(t, events)
+
+	_, complete = log.since(9)
+	assert.False(t, complete, "event 10 is no longer in the log")
+
+	_, complete = log.since(ReplayLogSize + 11)
+	assert.False(t, complete, "event id from before a restart")
+}
+
+func TestBroker_Publish_ChecklistDeletedDropsLog(t *testing.T) {
+	b := &broker{checklistGuardrail: allowAllGuardrail{}, checklistRepository: new(mockChecklistRepository)}
+
+	b.Publish(clientContext("client-a"), 100, domain.ChecklistItemUpdatesEvent{
+		EventType: domain.EventTypeChecklistDeleted,
+		Payload:   domain.ChecklistDeletedEventPayload{ChecklistId: 100},
+	})
+
+	assert.Eventually(t, func() bool {
+		_, ok := b.logs.Load(uint(100))
+		return !ok
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestBroker_ReleaseIdleLog(t *testing.T) {
+	b := &broker{checklistGuardrail: allowAllGuardrail{}, checklistRepository: new(mockChecklistRepository)}
+	idle := &eventLog{lastEventId: 2, publishedAt: time.Now().Add(-eventLogIdleTTL),
+		events: []domain.ChecklistItemUpdatesEvent{{Id: 1}, {Id: 2}}}
+	b.logs.Store(uint(100), idle)
+	watched := &eventLog{lastEventId: 1, publishedAt: time.Now().Add(-eventLogIdleTTL),
+		events: []domain.ChecklistItemUpdatesEvent{{Id: 1}}}
+	b.logs.Store(uint(200), watched)
+	_, err := b.Subscribe(clientContext("client-b"), 200)
+	assert.NoError(t, err)
+
+	b.releaseIdleLog(100)
+	b.releaseIdleLog(200)
+
+	_, complete, _ := b.Replay(context.Background(), 100, 1)
+	assert.False(t, complete, "released events cannot be replayed")
+	events, complete, _ := b.Replay(context.Background(), 100, 2)
+	assert.True(t, complete, "the last event id is kept")
+	assert.Empty(t, events)
+	assert.Len(t, watched.events, 1, "a checklist with subscribers keeps its log")
+}
+
+func TestBroker_Publish_SystemContextReachesEveryClient(t *testing.T) {
+	b := NewBroker(allowAllGuardrail{}, new(mockChecklistRepository), nil, ClientQueueConfiguration{})
+	first, err := b.Subscribe(clientContext("client-a"), 100)
+	assert.NoError(t, err)
+	second, err := b.Subscribe(clientContext("client-b"), 100)
+	assert.NoError(t, err)
+
+	payload := domain.ChecklistItemPositionsRebalancedEventPayload{Positions: map[uint]string{7: "a0"}}
+	NewNotificationService(b).NotifyPositionsRebalanced(domain.NewSystemContext(context.Background()), 100, payload.Positions)
+
+	for _, ch := range []chan domain.ChecklistItemUpdatesEvent{first, second} {
+		event := receiveEvent(t, ch)
+		assert.Equal(t, domain.EventTypeChecklistItemPositionsRebalanced, event.EventType)
+		assert.Equal(t, payload, event.Payload)
+	}
+}
@@ -0,0 +1,373 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	"com.raunlo.checklist/internal/core/domain"
+)
+
+// EditingHintTTL is how long an editing hint lasts. Clients repeat the hint while the user keeps editing.
+const EditingHintTTL = 30 * time.Second
+
+// editingHint is the item a user said they are editing, cleared by its timer when it is not repeated
+type editingHint struct {
+	itemId uint
+	timer  *time.Timer
+}
+
+// presence tracks which items the viewers of a checklist edit. The viewers themselves are the users of the
+// client channels subscribed to the checklist, and with several instances the viewers the other instances
+// announced; mu serializes subscribing and unsubscribing with the join and leave decisions, so that every user
+// joins and leaves once.
+type presence struct {
+	mu    sync.Mutex
+	hints map[uint]map[string]*editingHint // checklistId -> userId -> hint
+	// remote are the viewers connected to other instances: checklistId -> userId -> instanceId -> viewer
+	remote map[uint]map[string]map[string]*remoteViewer
+	// publish shares the joins, leaves and editing hints of this instance with the other instances; nil when
+	// the application runs as a single instance
+	publish func(record domain.ChecklistPresenceRecord)
+}
+
+// remoteViewer is a user viewing a checklist on another instance, dropped when it is not announced again
+type remoteViewer struct {
+	name   *string
+	seenAt time.Time
+}
+
+// hasViewer reports whether a client of the user is subscribed to the checklist. Must be called with mu held.
+func hasViewer(inner *sync.Map, userId string) bool {
+	found := false
+	inner.Range(func(_ any, v any) bool {
+		if cc, ok := v.(*clientChannel); ok && cc.userId == userId {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// clearHintLocked removes the hint of a user and reports whether there was one. Must be called with mu held.
+func (p *presence) clearHintLocked(checklistId uint, userId string) bool {
+	hint, ok := p.hints[checklistId][userId]
+	if !ok {
+		return false
+	}
+	hint.timer.Stop()
+	delete(p.hints[checklistId], userId)
+	if len(p.hints[checklistId]) == 0 {
+		delete(p.hints, checklistId)
+	}
+	return true
+}
+
+// hasRemoteViewerLocked reports whether another instance announced the user as a viewer of the checklist.
+// Must be called with mu held.
+func (p *presence) hasRemoteViewerLocked(checklistId uint, userId string) bool {
+	return len(p.remote[checklistId][userId]) > 0
+}
+
+// publishLocked shares a presence change of this instance. Must be called with mu held, which keeps the
+// changes of a user in order.
+func (p *presence) publishLocked(record domain.ChecklistPresenceRecord) {
+	if p.publish != nil {
+		p.publish(record)
+	}
+}
+
+// isViewerLocked reports whether the user views the checklist on this or another instance. Must be called
+// with mu held.
+func (b *broker) isViewerLocked(checklistId uint, userId string) bool {
+	if val, ok := b.clients.Load(checklistId); ok && hasViewer(val.(*sync.Map), userId) {
+		return true
+	}
+	return b.presence.hasRemoteViewerLocked(checklistId, userId)
+}
+
+// removeClient unsubscribes the channel if it is still registered and reports whether its user left the
+// checklist with it, on all instances. The replay log of the checklist is released once it stays without
+// subscribers.
+func (b *broker) removeClient(checklistId uint, inner *sync.Map, clientId any, cc *clientChannel) bool {
+	b.presence.mu.Lock()
+	defer b.presence.mu.Unlock()
+	if !inner.CompareAndDelete(clientId, cc) {
+		return false
+	}
+	if !b.hasSubscribers(checklistId) {
+		time.AfterFunc(eventLogIdleTTL, func() { b.releaseIdleLog(checklistId) })
+	}
+	if cc.userId == "" || hasViewer(inner, cc.userId) {
+		return false
+	}
+	b.presence.publishLocked(domain.ChecklistPresenceRecord{
+		ChecklistId: checklistId,
+		ClientId:    clientId.(string),
+		EventType:   domain.EventTypePresenceLeft,
+		UserId:      cc.userId,
+	})
+	if b.presence.hasRemoteViewerLocked(checklistId, cc.userId) {
+		return false
+	}
+	b.presence.clearHintLocked(checklistId, cc.userId)
+	return true
+}
+
+// findUserName returns the name shown to other viewers, or nil when it cannot be loaded
+func (b *broker) findUserName(ctx context.Context, userId string) *string {
+	user, err := b.userRepository.FindUserById(ctx, userId)
+	if err != nil || user == nil || user.Name == "" {
+		if err != nil {
+			log.Printf("sse: could not load name of viewer: %v", err)
+		}
+		return nil
+	}
+	return &user.Name
+}
+
+// Viewers returns the users subscribed to the checklist on this and the other instances, with the item each
+// one is editing
+func (b *broker) Viewers(ctx context.Context, checklistId uint) ([]domain.ChecklistViewer, error) {
+	if err := b.checklistGuardrail.HasAccessToChecklist(ctx, checklistId); err != nil {
+		return nil, err
+	}
+	viewers := []domain.ChecklistViewer{}
+
+	b.presence.mu.Lock()
+	defer b.presence.mu.Unlock()
+	seen := map[string]bool{}
+	addViewer := func(userId string, name *string) {
+		seen[userId] = true
+		viewer := domain.ChecklistViewer{UserId: userId, Name: name}
+		if hint, ok := b.presence.hints[checklistId][userId]; ok {
+			viewer.EditingItemId = &hint.itemId
+		}
+		viewers = append(viewers, viewer)
+	}
+	if val, ok := b.clients.Load(checklistId); ok {
+		val.(*sync.Map).Range(func(_ any, v any) bool {
+			if cc, ok := v.(*clientChannel); ok && cc.userId != "" && !seen[cc.userId] {
+				addViewer(cc.userId, cc.userName)
+			}
+			return true
+		})
+	}
+	for userId, instances := range b.presence.remote[checklistId] {
+		for _, viewer := range instances {
+			if !seen[userId] {
+				addViewer(userId, viewer.name)
+			}
+		}
+	}
+	return viewers, nil
+}
+
+// SetEditingItem sets the item the user of the client is editing, or clears it when itemId is nil. The client
+// has to be subscribed to the checklist, or the user has to view it through another instance. Repeating the
+// same item only extends the hint.
+func (b *broker) SetEditingItem(ctx context.Context, checklistId uint, itemId *uint) error {
+	userId, err := domain.GetUserIdFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	clientId := ctx.Value(domain.ClientIdContextKey)
+	if clientId == nil {
+		return errors.New("clientId not found in context")
+	}
+
+	b.presence.mu.Lock()
+	defer b.presence.mu.Unlock()
+	subscribed := false
+	if val, ok := b.clients.Load(checklistId); ok {
+		cc, ok := val.(*sync.Map).Load(clientId)
+		subscribed = ok && cc.(*clientChannel).userId == userId
+	}
+	if !subscribed && !b.presence.hasRemoteViewerLocked(checklistId, userId) {
+		return domain.NewError("Subscribe to the checklist events before sending presence", 409)
+	}
+
+	// repeated hints are shared as well, so that they do not expire on the other instances
+	b.presence.publishLocked(domain.ChecklistPresenceRecord{
+		ChecklistId: checklistId,
+		ClientId:    clientId.(string),
+		EventType:   domain.EventTypePresenceEditing,
+		UserId:      userId,
+		ItemId:      itemId,
+	})
+	if b.setEditingHintLocked(checklistId, userId, itemId) {
+		b.sendPresence(checklistId, clientId.(string), newPresenceEditingEvent(userId, itemId))
+	}
+	return nil
+}
+
+// setEditingHintLocked sets or clears the hint of a user and reports whether it changed; repeating the same
+// item only extends the hint. Every instance expires the hints on its own. Must be called with mu held.
+func (b *broker) setEditingHintLocked(checklistId uint, userId string, itemId *uint) bool {
+	hint, hasHint := b.presence.hints[checklistId][userId]
+	switch {
+	case itemId == nil:
+		return b.presence.clearHintLocked(checklistId, userId)
+	case hasHint && hint.itemId == *itemId:
+		hint.timer.Reset(EditingHintTTL)
+		return false
+	}
+	b.presence.clearHintLocked(checklistId, userId)
+	hint = &editingHint{itemId: *itemId}
+	hint.timer = time.AfterFunc(EditingHintTTL, func() { b.expireEditingHint(checklistId, userId, hint) })
+	if b.presence.hints == nil {
+		b.presence.hints = map[uint]map[string]*editingHint{}
+	}
+	if b.presence.hints[checklistId] == nil {
+		b.presence.hints[checklistId] = map[string]*editingHint{}
+	}
+	b.presence.hints[checklistId][userId] = hint
+	return true
+}
+
+// applyRemotePresence applies a presence change of another instance and sends the presence events that follow
+// from it to the clients of this instance. A user who views a checklist on several instances joins and leaves
+// once.
+func (b *broker) applyRemotePresence(record domain.ChecklistPresenceRecord, now time.Time) {
+	b.presence.mu.Lock()
+	defer b.presence.mu.Unlock()
+	switch record.EventType {
+	case domain.EventTypePresenceJoined:
+		joined := !b.isViewerLocked(record.ChecklistId, record.UserId)
+		if b.presence.remote == nil {
+			b.presence.remote = map[uint]map[string]map[string]*remoteViewer{}
+		}
+		if b.presence.remote[record.ChecklistId] == nil {
+			b.presence.remote[record.ChecklistId] = map[string]map[string]*remoteViewer{}
+		}
+		if b.presence.remote[record.ChecklistId][record.UserId] == nil {
+			b.presence.remote[record.ChecklistId][record.UserId] = map[string]*remoteViewer{}
+		}
+		b.presence.remote[record.ChecklistId][record.UserId][record.InstanceId] = &remoteViewer{name: record.Name, seenAt: now}
+		if joined {
+			b.sendPresence(record.ChecklistId, record.ClientId, newPresenceJoinedEvent(record.UserId, record.Name))
+		}
+	case domain.EventTypePresenceLeft:
+		if _, ok := b.presence.remote[record.ChecklistId][record.UserId][record.InstanceId]; !ok {
+			return
+		}
+		b.removeRemoteViewerLocked(record.ChecklistId, record.UserId, record.InstanceId)
+	case domain.EventTypePresenceEditing:
+		if b.setEditingHintLocked(record.ChecklistId, record.UserId, record.ItemId) {
+			b.sendPresence(record.ChecklistId, record.ClientId, newPresenceEditingEvent(record.UserId, record.ItemId))
+		}
+	}
+}
+
+// expireRemoteViewers drops the viewers of other instances that were last announced before the given time,
+// e.g. because their instance stopped
+func (b *broker) expireRemoteViewers(before time.Time) {
+	b.presence.mu.Lock()
+	defer b.presence.mu.Unlock()
+	for checklistId, users := range b.presence.remote {
+		for userId, instances := range users {
+			for instanceId, viewer := range instances {
+				if viewer.seenAt.Before(before) {
+					b.removeRemoteViewerLocked(checklistId, userId, instanceId)
+				}
+			}
+		}
+	}
+}
+
+// removeRemoteViewerLocked removes a viewer of another instance and sends presenceLeft when the user no longer
+// views the checklist anywhere. Must be called with mu held.
+func (b *broker) removeRemoteViewerLocked(checklistId uint, userId string, instanceId string) {
+	delete(b.presence.remote[checklistId][userId], instanceId)
+	if len(b.presence.remote[checklistId][userId]) == 0 {
+		delete(b.presence.remote[checklistId], userId)
+	}
+	if len(b.presence.remote[checklistId]) == 0 {
+		delete(b.presence.remote, checklistId)
+	}
+	if b.isViewerLocked(checklistId, userId) {
+		return
+	}
+	b.presence.clearHintLocked(checklistId, userId)
+	b.sendPresence(checklistId, "", newPresenceLeftEvent(userId))
+}
+
+// localViewers returns a joined record for every user viewing a checklist on this instance, which the other
+// instances take as a sign that the viewer is still there
+func (b *broker) localViewers() []domain.ChecklistPresenceRecord {
+	b.presence.mu.Lock()
+	defer b.presence.mu.Unlock()
+	records := []domain.ChecklistPresenceRecord{}
+	b.clients.Range(func(key any, val any) bool {
+		seen := map[string]bool{}
+		val.(*sync.Map).Range(func(_ any, v any) bool {
+			if cc, ok := v.(*clientChannel); ok && cc.userId != "" && !seen[cc.userId] {
+				seen[cc.userId] = true
+				records = append(records, domain.ChecklistPresenceRecord{
+					ChecklistId: key.(uint),
+					EventType:   domain.EventTypePresenceJoined,
+					UserId:      cc.userId,
+					Name:        cc.userName,
+				})
+			}
+			return true
+		})
+		return true
+	})
+	return records
+}
+
+// expireEditingHint clears a hint that was not repeated within EditingHintTTL
+func (b *broker) expireEditingHint(checklistId uint, userId string, hint *editingHint) {
+	b.presence.mu.Lock()
+	defer b.presence.mu.Unlock()
+	if b.presence.hints[checklistId][userId] != hint {
+		return
+	}
+	b.presence.clearHintLocked(checklistId, userId)
+	b.sendPresence(checklistId, "", domain.ChecklistItemUpdatesEvent{
+		EventType: domain.EventTypePresenceEditing,
+		Payload:   domain.PresenceEditingEventPayload{UserId: userId},
+	})
+}
+
+// sendPresence sends a presence event to the clients subscribed to the checklist except the origin client.
+// Presence events are not replayed, so they are dropped for clients that are behind instead of disconnecting
+// them.
+func (b *broker) sendPresence(checklistId uint, originClientId string, event domain.ChecklistItemUpdatesEvent) {
+	val, ok := b.clients.Load(checklistId)
+	if !ok {
+		return
+	}
+	event.ChecklistId = checklistId
+	val.(*sync.Map).Range(func(clientId any, v any) bool {
+		cc, ok := v.(*clientChannel)
+		if ok && clientId.(string) != originClientId && !cc.Send(event) {
+			log.Printf("sse: client %v is too far behind, dropping %s event", clientId, event.EventType)
+		}
+		return true
+	})
+}
+
+func newPresenceJoinedEvent(userId string, name *string) domain.ChecklistItemUpdatesEvent {
+	return domain.ChecklistItemUpdatesEvent{
+		EventType: domain.EventTypePresenceJoined,
+		Payload:   domain.ChecklistViewer{UserId: userId, Name: name},
+	}
+}
+
+func newPresenceLeftEvent(userId string) domain.ChecklistItemUpdatesEvent {
+	return domain.ChecklistItemUpdatesEvent{
+		EventType: domain.EventTypePresenceLeft,
+		Payload:   domain.PresenceLeftEventPayload{UserId: userId},
+	}
+}
+
+func newPresenceEditingEvent(userId string, itemId *uint) domain.ChecklistItemUpdatesEvent {
+	return domain.ChecklistItemUpdatesEvent{
+		EventType: domain.EventTypePresenceEditing,
+		Payload:   domain.PresenceEditingEventPayload{UserId: userId, ItemId: itemId},
+	}
+}
@@ -0,0 +1,110 @@
+package notification
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"com.raunlo.checklist/internal/core/domain"
+	"com.raunlo.checklist/internal/core/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// mockUserRepository mocks the name lookup of viewers; other methods are not implemented.
+type mockUserRepository struct {
+	mock.Mock
+	repository.IUserRepository
+}
+
+func (m *mockUserRepository) FindUserById(ctx context.Context, userId string) (*domain.User, domain.Error) {
+	args := m.Called(ctx, userId)
+	var err domain.Error
+	if arg := args.Get(1); arg != nil {
+		err = arg.(domain.Error)
+	}
+	return args.Get(0).(*domain.User), err
+}
+
+func newPresenceTestBroker() *broker {
+	users := new(mockUserRepository)
+	users.On("FindUserById", mock.Anything, "user-a").Return(&domain.User{UserId: "user-a", Name: "Anna"}, nil)
+	users.On("FindUserById", mock.Anything, "user-b").Return(&domain.User{UserId: "user-b", Name: "Bert"}, nil)
+	return NewBroker(allowAllGuardrail{}, new(mockChecklistRepository), users, ClientQueueConfiguration{}).(*broker)
+}
+
+func TestBroker_Presence_JoinsAndLeavesOncePerUser(t *testing.T) {
+	b := newPresenceTestBroker()
+	watcher, err := b.Subscribe(userContext("user-b", "client-b"), 100)
+	assert.NoError(t, err)
+
+	_, err = b.Subscribe(userContext("user-a", "client-a1"), 100)
+	assert.NoError(t, err)
+	joined := receiveEvent(t, watcher)
+	assert.Equal(t, domain.EventTypePresenceJoined, joined.EventType)
+	assert.Equal(t, domain.ChecklistViewer{UserId: "user-a", Name: new("Anna")}, joined.Payload)
+	assert.Equal(t, uint64(0), joined.Id, "presence events are not replayed")
+
+	// a second client of the same user does not join again, and closing one of the two does not leave
+	_, err = b.Subscribe(userContext("user-a", "client-a2"), 100)
+	assert.NoError(t, err)
+	assert.NoError(t, b.Unsubscribe(userContext("user-a", "client-a1"), 100))
+	assertNoEvent(t, watcher)
+
+	viewers, err := b.Viewers(context.Background(), 100)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []domain.ChecklistViewer{
+		{UserId: "user-a", Name: new("Anna")},
+		{UserId: "user-b", Name: new("Bert")},
+	}, viewers)
+
+	assert.NoError(t, b.Unsubscribe(userContext("user-a", "client-a2"), 100))
+	left := receiveEvent(t, watcher)
+	assert.Equal(t, domain.EventTypePresenceLeft, left.EventType)
+	assert.Equal(t, domain.PresenceLeftEventPayload{UserId: "user-a"}, left.Payload)
+}
+
+func TestBroker_SetEditingItem(t *testing.T) {
+	b := newPresenceTestBroker()
+	watcher, err := b.Subscribe(userContext("user-b", "client-b"), 100)
+	assert.NoError(t, err)
+	editorContext := userContext("user-a", "client-a")
+
+	assert.Error(t, b.SetEditingItem(editorContext, 100, new(uint(7))), "client is not subscribed")
+
+	_, err = b.Subscribe(editorContext, 100)
+	assert.NoError(t, err)
+	receiveEvent(t, watcher) // joined
+
+	assert.NoError(t, b.SetEditingItem(editorContext, 100, new(uint(7))))
+	editing := receiveEvent(t, watcher)
+	assert.Equal(t, domain.EventTypePresenceEditing, editing.EventType)
+	assert.Equal(t, domain.PresenceEditingEventPayload{UserId: "user-a", ItemId: new(uint(7))}, editing.Payload)
+
+	// repeating the hint only extends it
+	assert.NoError(t, b.SetEditingItem(editorContext, 100, new(uint(7))))
+	assertNoEvent(t, watcher)
+
+	viewers, err := b.Viewers(context.Background(), 100)
+	assert.NoError(t, err)
+	assert.Contains(t, viewers, domain.ChecklistViewer{UserId: "user-a", Name: new("Anna"), EditingItemId: new(uint(7))})
+
+	assert.NoError(t, b.SetEditingItem(editorContext, 100, nil))
+	cleared := receiveEvent(t, watcher)
+	assert.Equal(t, domain.PresenceEditingEventPayload{UserId: "user-a"}, cleared.Payload)
+}
+
+func TestBroker_EditingHintExpires(t *testing.T) {
+	b := newPresenceTestBroker()
+	watcher, err := b.Subscribe(userContext("user-b", "client-b"), 100)
+	assert.NoError(t, err)
+	hint := &editingHint{itemId: 7, timer: time.NewTimer(time.Hour)}
+	b.presence.hints = map[uint]map[string]*editingHint{100: {"user-a": hint}}
+
+	b.expireEditingHint(100, "user-a", hint)
+
+	expired := receiveEvent(t, watcher)
+	assert.Equal(t, domain.EventTypePresenceEditing, expired.EventType)
+	assert.Equal(t, domain.PresenceEditingEventPayload{UserId: "user-a"}, expired.Payload)
+	assert.Empty(t, b.presence.hints)
+}
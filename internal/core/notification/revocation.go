@@ -0,0 +1,113 @@
+package notification
+
+import (
+	"context"
+	"log"
+	"slices"
+	"sync"
+
+	"com.raunlo.checklist/internal/core/domain"
+)
+
+// checklistSubscription is a client channel subscribed to one checklist
+type checklistSubscription struct {
+	checklistId uint
+	inner       *sync.Map
+	clientId    any
+	cc          *clientChannel
+}
+
+// revokeSubscriptions closes the checklist subscriptions that end with the event: every subscription of a
+// deleted checklist, and the subscriptions of users who lost access to the checklist they watch. Access is only
+// checked at subscribe time, so without this the clients would keep receiving events. The clients get the
+// deletion or a checklistAccessRevoked event as their last event.
+func (b *broker) revokeSubscriptions(checklistId uint, event domain.ChecklistItemUpdatesEvent) {
+	if event.EventType == domain.EventTypeChecklistDeleted {
+		b.closeChecklistSubscriptions(checklistId)
+		return
+	}
+
+	userIds := slices.Clone(event.AccessChangedFor)
+	if event.EventType == domain.EventTypeChecklistMoved {
+		// viewers who reached the checklist through its old workspace
+		for _, sub := range b.checklistSubscriptions(checklistId) {
+			userIds = append(userIds, sub.cc.userId)
+		}
+	}
+	slices.Sort(userIds)
+	for _, userId := range slices.Compact(userIds) {
+		b.revokeLostAccess(userId)
+	}
+}
+
+// revokeLostAccess closes the checklist subscriptions of the user's clients for checklists the user can no
+// longer access
+func (b *broker) revokeLostAccess(userId string) {
+	if userId == "" {
+		return
+	}
+	subs := b.userChecklistSubscriptions(userId)
+	if len(subs) == 0 {
+		return
+	}
+	checklistIds, err := b.checklistRepository.FindAccessibleChecklistIds(context.Background(), userId)
+	if err != nil {
+		log.Printf("sse: could not check access of subscriptions: %v", err)
+		return
+	}
+	for _, sub := range subs {
+		if slices.Contains(checklistIds, sub.checklistId) {
+			continue
+		}
+		sub.cc.Send(newChecklistAccessEvent(sub.checklistId, domain.EventTypeChecklistAccessRevoked))
+		if b.removeClient(sub.checklistId, sub.inner, sub.clientId, sub.cc) {
+			b.sendPresence(sub.checklistId, "", newPresenceLeftEvent(userId))
+		}
+		sub.cc.CloseAfterPending()
+	}
+}
+
+// closeChecklistSubscriptions closes every subscription of the checklist and drops its editing hints
+func (b *broker) closeChecklistSubscriptions(checklistId uint) {
+	b.presence.mu.Lock()
+	defer b.presence.mu.Unlock()
+	for _, sub := range b.checklistSubscriptions(checklistId) {
+		if sub.inner.CompareAndDelete(sub.clientId, sub.cc) {
+			sub.cc.CloseAfterPending()
+		}
+	}
+	for userId := range b.presence.hints[checklistId] {
+		b.presence.clearHintLocked(checklistId, userId)
+	}
+}
+
+// checklistSubscriptions returns the client channels subscribed to the checklist
+func (b *broker) checklistSubscriptions(checklistId uint) []checklistSubscription {
+	val, ok := b.clients.Load(checklistId)
+	if !ok {
+		return nil
+	}
+	inner := val.(*sync.Map)
+	var subs []checklistSubscription
+	inner.Range(func(clientId any, v any) bool {
+		if cc, ok := v.(*clientChannel); ok {
+			subs = append(subs, checklistSubscription{checklistId: checklistId, inner: inner, clientId: clientId, cc: cc})
+		}
+		return true
+	})
+	return subs
+}
+
+// userChecklistSubscriptions returns the checklist subscriptions of all clients of the user
+func (b *broker) userChecklistSubscriptions(userId string) []checklistSubscription {
+	var subs []checklistSubscription
+	b.clients.Range(func(checklistId any, _ any) bool {
+		for _, sub := range b.checklistSubscriptions(checklistId.(uint)) {
+			if sub.cc.userId == userId {
+				subs = append(subs, sub)
+			}
+		}
+		return true
+	})
+	return subs
+}
@@ -0,0 +1,70 @@
+package notification
+
+import (
+	"testing"
+	"time"
+
+	"com.raunlo.checklist/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func assertClosed(t *testing.T, ch chan domain.ChecklistItemUpdatesEvent) {
+	t.Helper()
+	select {
+	case _, ok := <-ch:
+		assert.False(t, ok, "expected channel to be closed")
+	case <-time.After(time.Second):
+		t.Fatal("expected channel to be closed")
+	}
+}
+
+func TestBroker_ChecklistDeleted_ClosesSubscriptions(t *testing.T) {
+	b := newPresenceTestBroker()
+	viewer, err := b.Subscribe(userContext("user-b", "client-b"), 100)
+	assert.NoError(t, err)
+	deleter, err := b.Subscribe(userContext("user-a", "client-a"), 100)
+	assert.NoError(t, err)
+	receiveEvent(t, viewer) // user-a joined
+
+	b.Publish(userContext("user-a", "client-a"), 100, domain.ChecklistItemUpdatesEvent{
+		EventType: domain.EventTypeChecklistDeleted,
+		Payload:   domain.ChecklistDeletedEventPayload{ChecklistId: 100},
+	})
+
+	assert.Equal(t, domain.EventTypeChecklistDeleted, receiveEvent(t, viewer).EventType)
+	assertClosed(t, viewer)
+	assertClosed(t, deleter)
+}
+
+func TestBroker_AccessChanged_RevokesSubscriptionsWithoutAccess(t *testing.T) {
+	b := newPresenceTestBroker()
+	repo := b.checklistRepository.(*mockChecklistRepository)
+	repo.On("FindAccessibleChecklistIds", mock.Anything, "user-a").Return([]uint{200}, nil)
+
+	owner, err := b.Subscribe(userContext("user-b", "client-b"), 100)
+	assert.NoError(t, err)
+	revoked, err := b.Subscribe(userContext("user-a", "client-a"), 100)
+	assert.NoError(t, err)
+	kept, err := b.Subscribe(userContext("user-a", "client-a"), 200)
+	assert.NoError(t, err)
+	receiveEvent(t, owner) // user-a joined
+
+	// the user left a shared checklist
+	b.Publish(userContext("user-a", "client-a"), 0, domain.ChecklistItemUpdatesEvent{
+		EventType:        domain.EventTypeAccessChanged,
+		AccessChangedFor: []string{"user-a"},
+	})
+
+	event := receiveEvent(t, revoked)
+	assert.Equal(t, domain.EventTypeChecklistAccessRevoked, event.EventType)
+	assert.Equal(t, uint(100), event.ChecklistId)
+	assertClosed(t, revoked)
+	assert.Equal(t, domain.PresenceLeftEventPayload{UserId: "user-a"}, receiveEvent(t, owner).Payload)
+
+	b.Publish(userContext("user-b", "client-b"), 200, domain.ChecklistItemUpdatesEvent{
+		EventType: domain.EventTypeChecklistItemDeleted,
+		Payload:   domain.ChecklistItemDeletedEventPayload{ItemId: 1},
+	})
+	assert.Equal(t, domain.EventTypeChecklistItemDeleted, receiveEvent(t, kept).EventType)
+}
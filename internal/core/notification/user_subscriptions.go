@@ -0,0 +1,168 @@
+package notification
+
+import (
+	"sync"
+
+	"com.raunlo.checklist/internal/core/domain"
+)
+
+// userSubscription is the user stream of one client. It receives the checklist-level events of every checklist
+// the user can access.
+type userSubscription struct {
+	cc       *clientChannel
+	userId   string
+	clientId string
+	// checklistIds is guarded by the mutex of userSubscriptions
+	checklistIds map[uint]bool
+}
+
+// userSubscriptions indexes the user streams of an instance by user and by the checklists they cover
+type userSubscriptions struct {
+	mu          sync.RWMutex
+	byUser      map[string]map[string]*userSubscription // userId -> clientId -> subscription
+	byChecklist map[uint]map[*userSubscription]bool
+}
+
+// add registers the subscription for the given checklists, replacing an earlier stream of the same client
+func (s *userSubscriptions) add(sub *userSubscription, checklistIds []uint) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.byUser == nil {
+		s.byUser = map[string]map[string]*userSubscription{}
+		s.byChecklist = map[uint]map[*userSubscription]bool{}
+	}
+
+	if existing, ok := s.byUser[sub.userId][sub.clientId]; ok {
+		s.removeLocked(existing)
+		existing.cc.Close()
+	}
+	if s.byUser[sub.userId] == nil {
+		s.byUser[sub.userId] = map[string]*userSubscription{}
+	}
+	s.byUser[sub.userId][sub.clientId] = sub
+	sub.checklistIds = map[uint]bool{}
+	s.setChecklistsLocked(sub, checklistIds)
+}
+
+// remove unregisters the subscription and reports whether it was still registered
+func (s *userSubscriptions) remove(sub *userSubscription) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.byUser[sub.userId][sub.clientId] != sub {
+		return false
+	}
+	s.removeLocked(sub)
+	return true
+}
+
+func (s *userSubscriptions) removeLocked(sub *userSubscription) {
+	for checklistId := range sub.checklistIds {
+		delete(s.byChecklist[checklistId], sub)
+		if len(s.byChecklist[checklistId]) == 0 {
+			delete(s.byChecklist, checklistId)
+		}
+	}
+	delete(s.byUser[sub.userId], sub.clientId)
+	if len(s.byUser[sub.userId]) == 0 {
+		delete(s.byUser, sub.userId)
+	}
+}
+
+// find returns the subscription of a client
+func (s *userSubscriptions) find(userId string, clientId string) (*userSubscription, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	sub, ok := s.byUser[userId][clientId]
+	return sub, ok
+}
+
+func (s *userSubscriptions) forUser(userId string) []*userSubscription {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	subs := make([]*userSubscription, 0, len(s.byUser[userId]))
+	for _, sub := range s.byUser[userId] {
+		subs = append(subs, sub)
+	}
+	return subs
+}
+
+func (s *userSubscriptions) forChecklist(checklistId uint) []*userSubscription {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	subs := make([]*userSubscription, 0, len(s.byChecklist[checklistId]))
+	for sub := range s.byChecklist[checklistId] {
+		subs = append(subs, sub)
+	}
+	return subs
+}
+
+func (s *userSubscriptions) hasChecklist(checklistId uint) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.byChecklist[checklistId]) > 0
+}
+
+// setChecklists replaces the checklists a registered subscription covers and returns the checklists it gained
+// and lost
+func (s *userSubscriptions) setChecklists(sub *userSubscription, checklistIds []uint) (granted []uint, revoked []uint) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.byUser[sub.userId][sub.clientId] != sub {
+		return nil, nil
+	}
+	return s.setChecklistsLocked(sub, checklistIds)
+}
+
+func (s *userSubscriptions) setChecklistsLocked(sub *userSubscription, checklistIds []uint) (granted []uint, revoked []uint) {
+	accessible := make(map[uint]bool, len(checklistIds))
+	for _, checklistId := range checklistIds {
+		accessible[checklistId] = true
+		if sub.checklistIds[checklistId] {
+			continue
+		}
+		granted = append(granted, checklistId)
+		sub.checklistIds[checklistId] = true
+		if s.byChecklist[checklistId] == nil {
+			s.byChecklist[checklistId] = map[*userSubscription]bool{}
+		}
+		s.byChecklist[checklistId][sub] = true
+	}
+	for checklistId := range sub.checklistIds {
+		if accessible[checklistId] {
+			continue
+		}
+		revoked = append(revoked, checklistId)
+		delete(sub.checklistIds, checklistId)
+		delete(s.byChecklist[checklistId], sub)
+		if len(s.byChecklist[checklistId]) == 0 {
+			delete(s.byChecklist, checklistId)
+		}
+	}
+	return granted, revoked
+}
+
+// isUserStreamEvent reports whether the event type is delivered on user streams
+func isUserStreamEvent(eventType string) bool {
+	switch eventType {
+	case domain.EventTypeChecklistCreated, domain.EventTypeChecklistUpdated, domain.EventTypeChecklistMoved,
+		domain.EventTypeChecklistDeleted, domain.EventTypeChecklistMerged:
+		return true
+	default:
+		return false
+	}
+}
+
+// changesChecklistStats reports whether the event type can change the item counts of a checklist. Adding and
+// deleting rows can complete or reopen their item.
+func changesChecklistStats(eventType string) bool {
+	switch eventType {
+	case domain.EventTypeChecklistItemCreated, domain.EventTypeChecklistItemUpdated,
+		domain.EventTypeChecklistItemDeleted, domain.EventTypeChecklistItemSoftDeleted,
+		domain.EventTypeChecklistItemRestored, domain.EventTypeChecklistItemsBatchUpdated,
+		domain.EventTypeChecklistItemRowAdded, domain.EventTypeChecklistItemRowDeleted,
+		domain.EventTypeChecklistMerged, domain.EventTypeChecklistSplit:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,155 @@
+package notification
+
+import (
+	"context"
+	"testing"
+
+	"com.raunlo.checklist/internal/core/domain"
+	"com.raunlo.checklist/internal/core/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// mockChecklistRepository mocks the checklist queries of the user streams; other methods are not implemented.
+type mockChecklistRepository struct {
+	mock.Mock
+	repository.IChecklistRepository
+}
+
+func (m *mockChecklistRepository) FindAccessibleChecklistIds(ctx context.Context, userId string) ([]uint, domain.Error) {
+	args := m.Called(ctx, userId)
+	var err domain.Error
+	if arg := args.Get(1); arg != nil {
+		err = arg.(domain.Error)
+	}
+	return args.Get(0).([]uint), err
+}
+
+func (m *mockChecklistRepository) FindChecklistStats(ctx context.Context, checklistId uint) (domain.ChecklistStats, domain.Error) {
+	args := m.Called(ctx, checklistId)
+	var err domain.Error
+	if arg := args.Get(1); arg != nil {
+		err = arg.(domain.Error)
+	}
+	return args.Get(0).(domain.ChecklistStats), err
+}
+
+func (m *mockChecklistRepository) FindChecklistItemOrder(ctx context.Context, checklistId uint) ([]domain.ChecklistItemOrder, domain.Error) {
+	args := m.Called(ctx, checklistId)
+	var items []domain.ChecklistItemOrder
+	if arg := args.Get(0); arg != nil {
+		items = arg.([]domain.ChecklistItemOrder)
+	}
+	var err domain.Error
+	if arg := args.Get(1); arg != nil {
+		err = arg.(domain.Error)
+	}
+	return items, err
+}
+
+func userContext(userId string, clientId string) context.Context {
+	return context.WithValue(clientContext(clientId), domain.UserIdContextKey, userId)
+}
+
+func TestBroker_SubscribeUser_ReceivesEventsOfAccessibleChecklists(t *testing.T) {
+	repo := new(mockChecklistRepository)
+	repo.On("FindAccessibleChecklistIds", mock.Anything, "user-a").Return([]uint{1, 2}, nil)
+	b := NewBroker(allowAllGuardrail{}, repo, nil, ClientQueueConfiguration{})
+	ch, err := b.SubscribeUser(userContext("user-a", "client-a"))
+	assert.NoError(t, err)
+
+	b.Publish(clientContext("client-b"), 3, domain.ChecklistItemUpdatesEvent{
+		EventType: domain.EventTypeChecklistUpdated,
+		Payload:   domain.ChecklistEventPayload{Name: "Not shared"},
+	})
+	b.Publish(clientContext("client-b"), 2, domain.ChecklistItemUpdatesEvent{
+		EventType: domain.EventTypeChecklistUpdated,
+		Payload:   domain.ChecklistEventPayload{Name: "Groceries"},
+	})
+
+	assert.Equal(t, domain.ChecklistItemUpdatesEvent{
+		ChecklistId: 2,
+		EventType:   domain.EventTypeChecklistUpdated,
+		Payload:     domain.ChecklistEventPayload{Name: "Groceries"},
+	}, receiveEvent(t, ch))
+}
+
+func TestBroker_SubscribeUser_FollowsAccessChanges(t *testing.T) {
+	repo := new(mockChecklistRepository)
+	repo.On("FindAccessibleChecklistIds", mock.Anything, "user-a").Return([]uint{1}, nil).Once()
+	repo.On("FindAccessibleChecklistIds", mock.Anything, "user-a").Return([]uint{2}, nil).Once()
+	b := NewBroker(allowAllGuardrail{}, repo, nil, ClientQueueConfiguration{})
+	ch, err := b.SubscribeUser(userContext("user-a", "client-a"))
+	assert.NoError(t, err)
+
+	b.Publish(clientContext("client-b"), 0, domain.ChecklistItemUpdatesEvent{
+		EventType:        domain.EventTypeAccessChanged,
+		AccessChangedFor: []string{"user-a"},
+	})
+
+	assert.Equal(t, newChecklistAccessEvent(2, domain.EventTypeChecklistAccessGranted), receiveEvent(t, ch))
+	assert.Equal(t, newChecklistAccessEvent(1, domain.EventTypeChecklistAccessRevoked), receiveEvent(t, ch))
+}
+
+func TestBroker_SubscribeUser_DeletedChecklistIsDeliveredBeforeLosingIt(t *testing.T) {
+	repo := new(mockChecklistRepository)
+	repo.On("FindAccessibleChecklistIds", mock.Anything, "user-a").Return([]uint{1}, nil).Once()
+	repo.On("FindAccessibleChecklistIds", mock.Anything, "user-a").Return([]uint{}, nil).Once()
+	b := NewBroker(allowAllGuardrail{}, repo, nil, ClientQueueConfiguration{})
+	ch, err := b.SubscribeUser(userContext("user-a", "client-a"))
+	assert.NoError(t, err)
+
+	b.Publish(clientContext("client-b"), 1, domain.ChecklistItemUpdatesEvent{
+		EventType: domain.EventTypeChecklistDeleted,
+		Payload:   domain.ChecklistDeletedEventPayload{ChecklistId: 1},
+	})
+
+	assert.Equal(t, domain.ChecklistItemUpdatesEvent{
+		ChecklistId: 1,
+		EventType:   domain.EventTypeChecklistDeleted,
+		Payload:     domain.ChecklistDeletedEventPayload{ChecklistId: 1},
+	}, receiveEvent(t, ch))
+	assert.False(t, b.(*broker).users.hasChecklist(1))
+}
+
+func TestBroker_SubscribeUser_ReceivesStatsWhenItemsChange(t *testing.T) {
+	repo := new(mockChecklistRepository)
+	repo.On("FindAccessibleChecklistIds", mock.Anything, "user-a").Return([]uint{1}, nil)
+	repo.On("FindChecklistStats", mock.Anything, uint(1)).Return(domain.ChecklistStats{TotalItems: 3, CompletedItems: 1}, nil)
+	b := NewBroker(allowAllGuardrail{}, repo, nil, ClientQueueConfiguration{})
+	ch, err := b.SubscribeUser(userContext("user-a", "client-a"))
+	assert.NoError(t, err)
+
+	// the client that changed the items also gets the new counts
+	b.Publish(clientContext("client-a"), 1, domain.ChecklistItemUpdatesEvent{
+		EventType: domain.EventTypeChecklistItemDeleted,
+		Payload:   domain.ChecklistItemDeletedEventPayload{ItemId: 7},
+	})
+
+	assert.Equal(t, domain.ChecklistItemUpdatesEvent{
+		ChecklistId: 1,
+		EventType:   domain.EventTypeChecklistStatsChanged,
+		Payload:     domain.ChecklistStatsChangedEventPayload{TotalItems: 3, CompletedItems: 1},
+	}, receiveEvent(t, ch))
+}
+
+func TestBroker_SubscribeUser_ReceivesStatsWhenRowsChange(t *testing.T) {
+	repo := new(mockChecklistRepository)
+	repo.On("FindAccessibleChecklistIds", mock.Anything, "user-a").Return([]uint{1}, nil)
+	repo.On("FindChecklistStats", mock.Anything, uint(1)).Return(domain.ChecklistStats{TotalItems: 3, CompletedItems: 2}, nil)
+	b := NewBroker(allowAllGuardrail{}, repo, nil, ClientQueueConfiguration{})
+	ch, err := b.SubscribeUser(userContext("user-a", "client-a"))
+	assert.NoError(t, err)
+
+	// deleting the last open row completes the item
+	b.Publish(clientContext("client-b"), 1, domain.ChecklistItemUpdatesEvent{
+		EventType: domain.EventTypeChecklistItemRowDeleted,
+		Payload:   domain.ChecklistItemRowDeletedPayload{ItemId: 7, RowId: 3},
+	})
+
+	assert.Equal(t, domain.ChecklistItemUpdatesEvent{
+		ChecklistId: 1,
+		EventType:   domain.EventTypeChecklistStatsChanged,
+		Payload:     domain.ChecklistStatsChangedEventPayload{TotalItems: 3, CompletedItems: 2},
+	}, receiveEvent(t, ch))
+}
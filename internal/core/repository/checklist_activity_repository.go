@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"com.raunlo.checklist/internal/core/domain"
+)
+
+// IChecklistActivityRepository stores the append-only checklist activity log.
+// Entries are never updated; they are only removed by retention.
+type IChecklistActivityRepository interface {
+	AppendActivity(ctx context.Context, activity domain.ChecklistActivity) domain.Error
+	// FindActivityPage returns up to limit entries older than the before cursor (nil = newest), newest first
+	FindActivityPage(ctx context.Context, checklistId uint, before *uint, limit int) (domain.ChecklistActivityPage, domain.Error)
+	PurgeActivityOlderThan(ctx context.Context, retentionPeriod time.Duration) (int64, domain.Error)
+}
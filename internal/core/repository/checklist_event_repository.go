@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"com.raunlo.checklist/internal/core/domain"
+)
+
+// IChecklistEventRepository shares checklist events between application instances through the database
+type IChecklistEventRepository interface {
+	// SaveEvent numbers the event within its checklist, stores it and notifies all listening instances about it.
+	// Only the last replayLogSize events of the checklist are kept.
+	SaveEvent(ctx context.Context, event domain.ChecklistEventRecord, replayLogSize int) domain.Error
+	// FindEventsAfter returns the stored events of a checklist with an event id after afterEventId, oldest first,
+	// and the id of the last event of the checklist
+	FindEventsAfter(ctx context.Context, checklistId uint, afterEventId uint64) ([]domain.ChecklistEventRecord, uint64, domain.Error)
+	// ListenForEvents calls handler for every saved event and presenceHandler for every published presence
+	// change, including those of this instance, until the context is cancelled or the connection is lost. It
+	// returns nil when the context is cancelled. When afterId is not zero, the stored events with a larger id
+	// are handled first, so events saved while a previous connection was down are not missed. Ids come from
+	// one sequence and events of different checklists can commit out of id order, so the caller passes an id
+	// before the last event it handled and skips the events it already handled.
+	ListenForEvents(ctx context.Context, afterId uint64, handler func(event domain.ChecklistEventRecord),
+		presenceHandler func(record domain.ChecklistPresenceRecord)) domain.Error
+	// PublishPresence notifies all listening instances about a presence change without storing it
+	PublishPresence(ctx context.Context, record domain.ChecklistPresenceRecord) domain.Error
+	PurgeEventsOlderThan(ctx context.Context, retentionPeriod time.Duration) (int64, domain.Error)
+}
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"com.raunlo.checklist/internal/core/domain"
+)
+
+// IChecklistHistoryRepository reads the append-only change history of checklists.
+// Entries are written per changed item by the item mutations themselves, inside their transaction.
+type IChecklistHistoryRepository interface {
+	// FindStateAsOf rebuilds the active items of a checklist, in display order, from the latest entry of
+	// each item recorded at or before the given time
+	FindStateAsOf(ctx context.Context, checklistId uint, at time.Time) (domain.ChecklistState, domain.Error)
+	// PurgeHistoryOlderThan removes entries older than the retention period,
+	// keeping the newest expired entry of each item so the state at the cutoff stays known
+	PurgeHistoryOlderThan(ctx context.Context, retentionPeriod time.Duration) (int64, domain.Error)
+}
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	"com.raunlo.checklist/internal/core/domain"
+)
+
+type IChecklistItemCommentRepository interface {
+	FindItemComments(ctx context.Context, checklistId uint, itemId uint) ([]domain.ChecklistItemComment, domain.Error)
+	// FindItemCommentById returns nil when the comment does not exist or is deleted
+	FindItemCommentById(ctx context.Context, checklistId uint, itemId uint, commentId uint) (*domain.ChecklistItemComment, domain.Error)
+	SaveItemComment(ctx context.Context, comment domain.ChecklistItemComment) (domain.ChecklistItemComment, domain.Error)
+	UpdateItemComment(ctx context.Context, comment domain.ChecklistItemComment) (domain.ChecklistItemComment, domain.Error)
+	DeleteItemComment(ctx context.Context, checklistId uint, itemId uint, commentId uint, author string) domain.Error
+	// FindUsersWithAccess returns the given users that can access the checklist
+	FindUsersWithAccess(ctx context.Context, checklistId uint, userIds []string) ([]string, domain.Error)
+}
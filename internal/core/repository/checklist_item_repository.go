@@ -18,11 +18,32 @@ type IChecklistItemsRepository interface {
 	DeleteChecklistItemRowAndAutoComplete(ctx context.Context, checklistId uint, itemId uint, rowId uint) (domain.ChecklistItemRowDeletionResult, domain.Error)
 	FindAllChecklistItems(ctx context.Context, checklistId uint, completed *bool, sortOrder domain.SortOrder) ([]domain.ChecklistItem, domain.Error)
 	ChangeChecklistItemOrder(ctx context.Context, request domain.ChangeOrderRequest) (domain.ChangeOrderResponse, domain.Error)
-	ToggleItemCompleted(ctx context.Context, checklistId uint, checklistItemId uint, completed bool) (domain.ChecklistItem, domain.Error)
-	// RebalancePositions redistributes positions evenly for all items in a checklist
-	RebalancePositions(ctx context.Context, checklistId uint) domain.Error
+	ToggleItemCompleted(ctx context.Context, checklistId uint, checklistItemId uint, completed bool) (domain.ChecklistItemToggleResult, domain.Error)
+	// ApplyBatch applies all operations in a single transaction; if any operation fails nothing is applied
+	ApplyBatch(ctx context.Context, checklistId uint, operations []domain.ChecklistItemBatchOperation) (domain.ChecklistItemBatchResult, domain.Error)
+	// MoveChecklistItem re-parents an item with its rows into another checklist
+	MoveChecklistItem(ctx context.Context, request domain.ChecklistItemTransferRequest) (domain.ChecklistItemTransferResult, domain.Error)
+	// CopyChecklistItem duplicates an item with its rows into another checklist, keeping completion state
+	CopyChecklistItem(ctx context.Context, request domain.ChecklistItemTransferRequest) (domain.ChecklistItemTransferResult, domain.Error)
+	// RebalancePositions runs the pending rebalance of a checklist: it rewrites the positions of all items to
+	// short keys and returns the new positions of the active items. Returns false when no rebalance is pending or
+	// another instance is running it.
+	RebalancePositions(ctx context.Context, checklistId uint) (map[uint]string, bool, domain.Error)
+	// ScheduleRebalance records a pending rebalance that is due after debounce; repeated requests postpone it,
+	// but not further than maxWait after the first one
+	ScheduleRebalance(ctx context.Context, checklistId uint, debounce time.Duration, maxWait time.Duration) domain.Error
+	// FindDueRebalances returns checklists whose pending rebalance is due
+	FindDueRebalances(ctx context.Context) ([]uint, domain.Error)
 	// RestoreChecklistItem restores a soft-deleted item (undo functionality)
 	RestoreChecklistItem(ctx context.Context, checklistId uint, itemId uint) (domain.ChecklistItem, domain.Error)
+	// ClearCompletedItems soft-deletes all completed items of a checklist as one deletion group
+	ClearCompletedItems(ctx context.Context, checklistId uint) (domain.ChecklistItemDeletionGroup, domain.Error)
+	// RestoreDeletionGroup restores all items of a deletion group that are still soft-deleted
+	RestoreDeletionGroup(ctx context.Context, checklistId uint, groupId uint) (domain.ChecklistItemBatchResult, domain.Error)
+	// ResetChecklistItems unchecks all items and rows and moves them into the incomplete section
+	ResetChecklistItems(ctx context.Context, checklistId uint) (domain.ChecklistItemBatchResult, domain.Error)
+	// SortChecklistItems rewrites the positions of all items in the requested order and returns them in display order
+	SortChecklistItems(ctx context.Context, request domain.ChecklistItemSortRequest) ([]domain.ChecklistItem, domain.Error)
 	// PurgeSoftDeletedItems permanently deletes items that were soft-deleted before the retention period
 	// Returns the number of items purged
 	PurgeSoftDeletedItems(ctx context.Context, retentionPeriod time.Duration) (int64, domain.Error)
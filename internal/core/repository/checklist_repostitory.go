@@ -10,6 +10,12 @@ type IChecklistRepository interface {
 	UpdateChecklist(ctx context.Context, checklist domain.Checklist) (domain.Checklist, domain.Error)
 	SaveChecklist(ctx context.Context, checklist domain.Checklist) (domain.Checklist, domain.Error)
 	FindChecklistById(ctx context.Context, id uint) (*domain.Checklist, domain.Error)
+	// CloneChecklist copies a checklist with its items and rows into a new checklist owned by the caller
+	CloneChecklist(ctx context.Context, sourceChecklistId uint, name string, options domain.ChecklistCloneOptions) (domain.Checklist, domain.Error)
+	// MergeChecklists moves the items of the source checklist into the target and archives the source
+	MergeChecklists(ctx context.Context, targetChecklistId uint, sourceChecklistId uint) (domain.ChecklistMergeResult, domain.Error)
+	// SplitChecklist moves the selected items into a new checklist owned by the caller
+	SplitChecklist(ctx context.Context, sourceChecklistId uint, request domain.ChecklistSplitRequest) (domain.ChecklistSplitResult, domain.Error)
 	DeleteChecklistById(ctx context.Context, id uint) domain.Error
 	CheckUserHasAccessToChecklist(ctx context.Context, checklistId uint, userId string) (bool, domain.Error)
 	CheckUserIsOwner(ctx context.Context, checklistId uint, userId string) (bool, domain.Error)
@@ -17,4 +23,13 @@ type IChecklistRepository interface {
 	CreateChecklistShare(ctx context.Context, checklistId uint, sharedByUserId string, sharedWithUserId string) domain.Error
 	DeleteChecklistShare(ctx context.Context, checklistId uint, userId string) domain.Error
 	FindChecklistsByWorkspaceId(ctx context.Context, workspaceId uint) ([]domain.Checklist, domain.Error)
+	// FindAccessibleChecklistIds returns the ids of the active checklists the user owns, was shared or can
+	// access through a workspace
+	FindAccessibleChecklistIds(ctx context.Context, userId string) ([]uint, domain.Error)
+	// FindUserIdsWithAccess returns the users that can access the checklist
+	FindUserIdsWithAccess(ctx context.Context, checklistId uint) ([]string, domain.Error)
+	// FindChecklistStats counts the active items of a checklist
+	FindChecklistStats(ctx context.Context, checklistId uint) (domain.ChecklistStats, domain.Error)
+	// FindChecklistItemOrder returns the order number of every active item of a checklist within its section
+	FindChecklistItemOrder(ctx context.Context, checklistId uint) ([]domain.ChecklistItemOrder, domain.Error)
 }
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	"com.raunlo.checklist/internal/core/domain"
+)
+
+type IChecklistRunRepository interface {
+	// CreateRunFromChecklist snapshots the active items and rows of a checklist into a new run
+	CreateRunFromChecklist(ctx context.Context, checklistId uint, startedBy string) (domain.ChecklistRun, domain.Error)
+	FindRunsByChecklistId(ctx context.Context, checklistId uint) ([]domain.ChecklistRun, domain.Error)
+	FindRunById(ctx context.Context, checklistId uint, runId uint) (*domain.ChecklistRun, domain.Error)
+	UpdateRunStepStatus(ctx context.Context, runId uint, stepId uint, status domain.ChecklistRunStepStatus, checkedBy string) (domain.ChecklistRunStep, domain.Error)
+	UpdateRunStepRowStatus(ctx context.Context, runId uint, stepId uint, rowId uint, status domain.ChecklistRunStepStatus, checkedBy string) (domain.ChecklistRunStepRow, domain.Error)
+	// FinishRun stores who finished the run, its duration and its completion computed from the current steps
+	FinishRun(ctx context.Context, checklistId uint, runId uint, finishedBy string) (domain.ChecklistRun, domain.Error)
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"com.raunlo.checklist/internal/core/domain"
+)
+
+type IChecklistSectionRepository interface {
+	FindChecklistSections(ctx context.Context, checklistId uint) ([]domain.ChecklistSection, domain.Error)
+	// SaveChecklistSection adds a section after the last section of its checklist
+	SaveChecklistSection(ctx context.Context, section domain.ChecklistSection) (domain.ChecklistSection, domain.Error)
+	UpdateChecklistSection(ctx context.Context, section domain.ChecklistSection) (domain.ChecklistSection, domain.Error)
+	// DeleteChecklistSection deletes a section and moves its items to the end of the items without a section
+	DeleteChecklistSection(ctx context.Context, checklistId uint, sectionId uint) (domain.ChecklistSectionDeletionResult, domain.Error)
+	ChangeChecklistSectionOrder(ctx context.Context, request domain.ChangeSectionOrderRequest) (domain.ChecklistSection, domain.Error)
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"com.raunlo.checklist/internal/core/domain"
+)
+
+// IWorkspaceActivityRepository stores the append-only workspace activity feed.
+// Entries are never updated; they are only removed by retention.
+type IWorkspaceActivityRepository interface {
+	AppendActivity(ctx context.Context, activity domain.WorkspaceActivity) domain.Error
+	// FindActivityPage returns up to limit matching entries older than the before cursor (nil = newest), newest first
+	FindActivityPage(ctx context.Context, workspaceId uint, filter domain.WorkspaceActivityFilter, before *uint, limit int) (domain.WorkspaceActivityPage, domain.Error)
+	PurgeActivityOlderThan(ctx context.Context, retentionPeriod time.Duration) (int64, domain.Error)
+}
@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+	"log"
+
+	"com.raunlo.checklist/internal/core/domain"
+	guardrail "com.raunlo.checklist/internal/core/guard_rail"
+	"com.raunlo.checklist/internal/core/repository"
+)
+
+type IChecklistActivityService interface {
+	// RecordActivity appends an entry to the checklist activity log on behalf of the current user.
+	// Failures are logged and never fail the mutation that produced the entry.
+	RecordActivity(ctx context.Context, activity domain.ChecklistActivity)
+	FindChecklistActivity(ctx context.Context, checklistId uint, before *uint, limit *int) (domain.ChecklistActivityPage, domain.Error)
+}
+
+type checklistActivityService struct {
+	repository                repository.IChecklistActivityRepository
+	checklistOwnershipChecker guardrail.IChecklistOwnershipChecker
+}
+
+func (service *checklistActivityService) RecordActivity(ctx context.Context, activity domain.ChecklistActivity) {
+	if activity.Actor == "" {
+		userId, err := domain.GetUserIdFromContext(ctx)
+		if err != nil {
+			log.Printf("Activity not recorded: checklistId=%d, action=%s, no user in context", activity.ChecklistId, activity.Action)
+			return
+		}
+		activity.Actor = userId
+	}
+
+	if err := service.repository.AppendActivity(ctx, activity); err != nil {
+		log.Printf("Activity not recorded: checklistId=%d, action=%s: %v", activity.ChecklistId, activity.Action, err)
+	}
+}
+
+func (service *checklistActivityService) FindChecklistActivity(ctx context.Context, checklistId uint, before *uint, limit *int) (domain.ChecklistActivityPage, domain.Error) {
+	if err := service.checklistOwnershipChecker.HasAccessToChecklist(ctx, checklistId); err != nil {
+		return domain.ChecklistActivityPage{}, err
+	}
+	return service.repository.FindActivityPage(ctx, checklistId, before, domain.NormalizeActivityPageSize(limit))
+}
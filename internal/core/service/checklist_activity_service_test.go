@@ -0,0 +1,97 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"com.raunlo.checklist/internal/core/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+// mockChecklistActivityRepository uses testify's mock for repository.IChecklistActivityRepository.
+type mockChecklistActivityRepository struct {
+	mock.Mock
+}
+
+func (m *mockChecklistActivityRepository) AppendActivity(ctx context.Context, activity domain.ChecklistActivity) domain.Error {
+	args := m.Called(ctx, activity)
+	if arg := args.Get(0); arg != nil {
+		return arg.(domain.Error)
+	}
+	return nil
+}
+
+func (m *mockChecklistActivityRepository) FindActivityPage(ctx context.Context, checklistId uint, before *uint, limit int) (domain.ChecklistActivityPage, domain.Error) {
+	args := m.Called(ctx, checklistId, before, limit)
+	var err domain.Error
+	if arg := args.Get(1); arg != nil {
+		err = arg.(domain.Error)
+	}
+	return args.Get(0).(domain.ChecklistActivityPage), err
+}
+
+func (m *mockChecklistActivityRepository) PurgeActivityOlderThan(ctx context.Context, retentionPeriod time.Duration) (int64, domain.Error) {
+	args := m.Called(ctx, retentionPeriod)
+	var err domain.Error
+	if arg := args.Get(1); arg != nil {
+		err = arg.(domain.Error)
+	}
+	return args.Get(0).(int64), err
+}
+
+func TestChecklistActivityService_RecordActivity_FillsActorFromContext(t *testing.T) {
+	ctx := domain.AddUserIdToContext(context.Background(), "user-1")
+	repo := new(mockChecklistActivityRepository)
+	repo.On("AppendActivity", mock.Anything, mock.AnythingOfType("domain.ChecklistActivity")).Return(nil)
+
+	svc := &checklistActivityService{repository: repo}
+	svc.RecordActivity(ctx, domain.ChecklistActivity{ChecklistId: 100, Action: domain.ActivityItemCreated})
+
+	recorded := repo.Calls[0].Arguments.Get(1).(domain.ChecklistActivity)
+	if recorded.Actor != "user-1" {
+		t.Fatalf("expected actor user-1 got %q", recorded.Actor)
+	}
+}
+
+func TestChecklistActivityService_RecordActivity_IgnoresRepositoryFailure(t *testing.T) {
+	ctx := domain.AddUserIdToContext(context.Background(), "user-1")
+	repo := new(mockChecklistActivityRepository)
+	repo.On("AppendActivity", mock.Anything, mock.Anything).Return(domain.NewError("db down", 500))
+
+	svc := &checklistActivityService{repository: repo}
+	svc.RecordActivity(ctx, domain.ChecklistActivity{ChecklistId: 100, Action: domain.ActivityItemDeleted})
+
+	repo.AssertExpectations(t)
+}
+
+func TestChecklistActivityService_FindChecklistActivity_ClampsPageSize(t *testing.T) {
+	ctx := domain.AddUserIdToContext(context.Background(), "user-1")
+	repo := new(mockChecklistActivityRepository)
+	ownershipChecker := new(mockChecklistOwnershipChecker)
+	limit := 10000
+
+	ownershipChecker.On("HasAccessToChecklist", mock.Anything, uint(100)).Return(nil)
+	repo.On("FindActivityPage", mock.Anything, uint(100), (*uint)(nil), domain.MaxActivityPageSize).Return(domain.ChecklistActivityPage{}, nil)
+
+	svc := &checklistActivityService{repository: repo, checklistOwnershipChecker: ownershipChecker}
+	if _, err := svc.FindChecklistActivity(ctx, 100, nil, &limit); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	repo.AssertExpectations(t)
+}
+
+func TestChecklistActivityService_FindChecklistActivity_AccessDenied(t *testing.T) {
+	ctx := domain.AddUserIdToContext(context.Background(), "user-1")
+	repo := new(mockChecklistActivityRepository)
+	ownershipChecker := new(mockChecklistOwnershipChecker)
+
+	ownershipChecker.On("HasAccessToChecklist", mock.Anything, uint(100)).Return(domain.NewError("Checklist(id=100) not found", 404))
+
+	svc := &checklistActivityService{repository: repo, checklistOwnershipChecker: ownershipChecker}
+	_, err := svc.FindChecklistActivity(ctx, 100, nil, nil)
+	if err == nil || err.ResponseCode() != 404 {
+		t.Fatalf("expected 404 got %v", err)
+	}
+	repo.AssertNotCalled(t, "FindActivityPage", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
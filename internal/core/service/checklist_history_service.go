@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"com.raunlo.checklist/internal/core/domain"
+	guardrail "com.raunlo.checklist/internal/core/guard_rail"
+	"com.raunlo.checklist/internal/core/repository"
+)
+
+type IChecklistHistoryService interface {
+	FindChecklistStateAsOf(ctx context.Context, checklistId uint, asOf time.Time) (domain.ChecklistState, domain.Error)
+	DiffChecklistState(ctx context.Context, checklistId uint, from time.Time, to time.Time) (domain.ChecklistStateDiff, domain.Error)
+}
+
+type checklistHistoryService struct {
+	repository                repository.IChecklistHistoryRepository
+	checklistOwnershipChecker guardrail.IChecklistOwnershipChecker
+}
+
+func (service *checklistHistoryService) FindChecklistStateAsOf(ctx context.Context, checklistId uint, asOf time.Time) (domain.ChecklistState, domain.Error) {
+	if err := service.checklistOwnershipChecker.HasAccessToChecklist(ctx, checklistId); err != nil {
+		return domain.ChecklistState{}, err
+	}
+	return service.findState(ctx, checklistId, asOf)
+}
+
+func (service *checklistHistoryService) DiffChecklistState(ctx context.Context, checklistId uint, from time.Time, to time.Time) (domain.ChecklistStateDiff, domain.Error) {
+	if !from.Before(to) {
+		return domain.ChecklistStateDiff{}, domain.NewError("from must be before to", 400)
+	}
+	if err := service.checklistOwnershipChecker.HasAccessToChecklist(ctx, checklistId); err != nil {
+		return domain.ChecklistStateDiff{}, err
+	}
+
+	fromState, err := service.findState(ctx, checklistId, from)
+	if err != nil {
+		return domain.ChecklistStateDiff{}, err
+	}
+	toState, err := service.findState(ctx, checklistId, to)
+	if err != nil {
+		return domain.ChecklistStateDiff{}, err
+	}
+	return domain.DiffChecklistStates(fromState, toState), nil
+}
+
+func (service *checklistHistoryService) findState(ctx context.Context, checklistId uint, asOf time.Time) (domain.ChecklistState, domain.Error) {
+	state, err := service.repository.FindStateAsOf(ctx, checklistId, asOf.UTC())
+	if err != nil {
+		return domain.ChecklistState{}, err
+	}
+	state.ChecklistId = checklistId
+	state.AsOf = asOf
+	return state, nil
+}
@@ -0,0 +1,124 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"com.raunlo.checklist/internal/core/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+// mockChecklistHistoryRepository uses testify's mock for repository.IChecklistHistoryRepository.
+type mockChecklistHistoryRepository struct {
+	mock.Mock
+}
+
+func (m *mockChecklistHistoryRepository) FindStateAsOf(ctx context.Context, checklistId uint, at time.Time) (domain.ChecklistState, domain.Error) {
+	args := m.Called(ctx, checklistId, at)
+	var err domain.Error
+	if arg := args.Get(1); arg != nil {
+		err = arg.(domain.Error)
+	}
+	return args.Get(0).(domain.ChecklistState), err
+}
+
+func (m *mockChecklistHistoryRepository) PurgeHistoryOlderThan(ctx context.Context, retentionPeriod time.Duration) (int64, domain.Error) {
+	args := m.Called(ctx, retentionPeriod)
+	var err domain.Error
+	if arg := args.Get(1); arg != nil {
+		err = arg.(domain.Error)
+	}
+	return args.Get(0).(int64), err
+}
+
+func TestChecklistHistoryService_DiffChecklistState(t *testing.T) {
+	ctx := domain.AddUserIdToContext(context.Background(), "user-1")
+	from := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	to := from.Add(time.Hour)
+
+	repo := new(mockChecklistHistoryRepository)
+	ownershipChecker := new(mockChecklistOwnershipChecker)
+	ownershipChecker.On("HasAccessToChecklist", mock.Anything, uint(100)).Return(nil)
+	repo.On("FindStateAsOf", mock.Anything, uint(100), from).Return(domain.ChecklistState{
+		RecordedAt: new(from.Add(-time.Minute)),
+		Items: []domain.ChecklistItem{
+			{Id: 1, Name: "Milk", OrderNumber: 1},
+			{Id: 2, Name: "Bread", OrderNumber: 2, Rows: []domain.ChecklistItemRow{{Id: 10, Name: "Rye"}}},
+			{Id: 3, Name: "Eggs", OrderNumber: 3},
+		},
+	}, nil)
+	repo.On("FindStateAsOf", mock.Anything, uint(100), to).Return(domain.ChecklistState{
+		RecordedAt: new(to.Add(-time.Minute)),
+		Items: []domain.ChecklistItem{
+			{Id: 2, Name: "Bread", OrderNumber: 1, Rows: []domain.ChecklistItemRow{{Id: 10, Name: "Rye", Completed: true}}},
+			{Id: 3, Name: "Eggs", OrderNumber: 2},
+			{Id: 1, Name: "Milk", OrderNumber: 3, Completed: true},
+			{Id: 4, Name: "Butter", OrderNumber: 4},
+		},
+	}, nil)
+
+	svc := &checklistHistoryService{repository: repo, checklistOwnershipChecker: ownershipChecker}
+	diff, err := svc.DiffChecklistState(ctx, 100, from, to)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(diff.Added) != 1 || diff.Added[0].Id != 4 {
+		t.Fatalf("expected item 4 to be added, got %+v", diff.Added)
+	}
+	if len(diff.Removed) != 0 {
+		t.Fatalf("expected no removed items, got %+v", diff.Removed)
+	}
+	changes := map[uint][]domain.ChecklistItemChangeField{}
+	for _, change := range diff.Changed {
+		changes[change.ItemId] = change.Fields
+	}
+	expected := map[uint][]domain.ChecklistItemChangeField{
+		1: {domain.ItemChangeCompleted, domain.ItemChangeOrder},
+		2: {domain.ItemChangeOrder, domain.ItemChangeRows},
+		3: {domain.ItemChangeOrder},
+	}
+	for itemId, fields := range expected {
+		if len(changes[itemId]) != len(fields) {
+			t.Fatalf("item %d: expected fields %v got %v", itemId, fields, changes[itemId])
+		}
+		for index, field := range fields {
+			if changes[itemId][index] != field {
+				t.Fatalf("item %d: expected fields %v got %v", itemId, fields, changes[itemId])
+			}
+		}
+	}
+}
+
+func TestChecklistHistoryService_FindChecklistStateAsOf_NoHistory(t *testing.T) {
+	ctx := domain.AddUserIdToContext(context.Background(), "user-1")
+	asOf := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	repo := new(mockChecklistHistoryRepository)
+	ownershipChecker := new(mockChecklistOwnershipChecker)
+	ownershipChecker.On("HasAccessToChecklist", mock.Anything, uint(100)).Return(nil)
+	repo.On("FindStateAsOf", mock.Anything, uint(100), asOf).Return(domain.ChecklistState{Items: []domain.ChecklistItem{}}, nil)
+
+	svc := &checklistHistoryService{repository: repo, checklistOwnershipChecker: ownershipChecker}
+	state, err := svc.FindChecklistStateAsOf(ctx, 100, asOf)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if state.ChecklistId != 100 || !state.AsOf.Equal(asOf) || state.RecordedAt != nil || len(state.Items) != 0 {
+		t.Fatalf("expected an empty state, got %+v", state)
+	}
+}
+
+func TestChecklistHistoryService_DiffChecklistState_InvalidRange(t *testing.T) {
+	ctx := domain.AddUserIdToContext(context.Background(), "user-1")
+	at := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	repo := new(mockChecklistHistoryRepository)
+	svc := &checklistHistoryService{repository: repo, checklistOwnershipChecker: new(mockChecklistOwnershipChecker)}
+	_, err := svc.DiffChecklistState(ctx, 100, at, at)
+	if err == nil || err.ResponseCode() != 400 {
+		t.Fatalf("expected 400 got %v", err)
+	}
+	repo.AssertNotCalled(t, "FindStateAsOf", mock.Anything, mock.Anything, mock.Anything)
+}
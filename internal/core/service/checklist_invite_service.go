@@ -2,12 +2,14 @@ package service
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
 	"com.raunlo.checklist/internal/core/domain"
 	"com.raunlo.checklist/internal/core/error"
 	guardrail "com.raunlo.checklist/internal/core/guard_rail"
+	"com.raunlo.checklist/internal/core/notification"
 	"com.raunlo.checklist/internal/core/repository"
 	"com.raunlo.checklist/internal/util"
 )
@@ -23,17 +25,23 @@ type checklistInviteService struct {
 	inviteRepository    repository.IChecklistInviteRepository
 	checklistRepository repository.IChecklistRepository
 	ownershipChecker    guardrail.IChecklistOwnershipChecker
+	activityService     IChecklistActivityService
+	notifier            notification.INotificationService
 }
 
 func newChecklistInviteService(
 	inviteRepo repository.IChecklistInviteRepository,
 	checklistRepo repository.IChecklistRepository,
 	ownershipChecker guardrail.IChecklistOwnershipChecker,
+	activityService IChecklistActivityService,
+	notifier notification.INotificationService,
 ) IChecklistInviteService {
 	return &checklistInviteService{
 		inviteRepository:    inviteRepo,
 		checklistRepository: checklistRepo,
 		ownershipChecker:    ownershipChecker,
+		activityService:     activityService,
+		notifier:            notifier,
 	}
 }
 
@@ -42,6 +50,10 @@ func (s *checklistInviteService) CreateInvite(ctx context.Context, checklistId u
 	if err := s.ownershipChecker.IsChecklistOwner(ctx, checklistId); err != nil {
 		return domain.ChecklistInvite{}, err
 	}
+	// Archived checklists can not be shared any further
+	if err := s.ownershipChecker.CanModifyChecklist(ctx, checklistId); err != nil {
+		return domain.ChecklistInvite{}, err
+	}
 
 	// Get userId from context
 	userId, err := domain.GetUserIdFromContext(ctx)
@@ -170,6 +182,16 @@ func (s *checklistInviteService) ClaimInvite(ctx context.Context, token string)
 		return invite.ChecklistId, nil
 	}
 
+	// Archived checklists can not be shared any further
+	checklist, findErr := s.checklistRepository.FindChecklistById(ctx, invite.ChecklistId)
+	if findErr != nil {
+		return 0, findErr
+	} else if checklist == nil {
+		return 0, error.NewChecklistNotFoundError(invite.ChecklistId)
+	} else if checklist.ArchivedAt != nil {
+		return 0, error.NewChecklistArchivedError(invite.ChecklistId)
+	}
+
 	// Claim the invite and create share in a single transaction
 	// This prevents race conditions where invite is claimed but share fails
 	claimAndShareErr := s.inviteRepository.ClaimInviteAndCreateShare(ctx, token, userId, invite.ChecklistId, invite.CreatedBy)
@@ -178,5 +200,15 @@ func (s *checklistInviteService) ClaimInvite(ctx context.Context, token string)
 	}
 
 	log.Printf("Invite claimed: token=%s..., checklistId=%d, claimedBy=%s", token[:8], invite.ChecklistId, domain.GetHashedUserIdFromContext(ctx))
+	if s.activityService != nil {
+		s.activityService.RecordActivity(ctx, domain.ChecklistActivity{
+			ChecklistId: invite.ChecklistId,
+			Action:      domain.ActivityShareAdded,
+			After:       new(fmt.Sprintf("sharedWithUserId=%s, inviteId=%d", userId, invite.Id)),
+		})
+	}
+	if s.notifier != nil {
+		s.notifier.NotifyAccessChanged(ctx, []string{userId})
+	}
 	return invite.ChecklistId, nil
 }
@@ -0,0 +1,151 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"com.raunlo.checklist/internal/core/domain"
+	guardrail "com.raunlo.checklist/internal/core/guard_rail"
+	"com.raunlo.checklist/internal/core/notification"
+	"com.raunlo.checklist/internal/core/repository"
+)
+
+const (
+	// MaxCommentLength is the maximum allowed length for the content of an item comment
+	MaxCommentLength = 2000
+	// MaxMentionsPerComment is the maximum number of users mentioned in one comment
+	MaxMentionsPerComment = 20
+)
+
+type IChecklistItemCommentService interface {
+	FindItemComments(ctx context.Context, checklistId uint, itemId uint) ([]domain.ChecklistItemComment, domain.Error)
+	// CreateItemComment adds a comment or a reply by the current user. Mentioned users must have access to the checklist.
+	CreateItemComment(ctx context.Context, comment domain.ChecklistItemComment) (domain.ChecklistItemComment, domain.Error)
+	// UpdateItemComment changes the content and mentions of a comment. Only its author can edit it.
+	UpdateItemComment(ctx context.Context, comment domain.ChecklistItemComment) (domain.ChecklistItemComment, domain.Error)
+	// DeleteItemComment deletes a comment of the current user. Replies to it are kept.
+	DeleteItemComment(ctx context.Context, checklistId uint, itemId uint, commentId uint) domain.Error
+}
+
+type checklistItemCommentService struct {
+	repository                repository.IChecklistItemCommentRepository
+	notifier                  notification.INotificationService
+	checklistOwnershipChecker guardrail.IChecklistOwnershipChecker
+}
+
+func (service *checklistItemCommentService) FindItemComments(ctx context.Context, checklistId uint, itemId uint) ([]domain.ChecklistItemComment, domain.Error) {
+	if err := service.checklistOwnershipChecker.HasAccessToChecklist(ctx, checklistId); err != nil {
+		return nil, err
+	}
+	return service.repository.FindItemComments(ctx, checklistId, itemId)
+}
+
+func (service *checklistItemCommentService) CreateItemComment(ctx context.Context, comment domain.ChecklistItemComment) (domain.ChecklistItemComment, domain.Error) {
+	if err := service.checklistOwnershipChecker.CanModifyChecklist(ctx, comment.ChecklistId); err != nil {
+		return domain.ChecklistItemComment{}, err
+	}
+	userId, err := domain.GetUserIdFromContext(ctx)
+	if err != nil {
+		return domain.ChecklistItemComment{}, err
+	}
+	comment.Author = userId
+	if err := service.validateComment(ctx, &comment); err != nil {
+		return domain.ChecklistItemComment{}, err
+	}
+
+	result, err := service.repository.SaveItemComment(ctx, comment)
+	if err == nil {
+		service.notifier.NotifyCommentCreated(ctx, result)
+	}
+	return result, err
+}
+
+func (service *checklistItemCommentService) UpdateItemComment(ctx context.Context, comment domain.ChecklistItemComment) (domain.ChecklistItemComment, domain.Error) {
+	if err := service.checklistOwnershipChecker.CanModifyChecklist(ctx, comment.ChecklistId); err != nil {
+		return domain.ChecklistItemComment{}, err
+	}
+	userId, err := service.checkAuthor(ctx, comment.ChecklistId, comment.ItemId, comment.Id)
+	if err != nil {
+		return domain.ChecklistItemComment{}, err
+	}
+	comment.Author = userId
+	if err := service.validateComment(ctx, &comment); err != nil {
+		return domain.ChecklistItemComment{}, err
+	}
+
+	result, err := service.repository.UpdateItemComment(ctx, comment)
+	if err == nil {
+		service.notifier.NotifyCommentUpdated(ctx, result)
+	}
+	return result, err
+}
+
+func (service *checklistItemCommentService) DeleteItemComment(ctx context.Context, checklistId uint, itemId uint, commentId uint) domain.Error {
+	if err := service.checklistOwnershipChecker.CanModifyChecklist(ctx, checklistId); err != nil {
+		return err
+	}
+	userId, err := service.checkAuthor(ctx, checklistId, itemId, commentId)
+	if err != nil {
+		return err
+	}
+
+	err = service.repository.DeleteItemComment(ctx, checklistId, itemId, commentId, userId)
+	if err == nil {
+		service.notifier.NotifyCommentDeleted(ctx, checklistId, itemId, commentId)
+	}
+	return err
+}
+
+// checkAuthor verifies that the comment exists and was written by the current user, whose id is returned
+func (service *checklistItemCommentService) checkAuthor(ctx context.Context, checklistId uint, itemId uint, commentId uint) (string, domain.Error) {
+	userId, err := domain.GetUserIdFromContext(ctx)
+	if err != nil {
+		return "", err
+	}
+	existing, err := service.repository.FindItemCommentById(ctx, checklistId, itemId, commentId)
+	if err != nil {
+		return "", err
+	} else if existing == nil {
+		return "", domain.NewError(fmt.Sprintf("Comment(id=%d) not found", commentId), 404)
+	} else if existing.Author != userId {
+		return "", domain.NewError("Only the author can change a comment", 403)
+	}
+	return userId, nil
+}
+
+// validateComment trims the content, removes duplicate mentions and checks that every mentioned user has
+// access to the checklist
+func (service *checklistItemCommentService) validateComment(ctx context.Context, comment *domain.ChecklistItemComment) domain.Error {
+	comment.Content = strings.TrimSpace(comment.Content)
+	if comment.Content == "" || len(comment.Content) > MaxCommentLength {
+		return domain.NewError(fmt.Sprintf("Comment must be 1-%d characters", MaxCommentLength), 400)
+	}
+
+	mentionedUserIds := []string{}
+	for _, userId := range comment.MentionedUserIds() {
+		if userId != "" && !slices.Contains(mentionedUserIds, userId) {
+			mentionedUserIds = append(mentionedUserIds, userId)
+		}
+	}
+	if len(mentionedUserIds) > MaxMentionsPerComment {
+		return domain.NewError(fmt.Sprintf("Comment exceeds maximum of %d mentions", MaxMentionsPerComment), 400)
+	}
+
+	comment.Mentions = make([]domain.ChecklistItemCommentMention, 0, len(mentionedUserIds))
+	if len(mentionedUserIds) == 0 {
+		return nil
+	}
+	usersWithAccess, err := service.repository.FindUsersWithAccess(ctx, comment.ChecklistId, mentionedUserIds)
+	if err != nil {
+		return err
+	}
+	for _, userId := range mentionedUserIds {
+		if !slices.Contains(usersWithAccess, userId) {
+			return domain.NewError("Mentioned users must have access to the checklist", 400)
+		}
+		comment.Mentions = append(comment.Mentions, domain.ChecklistItemCommentMention{UserId: userId})
+	}
+	return nil
+}
@@ -0,0 +1,178 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"com.raunlo.checklist/internal/core/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+// mockChecklistItemCommentRepository uses testify's mock for repository.IChecklistItemCommentRepository.
+type mockChecklistItemCommentRepository struct {
+	mock.Mock
+}
+
+func (m *mockChecklistItemCommentRepository) FindItemComments(ctx context.Context, checklistId uint, itemId uint) ([]domain.ChecklistItemComment, domain.Error) {
+	args := m.Called(ctx, checklistId, itemId)
+	var comments []domain.ChecklistItemComment
+	var err domain.Error
+	if arg := args.Get(0); arg != nil {
+		comments = arg.([]domain.ChecklistItemComment)
+	}
+	if arg := args.Get(1); arg != nil {
+		err = arg.(domain.Error)
+	}
+	return comments, err
+}
+
+func (m *mockChecklistItemCommentRepository) FindItemCommentById(ctx context.Context, checklistId uint, itemId uint, commentId uint) (*domain.ChecklistItemComment, domain.Error) {
+	args := m.Called(ctx, checklistId, itemId, commentId)
+	var comment *domain.ChecklistItemComment
+	var err domain.Error
+	if arg := args.Get(0); arg != nil {
+		comment = arg.(*domain.ChecklistItemComment)
+	}
+	if arg := args.Get(1); arg != nil {
+		err = arg.(domain.Error)
+	}
+	return comment, err
+}
+
+func (m *mockChecklistItemCommentRepository) SaveItemComment(ctx context.Context, comment domain.ChecklistItemComment) (domain.ChecklistItemComment, domain.Error) {
+	args := m.Called(ctx, comment)
+	var err domain.Error
+	if arg := args.Get(1); arg != nil {
+		err = arg.(domain.Error)
+	}
+	return args.Get(0).(domain.ChecklistItemComment), err
+}
+
+func (m *mockChecklistItemCommentRepository) UpdateItemComment(ctx context.Context, comment domain.ChecklistItemComment) (domain.ChecklistItemComment, domain.Error) {
+	args := m.Called(ctx, comment)
+	var err domain.Error
+	if arg := args.Get(1); arg != nil {
+		err = arg.(domain.Error)
+	}
+	return args.Get(0).(domain.ChecklistItemComment), err
+}
+
+func (m *mockChecklistItemCommentRepository) DeleteItemComment(ctx context.Context, checklistId uint, itemId uint, commentId uint, author string) domain.Error {
+	args := m.Called(ctx, checklistId, itemId, commentId, author)
+	if arg := args.Get(0); arg != nil {
+		return arg.(domain.Error)
+	}
+	return nil
+}
+
+func (m *mockChecklistItemCommentRepository) FindUsersWithAccess(ctx context.Context, checklistId uint, userIds []string) ([]string, domain.Error) {
+	args := m.Called(ctx, checklistId, userIds)
+	var users []string
+	var err domain.Error
+	if arg := args.Get(0); arg != nil {
+		users = arg.([]string)
+	}
+	if arg := args.Get(1); arg != nil {
+		err = arg.(domain.Error)
+	}
+	return users, err
+}
+
+func TestChecklistItemCommentService_CreateItemComment_TrimsContentAndNotifies(t *testing.T) {
+	ctx := context.WithValue(context.Background(), domain.UserIdContextKey, "user-1")
+	repo := new(mockChecklistItemCommentRepository)
+	notifier := new(mockNotificationService)
+	ownershipChecker := new(mockChecklistOwnershipChecker)
+
+	expected := domain.ChecklistItemComment{
+		ChecklistId: 100,
+		ItemId:      7,
+		Author:      "user-1",
+		Content:     "Which brand?",
+		Mentions:    []domain.ChecklistItemCommentMention{{UserId: "user-2"}},
+	}
+	saved := expected
+	saved.Id = 11
+	ownershipChecker.On("CanModifyChecklist", mock.Anything, uint(100)).Return(nil)
+	repo.On("FindUsersWithAccess", mock.Anything, uint(100), []string{"user-2"}).Return([]string{"user-2"}, nil)
+	repo.On("SaveItemComment", mock.Anything, expected).Return(saved, nil)
+	notifier.On("NotifyCommentCreated", mock.Anything, saved).Return()
+
+	svc := &checklistItemCommentService{repository: repo, notifier: notifier, checklistOwnershipChecker: ownershipChecker}
+	result, err := svc.CreateItemComment(ctx, domain.ChecklistItemComment{
+		ChecklistId: 100,
+		ItemId:      7,
+		Content:     "  Which brand? ",
+		Mentions:    []domain.ChecklistItemCommentMention{{UserId: "user-2"}, {UserId: "user-2"}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Id != 11 {
+		t.Fatalf("expected comment id 11, got %d", result.Id)
+	}
+	repo.AssertExpectations(t)
+	notifier.AssertExpectations(t)
+}
+
+func TestChecklistItemCommentService_CreateItemComment_MentionWithoutAccess(t *testing.T) {
+	ctx := context.WithValue(context.Background(), domain.UserIdContextKey, "user-1")
+	repo := new(mockChecklistItemCommentRepository)
+	notifier := new(mockNotificationService)
+	ownershipChecker := new(mockChecklistOwnershipChecker)
+
+	ownershipChecker.On("CanModifyChecklist", mock.Anything, uint(100)).Return(nil)
+	repo.On("FindUsersWithAccess", mock.Anything, uint(100), []string{"stranger"}).Return([]string{}, nil)
+
+	svc := &checklistItemCommentService{repository: repo, notifier: notifier, checklistOwnershipChecker: ownershipChecker}
+	_, err := svc.CreateItemComment(ctx, domain.ChecklistItemComment{
+		ChecklistId: 100,
+		ItemId:      7,
+		Content:     "@stranger what do you think?",
+		Mentions:    []domain.ChecklistItemCommentMention{{UserId: "stranger"}},
+	})
+	if err == nil || err.ResponseCode() != 400 {
+		t.Fatalf("expected 400 error, got %v", err)
+	}
+	repo.AssertNotCalled(t, "SaveItemComment", mock.Anything, mock.Anything)
+	notifier.AssertNotCalled(t, "NotifyCommentCreated", mock.Anything, mock.Anything)
+}
+
+func TestChecklistItemCommentService_UpdateItemComment_NotAuthor(t *testing.T) {
+	ctx := context.WithValue(context.Background(), domain.UserIdContextKey, "user-2")
+	repo := new(mockChecklistItemCommentRepository)
+	notifier := new(mockNotificationService)
+	ownershipChecker := new(mockChecklistOwnershipChecker)
+
+	ownershipChecker.On("CanModifyChecklist", mock.Anything, uint(100)).Return(nil)
+	repo.On("FindItemCommentById", mock.Anything, uint(100), uint(7), uint(11)).
+		Return(&domain.ChecklistItemComment{Id: 11, ChecklistId: 100, ItemId: 7, Author: "user-1", Content: "Which brand?"}, nil)
+
+	svc := &checklistItemCommentService{repository: repo, notifier: notifier, checklistOwnershipChecker: ownershipChecker}
+	_, err := svc.UpdateItemComment(ctx, domain.ChecklistItemComment{Id: 11, ChecklistId: 100, ItemId: 7, Content: "Any brand"})
+	if err == nil || err.ResponseCode() != 403 {
+		t.Fatalf("expected 403 error, got %v", err)
+	}
+	repo.AssertNotCalled(t, "UpdateItemComment", mock.Anything, mock.Anything)
+	notifier.AssertNotCalled(t, "NotifyCommentUpdated", mock.Anything, mock.Anything)
+}
+
+func TestChecklistItemCommentService_DeleteItemComment_Notifies(t *testing.T) {
+	ctx := context.WithValue(context.Background(), domain.UserIdContextKey, "user-1")
+	repo := new(mockChecklistItemCommentRepository)
+	notifier := new(mockNotificationService)
+	ownershipChecker := new(mockChecklistOwnershipChecker)
+
+	ownershipChecker.On("CanModifyChecklist", mock.Anything, uint(100)).Return(nil)
+	repo.On("FindItemCommentById", mock.Anything, uint(100), uint(7), uint(11)).
+		Return(&domain.ChecklistItemComment{Id: 11, ChecklistId: 100, ItemId: 7, Author: "user-1", Content: "Which brand?"}, nil)
+	repo.On("DeleteItemComment", mock.Anything, uint(100), uint(7), uint(11), "user-1").Return(nil)
+	notifier.On("NotifyCommentDeleted", mock.Anything, uint(100), uint(7), uint(11)).Return()
+
+	svc := &checklistItemCommentService{repository: repo, notifier: notifier, checklistOwnershipChecker: ownershipChecker}
+	if err := svc.DeleteItemComment(ctx, 100, 7, 11); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	repo.AssertExpectations(t)
+	notifier.AssertExpectations(t)
+}
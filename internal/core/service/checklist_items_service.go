@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"fmt"
 
 	"com.raunlo.checklist/internal/core/domain"
 	guardrail "com.raunlo.checklist/internal/core/guard_rail"
@@ -12,10 +13,20 @@ import (
 const (
 	// MaxItemNameLength is the maximum allowed length for a checklist item name
 	MaxItemNameLength = 500
+	// MaxItemNotesLength is the maximum allowed length for the markdown notes of a checklist item
+	MaxItemNotesLength = 10000
 	// MaxRowsPerItem is the maximum number of rows allowed per checklist item
 	MaxRowsPerItem = 50
 )
 
+// validateNotes checks the length of the markdown notes of an item or a template; subject names the owner in the error
+func validateNotes(subject string, notes *string) domain.Error {
+	if notes != nil && len(*notes) > MaxItemNotesLength {
+		return domain.NewError(fmt.Sprintf("%s notes exceed maximum length of %d characters", subject, MaxItemNotesLength), 400)
+	}
+	return nil
+}
+
 type IChecklistItemsService interface {
 	SaveChecklistItem(context context.Context, checklistId uint, checklistItem domain.ChecklistItem) (domain.ChecklistItem, domain.Error)
 	UpdateChecklistItem(context context.Context, checklistId uint, checklistItem domain.ChecklistItem) (domain.ChecklistItem, domain.Error)
@@ -27,6 +38,20 @@ type IChecklistItemsService interface {
 	FindAllChecklistItems(context context.Context, checklistId uint, completed *bool, sortOrder domain.SortOrder) ([]domain.ChecklistItem, domain.Error)
 	ChangeChecklistItemOrder(context context.Context, request domain.ChangeOrderRequest) (domain.ChangeOrderResponse, domain.Error)
 	ToggleCompleted(context context.Context, checklistId uint, itemId uint, completed bool) (domain.ChecklistItem, domain.Error)
+	// MoveChecklistItem moves an item with its rows into another checklist
+	MoveChecklistItem(context context.Context, request domain.ChecklistItemTransferRequest) (domain.ChecklistItem, domain.Error)
+	// CopyChecklistItem copies an item with its rows into another checklist
+	CopyChecklistItem(context context.Context, request domain.ChecklistItemTransferRequest) (domain.ChecklistItem, domain.Error)
+	// ApplyBatch applies several item operations atomically and publishes a single batch event
+	ApplyBatch(context context.Context, checklistId uint, operations []domain.ChecklistItemBatchOperation) (domain.ChecklistItemBatchResult, domain.Error)
+	// ClearCompletedItems soft-deletes all completed items as one group that can be restored with RestoreDeletionGroup
+	ClearCompletedItems(context context.Context, checklistId uint) (domain.ChecklistItemDeletionGroup, domain.Error)
+	// RestoreDeletionGroup undoes ClearCompletedItems for the items that have not been purged yet
+	RestoreDeletionGroup(context context.Context, checklistId uint, groupId uint) (domain.ChecklistItemBatchResult, domain.Error)
+	// ResetChecklistItems unchecks every item and row so a reusable checklist can start over
+	ResetChecklistItems(context context.Context, checklistId uint) (domain.ChecklistItemBatchResult, domain.Error)
+	// SortChecklistItems persists a one-shot sort of all items and publishes the new order as a single event
+	SortChecklistItems(context context.Context, request domain.ChecklistItemSortRequest) ([]domain.ChecklistItem, domain.Error)
 }
 
 type checklistItemsService struct {
@@ -34,10 +59,11 @@ type checklistItemsService struct {
 	notifier                  notification.INotificationService
 	checklistOwnershipChecker guardrail.IChecklistOwnershipChecker
 	rebalanceService          IRebalanceService
+	activityService           IChecklistActivityService
 }
 
 func (service *checklistItemsService) UpdateChecklistItem(ctx context.Context, checklistId uint, checklistItem domain.ChecklistItem) (domain.ChecklistItem, domain.Error) {
-	if err := service.checklistOwnershipChecker.HasAccessToChecklist(ctx, checklistId); err != nil {
+	if err := service.checklistOwnershipChecker.CanModifyChecklist(ctx, checklistId); err != nil {
 		return domain.ChecklistItem{}, err
 	}
 
@@ -46,20 +72,27 @@ func (service *checklistItemsService) UpdateChecklistItem(ctx context.Context, c
 		return domain.ChecklistItem{}, domain.NewError("Item name exceeds maximum length of 500 characters", 400)
 	}
 
+	// Validate item notes length
+	if err := validateNotes("Item", checklistItem.Notes); err != nil {
+		return domain.ChecklistItem{}, err
+	}
+
 	// Validate number of rows
 	if len(checklistItem.Rows) > MaxRowsPerItem {
 		return domain.ChecklistItem{}, domain.NewError("Item exceeds maximum of 50 rows", 400)
 	}
 
+	previous := service.findItemForActivity(ctx, checklistId, checklistItem.Id)
 	result, err := service.repository.UpdateChecklistItem(ctx, checklistId, checklistItem)
 	if err == nil {
 		service.notifier.NotifyItemUpdated(ctx, checklistId, result)
+		service.recordActivity(ctx, checklistId, result.Id, domain.ActivityItemUpdated, summarizeItem(previous), new(domain.SummarizeChecklistItem(result)))
 	}
 	return result, err
 }
 
 func (service *checklistItemsService) SaveChecklistItem(ctx context.Context, checklistId uint, checklistItem domain.ChecklistItem) (domain.ChecklistItem, domain.Error) {
-	if err := service.checklistOwnershipChecker.HasAccessToChecklist(ctx, checklistId); err != nil {
+	if err := service.checklistOwnershipChecker.CanModifyChecklist(ctx, checklistId); err != nil {
 		return domain.ChecklistItem{}, err
 	}
 
@@ -68,6 +101,11 @@ func (service *checklistItemsService) SaveChecklistItem(ctx context.Context, che
 		return domain.ChecklistItem{}, domain.NewError("Item name exceeds maximum length of 500 characters", 400)
 	}
 
+	// Validate item notes length
+	if err := validateNotes("Item", checklistItem.Notes); err != nil {
+		return domain.ChecklistItem{}, err
+	}
+
 	// Validate number of rows
 	if len(checklistItem.Rows) > MaxRowsPerItem {
 		return domain.ChecklistItem{}, domain.NewError("Item exceeds maximum of 50 rows", 400)
@@ -76,12 +114,13 @@ func (service *checklistItemsService) SaveChecklistItem(ctx context.Context, che
 	result, err := service.repository.SaveChecklistItem(ctx, checklistId, checklistItem)
 	if err == nil {
 		service.notifier.NotifyItemCreated(ctx, checklistId, result)
+		service.recordActivity(ctx, checklistId, result.Id, domain.ActivityItemCreated, nil, new(domain.SummarizeChecklistItem(result)))
 	}
 	return result, err
 }
 
 func (service *checklistItemsService) SaveChecklistItemRow(ctx context.Context, checklistId uint, itemId uint, row domain.ChecklistItemRow) (domain.ChecklistItemRow, domain.Error) {
-	if err := service.checklistOwnershipChecker.HasAccessToChecklist(ctx, checklistId); err != nil {
+	if err := service.checklistOwnershipChecker.CanModifyChecklist(ctx, checklistId); err != nil {
 		return domain.ChecklistItemRow{}, err
 	}
 
@@ -100,6 +139,7 @@ func (service *checklistItemsService) SaveChecklistItemRow(ctx context.Context,
 	result, err := service.repository.SaveChecklistItemRow(ctx, checklistId, itemId, row)
 	if err == nil {
 		service.notifier.NotifyItemRowAdded(ctx, checklistId, itemId, result)
+		service.recordActivity(ctx, checklistId, itemId, domain.ActivityRowAdded, nil, new(domain.SummarizeChecklistItemRow(result)))
 	}
 	return result, err
 }
@@ -112,26 +152,29 @@ func (service *checklistItemsService) FindChecklistItemById(ctx context.Context,
 }
 
 func (service *checklistItemsService) DeleteChecklistItemById(ctx context.Context, checklistId uint, id uint) domain.Error {
-	if err := service.checklistOwnershipChecker.HasAccessToChecklist(ctx, checklistId); err != nil {
+	if err := service.checklistOwnershipChecker.CanModifyChecklist(ctx, checklistId); err != nil {
 		return err
 	}
 
+	previous := service.findItemForActivity(ctx, checklistId, id)
 	err := service.repository.DeleteChecklistItemById(ctx, checklistId, id)
 	if err == nil {
 		// Notify with soft delete event (item can be restored)
 		service.notifier.NotifyItemSoftDeleted(ctx, checklistId, id)
+		service.recordActivity(ctx, checklistId, id, domain.ActivityItemDeleted, summarizeItem(previous), nil)
 	}
 	return err
 }
 
 func (service *checklistItemsService) RestoreChecklistItem(ctx context.Context, checklistId uint, id uint) (domain.ChecklistItem, domain.Error) {
-	if err := service.checklistOwnershipChecker.HasAccessToChecklist(ctx, checklistId); err != nil {
+	if err := service.checklistOwnershipChecker.CanModifyChecklist(ctx, checklistId); err != nil {
 		return domain.ChecklistItem{}, err
 	}
 
 	result, err := service.repository.RestoreChecklistItem(ctx, checklistId, id)
 	if err == nil {
 		service.notifier.NotifyItemRestored(ctx, checklistId, result)
+		service.recordActivity(ctx, checklistId, id, domain.ActivityItemRestored, nil, new(domain.SummarizeChecklistItem(result)))
 	}
 	return result, err
 }
@@ -139,10 +182,19 @@ func (service *checklistItemsService) RestoreChecklistItem(ctx context.Context,
 func (service *checklistItemsService) DeleteChecklistItemRow(ctx context.Context, checklistId uint, itemId uint, rowId uint) domain.Error {
 	// Auth check: Verify user has access to this checklist before any operations
 	// This ensures the subsequent transaction operations are authorized
-	if err := service.checklistOwnershipChecker.HasAccessToChecklist(ctx, checklistId); err != nil {
+	if err := service.checklistOwnershipChecker.CanModifyChecklist(ctx, checklistId); err != nil {
 		return err
 	}
 
+	var previousRow *string
+	if item := service.findItemForActivity(ctx, checklistId, itemId); item != nil {
+		for _, row := range item.Rows {
+			if row.Id == rowId {
+				previousRow = new(domain.SummarizeChecklistItemRow(row))
+			}
+		}
+	}
+
 	// The repository handles row deletion and auto-completion atomically in a single transaction
 	// This prevents race conditions when multiple rows are deleted concurrently
 	// The SQL ensures checklistId is validated in all queries, preventing unauthorized access
@@ -153,6 +205,7 @@ func (service *checklistItemsService) DeleteChecklistItemRow(ctx context.Context
 
 	// Notify about row deletion
 	service.notifier.NotifyItemRowDeleted(ctx, checklistId, itemId, rowId)
+	service.recordActivity(ctx, checklistId, itemId, domain.ActivityRowDeleted, previousRow, nil)
 
 	return nil
 }
@@ -166,27 +219,295 @@ func (service *checklistItemsService) FindAllChecklistItems(ctx context.Context,
 }
 
 func (service *checklistItemsService) ChangeChecklistItemOrder(ctx context.Context, request domain.ChangeOrderRequest) (domain.ChangeOrderResponse, domain.Error) {
-	if err := service.checklistOwnershipChecker.HasAccessToChecklist(ctx, request.ChecklistId); err != nil {
+	if err := service.checklistOwnershipChecker.CanModifyChecklist(ctx, request.ChecklistId); err != nil {
 		return domain.ChangeOrderResponse{}, err
 	}
 	result, err := service.repository.ChangeChecklistItemOrder(ctx, request)
 	if err == nil {
 		service.notifier.NotifyItemReordered(ctx, request, result)
-		// Trigger async rebalancing if gaps became too small
+		after := fmt.Sprintf("orderNumber=%d", result.OrderNumber)
+		if request.SectionId != nil {
+			after += fmt.Sprintf(" sectionId=%d", *request.SectionId)
+		}
+		service.recordActivity(ctx, request.ChecklistId, request.ChecklistItemId, domain.ActivityItemReordered, nil, &after)
+		// Trigger async compaction of the positions if the new key got long
 		if result.RebalanceNeeded && service.rebalanceService != nil {
-			service.rebalanceService.TriggerRebalance(request.ChecklistId)
+			service.rebalanceService.TriggerRebalance(ctx, request.ChecklistId)
 		}
 	}
 	return result, err
 }
 
 func (service *checklistItemsService) ToggleCompleted(ctx context.Context, checklistId uint, itemId uint, completed bool) (domain.ChecklistItem, domain.Error) {
-	if err := service.checklistOwnershipChecker.HasAccessToChecklist(ctx, checklistId); err != nil {
+	if err := service.checklistOwnershipChecker.CanModifyChecklist(ctx, checklistId); err != nil {
 		return domain.ChecklistItem{}, err
 	}
 	result, err := service.repository.ToggleItemCompleted(ctx, checklistId, itemId, completed)
-	if err == nil {
-		service.notifier.NotifyItemUpdated(ctx, checklistId, result)
+	if err != nil {
+		return domain.ChecklistItem{}, err
 	}
-	return result, err
+
+	service.notifier.NotifyItemUpdated(ctx, checklistId, result.Item)
+	previous := result.Item
+	previous.Completed = result.WasCompleted
+	service.recordActivity(ctx, checklistId, itemId, domain.ActivityItemToggled,
+		new(domain.SummarizeChecklistItem(previous)), new(domain.SummarizeChecklistItem(result.Item)))
+	return result.Item, nil
+}
+
+func (service *checklistItemsService) MoveChecklistItem(ctx context.Context, request domain.ChecklistItemTransferRequest) (domain.ChecklistItem, domain.Error) {
+	if request.SourceChecklistId == request.TargetChecklistId {
+		return domain.ChecklistItem{}, domain.NewError("Item is already in the target checklist", 400)
+	}
+	if err := service.checkTransferRequest(ctx, request, true); err != nil {
+		return domain.ChecklistItem{}, err
+	}
+
+	previous := service.findItemForActivity(ctx, request.SourceChecklistId, request.ChecklistItemId)
+	result, err := service.repository.MoveChecklistItem(ctx, request)
+	if err != nil {
+		return domain.ChecklistItem{}, err
+	}
+
+	// Subscribers of the source see the item disappear and subscribers of the target see it appear
+	service.notifier.NotifyItemDeleted(ctx, request.SourceChecklistId, request.ChecklistItemId)
+	service.notifier.NotifyItemCreated(ctx, request.TargetChecklistId, result.Item)
+	service.recordActivity(ctx, request.SourceChecklistId, request.ChecklistItemId, domain.ActivityItemMovedOut,
+		summarizeItem(previous), new(fmt.Sprintf("checklistId=%d", request.TargetChecklistId)))
+	service.recordActivity(ctx, request.TargetChecklistId, result.Item.Id, domain.ActivityItemMovedIn,
+		new(fmt.Sprintf("checklistId=%d", request.SourceChecklistId)), new(domain.SummarizeChecklistItem(result.Item)))
+	if result.RebalanceNeeded && service.rebalanceService != nil {
+		service.rebalanceService.TriggerRebalance(ctx, request.TargetChecklistId)
+	}
+	return result.Item, nil
+}
+
+func (service *checklistItemsService) CopyChecklistItem(ctx context.Context, request domain.ChecklistItemTransferRequest) (domain.ChecklistItem, domain.Error) {
+	if err := service.checkTransferRequest(ctx, request, false); err != nil {
+		return domain.ChecklistItem{}, err
+	}
+
+	result, err := service.repository.CopyChecklistItem(ctx, request)
+	if err != nil {
+		return domain.ChecklistItem{}, err
+	}
+
+	service.notifier.NotifyItemCreated(ctx, request.TargetChecklistId, result.Item)
+	service.recordActivity(ctx, request.TargetChecklistId, result.Item.Id, domain.ActivityItemCopied,
+		new(fmt.Sprintf("checklistId=%d, checklistItemId=%d", request.SourceChecklistId, request.ChecklistItemId)),
+		new(domain.SummarizeChecklistItem(result.Item)))
+	if result.RebalanceNeeded && service.rebalanceService != nil {
+		service.rebalanceService.TriggerRebalance(ctx, request.TargetChecklistId)
+	}
+	return result.Item, nil
+}
+
+// checkTransferRequest validates a move/copy request and verifies the user has access to both checklists.
+// The target must not be archived, and neither may the source of a move.
+func (service *checklistItemsService) checkTransferRequest(ctx context.Context, request domain.ChecklistItemTransferRequest, move bool) domain.Error {
+	if request.OrderNumber != nil && *request.OrderNumber == 0 {
+		return domain.NewError("Order number must be at least 1", 400)
+	}
+	checkSource := service.checklistOwnershipChecker.HasAccessToChecklist
+	if move {
+		checkSource = service.checklistOwnershipChecker.CanModifyChecklist
+	}
+	if err := checkSource(ctx, request.SourceChecklistId); err != nil {
+		return err
+	}
+	return service.checklistOwnershipChecker.CanModifyChecklist(ctx, request.TargetChecklistId)
+}
+
+func (service *checklistItemsService) ApplyBatch(ctx context.Context, checklistId uint, operations []domain.ChecklistItemBatchOperation) (domain.ChecklistItemBatchResult, domain.Error) {
+	if err := validateBatchOperations(operations); err != nil {
+		return domain.ChecklistItemBatchResult{}, err
+	}
+	if err := service.checklistOwnershipChecker.CanModifyChecklist(ctx, checklistId); err != nil {
+		return domain.ChecklistItemBatchResult{}, err
+	}
+
+	result, err := service.repository.ApplyBatch(ctx, checklistId, operations)
+	if err != nil {
+		return domain.ChecklistItemBatchResult{}, err
+	}
+
+	// One event for the whole batch instead of one per item keeps subscribers' buffers from overflowing
+	service.notifier.NotifyItemsBatchUpdated(ctx, checklistId, result)
+	for _, operation := range operations {
+		action, after := describeBatchOperation(operation)
+		service.recordActivity(ctx, checklistId, operation.ItemId, action, nil, after)
+	}
+	if result.RebalanceNeeded && service.rebalanceService != nil {
+		service.rebalanceService.TriggerRebalance(ctx, checklistId)
+	}
+	return result, nil
+}
+
+func (service *checklistItemsService) ClearCompletedItems(ctx context.Context, checklistId uint) (domain.ChecklistItemDeletionGroup, domain.Error) {
+	if err := service.checklistOwnershipChecker.CanModifyChecklist(ctx, checklistId); err != nil {
+		return domain.ChecklistItemDeletionGroup{}, err
+	}
+
+	group, err := service.repository.ClearCompletedItems(ctx, checklistId)
+	if err != nil || len(group.ItemIds) == 0 {
+		return group, err
+	}
+
+	service.notifier.NotifyItemsBatchUpdated(ctx, checklistId, domain.ChecklistItemBatchResult{
+		ChecklistId:    checklistId,
+		Items:          []domain.ChecklistItem{},
+		DeletedItemIds: group.ItemIds,
+	})
+	service.recordBulkChange(ctx, checklistId, group.ItemIds, domain.ActivityItemDeleted, new(fmt.Sprintf("deletionGroupId=%d", group.Id)))
+	return group, nil
+}
+
+func (service *checklistItemsService) RestoreDeletionGroup(ctx context.Context, checklistId uint, groupId uint) (domain.ChecklistItemBatchResult, domain.Error) {
+	if err := service.checklistOwnershipChecker.CanModifyChecklist(ctx, checklistId); err != nil {
+		return domain.ChecklistItemBatchResult{}, err
+	}
+
+	result, err := service.repository.RestoreDeletionGroup(ctx, checklistId, groupId)
+	if err != nil {
+		return domain.ChecklistItemBatchResult{}, err
+	}
+
+	service.notifier.NotifyItemsBatchUpdated(ctx, checklistId, result)
+	service.recordBulkChange(ctx, checklistId, itemIdsOf(result.Items), domain.ActivityItemRestored, new(fmt.Sprintf("deletionGroupId=%d", groupId)))
+	return result, nil
+}
+
+func (service *checklistItemsService) ResetChecklistItems(ctx context.Context, checklistId uint) (domain.ChecklistItemBatchResult, domain.Error) {
+	if err := service.checklistOwnershipChecker.CanModifyChecklist(ctx, checklistId); err != nil {
+		return domain.ChecklistItemBatchResult{}, err
+	}
+
+	result, err := service.repository.ResetChecklistItems(ctx, checklistId)
+	if err != nil || len(result.Items) == 0 {
+		return result, err
+	}
+
+	service.notifier.NotifyItemsBatchUpdated(ctx, checklistId, result)
+	service.recordBulkChange(ctx, checklistId, itemIdsOf(result.Items), domain.ActivityItemToggled, new("completed=false"))
+	return result, nil
+}
+
+func (service *checklistItemsService) SortChecklistItems(ctx context.Context, request domain.ChecklistItemSortRequest) ([]domain.ChecklistItem, domain.Error) {
+	if err := service.checklistOwnershipChecker.CanModifyChecklist(ctx, request.ChecklistId); err != nil {
+		return nil, err
+	}
+	sortBy, err := domain.NewChecklistItemSortKey(string(request.SortBy))
+	if err != nil {
+		return nil, err
+	}
+	request.SortBy = sortBy
+	if request.Locale, err = domain.NewChecklistItemSortLocale(request.Locale); err != nil {
+		return nil, err
+	}
+
+	items, err := service.repository.SortChecklistItems(ctx, request)
+	if err != nil || len(items) == 0 {
+		return items, err
+	}
+
+	itemIds := itemIdsOf(items)
+	service.notifier.NotifyItemsSorted(ctx, request.ChecklistId, itemIds)
+	service.recordBulkChange(ctx, request.ChecklistId, itemIds, domain.ActivityItemReordered,
+		new(fmt.Sprintf("sortBy=%s %s", request.SortBy, request.SortOrder)))
+	return items, nil
+}
+
+// recordBulkChange appends one activity entry per item for a checklist-wide action
+func (service *checklistItemsService) recordBulkChange(ctx context.Context, checklistId uint, itemIds []uint, action domain.ChecklistActivityAction, after *string) {
+	for _, itemId := range itemIds {
+		service.recordActivity(ctx, checklistId, itemId, action, nil, after)
+	}
+}
+
+func itemIdsOf(items []domain.ChecklistItem) []uint {
+	itemIds := make([]uint, 0, len(items))
+	for _, item := range items {
+		itemIds = append(itemIds, item.Id)
+	}
+	return itemIds
+}
+
+// validateBatchOperations checks that every operation carries the fields its type requires
+func validateBatchOperations(operations []domain.ChecklistItemBatchOperation) domain.Error {
+	if len(operations) == 0 {
+		return domain.NewError("Batch must contain at least one operation", 400)
+	}
+	if len(operations) > domain.MaxBatchOperations {
+		return domain.NewError(fmt.Sprintf("Batch exceeds maximum of %d operations", domain.MaxBatchOperations), 400)
+	}
+	for index, operation := range operations {
+		switch operation.Type {
+		case domain.BatchOperationToggle:
+			if operation.Completed == nil {
+				return domain.NewError(fmt.Sprintf("operation %d: completed is required for %s", index, operation.Type), 400)
+			}
+		case domain.BatchOperationRename:
+			if operation.Name == nil || *operation.Name == "" {
+				return domain.NewError(fmt.Sprintf("operation %d: name is required for %s", index, operation.Type), 400)
+			}
+			if len(*operation.Name) > MaxItemNameLength {
+				return domain.NewError(fmt.Sprintf("operation %d: item name exceeds maximum length of 500 characters", index), 400)
+			}
+		case domain.BatchOperationMove:
+			if operation.OrderNumber == nil || *operation.OrderNumber == 0 {
+				return domain.NewError(fmt.Sprintf("operation %d: orderNumber is required for %s", index, operation.Type), 400)
+			}
+		case domain.BatchOperationDelete, domain.BatchOperationRestore:
+		default:
+			return domain.NewError(fmt.Sprintf("operation %d: unknown operation type %s", index, operation.Type), 400)
+		}
+	}
+	return nil
+}
+
+func describeBatchOperation(operation domain.ChecklistItemBatchOperation) (domain.ChecklistActivityAction, *string) {
+	switch operation.Type {
+	case domain.BatchOperationToggle:
+		return domain.ActivityItemToggled, new(fmt.Sprintf("completed=%t", *operation.Completed))
+	case domain.BatchOperationRename:
+		return domain.ActivityItemUpdated, new(fmt.Sprintf("name=%q", *operation.Name))
+	case domain.BatchOperationDelete:
+		return domain.ActivityItemDeleted, nil
+	case domain.BatchOperationRestore:
+		return domain.ActivityItemRestored, nil
+	default:
+		return domain.ActivityItemReordered, new(fmt.Sprintf("orderNumber=%d", *operation.OrderNumber))
+	}
+}
+
+func (service *checklistItemsService) recordActivity(ctx context.Context, checklistId uint, itemId uint, action domain.ChecklistActivityAction, before *string, after *string) {
+	if service.activityService != nil {
+		service.activityService.RecordActivity(ctx, domain.ChecklistActivity{
+			ChecklistId: checklistId,
+			ItemId:      &itemId,
+			Action:      action,
+			Before:      before,
+			After:       after,
+		})
+	}
+}
+
+// findItemForActivity loads the current state of an item for the "before" part of an activity entry.
+// Returns nil when activity tracking is disabled or the item can not be loaded.
+func (service *checklistItemsService) findItemForActivity(ctx context.Context, checklistId uint, itemId uint) *domain.ChecklistItem {
+	if service.activityService == nil {
+		return nil
+	}
+	item, err := service.repository.FindChecklistItemById(ctx, checklistId, itemId)
+	if err != nil {
+		return nil
+	}
+	return item
+}
+
+func summarizeItem(item *domain.ChecklistItem) *string {
+	if item == nil {
+		return nil
+	}
+	return new(domain.SummarizeChecklistItem(*item))
 }
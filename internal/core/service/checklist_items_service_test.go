@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
@@ -32,6 +33,14 @@ func (m *mockChecklistOwnershipChecker) HasAccessToChecklist(ctx context.Context
 	return nil
 }
 
+func (m *mockChecklistOwnershipChecker) CanModifyChecklist(ctx context.Context, checklistId uint) domain.Error {
+	args := m.Called(ctx, checklistId)
+	if arg := args.Get(0); arg != nil {
+		return arg.(domain.Error)
+	}
+	return nil
+}
+
 func (m *mockChecklistOwnershipChecker) IsChecklistOwner(ctx context.Context, checklistId uint) domain.Error {
 	args := m.Called(ctx, checklistId)
 	if arg := args.Get(0); arg != nil {
@@ -72,12 +81,85 @@ func (m *mockNotificationService) NotifyItemRestored(ctx context.Context, checkl
 	m.Called(ctx, checklistId, item)
 }
 
+func (m *mockNotificationService) NotifyItemsBatchUpdated(ctx context.Context, checklistId uint, result domain.ChecklistItemBatchResult) {
+	m.Called(ctx, checklistId, result)
+}
+
+func (m *mockNotificationService) NotifyItemsSorted(ctx context.Context, checklistId uint, itemIds []uint) {
+	m.Called(ctx, checklistId, itemIds)
+}
+
+func (m *mockNotificationService) NotifyPositionsRebalanced(ctx context.Context, checklistId uint, positions map[uint]string) {
+	m.Called(ctx, checklistId, positions)
+}
+
+func (m *mockNotificationService) NotifySectionCreated(ctx context.Context, section domain.ChecklistSection) {
+	m.Called(ctx, section)
+}
+
+func (m *mockNotificationService) NotifySectionUpdated(ctx context.Context, section domain.ChecklistSection) {
+	m.Called(ctx, section)
+}
+
+func (m *mockNotificationService) NotifySectionDeleted(ctx context.Context, result domain.ChecklistSectionDeletionResult) {
+	m.Called(ctx, result)
+}
+
+func (m *mockNotificationService) NotifySectionReordered(ctx context.Context, section domain.ChecklistSection) {
+	m.Called(ctx, section)
+}
+
+func (m *mockNotificationService) NotifyCommentCreated(ctx context.Context, comment domain.ChecklistItemComment) {
+	m.Called(ctx, comment)
+}
+
+func (m *mockNotificationService) NotifyCommentUpdated(ctx context.Context, comment domain.ChecklistItemComment) {
+	m.Called(ctx, comment)
+}
+
+func (m *mockNotificationService) NotifyCommentDeleted(ctx context.Context, checklistId uint, itemId uint, commentId uint) {
+	m.Called(ctx, checklistId, itemId, commentId)
+}
+
+func (m *mockNotificationService) NotifyChecklistCreated(ctx context.Context, checklist domain.Checklist, userIds []string) {
+	m.Called(ctx, checklist, userIds)
+}
+
+func (m *mockNotificationService) NotifyChecklistUpdated(ctx context.Context, checklist domain.Checklist) {
+	m.Called(ctx, checklist)
+}
+
+func (m *mockNotificationService) NotifyChecklistMoved(ctx context.Context, checklist domain.Checklist, userIds []string) {
+	m.Called(ctx, checklist, userIds)
+}
+
+func (m *mockNotificationService) NotifyChecklistDeleted(ctx context.Context, checklistId uint) {
+	m.Called(ctx, checklistId)
+}
+
+func (m *mockNotificationService) NotifyAccessChanged(ctx context.Context, userIds []string) {
+	m.Called(ctx, userIds)
+}
+
+func (m *mockNotificationService) NotifyChecklistsMerged(ctx context.Context, result domain.ChecklistMergeResult) {
+	m.Called(ctx, result)
+}
+
+func (m *mockNotificationService) NotifyChecklistSplit(ctx context.Context, result domain.ChecklistSplitResult) {
+	m.Called(ctx, result)
+}
+
 func (m *mockChecklistItemsRepository) UpdateChecklistItem(ctx context.Context, checklistId uint, checklistItem domain.ChecklistItem) (domain.ChecklistItem, domain.Error) {
 	return domain.ChecklistItem{}, nil
 }
 
 func (m *mockChecklistItemsRepository) SaveChecklistItem(ctx context.Context, checklistId uint, checklistItem domain.ChecklistItem) (domain.ChecklistItem, domain.Error) {
-	return domain.ChecklistItem{}, nil
+	args := m.Called(ctx, checklistId, checklistItem)
+	var err domain.Error
+	if arg := args.Get(1); arg != nil {
+		err = arg.(domain.Error)
+	}
+	return args.Get(0).(domain.ChecklistItem), err
 }
 
 func (m *mockChecklistItemsRepository) SaveChecklistItemRow(ctx context.Context, checklistId uint, itemId uint, row domain.ChecklistItemRow) (domain.ChecklistItemRow, domain.Error) {
@@ -89,13 +171,13 @@ func (m *mockChecklistItemsRepository) SaveChecklistItemRow(ctx context.Context,
 	return args.Get(0).(domain.ChecklistItemRow), err
 }
 
-func (m *mockChecklistItemsRepository) ToggleItemCompleted(ctx context.Context, checklistId uint, checklistItemId uint, completed bool) (domain.ChecklistItem, domain.Error) {
+func (m *mockChecklistItemsRepository) ToggleItemCompleted(ctx context.Context, checklistId uint, checklistItemId uint, completed bool) (domain.ChecklistItemToggleResult, domain.Error) {
 	args := m.Called(ctx, checklistId, checklistItemId, completed)
 	var err domain.Error
 	if arg := args.Get(1); arg != nil {
 		err = arg.(domain.Error)
 	}
-	return args.Get(0).(domain.ChecklistItem), err
+	return args.Get(0).(domain.ChecklistItemToggleResult), err
 }
 
 func (m *mockChecklistItemsRepository) DeleteChecklistItemRow(ctx context.Context, checklistId uint, itemId uint, rowId uint) domain.Error {
@@ -144,14 +226,103 @@ func (m *mockChecklistItemsRepository) ChangeChecklistItemOrder(ctx context.Cont
 	return domain.ChangeOrderResponse{}, nil
 }
 
-func (m *mockChecklistItemsRepository) RebalancePositions(ctx context.Context, checklistId uint) domain.Error {
+func (m *mockChecklistItemsRepository) ApplyBatch(ctx context.Context, checklistId uint, operations []domain.ChecklistItemBatchOperation) (domain.ChecklistItemBatchResult, domain.Error) {
+	args := m.Called(ctx, checklistId, operations)
+	var err domain.Error
+	if arg := args.Get(1); arg != nil {
+		err = arg.(domain.Error)
+	}
+	return args.Get(0).(domain.ChecklistItemBatchResult), err
+}
+
+func (m *mockChecklistItemsRepository) ClearCompletedItems(ctx context.Context, checklistId uint) (domain.ChecklistItemDeletionGroup, domain.Error) {
 	args := m.Called(ctx, checklistId)
+	var err domain.Error
+	if arg := args.Get(1); arg != nil {
+		err = arg.(domain.Error)
+	}
+	return args.Get(0).(domain.ChecklistItemDeletionGroup), err
+}
+
+func (m *mockChecklistItemsRepository) RestoreDeletionGroup(ctx context.Context, checklistId uint, groupId uint) (domain.ChecklistItemBatchResult, domain.Error) {
+	args := m.Called(ctx, checklistId, groupId)
+	var err domain.Error
+	if arg := args.Get(1); arg != nil {
+		err = arg.(domain.Error)
+	}
+	return args.Get(0).(domain.ChecklistItemBatchResult), err
+}
+
+func (m *mockChecklistItemsRepository) ResetChecklistItems(ctx context.Context, checklistId uint) (domain.ChecklistItemBatchResult, domain.Error) {
+	args := m.Called(ctx, checklistId)
+	var err domain.Error
+	if arg := args.Get(1); arg != nil {
+		err = arg.(domain.Error)
+	}
+	return args.Get(0).(domain.ChecklistItemBatchResult), err
+}
+
+func (m *mockChecklistItemsRepository) SortChecklistItems(ctx context.Context, request domain.ChecklistItemSortRequest) ([]domain.ChecklistItem, domain.Error) {
+	args := m.Called(ctx, request)
+	var err domain.Error
+	if arg := args.Get(1); arg != nil {
+		err = arg.(domain.Error)
+	}
+	return args.Get(0).([]domain.ChecklistItem), err
+}
+
+func (m *mockChecklistItemsRepository) MoveChecklistItem(ctx context.Context, request domain.ChecklistItemTransferRequest) (domain.ChecklistItemTransferResult, domain.Error) {
+	args := m.Called(ctx, request)
+	var err domain.Error
+	if arg := args.Get(1); arg != nil {
+		err = arg.(domain.Error)
+	}
+	return args.Get(0).(domain.ChecklistItemTransferResult), err
+}
+
+func (m *mockChecklistItemsRepository) CopyChecklistItem(ctx context.Context, request domain.ChecklistItemTransferRequest) (domain.ChecklistItemTransferResult, domain.Error) {
+	args := m.Called(ctx, request)
+	var err domain.Error
+	if arg := args.Get(1); arg != nil {
+		err = arg.(domain.Error)
+	}
+	return args.Get(0).(domain.ChecklistItemTransferResult), err
+}
+
+func (m *mockChecklistItemsRepository) RebalancePositions(ctx context.Context, checklistId uint) (map[uint]string, bool, domain.Error) {
+	args := m.Called(ctx, checklistId)
+	var positions map[uint]string
+	if arg := args.Get(0); arg != nil {
+		positions = arg.(map[uint]string)
+	}
+	var err domain.Error
+	if arg := args.Get(2); arg != nil {
+		err = arg.(domain.Error)
+	}
+	return positions, args.Bool(1), err
+}
+
+func (m *mockChecklistItemsRepository) ScheduleRebalance(ctx context.Context, checklistId uint, debounce time.Duration, maxWait time.Duration) domain.Error {
+	args := m.Called(ctx, checklistId, debounce, maxWait)
 	if arg := args.Get(0); arg != nil {
 		return arg.(domain.Error)
 	}
 	return nil
 }
 
+func (m *mockChecklistItemsRepository) FindDueRebalances(ctx context.Context) ([]uint, domain.Error) {
+	args := m.Called(ctx)
+	var checklistIds []uint
+	if arg := args.Get(0); arg != nil {
+		checklistIds = arg.([]uint)
+	}
+	var err domain.Error
+	if arg := args.Get(1); arg != nil {
+		err = arg.(domain.Error)
+	}
+	return checklistIds, err
+}
+
 func (m *mockChecklistItemsRepository) RestoreChecklistItem(ctx context.Context, checklistId uint, itemId uint) (domain.ChecklistItem, domain.Error) {
 	args := m.Called(ctx, checklistId, itemId)
 	var err domain.Error
@@ -195,6 +366,47 @@ func (m *mockChecklistItemsRepository) UpdateCleanupLastRun(ctx context.Context)
 	return nil
 }
 
+func TestChecklistItemsService_SaveChecklistItem_WithNotes(t *testing.T) {
+	notes := "- buy **oat** milk"
+	item := domain.ChecklistItem{Name: "Milk", Notes: &notes}
+	savedItem := domain.ChecklistItem{Id: 5, Name: "Milk", Notes: &notes, OrderNumber: 1}
+	repo := new(mockChecklistItemsRepository)
+	notifier := new(mockNotificationService)
+	ownershipChecker := new(mockChecklistOwnershipChecker)
+
+	ownershipChecker.On("CanModifyChecklist", mock.Anything, uint(100)).Return(nil)
+	repo.On("SaveChecklistItem", mock.Anything, uint(100), item).Return(savedItem, nil)
+	notifier.On("NotifyItemCreated", mock.Anything, uint(100), savedItem).Return()
+
+	svc := &checklistItemsService{repository: repo, notifier: notifier, checklistOwnershipChecker: ownershipChecker}
+	result, err := svc.SaveChecklistItem(context.Background(), 100, item)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Notes == nil || *result.Notes != notes {
+		t.Fatalf("expected notes %q got %v", notes, result.Notes)
+	}
+	repo.AssertExpectations(t)
+	notifier.AssertExpectations(t)
+}
+
+func TestChecklistItemsService_SaveChecklistItem_NotesTooLong(t *testing.T) {
+	notes := strings.Repeat("a", MaxItemNotesLength+1)
+	repo := new(mockChecklistItemsRepository)
+	notifier := new(mockNotificationService)
+	ownershipChecker := new(mockChecklistOwnershipChecker)
+
+	ownershipChecker.On("CanModifyChecklist", mock.Anything, uint(100)).Return(nil)
+
+	svc := &checklistItemsService{repository: repo, notifier: notifier, checklistOwnershipChecker: ownershipChecker}
+	_, err := svc.SaveChecklistItem(context.Background(), 100, domain.ChecklistItem{Name: "Milk", Notes: &notes})
+	if err == nil || err.ResponseCode() != 400 {
+		t.Fatalf("expected 400 got %v", err)
+	}
+	repo.AssertNotCalled(t, "SaveChecklistItem", mock.Anything, mock.Anything, mock.Anything)
+	notifier.AssertNotCalled(t, "NotifyItemCreated", mock.Anything, mock.Anything, mock.Anything)
+}
+
 func TestChecklistItemsService_SaveChecklistItemRow(t *testing.T) {
 	expected := domain.ChecklistItemRow{Id: 1, Name: "row", Completed: false}
 	existingItem := &domain.ChecklistItem{Id: 20, Name: "item", Rows: []domain.ChecklistItemRow{{Id: 1}}}
@@ -204,7 +416,7 @@ func TestChecklistItemsService_SaveChecklistItemRow(t *testing.T) {
 	repo.On("FindChecklistItemById", mock.Anything, uint(10), uint(20)).Return(existingItem, nil)
 	repo.On("SaveChecklistItemRow", mock.Anything, uint(10), uint(20), domain.ChecklistItemRow{Name: "row"}).Return(expected, nil)
 	notifier.On("NotifyItemRowAdded", mock.Anything, uint(10), uint(20), expected).Return()
-	ownershipChecker.On("HasAccessToChecklist", mock.Anything, uint(10)).Return(nil)
+	ownershipChecker.On("CanModifyChecklist", mock.Anything, uint(10)).Return(nil)
 
 	svc := &checklistItemsService{repository: repo, notifier: notifier, checklistOwnershipChecker: ownershipChecker}
 	row, err := svc.SaveChecklistItemRow(context.Background(), 10, 20, domain.ChecklistItemRow{Name: "row"})
@@ -227,7 +439,7 @@ func TestChecklistItemsService_SaveChecklistItemRow_Error(t *testing.T) {
 	ownershipChecker := new(mockChecklistOwnershipChecker)
 	repo.On("FindChecklistItemById", mock.Anything, uint(1), uint(2)).Return(existingItem, nil)
 	repo.On("SaveChecklistItemRow", mock.Anything, uint(1), uint(2), domain.ChecklistItemRow{Name: "x"}).Return(domain.ChecklistItemRow{}, expectedErr)
-	ownershipChecker.On("HasAccessToChecklist", mock.Anything, uint(1)).Return(nil)
+	ownershipChecker.On("CanModifyChecklist", mock.Anything, uint(1)).Return(nil)
 
 	svc := &checklistItemsService{repository: repo, notifier: notifier, checklistOwnershipChecker: ownershipChecker}
 	_, err := svc.SaveChecklistItemRow(context.Background(), 1, 2, domain.ChecklistItemRow{Name: "x"})
@@ -249,7 +461,7 @@ func TestChecklistItemsService_DeleteChecklistItemRow(t *testing.T) {
 		nil,
 	)
 	notifier.On("NotifyItemRowDeleted", mock.Anything, uint(1), uint(2), uint(3)).Return()
-	ownershipChecker.On("HasAccessToChecklist", mock.Anything, uint(1)).Return(nil)
+	ownershipChecker.On("CanModifyChecklist", mock.Anything, uint(1)).Return(nil)
 
 	svc := &checklistItemsService{repository: repo, notifier: notifier, checklistOwnershipChecker: ownershipChecker}
 	err := svc.DeleteChecklistItemRow(context.Background(), 1, 2, 3)
@@ -269,7 +481,7 @@ func TestChecklistItemsService_DeleteChecklistItemRow_Error(t *testing.T) {
 		domain.ChecklistItemRowDeletionResult{Success: false, ItemAutoCompleted: false},
 		expectedErr,
 	)
-	ownershipChecker.On("HasAccessToChecklist", mock.Anything, uint(1)).Return(nil)
+	ownershipChecker.On("CanModifyChecklist", mock.Anything, uint(1)).Return(nil)
 
 	svc := &checklistItemsService{repository: repo, notifier: notifier, checklistOwnershipChecker: ownershipChecker}
 	err := svc.DeleteChecklistItemRow(context.Background(), 1, 2, 3)
@@ -287,7 +499,7 @@ func TestChecklistItemsService_RestoreChecklistItem_Success(t *testing.T) {
 		Id:        1,
 		Name:      "Restored Item",
 		Completed: false,
-		Position:  1.0,
+		Position:  "a0",
 		Rows: []domain.ChecklistItemRow{
 			{Id: 10, Name: "Subitem", Completed: false},
 		},
@@ -296,7 +508,7 @@ func TestChecklistItemsService_RestoreChecklistItem_Success(t *testing.T) {
 	notifier := new(mockNotificationService)
 	ownershipChecker := new(mockChecklistOwnershipChecker)
 
-	ownershipChecker.On("HasAccessToChecklist", mock.Anything, uint(100)).Return(nil)
+	ownershipChecker.On("CanModifyChecklist", mock.Anything, uint(100)).Return(nil)
 	repo.On("RestoreChecklistItem", mock.Anything, uint(100), uint(1)).Return(expectedItem, nil)
 	notifier.On("NotifyItemRestored", mock.Anything, uint(100), expectedItem).Return()
 
@@ -325,7 +537,7 @@ func TestChecklistItemsService_RestoreChecklistItem_NotFound(t *testing.T) {
 	notifier := new(mockNotificationService)
 	ownershipChecker := new(mockChecklistOwnershipChecker)
 
-	ownershipChecker.On("HasAccessToChecklist", mock.Anything, uint(100)).Return(nil)
+	ownershipChecker.On("CanModifyChecklist", mock.Anything, uint(100)).Return(nil)
 	repo.On("RestoreChecklistItem", mock.Anything, uint(100), uint(999)).Return(domain.ChecklistItem{}, expectedErr)
 
 	svc := &checklistItemsService{repository: repo, notifier: notifier, checklistOwnershipChecker: ownershipChecker}
@@ -347,7 +559,7 @@ func TestChecklistItemsService_RestoreChecklistItem_AccessDenied(t *testing.T) {
 	notifier := new(mockNotificationService)
 	ownershipChecker := new(mockChecklistOwnershipChecker)
 
-	ownershipChecker.On("HasAccessToChecklist", mock.Anything, uint(100)).Return(accessErr)
+	ownershipChecker.On("CanModifyChecklist", mock.Anything, uint(100)).Return(accessErr)
 
 	svc := &checklistItemsService{repository: repo, notifier: notifier, checklistOwnershipChecker: ownershipChecker}
 	_, err := svc.RestoreChecklistItem(context.Background(), 100, 1)
@@ -361,3 +573,318 @@ func TestChecklistItemsService_RestoreChecklistItem_AccessDenied(t *testing.T) {
 	repo.AssertNotCalled(t, "RestoreChecklistItem", mock.Anything, mock.Anything, mock.Anything)
 	notifier.AssertNotCalled(t, "NotifyItemRestored", mock.Anything, mock.Anything, mock.Anything)
 }
+
+func TestChecklistItemsService_ApplyBatch_PublishesSingleEvent(t *testing.T) {
+	completed := true
+	name := "Renamed"
+	operations := []domain.ChecklistItemBatchOperation{
+		{Type: domain.BatchOperationToggle, ItemId: 1, Completed: &completed},
+		{Type: domain.BatchOperationRename, ItemId: 2, Name: &name},
+		{Type: domain.BatchOperationDelete, ItemId: 3},
+	}
+	result := domain.ChecklistItemBatchResult{
+		ChecklistId:    100,
+		Items:          []domain.ChecklistItem{{Id: 1, Completed: true}, {Id: 2, Name: name}},
+		DeletedItemIds: []uint{3},
+	}
+	repo := new(mockChecklistItemsRepository)
+	notifier := new(mockNotificationService)
+	ownershipChecker := new(mockChecklistOwnershipChecker)
+
+	ownershipChecker.On("CanModifyChecklist", mock.Anything, uint(100)).Return(nil)
+	repo.On("ApplyBatch", mock.Anything, uint(100), operations).Return(result, nil)
+	notifier.On("NotifyItemsBatchUpdated", mock.Anything, uint(100), result).Return().Once()
+
+	svc := &checklistItemsService{repository: repo, notifier: notifier, checklistOwnershipChecker: ownershipChecker}
+	res, err := svc.ApplyBatch(context.Background(), 100, operations)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(res.Items) != 2 || len(res.DeletedItemIds) != 1 {
+		t.Fatalf("unexpected result %v", res)
+	}
+	repo.AssertExpectations(t)
+	notifier.AssertExpectations(t)
+	notifier.AssertNotCalled(t, "NotifyItemUpdated", mock.Anything, mock.Anything, mock.Anything)
+	notifier.AssertNotCalled(t, "NotifyItemSoftDeleted", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestChecklistItemsService_ApplyBatch_FailureNotifiesNothing(t *testing.T) {
+	operations := []domain.ChecklistItemBatchOperation{
+		{Type: domain.BatchOperationDelete, ItemId: 1},
+		{Type: domain.BatchOperationRestore, ItemId: 999},
+	}
+	repo := new(mockChecklistItemsRepository)
+	notifier := new(mockNotificationService)
+	ownershipChecker := new(mockChecklistOwnershipChecker)
+
+	ownershipChecker.On("CanModifyChecklist", mock.Anything, uint(100)).Return(nil)
+	repo.On("ApplyBatch", mock.Anything, uint(100), operations).
+		Return(domain.ChecklistItemBatchResult{}, domain.NewError("operation 1: checklistItem(checklistItemId=999) was not found", 404))
+
+	svc := &checklistItemsService{repository: repo, notifier: notifier, checklistOwnershipChecker: ownershipChecker}
+	_, err := svc.ApplyBatch(context.Background(), 100, operations)
+	if err == nil || err.ResponseCode() != 404 {
+		t.Fatalf("expected 404 got %v", err)
+	}
+	notifier.AssertNotCalled(t, "NotifyItemsBatchUpdated", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestChecklistItemsService_ApplyBatch_Validation(t *testing.T) {
+	longName := strings.Repeat("a", MaxItemNameLength+1)
+	tooMany := make([]domain.ChecklistItemBatchOperation, domain.MaxBatchOperations+1)
+	for i := range tooMany {
+		tooMany[i] = domain.ChecklistItemBatchOperation{Type: domain.BatchOperationDelete, ItemId: uint(i + 1)}
+	}
+	cases := map[string][]domain.ChecklistItemBatchOperation{
+		"empty":             {},
+		"too many":          tooMany,
+		"toggle missing":    {{Type: domain.BatchOperationToggle, ItemId: 1}},
+		"rename missing":    {{Type: domain.BatchOperationRename, ItemId: 1}},
+		"rename too long":   {{Type: domain.BatchOperationRename, ItemId: 1, Name: &longName}},
+		"move missing":      {{Type: domain.BatchOperationMove, ItemId: 1}},
+		"unknown operation": {{Type: "ARCHIVE", ItemId: 1}},
+	}
+	for name, operations := range cases {
+		t.Run(name, func(t *testing.T) {
+			repo := new(mockChecklistItemsRepository)
+			svc := &checklistItemsService{repository: repo, notifier: new(mockNotificationService), checklistOwnershipChecker: new(mockChecklistOwnershipChecker)}
+			_, err := svc.ApplyBatch(context.Background(), 100, operations)
+			if err == nil || err.ResponseCode() != 400 {
+				t.Fatalf("expected 400 got %v", err)
+			}
+			repo.AssertNotCalled(t, "ApplyBatch", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestChecklistItemsService_MoveChecklistItem_NotifiesBothChecklists(t *testing.T) {
+	request := domain.ChecklistItemTransferRequest{SourceChecklistId: 100, ChecklistItemId: 1, TargetChecklistId: 200}
+	movedItem := domain.ChecklistItem{Id: 1, Name: "Item", Completed: true, OrderNumber: 1}
+	repo := new(mockChecklistItemsRepository)
+	notifier := new(mockNotificationService)
+	ownershipChecker := new(mockChecklistOwnershipChecker)
+
+	ownershipChecker.On("CanModifyChecklist", mock.Anything, uint(100)).Return(nil)
+	ownershipChecker.On("CanModifyChecklist", mock.Anything, uint(200)).Return(nil)
+	repo.On("MoveChecklistItem", mock.Anything, request).Return(domain.ChecklistItemTransferResult{Item: movedItem}, nil)
+	notifier.On("NotifyItemDeleted", mock.Anything, uint(100), uint(1)).Return()
+	notifier.On("NotifyItemCreated", mock.Anything, uint(200), movedItem).Return()
+
+	svc := &checklistItemsService{repository: repo, notifier: notifier, checklistOwnershipChecker: ownershipChecker}
+	item, err := svc.MoveChecklistItem(context.Background(), request)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if item.Id != 1 || !item.Completed {
+		t.Fatalf("unexpected item %v", item)
+	}
+	repo.AssertExpectations(t)
+	notifier.AssertExpectations(t)
+	ownershipChecker.AssertExpectations(t)
+}
+
+func TestChecklistItemsService_MoveChecklistItem_NoAccessToTarget(t *testing.T) {
+	request := domain.ChecklistItemTransferRequest{SourceChecklistId: 100, ChecklistItemId: 1, TargetChecklistId: 200}
+	repo := new(mockChecklistItemsRepository)
+	notifier := new(mockNotificationService)
+	ownershipChecker := new(mockChecklistOwnershipChecker)
+
+	ownershipChecker.On("CanModifyChecklist", mock.Anything, uint(100)).Return(nil)
+	ownershipChecker.On("CanModifyChecklist", mock.Anything, uint(200)).Return(domain.NewError("not found", 404))
+
+	svc := &checklistItemsService{repository: repo, notifier: notifier, checklistOwnershipChecker: ownershipChecker}
+	_, err := svc.MoveChecklistItem(context.Background(), request)
+	if err == nil || err.ResponseCode() != 404 {
+		t.Fatalf("expected 404 got %v", err)
+	}
+	repo.AssertNotCalled(t, "MoveChecklistItem", mock.Anything, mock.Anything)
+	notifier.AssertNotCalled(t, "NotifyItemCreated", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestChecklistItemsService_MoveChecklistItem_SameChecklist(t *testing.T) {
+	repo := new(mockChecklistItemsRepository)
+	svc := &checklistItemsService{repository: repo, notifier: new(mockNotificationService), checklistOwnershipChecker: new(mockChecklistOwnershipChecker)}
+	_, err := svc.MoveChecklistItem(context.Background(), domain.ChecklistItemTransferRequest{SourceChecklistId: 100, ChecklistItemId: 1, TargetChecklistId: 100})
+	if err == nil || err.ResponseCode() != 400 {
+		t.Fatalf("expected 400 got %v", err)
+	}
+	repo.AssertNotCalled(t, "MoveChecklistItem", mock.Anything, mock.Anything)
+}
+
+func TestChecklistItemsService_CopyChecklistItem_NotifiesTarget(t *testing.T) {
+	orderNumber := uint(2)
+	request := domain.ChecklistItemTransferRequest{SourceChecklistId: 100, ChecklistItemId: 1, TargetChecklistId: 200, OrderNumber: &orderNumber}
+	copiedItem := domain.ChecklistItem{Id: 7, Name: "Item", OrderNumber: 2, Rows: []domain.ChecklistItemRow{{Id: 70, Name: "row", Completed: true}}}
+	repo := new(mockChecklistItemsRepository)
+	notifier := new(mockNotificationService)
+	ownershipChecker := new(mockChecklistOwnershipChecker)
+
+	ownershipChecker.On("HasAccessToChecklist", mock.Anything, uint(100)).Return(nil)
+	ownershipChecker.On("CanModifyChecklist", mock.Anything, uint(200)).Return(nil)
+	repo.On("CopyChecklistItem", mock.Anything, request).Return(domain.ChecklistItemTransferResult{Item: copiedItem}, nil)
+	notifier.On("NotifyItemCreated", mock.Anything, uint(200), copiedItem).Return()
+
+	svc := &checklistItemsService{repository: repo, notifier: notifier, checklistOwnershipChecker: ownershipChecker}
+	item, err := svc.CopyChecklistItem(context.Background(), request)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if item.Id != 7 || len(item.Rows) != 1 {
+		t.Fatalf("unexpected item %v", item)
+	}
+	repo.AssertExpectations(t)
+	notifier.AssertExpectations(t)
+	notifier.AssertNotCalled(t, "NotifyItemDeleted", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestChecklistItemsService_ClearCompletedItems_PublishesSingleEvent(t *testing.T) {
+	group := domain.ChecklistItemDeletionGroup{Id: 7, ChecklistId: 100, ItemIds: []uint{1, 2, 3}}
+	repo := new(mockChecklistItemsRepository)
+	notifier := new(mockNotificationService)
+	ownershipChecker := new(mockChecklistOwnershipChecker)
+
+	ownershipChecker.On("CanModifyChecklist", mock.Anything, uint(100)).Return(nil)
+	repo.On("ClearCompletedItems", mock.Anything, uint(100)).Return(group, nil)
+	notifier.On("NotifyItemsBatchUpdated", mock.Anything, uint(100), domain.ChecklistItemBatchResult{
+		ChecklistId:    100,
+		Items:          []domain.ChecklistItem{},
+		DeletedItemIds: []uint{1, 2, 3},
+	}).Return().Once()
+
+	svc := &checklistItemsService{repository: repo, notifier: notifier, checklistOwnershipChecker: ownershipChecker}
+	res, err := svc.ClearCompletedItems(context.Background(), 100)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if res.Id != 7 || len(res.ItemIds) != 3 {
+		t.Fatalf("unexpected group %v", res)
+	}
+	notifier.AssertExpectations(t)
+	notifier.AssertNotCalled(t, "NotifyItemSoftDeleted", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestChecklistItemsService_ClearCompletedItems_NothingToClear(t *testing.T) {
+	repo := new(mockChecklistItemsRepository)
+	notifier := new(mockNotificationService)
+	ownershipChecker := new(mockChecklistOwnershipChecker)
+
+	ownershipChecker.On("CanModifyChecklist", mock.Anything, uint(100)).Return(nil)
+	repo.On("ClearCompletedItems", mock.Anything, uint(100)).
+		Return(domain.ChecklistItemDeletionGroup{ChecklistId: 100, ItemIds: []uint{}}, nil)
+
+	svc := &checklistItemsService{repository: repo, notifier: notifier, checklistOwnershipChecker: ownershipChecker}
+	if _, err := svc.ClearCompletedItems(context.Background(), 100); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	notifier.AssertNotCalled(t, "NotifyItemsBatchUpdated", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestChecklistItemsService_ResetChecklistItems(t *testing.T) {
+	result := domain.ChecklistItemBatchResult{
+		ChecklistId:    100,
+		Items:          []domain.ChecklistItem{{Id: 1}, {Id: 2}},
+		DeletedItemIds: []uint{},
+	}
+	repo := new(mockChecklistItemsRepository)
+	notifier := new(mockNotificationService)
+	ownershipChecker := new(mockChecklistOwnershipChecker)
+
+	ownershipChecker.On("CanModifyChecklist", mock.Anything, uint(100)).Return(nil)
+	repo.On("ResetChecklistItems", mock.Anything, uint(100)).Return(result, nil)
+	notifier.On("NotifyItemsBatchUpdated", mock.Anything, uint(100), result).Return().Once()
+
+	svc := &checklistItemsService{repository: repo, notifier: notifier, checklistOwnershipChecker: ownershipChecker}
+	res, err := svc.ResetChecklistItems(context.Background(), 100)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(res.Items) != 2 {
+		t.Fatalf("unexpected result %v", res)
+	}
+	repo.AssertExpectations(t)
+	notifier.AssertExpectations(t)
+}
+
+func TestChecklistItemsService_SortChecklistItems(t *testing.T) {
+	request := domain.ChecklistItemSortRequest{ChecklistId: 100, SortBy: "name", SortOrder: domain.AscSort, Locale: "DE-at"}
+	normalized := domain.ChecklistItemSortRequest{ChecklistId: 100, SortBy: domain.ItemSortByName, SortOrder: domain.AscSort, Locale: "de-AT"}
+	items := []domain.ChecklistItem{{Id: 2, Name: "Apples"}, {Id: 1, Name: "bread"}, {Id: 3, Name: "Milk", Completed: true}}
+	repo := new(mockChecklistItemsRepository)
+	notifier := new(mockNotificationService)
+	ownershipChecker := new(mockChecklistOwnershipChecker)
+
+	ownershipChecker.On("CanModifyChecklist", mock.Anything, uint(100)).Return(nil)
+	repo.On("SortChecklistItems", mock.Anything, normalized).Return(items, nil)
+	notifier.On("NotifyItemsSorted", mock.Anything, uint(100), []uint{2, 1, 3}).Return().Once()
+
+	svc := &checklistItemsService{repository: repo, notifier: notifier, checklistOwnershipChecker: ownershipChecker}
+	res, err := svc.SortChecklistItems(context.Background(), request)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(res) != 3 {
+		t.Fatalf("unexpected result %v", res)
+	}
+	repo.AssertExpectations(t)
+	notifier.AssertExpectations(t)
+}
+
+func TestChecklistItemsService_SortChecklistItems_InvalidKey(t *testing.T) {
+	repo := new(mockChecklistItemsRepository)
+	ownershipChecker := new(mockChecklistOwnershipChecker)
+	ownershipChecker.On("CanModifyChecklist", mock.Anything, uint(100)).Return(nil)
+
+	svc := &checklistItemsService{repository: repo, checklistOwnershipChecker: ownershipChecker}
+	_, err := svc.SortChecklistItems(context.Background(), domain.ChecklistItemSortRequest{ChecklistId: 100, SortBy: "DUE_DATE", SortOrder: domain.AscSort})
+	if err == nil || err.ResponseCode() != 400 {
+		t.Fatalf("expected 400 error, got %v", err)
+	}
+	repo.AssertNotCalled(t, "SortChecklistItems", mock.Anything, mock.Anything)
+}
+
+func TestChecklistItemsService_SortChecklistItems_InvalidLocale(t *testing.T) {
+	repo := new(mockChecklistItemsRepository)
+	ownershipChecker := new(mockChecklistOwnershipChecker)
+	ownershipChecker.On("CanModifyChecklist", mock.Anything, uint(100)).Return(nil)
+
+	svc := &checklistItemsService{repository: repo, checklistOwnershipChecker: ownershipChecker}
+	_, err := svc.SortChecklistItems(context.Background(), domain.ChecklistItemSortRequest{ChecklistId: 100,
+		SortBy: domain.ItemSortByName, SortOrder: domain.AscSort, Locale: `en" COLLATE "C`})
+	if err == nil || err.ResponseCode() != 400 {
+		t.Fatalf("expected 400 error, got %v", err)
+	}
+	repo.AssertNotCalled(t, "SortChecklistItems", mock.Anything, mock.Anything)
+}
+
+func TestChecklistItemsService_ToggleCompleted_RecordsPreviousState(t *testing.T) {
+	ctx := domain.AddUserIdToContext(context.Background(), "user-1")
+	item := domain.ChecklistItem{Id: 5, Name: "Milk", Completed: true}
+	repo := new(mockChecklistItemsRepository)
+	notifier := new(mockNotificationService)
+	ownershipChecker := new(mockChecklistOwnershipChecker)
+	activityRepo := new(mockChecklistActivityRepository)
+
+	ownershipChecker.On("CanModifyChecklist", mock.Anything, uint(100)).Return(nil)
+	repo.On("ToggleItemCompleted", mock.Anything, uint(100), uint(5), true).
+		Return(domain.ChecklistItemToggleResult{Item: item, WasCompleted: false}, nil)
+	notifier.On("NotifyItemUpdated", mock.Anything, uint(100), item).Return()
+	activityRepo.On("AppendActivity", mock.Anything, mock.AnythingOfType("domain.ChecklistActivity")).Return(nil)
+
+	svc := &checklistItemsService{repository: repo, notifier: notifier, checklistOwnershipChecker: ownershipChecker,
+		activityService: &checklistActivityService{repository: activityRepo}}
+	result, err := svc.ToggleCompleted(ctx, 100, 5, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Id != 5 || !result.Completed {
+		t.Fatalf("unexpected item %v", result)
+	}
+
+	recorded := activityRepo.Calls[0].Arguments.Get(1).(domain.ChecklistActivity)
+	if *recorded.Before != `name="Milk", completed=false` || *recorded.After != `name="Milk", completed=true` {
+		t.Fatalf("unexpected activity %q -> %q", *recorded.Before, *recorded.After)
+	}
+	repo.AssertNotCalled(t, "FindChecklistItemById", mock.Anything, mock.Anything, mock.Anything)
+	notifier.AssertExpectations(t)
+}
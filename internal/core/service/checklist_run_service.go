@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"com.raunlo.checklist/internal/core/domain"
+	"com.raunlo.checklist/internal/core/error"
+	guardrail "com.raunlo.checklist/internal/core/guard_rail"
+	"com.raunlo.checklist/internal/core/repository"
+)
+
+type IChecklistRunService interface {
+	StartRun(ctx context.Context, checklistId uint) (domain.ChecklistRun, domain.Error)
+	FindRuns(ctx context.Context, checklistId uint) ([]domain.ChecklistRun, domain.Error)
+	FindRunById(ctx context.Context, checklistId uint, runId uint) (*domain.ChecklistRun, domain.Error)
+	UpdateRunStep(ctx context.Context, checklistId uint, runId uint, stepId uint, status domain.ChecklistRunStepStatus) (domain.ChecklistRunStep, domain.Error)
+	UpdateRunStepRow(ctx context.Context, checklistId uint, runId uint, stepId uint, rowId uint, status domain.ChecklistRunStepStatus) (domain.ChecklistRunStepRow, domain.Error)
+	FinishRun(ctx context.Context, checklistId uint, runId uint) (domain.ChecklistRun, domain.Error)
+}
+
+type checklistRunService struct {
+	repository                repository.IChecklistRunRepository
+	checklistOwnershipChecker guardrail.IChecklistOwnershipChecker
+}
+
+func (service *checklistRunService) StartRun(ctx context.Context, checklistId uint) (domain.ChecklistRun, domain.Error) {
+	if err := service.checklistOwnershipChecker.HasAccessToChecklist(ctx, checklistId); err != nil {
+		return domain.ChecklistRun{}, err
+	}
+
+	userId, err := domain.GetUserIdFromContext(ctx)
+	if err != nil {
+		return domain.ChecklistRun{}, err
+	}
+
+	run, err := service.repository.CreateRunFromChecklist(ctx, checklistId, userId)
+	if err != nil {
+		return domain.ChecklistRun{}, err
+	}
+
+	log.Printf("Run started: checklistId=%d, runId=%d, steps=%d, startedBy=%s", checklistId, run.Id, len(run.Steps), domain.GetHashedUserIdFromContext(ctx))
+	return run, nil
+}
+
+func (service *checklistRunService) FindRuns(ctx context.Context, checklistId uint) ([]domain.ChecklistRun, domain.Error) {
+	if err := service.checklistOwnershipChecker.HasAccessToChecklist(ctx, checklistId); err != nil {
+		return nil, err
+	}
+	return service.repository.FindRunsByChecklistId(ctx, checklistId)
+}
+
+func (service *checklistRunService) FindRunById(ctx context.Context, checklistId uint, runId uint) (*domain.ChecklistRun, domain.Error) {
+	if err := service.checklistOwnershipChecker.HasAccessToChecklist(ctx, checklistId); err != nil {
+		return nil, err
+	}
+	return service.repository.FindRunById(ctx, checklistId, runId)
+}
+
+func (service *checklistRunService) UpdateRunStep(ctx context.Context, checklistId uint, runId uint, stepId uint, status domain.ChecklistRunStepStatus) (domain.ChecklistRunStep, domain.Error) {
+	userId, err := service.findActiveRun(ctx, checklistId, runId)
+	if err != nil {
+		return domain.ChecklistRunStep{}, err
+	}
+	return service.repository.UpdateRunStepStatus(ctx, runId, stepId, status, userId)
+}
+
+func (service *checklistRunService) UpdateRunStepRow(ctx context.Context, checklistId uint, runId uint, stepId uint, rowId uint, status domain.ChecklistRunStepStatus) (domain.ChecklistRunStepRow, domain.Error) {
+	userId, err := service.findActiveRun(ctx, checklistId, runId)
+	if err != nil {
+		return domain.ChecklistRunStepRow{}, err
+	}
+	return service.repository.UpdateRunStepRowStatus(ctx, runId, stepId, rowId, status, userId)
+}
+
+func (service *checklistRunService) FinishRun(ctx context.Context, checklistId uint, runId uint) (domain.ChecklistRun, domain.Error) {
+	if err := service.checklistOwnershipChecker.HasAccessToChecklist(ctx, checklistId); err != nil {
+		return domain.ChecklistRun{}, err
+	}
+
+	userId, err := domain.GetUserIdFromContext(ctx)
+	if err != nil {
+		return domain.ChecklistRun{}, err
+	}
+
+	run, err := service.repository.FindRunById(ctx, checklistId, runId)
+	if err != nil {
+		return domain.ChecklistRun{}, err
+	}
+	if run == nil {
+		return domain.ChecklistRun{}, error.NewChecklistRunNotFoundError(runId)
+	}
+	if run.IsFinished() {
+		return domain.ChecklistRun{}, domain.NewError(fmt.Sprintf("Run(id=%d) is already finished", runId), 400)
+	}
+
+	finished, err := service.repository.FinishRun(ctx, checklistId, runId, userId)
+	if err != nil {
+		return domain.ChecklistRun{}, err
+	}
+
+	log.Printf("Run finished: checklistId=%d, runId=%d, completion=%.1f%%, duration=%ds", checklistId, runId, *finished.CompletionPercentage, *finished.DurationSeconds)
+	return finished, nil
+}
+
+// findActiveRun verifies access to the checklist and that the run can still be modified.
+// Returns the id of the current user.
+func (service *checklistRunService) findActiveRun(ctx context.Context, checklistId uint, runId uint) (string, domain.Error) {
+	if err := service.checklistOwnershipChecker.HasAccessToChecklist(ctx, checklistId); err != nil {
+		return "", err
+	}
+
+	userId, err := domain.GetUserIdFromContext(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	run, err := service.repository.FindRunById(ctx, checklistId, runId)
+	if err != nil {
+		return "", err
+	}
+	if run == nil {
+		return "", error.NewChecklistRunNotFoundError(runId)
+	}
+	if run.IsFinished() {
+		return "", domain.NewError(fmt.Sprintf("Run(id=%d) is finished and can no longer be changed", runId), 400)
+	}
+	return userId, nil
+}
@@ -0,0 +1,175 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"com.raunlo.checklist/internal/core/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+// mockChecklistRunRepository uses testify's mock for repository.IChecklistRunRepository.
+type mockChecklistRunRepository struct {
+	mock.Mock
+}
+
+func (m *mockChecklistRunRepository) CreateRunFromChecklist(ctx context.Context, checklistId uint, startedBy string) (domain.ChecklistRun, domain.Error) {
+	args := m.Called(ctx, checklistId, startedBy)
+	var err domain.Error
+	if arg := args.Get(1); arg != nil {
+		err = arg.(domain.Error)
+	}
+	return args.Get(0).(domain.ChecklistRun), err
+}
+
+func (m *mockChecklistRunRepository) FindRunsByChecklistId(ctx context.Context, checklistId uint) ([]domain.ChecklistRun, domain.Error) {
+	args := m.Called(ctx, checklistId)
+	var runs []domain.ChecklistRun
+	var err domain.Error
+	if arg := args.Get(0); arg != nil {
+		runs = arg.([]domain.ChecklistRun)
+	}
+	if arg := args.Get(1); arg != nil {
+		err = arg.(domain.Error)
+	}
+	return runs, err
+}
+
+func (m *mockChecklistRunRepository) FindRunById(ctx context.Context, checklistId uint, runId uint) (*domain.ChecklistRun, domain.Error) {
+	args := m.Called(ctx, checklistId, runId)
+	var run *domain.ChecklistRun
+	var err domain.Error
+	if arg := args.Get(0); arg != nil {
+		run = arg.(*domain.ChecklistRun)
+	}
+	if arg := args.Get(1); arg != nil {
+		err = arg.(domain.Error)
+	}
+	return run, err
+}
+
+func (m *mockChecklistRunRepository) UpdateRunStepStatus(ctx context.Context, runId uint, stepId uint, status domain.ChecklistRunStepStatus, checkedBy string) (domain.ChecklistRunStep, domain.Error) {
+	args := m.Called(ctx, runId, stepId, status, checkedBy)
+	var err domain.Error
+	if arg := args.Get(1); arg != nil {
+		err = arg.(domain.Error)
+	}
+	return args.Get(0).(domain.ChecklistRunStep), err
+}
+
+func (m *mockChecklistRunRepository) UpdateRunStepRowStatus(ctx context.Context, runId uint, stepId uint, rowId uint, status domain.ChecklistRunStepStatus, checkedBy string) (domain.ChecklistRunStepRow, domain.Error) {
+	args := m.Called(ctx, runId, stepId, rowId, status, checkedBy)
+	var err domain.Error
+	if arg := args.Get(1); arg != nil {
+		err = arg.(domain.Error)
+	}
+	return args.Get(0).(domain.ChecklistRunStepRow), err
+}
+
+func (m *mockChecklistRunRepository) FinishRun(ctx context.Context, checklistId uint, runId uint, finishedBy string) (domain.ChecklistRun, domain.Error) {
+	args := m.Called(ctx, checklistId, runId, finishedBy)
+	var err domain.Error
+	if arg := args.Get(1); arg != nil {
+		err = arg.(domain.Error)
+	}
+	return args.Get(0).(domain.ChecklistRun), err
+}
+
+func TestChecklistRunService_FinishRun_FinishesInRepository(t *testing.T) {
+	ctx := domain.AddUserIdToContext(context.Background(), "user-1")
+	run := &domain.ChecklistRun{Id: 5, ChecklistId: 100, StartedAt: time.Now().Add(-90 * time.Second)}
+	finishedAt := time.Now()
+	repo := new(mockChecklistRunRepository)
+	ownershipChecker := new(mockChecklistOwnershipChecker)
+
+	ownershipChecker.On("HasAccessToChecklist", mock.Anything, uint(100)).Return(nil)
+	repo.On("FindRunById", mock.Anything, uint(100), uint(5)).Return(run, nil)
+	// completion and duration are computed by the repository from the locked run
+	repo.On("FinishRun", mock.Anything, uint(100), uint(5), "user-1").Return(domain.ChecklistRun{
+		Id:                   5,
+		ChecklistId:          100,
+		FinishedAt:           &finishedAt,
+		DurationSeconds:      new(int64(90)),
+		CompletionPercentage: new(66.7),
+	}, nil)
+
+	svc := &checklistRunService{repository: repo, checklistOwnershipChecker: ownershipChecker}
+	finished, err := svc.FinishRun(ctx, 100, 5)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if finished.Id != 5 || finished.CompletionPercentage == nil {
+		t.Fatalf("expected the finished run 5 with its completion got %+v", finished)
+	}
+	repo.AssertExpectations(t)
+	ownershipChecker.AssertExpectations(t)
+}
+
+func TestCalculateRunCompletion(t *testing.T) {
+	steps := []domain.ChecklistRunStep{
+		{Id: 1, Status: domain.RunStepCompleted},
+		{Id: 2, Status: domain.RunStepPending},
+		{Id: 3, Status: domain.RunStepNotApplicable},
+		{Id: 4, Status: domain.RunStepCompleted},
+	}
+	// 2 completed out of 3 applicable steps
+	if got := domain.CalculateRunCompletion(steps); got < 66.6 || got > 66.7 {
+		t.Fatalf("expected completion ~66.67 got %f", got)
+	}
+}
+
+func TestChecklistRunService_FinishRun_AlreadyFinished(t *testing.T) {
+	ctx := domain.AddUserIdToContext(context.Background(), "user-1")
+	finishedAt := time.Now()
+	repo := new(mockChecklistRunRepository)
+	ownershipChecker := new(mockChecklistOwnershipChecker)
+
+	ownershipChecker.On("HasAccessToChecklist", mock.Anything, uint(100)).Return(nil)
+	repo.On("FindRunById", mock.Anything, uint(100), uint(5)).Return(&domain.ChecklistRun{Id: 5, ChecklistId: 100, FinishedAt: &finishedAt}, nil)
+
+	svc := &checklistRunService{repository: repo, checklistOwnershipChecker: ownershipChecker}
+	_, err := svc.FinishRun(ctx, 100, 5)
+	if err == nil {
+		t.Fatalf("expected error")
+	}
+	if err.ResponseCode() != 400 {
+		t.Fatalf("expected 400 got %d", err.ResponseCode())
+	}
+	repo.AssertNotCalled(t, "FinishRun", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestChecklistRunService_UpdateRunStep_RunNotFound(t *testing.T) {
+	ctx := domain.AddUserIdToContext(context.Background(), "user-1")
+	repo := new(mockChecklistRunRepository)
+	ownershipChecker := new(mockChecklistOwnershipChecker)
+
+	ownershipChecker.On("HasAccessToChecklist", mock.Anything, uint(100)).Return(nil)
+	repo.On("FindRunById", mock.Anything, uint(100), uint(5)).Return(nil, nil)
+
+	svc := &checklistRunService{repository: repo, checklistOwnershipChecker: ownershipChecker}
+	_, err := svc.UpdateRunStep(ctx, 100, 5, 1, domain.RunStepCompleted)
+	if err == nil {
+		t.Fatalf("expected error")
+	}
+	if err.ResponseCode() != 404 {
+		t.Fatalf("expected 404 got %d", err.ResponseCode())
+	}
+	repo.AssertNotCalled(t, "UpdateRunStepStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestChecklistRunService_StartRun_AccessDenied(t *testing.T) {
+	ctx := domain.AddUserIdToContext(context.Background(), "user-1")
+	accessErr := domain.NewError("Checklist(id=100) not found", 404)
+	repo := new(mockChecklistRunRepository)
+	ownershipChecker := new(mockChecklistOwnershipChecker)
+
+	ownershipChecker.On("HasAccessToChecklist", mock.Anything, uint(100)).Return(accessErr)
+
+	svc := &checklistRunService{repository: repo, checklistOwnershipChecker: ownershipChecker}
+	_, err := svc.StartRun(ctx, 100)
+	if err == nil {
+		t.Fatalf("expected error")
+	}
+	repo.AssertNotCalled(t, "CreateRunFromChecklist", mock.Anything, mock.Anything, mock.Anything)
+}
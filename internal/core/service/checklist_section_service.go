@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"com.raunlo.checklist/internal/core/domain"
+	guardrail "com.raunlo.checklist/internal/core/guard_rail"
+	"com.raunlo.checklist/internal/core/notification"
+	"com.raunlo.checklist/internal/core/repository"
+)
+
+// MaxSectionNameLength is the maximum allowed length for a checklist section name
+const MaxSectionNameLength = 200
+
+type IChecklistSectionService interface {
+	FindChecklistSections(ctx context.Context, checklistId uint) ([]domain.ChecklistSection, domain.Error)
+	CreateChecklistSection(ctx context.Context, section domain.ChecklistSection) (domain.ChecklistSection, domain.Error)
+	// UpdateChecklistSection renames a section and collapses or expands it for everyone viewing the checklist
+	UpdateChecklistSection(ctx context.Context, section domain.ChecklistSection) (domain.ChecklistSection, domain.Error)
+	// DeleteChecklistSection deletes a section but keeps its items, which no longer belong to any section
+	DeleteChecklistSection(ctx context.Context, checklistId uint, sectionId uint) (domain.ChecklistSectionDeletionResult, domain.Error)
+	ChangeChecklistSectionOrder(ctx context.Context, request domain.ChangeSectionOrderRequest) (domain.ChecklistSection, domain.Error)
+}
+
+type checklistSectionService struct {
+	repository                repository.IChecklistSectionRepository
+	notifier                  notification.INotificationService
+	checklistOwnershipChecker guardrail.IChecklistOwnershipChecker
+}
+
+func (service *checklistSectionService) FindChecklistSections(ctx context.Context, checklistId uint) ([]domain.ChecklistSection, domain.Error) {
+	if err := service.checklistOwnershipChecker.HasAccessToChecklist(ctx, checklistId); err != nil {
+		return nil, err
+	}
+	return service.repository.FindChecklistSections(ctx, checklistId)
+}
+
+func (service *checklistSectionService) CreateChecklistSection(ctx context.Context, section domain.ChecklistSection) (domain.ChecklistSection, domain.Error) {
+	if err := service.checklistOwnershipChecker.CanModifyChecklist(ctx, section.ChecklistId); err != nil {
+		return domain.ChecklistSection{}, err
+	}
+	if err := validateSectionName(&section); err != nil {
+		return domain.ChecklistSection{}, err
+	}
+
+	result, err := service.repository.SaveChecklistSection(ctx, section)
+	if err == nil {
+		service.notifier.NotifySectionCreated(ctx, result)
+	}
+	return result, err
+}
+
+func (service *checklistSectionService) UpdateChecklistSection(ctx context.Context, section domain.ChecklistSection) (domain.ChecklistSection, domain.Error) {
+	if err := service.checklistOwnershipChecker.CanModifyChecklist(ctx, section.ChecklistId); err != nil {
+		return domain.ChecklistSection{}, err
+	}
+	if err := validateSectionName(&section); err != nil {
+		return domain.ChecklistSection{}, err
+	}
+
+	result, err := service.repository.UpdateChecklistSection(ctx, section)
+	if err == nil {
+		service.notifier.NotifySectionUpdated(ctx, result)
+	}
+	return result, err
+}
+
+func (service *checklistSectionService) DeleteChecklistSection(ctx context.Context, checklistId uint, sectionId uint) (domain.ChecklistSectionDeletionResult, domain.Error) {
+	if err := service.checklistOwnershipChecker.CanModifyChecklist(ctx, checklistId); err != nil {
+		return domain.ChecklistSectionDeletionResult{}, err
+	}
+
+	result, err := service.repository.DeleteChecklistSection(ctx, checklistId, sectionId)
+	if err == nil {
+		service.notifier.NotifySectionDeleted(ctx, result)
+	}
+	return result, err
+}
+
+func (service *checklistSectionService) ChangeChecklistSectionOrder(ctx context.Context, request domain.ChangeSectionOrderRequest) (domain.ChecklistSection, domain.Error) {
+	if err := service.checklistOwnershipChecker.CanModifyChecklist(ctx, request.ChecklistId); err != nil {
+		return domain.ChecklistSection{}, err
+	}
+	if request.NewOrderNumber == 0 {
+		return domain.ChecklistSection{}, domain.NewError("Order number must be at least 1", 400)
+	}
+
+	result, err := service.repository.ChangeChecklistSectionOrder(ctx, request)
+	if err == nil {
+		service.notifier.NotifySectionReordered(ctx, result)
+	}
+	return result, err
+}
+
+// validateSectionName trims the name and checks its length
+func validateSectionName(section *domain.ChecklistSection) domain.Error {
+	section.Name = strings.TrimSpace(section.Name)
+	if section.Name == "" || len(section.Name) > MaxSectionNameLength {
+		return domain.NewError(fmt.Sprintf("Section name must be 1-%d characters", MaxSectionNameLength), 400)
+	}
+	return nil
+}
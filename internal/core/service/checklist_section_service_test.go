@@ -0,0 +1,141 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"com.raunlo.checklist/internal/core/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+// mockChecklistSectionRepository uses testify's mock for repository.IChecklistSectionRepository.
+type mockChecklistSectionRepository struct {
+	mock.Mock
+}
+
+func (m *mockChecklistSectionRepository) FindChecklistSections(ctx context.Context, checklistId uint) ([]domain.ChecklistSection, domain.Error) {
+	args := m.Called(ctx, checklistId)
+	var sections []domain.ChecklistSection
+	var err domain.Error
+	if arg := args.Get(0); arg != nil {
+		sections = arg.([]domain.ChecklistSection)
+	}
+	if arg := args.Get(1); arg != nil {
+		err = arg.(domain.Error)
+	}
+	return sections, err
+}
+
+func (m *mockChecklistSectionRepository) SaveChecklistSection(ctx context.Context, section domain.ChecklistSection) (domain.ChecklistSection, domain.Error) {
+	args := m.Called(ctx, section)
+	var err domain.Error
+	if arg := args.Get(1); arg != nil {
+		err = arg.(domain.Error)
+	}
+	return args.Get(0).(domain.ChecklistSection), err
+}
+
+func (m *mockChecklistSectionRepository) UpdateChecklistSection(ctx context.Context, section domain.ChecklistSection) (domain.ChecklistSection, domain.Error) {
+	args := m.Called(ctx, section)
+	var err domain.Error
+	if arg := args.Get(1); arg != nil {
+		err = arg.(domain.Error)
+	}
+	return args.Get(0).(domain.ChecklistSection), err
+}
+
+func (m *mockChecklistSectionRepository) DeleteChecklistSection(ctx context.Context, checklistId uint, sectionId uint) (domain.ChecklistSectionDeletionResult, domain.Error) {
+	args := m.Called(ctx, checklistId, sectionId)
+	var err domain.Error
+	if arg := args.Get(1); arg != nil {
+		err = arg.(domain.Error)
+	}
+	return args.Get(0).(domain.ChecklistSectionDeletionResult), err
+}
+
+func (m *mockChecklistSectionRepository) ChangeChecklistSectionOrder(ctx context.Context, request domain.ChangeSectionOrderRequest) (domain.ChecklistSection, domain.Error) {
+	args := m.Called(ctx, request)
+	var err domain.Error
+	if arg := args.Get(1); arg != nil {
+		err = arg.(domain.Error)
+	}
+	return args.Get(0).(domain.ChecklistSection), err
+}
+
+func TestChecklistSectionService_CreateChecklistSection_TrimsNameAndNotifies(t *testing.T) {
+	ctx := context.Background()
+	repo := new(mockChecklistSectionRepository)
+	notifier := new(mockNotificationService)
+	ownershipChecker := new(mockChecklistOwnershipChecker)
+
+	saved := domain.ChecklistSection{Id: 3, ChecklistId: 100, Name: "Produce", Position: 1000, OrderNumber: 1}
+	ownershipChecker.On("CanModifyChecklist", mock.Anything, uint(100)).Return(nil)
+	repo.On("SaveChecklistSection", mock.Anything, domain.ChecklistSection{ChecklistId: 100, Name: "Produce"}).Return(saved, nil)
+	notifier.On("NotifySectionCreated", mock.Anything, saved).Return()
+
+	svc := &checklistSectionService{repository: repo, notifier: notifier, checklistOwnershipChecker: ownershipChecker}
+	result, err := svc.CreateChecklistSection(ctx, domain.ChecklistSection{ChecklistId: 100, Name: "  Produce "})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Id != 3 {
+		t.Fatalf("expected section 3 got %d", result.Id)
+	}
+	repo.AssertExpectations(t)
+	notifier.AssertExpectations(t)
+}
+
+func TestChecklistSectionService_UpdateChecklistSection_InvalidName(t *testing.T) {
+	ctx := context.Background()
+	repo := new(mockChecklistSectionRepository)
+	notifier := new(mockNotificationService)
+	ownershipChecker := new(mockChecklistOwnershipChecker)
+
+	ownershipChecker.On("CanModifyChecklist", mock.Anything, uint(100)).Return(nil)
+
+	svc := &checklistSectionService{repository: repo, notifier: notifier, checklistOwnershipChecker: ownershipChecker}
+	_, err := svc.UpdateChecklistSection(ctx, domain.ChecklistSection{Id: 3, ChecklistId: 100, Name: "   "})
+	if err == nil || err.ResponseCode() != 400 {
+		t.Fatalf("expected 400 got %v", err)
+	}
+	repo.AssertNotCalled(t, "UpdateChecklistSection", mock.Anything, mock.Anything)
+	notifier.AssertNotCalled(t, "NotifySectionUpdated", mock.Anything, mock.Anything)
+}
+
+func TestChecklistSectionService_DeleteChecklistSection_NotifiesMovedItems(t *testing.T) {
+	ctx := context.Background()
+	repo := new(mockChecklistSectionRepository)
+	notifier := new(mockNotificationService)
+	ownershipChecker := new(mockChecklistOwnershipChecker)
+
+	result := domain.ChecklistSectionDeletionResult{ChecklistId: 100, SectionId: 3, ItemIds: []uint{7, 8}}
+	ownershipChecker.On("CanModifyChecklist", mock.Anything, uint(100)).Return(nil)
+	repo.On("DeleteChecklistSection", mock.Anything, uint(100), uint(3)).Return(result, nil)
+	notifier.On("NotifySectionDeleted", mock.Anything, result).Return()
+
+	svc := &checklistSectionService{repository: repo, notifier: notifier, checklistOwnershipChecker: ownershipChecker}
+	if _, err := svc.DeleteChecklistSection(ctx, 100, 3); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	repo.AssertExpectations(t)
+	notifier.AssertExpectations(t)
+}
+
+func TestChecklistSectionService_ChangeChecklistSectionOrder_NotFound(t *testing.T) {
+	ctx := context.Background()
+	repo := new(mockChecklistSectionRepository)
+	notifier := new(mockNotificationService)
+	ownershipChecker := new(mockChecklistOwnershipChecker)
+
+	request := domain.ChangeSectionOrderRequest{ChecklistId: 100, SectionId: 3, NewOrderNumber: 1}
+	ownershipChecker.On("CanModifyChecklist", mock.Anything, uint(100)).Return(nil)
+	repo.On("ChangeChecklistSectionOrder", mock.Anything, request).
+		Return(domain.ChecklistSection{}, domain.NewError("Checklist section(id=3) not found", 404))
+
+	svc := &checklistSectionService{repository: repo, notifier: notifier, checklistOwnershipChecker: ownershipChecker}
+	_, err := svc.ChangeChecklistSectionOrder(ctx, request)
+	if err == nil || err.ResponseCode() != 404 {
+		t.Fatalf("expected 404 got %v", err)
+	}
+	notifier.AssertNotCalled(t, "NotifySectionReordered", mock.Anything, mock.Anything)
+}
@@ -2,13 +2,19 @@ package service
 
 import (
 	"context"
+	"fmt"
+	"log"
 
 	"com.raunlo.checklist/internal/core/domain"
 	"com.raunlo.checklist/internal/core/error"
 	guardrail "com.raunlo.checklist/internal/core/guard_rail"
+	"com.raunlo.checklist/internal/core/notification"
 	"com.raunlo.checklist/internal/core/repository"
 )
 
+// MaxChecklistNameLength is the maximum allowed length for a checklist name
+const MaxChecklistNameLength = 200
+
 type IChecklistService interface {
 	UpdateChecklist(ctx context.Context, checklist domain.Checklist) (domain.Checklist, domain.Error)
 	SaveChecklist(ctx context.Context, checklist domain.Checklist) (domain.Checklist, domain.Error)
@@ -16,23 +22,153 @@ type IChecklistService interface {
 	DeleteChecklistById(ctx context.Context, id uint) domain.Error
 	FindAllChecklists(ctx context.Context) ([]domain.Checklist, domain.Error)
 	LeaveSharedChecklist(ctx context.Context, checklistId uint) domain.Error
+	// CloneChecklist duplicates a checklist with its items, rows and order into a new checklist owned by the caller
+	CloneChecklist(ctx context.Context, checklistId uint, options domain.ChecklistCloneOptions) (domain.Checklist, domain.Error)
+	// MergeChecklists moves the items of the source checklist into the target, combining duplicates, and archives the source
+	MergeChecklists(ctx context.Context, targetChecklistId uint, sourceChecklistId uint) (domain.ChecklistMergeResult, domain.Error)
+	// SplitChecklist moves the selected items of a checklist into a new checklist owned by the caller
+	SplitChecklist(ctx context.Context, checklistId uint, request domain.ChecklistSplitRequest) (domain.ChecklistSplitResult, domain.Error)
 }
 
 type checklistService struct {
 	repository                repository.IChecklistRepository
 	checklistOwnershipChecker guardrail.IChecklistOwnershipChecker
+	workspaceOwnershipChecker guardrail.IWorkspaceOwnershipChecker
 	checklistItemService      IChecklistItemsService
+	notifier                  notification.INotificationService
+	activityService           IChecklistActivityService
+	workspaceActivityService  IWorkspaceActivityService
 }
 
 func (service *checklistService) UpdateChecklist(ctx context.Context, checklist domain.Checklist) (domain.Checklist, domain.Error) {
-	if err := service.checklistOwnershipChecker.HasAccessToChecklist(ctx, checklist.Id); err != nil {
-		return domain.Checklist{}, error.NewChecklistNotFoundError(checklist.Id)
+	if err := service.checklistOwnershipChecker.CanModifyChecklist(ctx, checklist.Id); err != nil {
+		return domain.Checklist{}, err
+	}
+	if err := validateOrderingMode(&checklist); err != nil {
+		return domain.Checklist{}, err
+	}
+
+	var previous *domain.Checklist
+	if service.activityService != nil || service.workspaceActivityService != nil || service.notifier != nil {
+		previous, _ = service.repository.FindChecklistById(ctx, checklist.Id)
+	}
+
+	result, err := service.repository.UpdateChecklist(ctx, checklist)
+	if err == nil && previous != nil {
+		if previous.Name != result.Name {
+			service.recordActivity(ctx, result.Id, domain.ActivityChecklistRenamed,
+				new(fmt.Sprintf("name=%q", previous.Name)), new(fmt.Sprintf("name=%q", result.Name)))
+		}
+		service.recordWorkspaceMove(ctx, result, previous.WorkspaceId)
+		service.notifyChecklistUpdated(ctx, *previous, result)
+		if previous.OrderingMode != result.OrderingMode {
+			service.notifyItemsOrder(ctx, result.Id)
+		}
+	}
+	return result, err
+}
+
+// notifyItemsOrder publishes the order of all items after an ordering mode change, which moves completed items
+// and may renumber positions
+func (service *checklistService) notifyItemsOrder(ctx context.Context, checklistId uint) {
+	if service.notifier == nil || service.checklistItemService == nil {
+		return
+	}
+	items, err := service.checklistItemService.FindAllChecklistItems(ctx, checklistId, nil, domain.AscSort)
+	if err != nil {
+		log.Printf("Could not publish the item order of checklist(id=%d): %v", checklistId, err)
+		return
+	}
+	service.notifier.NotifyItemsSorted(ctx, checklistId, itemIdsOf(items))
+}
+
+// notifyChecklistUpdated publishes a move when the workspace changed, since it changes who can access the
+// checklist, and otherwise a rename
+func (service *checklistService) notifyChecklistUpdated(ctx context.Context, previous domain.Checklist, checklist domain.Checklist) {
+	if service.notifier == nil {
+		return
+	}
+	if !sameWorkspace(previous.WorkspaceId, checklist.WorkspaceId) {
+		service.notifier.NotifyChecklistMoved(ctx, checklist, service.usersWithAccess(ctx, checklist.Id))
+	} else if previous.Name != checklist.Name {
+		service.notifier.NotifyChecklistUpdated(ctx, checklist)
+	}
+}
+
+// notifyChecklistCreated tells the users who can access a new checklist about it
+func (service *checklistService) notifyChecklistCreated(ctx context.Context, checklist domain.Checklist) {
+	if service.notifier != nil {
+		service.notifier.NotifyChecklistCreated(ctx, checklist, service.usersWithAccess(ctx, checklist.Id))
+	}
+}
+
+// usersWithAccess returns the users whose user streams cover the checklist. Without them the streams are
+// not updated, which is logged but does not fail the request.
+func (service *checklistService) usersWithAccess(ctx context.Context, checklistId uint) []string {
+	userIds, err := service.repository.FindUserIdsWithAccess(ctx, checklistId)
+	if err != nil {
+		log.Printf("Could not find users with access to checklist(id=%d): %v", checklistId, err)
+		return nil
+	}
+	return userIds
+}
+
+func sameWorkspace(a *uint, b *uint) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// recordWorkspaceMove adds the moved out/in entries to the feeds of the workspaces the checklist left and joined
+func (service *checklistService) recordWorkspaceMove(ctx context.Context, checklist domain.Checklist, previousWorkspaceId *uint) {
+	if service.workspaceActivityService == nil {
+		return
+	}
+	if sameWorkspace(previousWorkspaceId, checklist.WorkspaceId) {
+		return
+	}
+	if previousWorkspaceId != nil {
+		service.workspaceActivityService.RecordActivity(ctx, domain.WorkspaceActivity{
+			WorkspaceId: *previousWorkspaceId,
+			EventType:   domain.WorkspaceChecklistMovedOut,
+			ChecklistId: &checklist.Id,
+			Summary:     &checklist.Name,
+		})
+	}
+	if checklist.WorkspaceId != nil {
+		service.workspaceActivityService.RecordActivity(ctx, domain.WorkspaceActivity{
+			WorkspaceId: *checklist.WorkspaceId,
+			EventType:   domain.WorkspaceChecklistMovedIn,
+			ChecklistId: &checklist.Id,
+			Summary:     &checklist.Name,
+		})
 	}
-	return service.repository.UpdateChecklist(ctx, checklist)
 }
 
 func (service *checklistService) SaveChecklist(ctx context.Context, checklist domain.Checklist) (domain.Checklist, domain.Error) {
-	return service.repository.SaveChecklist(ctx, checklist)
+	if err := validateOrderingMode(&checklist); err != nil {
+		return domain.Checklist{}, err
+	}
+	result, err := service.repository.SaveChecklist(ctx, checklist)
+	if err == nil {
+		service.recordActivity(ctx, result.Id, domain.ActivityChecklistCreated, nil, new(fmt.Sprintf("name=%q", result.Name)))
+		service.notifyChecklistCreated(ctx, result)
+	}
+	return result, err
+}
+
+// validateOrderingMode normalizes a requested ordering mode; an empty mode is left for the repository to default
+func validateOrderingMode(checklist *domain.Checklist) domain.Error {
+	if checklist.OrderingMode == "" {
+		return nil
+	}
+	mode, err := domain.NewChecklistOrderingMode(string(checklist.OrderingMode))
+	if err != nil {
+		return err
+	}
+	checklist.OrderingMode = mode
+	return nil
 }
 
 func (service *checklistService) FindChecklistById(ctx context.Context, id uint) (*domain.Checklist, domain.Error) {
@@ -53,7 +189,13 @@ func (service *checklistService) DeleteChecklistById(ctx context.Context, id uin
 		// If there are items in the checklist, we can't delete it
 		return domain.NewError("Checklist is not empty", 400)
 	}
-	return service.repository.DeleteChecklistById(ctx, id)
+	if err := service.repository.DeleteChecklistById(ctx, id); err != nil {
+		return err
+	}
+	if service.notifier != nil {
+		service.notifier.NotifyChecklistDeleted(ctx, id)
+	}
+	return nil
 }
 
 func (service *checklistService) FindAllChecklists(ctx context.Context) ([]domain.Checklist, domain.Error) {
@@ -78,5 +220,134 @@ func (service *checklistService) LeaveSharedChecklist(ctx context.Context, check
 	}
 
 	// Delete the share (remove user's access)
-	return service.repository.DeleteChecklistShare(ctx, checklistId, userId)
+	if err := service.repository.DeleteChecklistShare(ctx, checklistId, userId); err != nil {
+		return err
+	}
+	service.recordActivity(ctx, checklistId, domain.ActivityShareRemoved, new(fmt.Sprintf("sharedWithUserId=%s", userId)), nil)
+	if service.notifier != nil {
+		service.notifier.NotifyAccessChanged(ctx, []string{userId})
+	}
+	return nil
+}
+
+func (service *checklistService) CloneChecklist(ctx context.Context, checklistId uint, options domain.ChecklistCloneOptions) (domain.Checklist, domain.Error) {
+	if err := service.checklistOwnershipChecker.HasAccessToChecklist(ctx, checklistId); err != nil {
+		return domain.Checklist{}, error.NewChecklistNotFoundError(checklistId)
+	}
+	// Copying shares exposes who the source is shared with, which only its owner may see
+	if options.IncludeShares {
+		if err := service.checklistOwnershipChecker.IsChecklistOwner(ctx, checklistId); err != nil {
+			return domain.Checklist{}, err
+		}
+	}
+	if options.WorkspaceId != nil {
+		if err := service.workspaceOwnershipChecker.IsMember(ctx, *options.WorkspaceId); err != nil {
+			return domain.Checklist{}, err
+		}
+	}
+
+	var name string
+	if options.Name != nil {
+		name = *options.Name
+	} else {
+		source, err := service.repository.FindChecklistById(ctx, checklistId)
+		if err != nil {
+			return domain.Checklist{}, err
+		} else if source == nil {
+			return domain.Checklist{}, error.NewChecklistNotFoundError(checklistId)
+		}
+		name = source.Name + " (copy)"
+	}
+	if name == "" || len(name) > MaxChecklistNameLength {
+		return domain.Checklist{}, domain.NewError(fmt.Sprintf("Checklist name must be 1-%d characters", MaxChecklistNameLength), 400)
+	}
+
+	result, err := service.repository.CloneChecklist(ctx, checklistId, name, options)
+	if err == nil {
+		service.recordActivity(ctx, result.Id, domain.ActivityChecklistCreated,
+			new(fmt.Sprintf("clonedFromChecklistId=%d", checklistId)), new(fmt.Sprintf("name=%q", result.Name)))
+		service.notifyChecklistCreated(ctx, result)
+	}
+	return result, err
+}
+
+func (service *checklistService) MergeChecklists(ctx context.Context, targetChecklistId uint, sourceChecklistId uint) (domain.ChecklistMergeResult, domain.Error) {
+	if targetChecklistId == sourceChecklistId {
+		return domain.ChecklistMergeResult{}, domain.NewError("A checklist can not be merged into itself", 400)
+	}
+	// Both checklists change, so neither may be archived or only viewable by the user
+	for _, checklistId := range []uint{targetChecklistId, sourceChecklistId} {
+		if err := service.checklistOwnershipChecker.CanModifyChecklist(ctx, checklistId); err != nil {
+			return domain.ChecklistMergeResult{}, err
+		}
+	}
+	// The source is archived by the merge, so only its owner may merge it away
+	if err := service.checklistOwnershipChecker.IsChecklistOwner(ctx, sourceChecklistId); err != nil {
+		return domain.ChecklistMergeResult{}, err
+	}
+
+	result, err := service.repository.MergeChecklists(ctx, targetChecklistId, sourceChecklistId)
+	if err != nil {
+		return domain.ChecklistMergeResult{}, err
+	}
+
+	if service.notifier != nil {
+		service.notifier.NotifyChecklistsMerged(ctx, result)
+	}
+	service.recordActivity(ctx, targetChecklistId, domain.ActivityChecklistMerged,
+		new(fmt.Sprintf("sourceChecklistId=%d", sourceChecklistId)), new(fmt.Sprintf("items=%d", len(result.Items))))
+	service.recordActivity(ctx, sourceChecklistId, domain.ActivityChecklistMerged,
+		nil, new(fmt.Sprintf("targetChecklistId=%d", targetChecklistId)))
+	return result, nil
+}
+
+func (service *checklistService) SplitChecklist(ctx context.Context, checklistId uint, request domain.ChecklistSplitRequest) (domain.ChecklistSplitResult, domain.Error) {
+	if request.Name == "" || len(request.Name) > MaxChecklistNameLength {
+		return domain.ChecklistSplitResult{}, domain.NewError(fmt.Sprintf("Checklist name must be 1-%d characters", MaxChecklistNameLength), 400)
+	}
+	if len(request.ItemIds) == 0 || len(request.ItemIds) > domain.MaxBatchOperations {
+		return domain.ChecklistSplitResult{}, domain.NewError(fmt.Sprintf("A split must select 1-%d items", domain.MaxBatchOperations), 400)
+	}
+	seen := make(map[uint]bool, len(request.ItemIds))
+	for _, itemId := range request.ItemIds {
+		if seen[itemId] {
+			return domain.ChecklistSplitResult{}, domain.NewError(fmt.Sprintf("Item %d is selected more than once", itemId), 400)
+		}
+		seen[itemId] = true
+	}
+	if err := service.checklistOwnershipChecker.CanModifyChecklist(ctx, checklistId); err != nil {
+		return domain.ChecklistSplitResult{}, err
+	}
+	// Items leave the source for a checklist the user owns, so as with a merge only the owner of the source may do it
+	if err := service.checklistOwnershipChecker.IsChecklistOwner(ctx, checklistId); err != nil {
+		return domain.ChecklistSplitResult{}, err
+	}
+
+	result, err := service.repository.SplitChecklist(ctx, checklistId, request)
+	if err != nil {
+		return domain.ChecklistSplitResult{}, err
+	}
+
+	if service.notifier != nil {
+		service.notifier.NotifyChecklistSplit(ctx, result)
+	}
+	service.notifyChecklistCreated(ctx, result.Checklist)
+	service.recordActivity(ctx, checklistId, domain.ActivityChecklistSplit,
+		nil, new(fmt.Sprintf("newChecklistId=%d, items=%d", result.Checklist.Id, len(result.Items))))
+	service.recordActivity(ctx, result.Checklist.Id, domain.ActivityChecklistCreated,
+		new(fmt.Sprintf("splitFromChecklistId=%d", checklistId)), new(fmt.Sprintf("name=%q", result.Checklist.Name)))
+	return result, nil
+}
+
+// recordActivity appends an entry to the checklist activity log when activity tracking is enabled
+func (service *checklistService) recordActivity(ctx context.Context, checklistId uint, action domain.ChecklistActivityAction, before *string, after *string) {
+	if service.activityService == nil {
+		return
+	}
+	service.activityService.RecordActivity(ctx, domain.ChecklistActivity{
+		ChecklistId: checklistId,
+		Action:      action,
+		Before:      before,
+		After:       after,
+	})
 }
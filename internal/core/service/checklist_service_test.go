@@ -44,6 +44,33 @@ func (m *mockChecklistRepository) FindChecklistById(ctx context.Context, id uint
 	return checklist, err
 }
 
+func (m *mockChecklistRepository) CloneChecklist(ctx context.Context, sourceChecklistId uint, name string, options domain.ChecklistCloneOptions) (domain.Checklist, domain.Error) {
+	args := m.Called(ctx, sourceChecklistId, name, options)
+	var err domain.Error
+	if arg := args.Get(1); arg != nil {
+		err = arg.(domain.Error)
+	}
+	return args.Get(0).(domain.Checklist), err
+}
+
+func (m *mockChecklistRepository) MergeChecklists(ctx context.Context, targetChecklistId uint, sourceChecklistId uint) (domain.ChecklistMergeResult, domain.Error) {
+	args := m.Called(ctx, targetChecklistId, sourceChecklistId)
+	var err domain.Error
+	if arg := args.Get(1); arg != nil {
+		err = arg.(domain.Error)
+	}
+	return args.Get(0).(domain.ChecklistMergeResult), err
+}
+
+func (m *mockChecklistRepository) SplitChecklist(ctx context.Context, sourceChecklistId uint, request domain.ChecklistSplitRequest) (domain.ChecklistSplitResult, domain.Error) {
+	args := m.Called(ctx, sourceChecklistId, request)
+	var err domain.Error
+	if arg := args.Get(1); arg != nil {
+		err = arg.(domain.Error)
+	}
+	return args.Get(0).(domain.ChecklistSplitResult), err
+}
+
 func (m *mockChecklistRepository) DeleteChecklistById(ctx context.Context, id uint) domain.Error {
 	args := m.Called(ctx, id)
 	if arg := args.Get(0); arg != nil {
@@ -91,6 +118,54 @@ func (m *mockChecklistRepository) CheckUserIsOwner(ctx context.Context, checklis
 	return args.Get(0).(bool), err
 }
 
+func (m *mockChecklistRepository) FindAccessibleChecklistIds(ctx context.Context, userId string) ([]uint, domain.Error) {
+	args := m.Called(ctx, userId)
+	var checklistIds []uint
+	var err domain.Error
+	if arg := args.Get(0); arg != nil {
+		checklistIds = arg.([]uint)
+	}
+	if arg := args.Get(1); arg != nil {
+		err = arg.(domain.Error)
+	}
+	return checklistIds, err
+}
+
+func (m *mockChecklistRepository) FindUserIdsWithAccess(ctx context.Context, checklistId uint) ([]string, domain.Error) {
+	args := m.Called(ctx, checklistId)
+	var userIds []string
+	var err domain.Error
+	if arg := args.Get(0); arg != nil {
+		userIds = arg.([]string)
+	}
+	if arg := args.Get(1); arg != nil {
+		err = arg.(domain.Error)
+	}
+	return userIds, err
+}
+
+func (m *mockChecklistRepository) FindChecklistStats(ctx context.Context, checklistId uint) (domain.ChecklistStats, domain.Error) {
+	args := m.Called(ctx, checklistId)
+	var err domain.Error
+	if arg := args.Get(1); arg != nil {
+		err = arg.(domain.Error)
+	}
+	return args.Get(0).(domain.ChecklistStats), err
+}
+
+func (m *mockChecklistRepository) FindChecklistItemOrder(ctx context.Context, checklistId uint) ([]domain.ChecklistItemOrder, domain.Error) {
+	args := m.Called(ctx, checklistId)
+	var items []domain.ChecklistItemOrder
+	if arg := args.Get(0); arg != nil {
+		items = arg.([]domain.ChecklistItemOrder)
+	}
+	var err domain.Error
+	if arg := args.Get(1); arg != nil {
+		err = arg.(domain.Error)
+	}
+	return items, err
+}
+
 func (m *mockChecklistRepository) CreateChecklistShare(ctx context.Context, checklistId uint, sharedByUserId string, sharedWithUserId string) domain.Error {
 	args := m.Called(ctx, checklistId, sharedByUserId, sharedWithUserId)
 	if arg := args.Get(0); arg != nil {
@@ -170,6 +245,69 @@ func (m *mockChecklistItemsService) ToggleCompleted(ctx context.Context, checkli
 	return args.Get(0).(domain.ChecklistItem), err
 }
 
+func (m *mockChecklistItemsService) MoveChecklistItem(ctx context.Context, request domain.ChecklistItemTransferRequest) (domain.ChecklistItem, domain.Error) {
+	args := m.Called(ctx, request)
+	var err domain.Error
+	if arg := args.Get(1); arg != nil {
+		err = arg.(domain.Error)
+	}
+	return args.Get(0).(domain.ChecklistItem), err
+}
+
+func (m *mockChecklistItemsService) CopyChecklistItem(ctx context.Context, request domain.ChecklistItemTransferRequest) (domain.ChecklistItem, domain.Error) {
+	args := m.Called(ctx, request)
+	var err domain.Error
+	if arg := args.Get(1); arg != nil {
+		err = arg.(domain.Error)
+	}
+	return args.Get(0).(domain.ChecklistItem), err
+}
+
+func (m *mockChecklistItemsService) ApplyBatch(ctx context.Context, checklistId uint, operations []domain.ChecklistItemBatchOperation) (domain.ChecklistItemBatchResult, domain.Error) {
+	args := m.Called(ctx, checklistId, operations)
+	var err domain.Error
+	if arg := args.Get(1); arg != nil {
+		err = arg.(domain.Error)
+	}
+	return args.Get(0).(domain.ChecklistItemBatchResult), err
+}
+
+func (m *mockChecklistItemsService) ClearCompletedItems(ctx context.Context, checklistId uint) (domain.ChecklistItemDeletionGroup, domain.Error) {
+	args := m.Called(ctx, checklistId)
+	var err domain.Error
+	if arg := args.Get(1); arg != nil {
+		err = arg.(domain.Error)
+	}
+	return args.Get(0).(domain.ChecklistItemDeletionGroup), err
+}
+
+func (m *mockChecklistItemsService) RestoreDeletionGroup(ctx context.Context, checklistId uint, groupId uint) (domain.ChecklistItemBatchResult, domain.Error) {
+	args := m.Called(ctx, checklistId, groupId)
+	var err domain.Error
+	if arg := args.Get(1); arg != nil {
+		err = arg.(domain.Error)
+	}
+	return args.Get(0).(domain.ChecklistItemBatchResult), err
+}
+
+func (m *mockChecklistItemsService) ResetChecklistItems(ctx context.Context, checklistId uint) (domain.ChecklistItemBatchResult, domain.Error) {
+	args := m.Called(ctx, checklistId)
+	var err domain.Error
+	if arg := args.Get(1); arg != nil {
+		err = arg.(domain.Error)
+	}
+	return args.Get(0).(domain.ChecklistItemBatchResult), err
+}
+
+func (m *mockChecklistItemsService) SortChecklistItems(ctx context.Context, request domain.ChecklistItemSortRequest) ([]domain.ChecklistItem, domain.Error) {
+	args := m.Called(ctx, request)
+	var err domain.Error
+	if arg := args.Get(1); arg != nil {
+		err = arg.(domain.Error)
+	}
+	return args.Get(0).([]domain.ChecklistItem), err
+}
+
 func (m *mockChecklistItemsService) DeleteChecklistItemRow(ctx context.Context, checklistId uint, itemId uint, rowId uint) domain.Error {
 	args := m.Called(ctx, checklistId, itemId, rowId)
 	if arg := args.Get(0); arg != nil {
@@ -436,3 +574,460 @@ func TestChecklistService_DeleteChecklistById_NilItemsSlice(t *testing.T) {
 	itemService.AssertExpectations(t)
 	repo.AssertExpectations(t)
 }
+
+// mockWorkspaceActivityService uses testify's mock for IWorkspaceActivityService.
+type mockWorkspaceActivityService struct {
+	mock.Mock
+}
+
+func (m *mockWorkspaceActivityService) RecordActivity(ctx context.Context, activity domain.WorkspaceActivity) {
+	m.Called(ctx, activity)
+}
+
+func (m *mockWorkspaceActivityService) FindWorkspaceActivity(ctx context.Context, workspaceId uint, filter domain.WorkspaceActivityFilter, before *uint, limit *int) (domain.WorkspaceActivityPage, domain.Error) {
+	args := m.Called(ctx, workspaceId, filter, before, limit)
+	var err domain.Error
+	if arg := args.Get(1); arg != nil {
+		err = arg.(domain.Error)
+	}
+	return args.Get(0).(domain.WorkspaceActivityPage), err
+}
+
+// Test UpdateChecklist - moving a checklist between workspaces is recorded in both feeds
+func TestChecklistService_UpdateChecklist_RecordsWorkspaceMove(t *testing.T) {
+	ctx := context.Background()
+	fromWorkspace, toWorkspace := uint(1), uint(2)
+	checklist := domain.Checklist{Id: 123, Name: "Groceries", WorkspaceId: &toWorkspace}
+
+	repo := new(mockChecklistRepository)
+	ownershipChecker := new(mockChecklistOwnershipChecker)
+	workspaceActivity := new(mockWorkspaceActivityService)
+
+	ownershipChecker.On("CanModifyChecklist", ctx, uint(123)).Return(nil)
+	repo.On("FindChecklistById", ctx, uint(123)).Return(&domain.Checklist{Id: 123, Name: "Groceries", WorkspaceId: &fromWorkspace}, nil)
+	repo.On("UpdateChecklist", ctx, checklist).Return(checklist, nil)
+	workspaceActivity.On("RecordActivity", ctx, mock.AnythingOfType("domain.WorkspaceActivity")).Return()
+
+	svc := &checklistService{
+		repository:                repo,
+		checklistOwnershipChecker: ownershipChecker,
+		workspaceActivityService:  workspaceActivity,
+	}
+
+	if _, err := svc.UpdateChecklist(ctx, checklist); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	workspaceActivity.AssertNumberOfCalls(t, "RecordActivity", 2)
+	movedOut := workspaceActivity.Calls[0].Arguments.Get(1).(domain.WorkspaceActivity)
+	movedIn := workspaceActivity.Calls[1].Arguments.Get(1).(domain.WorkspaceActivity)
+	if movedOut.WorkspaceId != fromWorkspace || movedOut.EventType != domain.WorkspaceChecklistMovedOut {
+		t.Fatalf("expected moved out of workspace %d, got %+v", fromWorkspace, movedOut)
+	}
+	if movedIn.WorkspaceId != toWorkspace || movedIn.EventType != domain.WorkspaceChecklistMovedIn {
+		t.Fatalf("expected moved into workspace %d, got %+v", toWorkspace, movedIn)
+	}
+}
+
+// Test UpdateChecklist - a rename within the same workspace is not a move
+func TestChecklistService_UpdateChecklist_SameWorkspaceNotRecorded(t *testing.T) {
+	ctx := context.Background()
+	workspaceId := uint(1)
+	checklist := domain.Checklist{Id: 123, Name: "Renamed", WorkspaceId: &workspaceId}
+
+	repo := new(mockChecklistRepository)
+	ownershipChecker := new(mockChecklistOwnershipChecker)
+	workspaceActivity := new(mockWorkspaceActivityService)
+
+	ownershipChecker.On("CanModifyChecklist", ctx, uint(123)).Return(nil)
+	repo.On("FindChecklistById", ctx, uint(123)).Return(&domain.Checklist{Id: 123, Name: "Groceries", WorkspaceId: &workspaceId}, nil)
+	repo.On("UpdateChecklist", ctx, checklist).Return(checklist, nil)
+
+	svc := &checklistService{
+		repository:                repo,
+		checklistOwnershipChecker: ownershipChecker,
+		workspaceActivityService:  workspaceActivity,
+	}
+
+	if _, err := svc.UpdateChecklist(ctx, checklist); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	workspaceActivity.AssertNotCalled(t, "RecordActivity", mock.Anything, mock.Anything)
+}
+
+// Test UpdateChecklist - a move tells the users who can access the checklist after the move
+func TestChecklistService_UpdateChecklist_NotifiesMove(t *testing.T) {
+	ctx := context.Background()
+	workspaceId := uint(2)
+	checklist := domain.Checklist{Id: 123, Name: "Renamed", WorkspaceId: &workspaceId}
+
+	repo := new(mockChecklistRepository)
+	ownershipChecker := new(mockChecklistOwnershipChecker)
+	notifier := new(mockNotificationService)
+
+	ownershipChecker.On("CanModifyChecklist", ctx, uint(123)).Return(nil)
+	repo.On("FindChecklistById", ctx, uint(123)).Return(&domain.Checklist{Id: 123, Name: "Groceries"}, nil)
+	repo.On("UpdateChecklist", ctx, checklist).Return(checklist, nil)
+	repo.On("FindUserIdsWithAccess", ctx, uint(123)).Return([]string{"owner", "member"}, nil)
+	notifier.On("NotifyChecklistMoved", ctx, checklist, []string{"owner", "member"}).Return().Once()
+
+	svc := &checklistService{repository: repo, checklistOwnershipChecker: ownershipChecker, notifier: notifier}
+
+	if _, err := svc.UpdateChecklist(ctx, checklist); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	notifier.AssertExpectations(t)
+	notifier.AssertNotCalled(t, "NotifyChecklistUpdated", mock.Anything, mock.Anything)
+}
+
+// Test UpdateChecklist - a rename within the same workspace only notifies the new name
+func TestChecklistService_UpdateChecklist_NotifiesRename(t *testing.T) {
+	ctx := context.Background()
+	checklist := domain.Checklist{Id: 123, Name: "Renamed"}
+
+	repo := new(mockChecklistRepository)
+	ownershipChecker := new(mockChecklistOwnershipChecker)
+	notifier := new(mockNotificationService)
+
+	ownershipChecker.On("CanModifyChecklist", ctx, uint(123)).Return(nil)
+	repo.On("FindChecklistById", ctx, uint(123)).Return(&domain.Checklist{Id: 123, Name: "Groceries"}, nil)
+	repo.On("UpdateChecklist", ctx, checklist).Return(checklist, nil)
+	notifier.On("NotifyChecklistUpdated", ctx, checklist).Return().Once()
+
+	svc := &checklistService{repository: repo, checklistOwnershipChecker: ownershipChecker, notifier: notifier}
+
+	if _, err := svc.UpdateChecklist(ctx, checklist); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	notifier.AssertExpectations(t)
+	repo.AssertNotCalled(t, "FindUserIdsWithAccess", mock.Anything, mock.Anything)
+}
+
+// Test UpdateChecklist - the requested ordering mode is normalized before it reaches the repository
+func TestChecklistService_UpdateChecklist_NormalizesOrderingMode(t *testing.T) {
+	ctx := context.Background()
+	expected := domain.Checklist{Id: 123, Name: "Procedure", OrderingMode: domain.OrderingModeKeepInPlace}
+
+	repo := new(mockChecklistRepository)
+	ownershipChecker := new(mockChecklistOwnershipChecker)
+
+	ownershipChecker.On("CanModifyChecklist", ctx, uint(123)).Return(nil)
+	repo.On("UpdateChecklist", ctx, expected).Return(expected, nil)
+
+	svc := &checklistService{
+		repository:                repo,
+		checklistOwnershipChecker: ownershipChecker,
+	}
+
+	result, err := svc.UpdateChecklist(ctx, domain.Checklist{Id: 123, Name: "Procedure", OrderingMode: "keep_in_place"})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if result.OrderingMode != domain.OrderingModeKeepInPlace {
+		t.Fatalf("expected KEEP_IN_PLACE, got %q", result.OrderingMode)
+	}
+	repo.AssertExpectations(t)
+}
+
+// Test UpdateChecklist - changing the ordering mode publishes the new order of the items
+func TestChecklistService_UpdateChecklist_OrderingModeChangePublishesOrder(t *testing.T) {
+	ctx := context.Background()
+	checklist := domain.Checklist{Id: 123, Name: "Procedure", OrderingMode: domain.OrderingModeKeepInPlace}
+
+	repo := new(mockChecklistRepository)
+	ownershipChecker := new(mockChecklistOwnershipChecker)
+	notifier := new(mockNotificationService)
+	itemsService := new(mockChecklistItemsService)
+
+	ownershipChecker.On("CanModifyChecklist", ctx, uint(123)).Return(nil)
+	repo.On("FindChecklistById", ctx, uint(123)).
+		Return(&domain.Checklist{Id: 123, Name: "Procedure", OrderingMode: domain.OrderingModeSinkCompleted}, nil)
+	repo.On("UpdateChecklist", ctx, checklist).Return(checklist, nil)
+	itemsService.On("FindAllChecklistItems", ctx, uint(123), (*bool)(nil), domain.AscSort).
+		Return([]domain.ChecklistItem{{Id: 2}, {Id: 1}, {Id: 3}}, nil)
+	notifier.On("NotifyItemsSorted", ctx, uint(123), []uint{2, 1, 3}).Return().Once()
+
+	svc := &checklistService{
+		repository:                repo,
+		checklistOwnershipChecker: ownershipChecker,
+		notifier:                  notifier,
+		checklistItemService:      itemsService,
+	}
+
+	if _, err := svc.UpdateChecklist(ctx, checklist); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	notifier.AssertExpectations(t)
+	notifier.AssertNotCalled(t, "NotifyChecklistUpdated", mock.Anything, mock.Anything)
+}
+
+// Test SaveChecklist - an unknown ordering mode is rejected
+func TestChecklistService_SaveChecklist_InvalidOrderingMode(t *testing.T) {
+	ctx := context.Background()
+	repo := new(mockChecklistRepository)
+	svc := &checklistService{repository: repo}
+
+	_, err := svc.SaveChecklist(ctx, domain.Checklist{Name: "Groceries", OrderingMode: "ALPHABETICAL"})
+	if err == nil || err.ResponseCode() != 400 {
+		t.Fatalf("expected 400 error, got: %v", err)
+	}
+	repo.AssertNotCalled(t, "SaveChecklist", mock.Anything, mock.Anything)
+}
+
+// mockWorkspaceOwnershipChecker uses testify's mock for guardrail.IWorkspaceOwnershipChecker.
+type mockWorkspaceOwnershipChecker struct {
+	mock.Mock
+}
+
+func (m *mockWorkspaceOwnershipChecker) IsWorkspaceOwner(ctx context.Context, workspaceId uint) domain.Error {
+	args := m.Called(ctx, workspaceId)
+	if arg := args.Get(0); arg != nil {
+		return arg.(domain.Error)
+	}
+	return nil
+}
+
+func (m *mockWorkspaceOwnershipChecker) IsMember(ctx context.Context, workspaceId uint) domain.Error {
+	args := m.Called(ctx, workspaceId)
+	if arg := args.Get(0); arg != nil {
+		return arg.(domain.Error)
+	}
+	return nil
+}
+
+// Test CloneChecklist - without a name the clone is named after the source checklist
+func TestChecklistService_CloneChecklist_DefaultName(t *testing.T) {
+	ctx := context.Background()
+	options := domain.ChecklistCloneOptions{ResetCompletion: true}
+
+	repo := new(mockChecklistRepository)
+	ownershipChecker := new(mockChecklistOwnershipChecker)
+
+	ownershipChecker.On("HasAccessToChecklist", ctx, uint(123)).Return(nil)
+	repo.On("FindChecklistById", ctx, uint(123)).Return(&domain.Checklist{Id: 123, Name: "Groceries"}, nil)
+	repo.On("CloneChecklist", ctx, uint(123), "Groceries (copy)", options).
+		Return(domain.Checklist{Id: 456, Name: "Groceries (copy)", Owner: "user"}, nil)
+
+	svc := &checklistService{repository: repo, checklistOwnershipChecker: ownershipChecker}
+	result, err := svc.CloneChecklist(ctx, 123, options)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if result.Id != 456 || result.Name != "Groceries (copy)" {
+		t.Fatalf("unexpected clone %+v", result)
+	}
+	repo.AssertExpectations(t)
+}
+
+// Test CloneChecklist - cloning into a workspace requires membership of that workspace
+func TestChecklistService_CloneChecklist_NotWorkspaceMember(t *testing.T) {
+	ctx := context.Background()
+	workspaceId := uint(9)
+	name := "Copy"
+	options := domain.ChecklistCloneOptions{Name: &name, WorkspaceId: &workspaceId}
+
+	repo := new(mockChecklistRepository)
+	ownershipChecker := new(mockChecklistOwnershipChecker)
+	workspaceChecker := new(mockWorkspaceOwnershipChecker)
+
+	ownershipChecker.On("HasAccessToChecklist", ctx, uint(123)).Return(nil)
+	workspaceChecker.On("IsMember", ctx, workspaceId).Return(domain.NewError("workspace not found", 404))
+
+	svc := &checklistService{repository: repo, checklistOwnershipChecker: ownershipChecker, workspaceOwnershipChecker: workspaceChecker}
+	_, err := svc.CloneChecklist(ctx, 123, options)
+	if err == nil || err.ResponseCode() != 404 {
+		t.Fatalf("expected 404, got: %v", err)
+	}
+	repo.AssertNotCalled(t, "CloneChecklist", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+// Test CloneChecklist - only the owner of the source may copy its shares
+func TestChecklistService_CloneChecklist_IncludeSharesRequiresOwner(t *testing.T) {
+	ctx := context.Background()
+	name := "Copy"
+	options := domain.ChecklistCloneOptions{Name: &name, IncludeShares: true}
+
+	repo := new(mockChecklistRepository)
+	ownershipChecker := new(mockChecklistOwnershipChecker)
+
+	ownershipChecker.On("HasAccessToChecklist", ctx, uint(123)).Return(nil)
+	ownershipChecker.On("IsChecklistOwner", ctx, uint(123)).Return(domain.NewError("not the owner", 403))
+
+	svc := &checklistService{repository: repo, checklistOwnershipChecker: ownershipChecker}
+	_, err := svc.CloneChecklist(ctx, 123, options)
+	if err == nil || err.ResponseCode() != 403 {
+		t.Fatalf("expected 403, got: %v", err)
+	}
+	repo.AssertNotCalled(t, "CloneChecklist", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+// Test CloneChecklist - a checklist the user can not access can not be cloned
+func TestChecklistService_CloneChecklist_AccessDenied(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(mockChecklistRepository)
+	ownershipChecker := new(mockChecklistOwnershipChecker)
+
+	ownershipChecker.On("HasAccessToChecklist", ctx, uint(123)).Return(domain.NewError("forbidden", 403))
+
+	svc := &checklistService{repository: repo, checklistOwnershipChecker: ownershipChecker}
+	_, err := svc.CloneChecklist(ctx, 123, domain.ChecklistCloneOptions{})
+	if err == nil || err.ResponseCode() != 404 {
+		t.Fatalf("expected 404, got: %v", err)
+	}
+	repo.AssertNotCalled(t, "CloneChecklist", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+// Test MergeChecklists - the merged items are published to both checklists in one call
+func TestChecklistService_MergeChecklists_NotifiesBothChecklists(t *testing.T) {
+	ctx := context.Background()
+	result := domain.ChecklistMergeResult{
+		TargetChecklistId: 1,
+		SourceChecklistId: 2,
+		Items:             []domain.ChecklistItem{{Id: 10, Name: "Milk"}, {Id: 20, Name: "Bread"}},
+	}
+
+	repo := new(mockChecklistRepository)
+	ownershipChecker := new(mockChecklistOwnershipChecker)
+	notifier := new(mockNotificationService)
+
+	ownershipChecker.On("CanModifyChecklist", ctx, uint(1)).Return(nil)
+	ownershipChecker.On("CanModifyChecklist", ctx, uint(2)).Return(nil)
+	ownershipChecker.On("IsChecklistOwner", ctx, uint(2)).Return(nil)
+	repo.On("MergeChecklists", ctx, uint(1), uint(2)).Return(result, nil)
+	notifier.On("NotifyChecklistsMerged", ctx, result).Return().Once()
+
+	svc := &checklistService{repository: repo, checklistOwnershipChecker: ownershipChecker, notifier: notifier}
+	res, err := svc.MergeChecklists(ctx, 1, 2)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if len(res.Items) != 2 {
+		t.Fatalf("expected 2 merged items, got %d", len(res.Items))
+	}
+	repo.AssertExpectations(t)
+	notifier.AssertExpectations(t)
+}
+
+// Test MergeChecklists - only the owner of the source checklist may merge it away
+func TestChecklistService_MergeChecklists_NotSourceOwner(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(mockChecklistRepository)
+	ownershipChecker := new(mockChecklistOwnershipChecker)
+
+	ownershipChecker.On("CanModifyChecklist", ctx, mock.Anything).Return(nil)
+	ownershipChecker.On("IsChecklistOwner", ctx, uint(2)).Return(domain.NewError("not owner", 403))
+
+	svc := &checklistService{repository: repo, checklistOwnershipChecker: ownershipChecker}
+	_, err := svc.MergeChecklists(ctx, 1, 2)
+	if err == nil || err.ResponseCode() != 403 {
+		t.Fatalf("expected 403, got: %v", err)
+	}
+	repo.AssertNotCalled(t, "MergeChecklists", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// Test MergeChecklists - an archived or view-only target can not receive the items
+func TestChecklistService_MergeChecklists_TargetNotModifiable(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(mockChecklistRepository)
+	ownershipChecker := new(mockChecklistOwnershipChecker)
+
+	ownershipChecker.On("CanModifyChecklist", ctx, uint(1)).Return(domain.NewError("Checklist(id=1) is archived and can no longer be changed", 400))
+
+	svc := &checklistService{repository: repo, checklistOwnershipChecker: ownershipChecker}
+	_, err := svc.MergeChecklists(ctx, 1, 2)
+	if err == nil || err.ResponseCode() != 400 {
+		t.Fatalf("expected 400, got: %v", err)
+	}
+	repo.AssertNotCalled(t, "MergeChecklists", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// Test MergeChecklists - a checklist can not be merged into itself
+func TestChecklistService_MergeChecklists_SameChecklist(t *testing.T) {
+	repo := new(mockChecklistRepository)
+
+	svc := &checklistService{repository: repo}
+	_, err := svc.MergeChecklists(context.Background(), 1, 1)
+	if err == nil || err.ResponseCode() != 400 {
+		t.Fatalf("expected 400, got: %v", err)
+	}
+	repo.AssertNotCalled(t, "MergeChecklists", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// Test SplitChecklist - the new checklist is created by the repository and both checklists are notified
+func TestChecklistService_SplitChecklist(t *testing.T) {
+	ctx := context.Background()
+	request := domain.ChecklistSplitRequest{Name: "Hardware store", ItemIds: []uint{10, 20}}
+	result := domain.ChecklistSplitResult{
+		SourceChecklistId: 1,
+		Checklist:         domain.Checklist{Id: 5, Name: "Hardware store", Owner: "user"},
+		Items:             []domain.ChecklistItem{{Id: 10}, {Id: 20}},
+	}
+
+	repo := new(mockChecklistRepository)
+	ownershipChecker := new(mockChecklistOwnershipChecker)
+	notifier := new(mockNotificationService)
+
+	ownershipChecker.On("CanModifyChecklist", ctx, uint(1)).Return(nil)
+	ownershipChecker.On("IsChecklistOwner", ctx, uint(1)).Return(nil)
+	repo.On("SplitChecklist", ctx, uint(1), request).Return(result, nil)
+	repo.On("FindUserIdsWithAccess", ctx, uint(5)).Return([]string{"user"}, nil)
+	notifier.On("NotifyChecklistSplit", ctx, result).Return().Once()
+	notifier.On("NotifyChecklistCreated", ctx, result.Checklist, []string{"user"}).Return().Once()
+
+	svc := &checklistService{repository: repo, checklistOwnershipChecker: ownershipChecker, notifier: notifier}
+	res, err := svc.SplitChecklist(ctx, 1, request)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if res.Checklist.Id != 5 {
+		t.Fatalf("expected new checklist 5, got %d", res.Checklist.Id)
+	}
+	repo.AssertExpectations(t)
+	notifier.AssertExpectations(t)
+}
+
+// Test SplitChecklist - only the owner of the source checklist may split items off it
+func TestChecklistService_SplitChecklist_NotSourceOwner(t *testing.T) {
+	ctx := context.Background()
+	request := domain.ChecklistSplitRequest{Name: "Hardware store", ItemIds: []uint{10}}
+
+	repo := new(mockChecklistRepository)
+	ownershipChecker := new(mockChecklistOwnershipChecker)
+
+	ownershipChecker.On("CanModifyChecklist", ctx, uint(1)).Return(nil)
+	ownershipChecker.On("IsChecklistOwner", ctx, uint(1)).Return(domain.NewError("not owner", 403))
+
+	svc := &checklistService{repository: repo, checklistOwnershipChecker: ownershipChecker}
+	_, err := svc.SplitChecklist(ctx, 1, request)
+	if err == nil || err.ResponseCode() != 403 {
+		t.Fatalf("expected 403, got: %v", err)
+	}
+	repo.AssertNotCalled(t, "SplitChecklist", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestChecklistService_SplitChecklist_Validation(t *testing.T) {
+	tests := []struct {
+		name    string
+		request domain.ChecklistSplitRequest
+	}{
+		{"empty name", domain.ChecklistSplitRequest{ItemIds: []uint{1}}},
+		{"no items", domain.ChecklistSplitRequest{Name: "New"}},
+		{"duplicate item", domain.ChecklistSplitRequest{Name: "New", ItemIds: []uint{1, 1}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := new(mockChecklistRepository)
+
+			svc := &checklistService{repository: repo}
+			_, err := svc.SplitChecklist(context.Background(), 1, tt.request)
+			if err == nil || err.ResponseCode() != 400 {
+				t.Fatalf("expected 400, got: %v", err)
+			}
+			repo.AssertNotCalled(t, "SplitChecklist", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
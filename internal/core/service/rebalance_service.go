@@ -7,13 +7,21 @@ import (
 	"sync"
 	"time"
 
+	"com.raunlo.checklist/internal/core/domain"
+	"com.raunlo.checklist/internal/core/notification"
 	"com.raunlo.checklist/internal/core/repository"
 )
 
-// IRebalanceService handles async rebalancing of checklist item positions
+// IRebalanceService handles async rebalancing of checklist item positions. Positions never run out of room, a
+// rebalance only compacts keys that grew long. Pending rebalances are stored in the database, so that they
+// survive restarts and any instance can run them.
 type IRebalanceService interface {
-	// TriggerRebalance schedules a rebalance for an entire checklist
-	TriggerRebalance(checklistId uint)
+	// TriggerRebalance schedules a rebalance for an entire checklist; this instance runs it once the triggers
+	// settle unless another instance gets to it first
+	TriggerRebalance(ctx context.Context, checklistId uint)
+	// RunDueRebalances runs the pending rebalances that are due, e.g. the ones of instances that stopped before
+	// running them or whose rebalance failed
+	RunDueRebalances(ctx context.Context)
 }
 
 // pendingRebalance tracks a pending rebalance with both debounce and max-wait timers
@@ -26,21 +34,27 @@ type pendingRebalance struct {
 
 type rebalanceService struct {
 	repository repository.IChecklistItemsRepository
+	notifier   notification.INotificationService
 	pending    sync.Map // key: checklistId -> *pendingRebalance
 	debounceMs time.Duration
 	maxWaitMs  time.Duration
 }
 
 // NewRebalanceService creates a new rebalance service
-func NewRebalanceService(repo repository.IChecklistItemsRepository) IRebalanceService {
+func NewRebalanceService(repo repository.IChecklistItemsRepository, notifier notification.INotificationService) IRebalanceService {
 	return &rebalanceService{
 		repository: repo,
+		notifier:   notifier,
 		debounceMs: 500 * time.Millisecond,
 		maxWaitMs:  5 * time.Second, // Guarantee execution within 5 seconds
 	}
 }
 
-func (s *rebalanceService) TriggerRebalance(checklistId uint) {
+func (s *rebalanceService) TriggerRebalance(ctx context.Context, checklistId uint) {
+	// The request may end before the rebalance is stored
+	if err := s.repository.ScheduleRebalance(context.WithoutCancel(ctx), checklistId, s.debounceMs, s.maxWaitMs); err != nil {
+		log.Printf("rebalance: could not schedule rebalance of checklist %d: %v", checklistId, err)
+	}
 	key := fmt.Sprintf("%d", checklistId)
 
 	// Check if there's already a pending rebalance
@@ -100,19 +114,38 @@ func (s *rebalanceService) executeOnce(key string, checklistId uint) {
 	pending.mu.Unlock()
 
 	// Execute rebalance
-	s.executeRebalance(checklistId)
+	s.executeRebalance(context.Background(), checklistId)
 
 	// Cleanup
 	s.pending.Delete(key)
 }
 
-func (s *rebalanceService) executeRebalance(checklistId uint) {
-	ctx := context.Background()
+func (s *rebalanceService) RunDueRebalances(ctx context.Context) {
+	checklistIds, err := s.repository.FindDueRebalances(ctx)
+	if err != nil {
+		log.Printf("rebalance: could not find due rebalances: %v", err)
+		return
+	}
+	for _, checklistId := range checklistIds {
+		s.executeRebalance(ctx, checklistId)
+	}
+}
+
+// executeRebalance rewrites the positions and sends them to every client of the checklist, including the one
+// whose change triggered the rebalance, since all cached positions are stale afterwards. A failed rebalance
+// stays pending and is retried by RunDueRebalances.
+func (s *rebalanceService) executeRebalance(ctx context.Context, checklistId uint) {
+	ctx = domain.NewSystemContext(ctx)
 
-	err := s.repository.RebalancePositions(ctx, checklistId)
+	positions, rebalanced, err := s.repository.RebalancePositions(ctx, checklistId)
 	if err != nil {
 		log.Printf("rebalance: failed for checklist %d: %v", checklistId, err)
-	} else {
-		log.Printf("rebalance: completed for checklist %d", checklistId)
+		return
+	}
+	if !rebalanced {
+		// already done or running on another instance
+		return
 	}
+	log.Printf("rebalance: completed for checklist %d", checklistId)
+	s.notifier.NotifyPositionsRebalanced(ctx, checklistId, positions)
 }
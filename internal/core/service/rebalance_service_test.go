@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"com.raunlo.checklist/internal/core/domain"
+	"github.com/stretchr/testify/mock"
 )
 
 // rebalanceMockRepo wraps mockChecklistItemsRepository to track rebalance calls
@@ -27,7 +28,11 @@ func newRebalanceMockRepo(delay time.Duration) *rebalanceMockRepo {
 	}
 }
 
-func (r *rebalanceMockRepo) RebalancePositions(ctx context.Context, checklistId uint) domain.Error {
+func (r *rebalanceMockRepo) ScheduleRebalance(ctx context.Context, checklistId uint, debounce time.Duration, maxWait time.Duration) domain.Error {
+	return nil
+}
+
+func (r *rebalanceMockRepo) RebalancePositions(ctx context.Context, checklistId uint) (map[uint]string, bool, domain.Error) {
 	atomic.AddInt32(&r.rebalanceCalls, 1)
 	// Simulate some work
 	if r.callDelay > 0 {
@@ -38,7 +43,7 @@ func (r *rebalanceMockRepo) RebalancePositions(ctx context.Context, checklistId
 	r.callHistory = append(r.callHistory, checklistId)
 	r.mu.Unlock()
 
-	return nil
+	return map[uint]string{1: "a0"}, true, nil
 }
 
 func (r *rebalanceMockRepo) GetRebalanceCalls() int32 {
@@ -51,11 +56,61 @@ func (r *rebalanceMockRepo) GetCallHistory() []uint {
 	return append([]uint{}, r.callHistory...)
 }
 
+// newRebalanceNotifier accepts the rebalance notifications of the timing tests
+func newRebalanceNotifier() *mockNotificationService {
+	notifier := new(mockNotificationService)
+	notifier.On("NotifyPositionsRebalanced", mock.Anything, mock.Anything, mock.Anything).Return()
+	return notifier
+}
+
+func TestRebalanceService_PublishesPositionsToAllClients(t *testing.T) {
+	repo := newRebalanceMockRepo(0)
+	notifier := new(mockNotificationService)
+	systemContext := mock.MatchedBy(func(ctx context.Context) bool {
+		clientId, ok := ctx.Value(domain.ClientIdContextKey).(string)
+		return ok && clientId == ""
+	})
+	notifier.On("NotifyPositionsRebalanced", systemContext, uint(7), map[uint]string{1: "a0"}).Return().Once()
+
+	NewRebalanceService(repo, notifier).(*rebalanceService).executeRebalance(context.Background(), 7)
+
+	notifier.AssertExpectations(t)
+}
+
+func TestRebalanceService_TriggerRebalance_StoresPendingRebalance(t *testing.T) {
+	repo := new(mockChecklistItemsRepository)
+	repo.On("ScheduleRebalance", mock.Anything, uint(7), 500*time.Millisecond, 5*time.Second).Return(nil).Once()
+	// another instance ran the rebalance before the timer of this one fired
+	repo.On("RebalancePositions", mock.Anything, uint(7)).Return(nil, false, nil).Once()
+	notifier := new(mockNotificationService)
+
+	service := NewRebalanceService(repo, notifier)
+	service.TriggerRebalance(context.Background(), 7)
+	time.Sleep(600 * time.Millisecond)
+
+	repo.AssertExpectations(t)
+	notifier.AssertNotCalled(t, "NotifyPositionsRebalanced", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestRebalanceService_RunDueRebalances_RetriesPendingRebalances(t *testing.T) {
+	repo := new(mockChecklistItemsRepository)
+	repo.On("FindDueRebalances", mock.Anything).Return([]uint{3, 4}, nil).Once()
+	repo.On("RebalancePositions", mock.Anything, uint(3)).Return(nil, false, domain.NewError("serialization failure", 500)).Once()
+	repo.On("RebalancePositions", mock.Anything, uint(4)).Return(map[uint]string{9: "a0"}, true, nil).Once()
+	notifier := new(mockNotificationService)
+	notifier.On("NotifyPositionsRebalanced", mock.Anything, uint(4), map[uint]string{9: "a0"}).Return().Once()
+
+	NewRebalanceService(repo, notifier).RunDueRebalances(context.Background())
+
+	repo.AssertExpectations(t)
+	notifier.AssertExpectations(t)
+}
+
 func TestRebalanceService_SingleTrigger(t *testing.T) {
 	repo := newRebalanceMockRepo(0)
-	service := NewRebalanceService(repo)
+	service := NewRebalanceService(repo, newRebalanceNotifier())
 
-	service.TriggerRebalance(1)
+	service.TriggerRebalance(context.Background(), 1)
 
 	// Wait for debounce to fire
 	time.Sleep(600 * time.Millisecond)
@@ -68,11 +123,11 @@ func TestRebalanceService_SingleTrigger(t *testing.T) {
 
 func TestRebalanceService_DebounceMultipleTriggers(t *testing.T) {
 	repo := newRebalanceMockRepo(0)
-	service := NewRebalanceService(repo)
+	service := NewRebalanceService(repo, newRebalanceNotifier())
 
 	// Trigger 10 times rapidly (within debounce window)
 	for range 10 {
-		service.TriggerRebalance(1)
+		service.TriggerRebalance(context.Background(), 1)
 		time.Sleep(100 * time.Millisecond)
 	}
 
@@ -87,13 +142,13 @@ func TestRebalanceService_DebounceMultipleTriggers(t *testing.T) {
 
 func TestRebalanceService_MaxWaitTimer(t *testing.T) {
 	repo := newRebalanceMockRepo(0)
-	service := NewRebalanceService(repo)
+	service := NewRebalanceService(repo, newRebalanceNotifier())
 
 	// Trigger every 400ms for 6 seconds (should hit maxWait at 5s)
 	done := make(chan bool)
 	go func() {
 		for range 15 {
-			service.TriggerRebalance(1)
+			service.TriggerRebalance(context.Background(), 1)
 			time.Sleep(400 * time.Millisecond)
 		}
 		done <- true
@@ -112,7 +167,7 @@ func TestRebalanceService_MaxWaitTimer(t *testing.T) {
 
 func TestRebalanceService_ConcurrentTriggersHighLoad(t *testing.T) {
 	repo := newRebalanceMockRepo(10 * time.Millisecond)
-	service := NewRebalanceService(repo)
+	service := NewRebalanceService(repo, newRebalanceNotifier())
 
 	var wg sync.WaitGroup
 	numGoroutines := 100
@@ -124,7 +179,7 @@ func TestRebalanceService_ConcurrentTriggersHighLoad(t *testing.T) {
 		go func(goroutineID int) {
 			defer wg.Done()
 			for range triggersPerGoroutine {
-				service.TriggerRebalance(1)
+				service.TriggerRebalance(context.Background(), 1)
 				time.Sleep(1 * time.Millisecond)
 			}
 		}(i)
@@ -150,12 +205,12 @@ func TestRebalanceService_ConcurrentTriggersHighLoad(t *testing.T) {
 
 func TestRebalanceService_MultipleChecklists(t *testing.T) {
 	repo := newRebalanceMockRepo(0)
-	service := NewRebalanceService(repo)
+	service := NewRebalanceService(repo, newRebalanceNotifier())
 
 	// Trigger 3 different checklists
-	service.TriggerRebalance(1)
-	service.TriggerRebalance(2)
-	service.TriggerRebalance(3)
+	service.TriggerRebalance(context.Background(), 1)
+	service.TriggerRebalance(context.Background(), 2)
+	service.TriggerRebalance(context.Background(), 3)
 
 	// Wait for debounces to fire
 	time.Sleep(600 * time.Millisecond)
@@ -173,16 +228,16 @@ func TestRebalanceService_MultipleChecklists(t *testing.T) {
 
 func TestRebalanceService_NoDoubleExecution(t *testing.T) {
 	repo := newRebalanceMockRepo(50 * time.Millisecond)
-	service := NewRebalanceService(repo)
+	service := NewRebalanceService(repo, newRebalanceNotifier())
 
 	// Trigger once
-	service.TriggerRebalance(1)
+	service.TriggerRebalance(context.Background(), 1)
 
 	// Wait for debounce
 	time.Sleep(600 * time.Millisecond)
 
 	// Trigger again immediately after first execution
-	service.TriggerRebalance(1)
+	service.TriggerRebalance(context.Background(), 1)
 
 	// Wait for second debounce
 	time.Sleep(600 * time.Millisecond)
@@ -197,14 +252,14 @@ func TestRebalanceService_RaceCondition(t *testing.T) {
 	// This test tries to trigger the race condition where executeOnce
 	// and TriggerRebalance happen at the same time
 	repo := newRebalanceMockRepo(100 * time.Millisecond)
-	service := NewRebalanceService(repo)
+	service := NewRebalanceService(repo, newRebalanceNotifier())
 
 	var wg sync.WaitGroup
 
 	// Goroutine 1: Trigger continuously
 	wg.Go(func() {
 		for range 50 {
-			service.TriggerRebalance(1)
+			service.TriggerRebalance(context.Background(), 1)
 			time.Sleep(20 * time.Millisecond)
 		}
 	})
@@ -212,7 +267,7 @@ func TestRebalanceService_RaceCondition(t *testing.T) {
 	// Goroutine 2: Also trigger continuously (creates contention)
 	wg.Go(func() {
 		for range 50 {
-			service.TriggerRebalance(1)
+			service.TriggerRebalance(context.Background(), 1)
 			time.Sleep(25 * time.Millisecond)
 		}
 	})
@@ -241,7 +296,7 @@ func TestRebalanceService_StressTest(t *testing.T) {
 	}
 
 	repo := newRebalanceMockRepo(5 * time.Millisecond)
-	service := NewRebalanceService(repo)
+	service := NewRebalanceService(repo, newRebalanceNotifier())
 
 	var wg sync.WaitGroup
 	numGoroutines := 500
@@ -257,7 +312,7 @@ func TestRebalanceService_StressTest(t *testing.T) {
 			defer wg.Done()
 			checklistID := uint(id%10 + 1) // Use 10 different checklists
 			for range triggersPerGoroutine {
-				service.TriggerRebalance(checklistID)
+				service.TriggerRebalance(context.Background(), checklistID)
 				time.Sleep(time.Duration(id%5) * time.Millisecond)
 			}
 		}(i)
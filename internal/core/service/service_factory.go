@@ -20,11 +20,19 @@ func NewServiceFactory() *ServiceFactory {
 
 func CreateChecklistService(checklistRepository repository.IChecklistRepository,
 	checklistOwnershipChecker guardrail.IChecklistOwnershipChecker,
-	checklistItemService IChecklistItemsService) IChecklistService {
+	workspaceOwnershipChecker guardrail.IWorkspaceOwnershipChecker,
+	checklistItemService IChecklistItemsService,
+	notificationService notification.INotificationService,
+	activityService IChecklistActivityService,
+	workspaceActivityService IWorkspaceActivityService) IChecklistService {
 	return &checklistService{
 		repository:                checklistRepository,
 		checklistOwnershipChecker: checklistOwnershipChecker,
+		workspaceOwnershipChecker: workspaceOwnershipChecker,
 		checklistItemService:      checklistItemService,
+		notifier:                  notificationService,
+		activityService:           activityService,
+		workspaceActivityService:  workspaceActivityService,
 	}
 }
 
@@ -32,12 +40,36 @@ func CreateChecklistItemService(repository repository.IChecklistItemsRepository,
 	notificationService notification.INotificationService,
 	checklistOwnershipChecker guardrail.IChecklistOwnershipChecker,
 	rebalanceService IRebalanceService,
+	activityService IChecklistActivityService,
 ) IChecklistItemsService {
 	return &checklistItemsService{
 		repository:                repository,
 		notifier:                  notificationService,
 		checklistOwnershipChecker: checklistOwnershipChecker,
 		rebalanceService:          rebalanceService,
+		activityService:           activityService,
+	}
+}
+
+func CreateChecklistSectionService(repository repository.IChecklistSectionRepository,
+	notificationService notification.INotificationService,
+	checklistOwnershipChecker guardrail.IChecklistOwnershipChecker,
+) IChecklistSectionService {
+	return &checklistSectionService{
+		repository:                repository,
+		notifier:                  notificationService,
+		checklistOwnershipChecker: checklistOwnershipChecker,
+	}
+}
+
+func CreateChecklistItemCommentService(repository repository.IChecklistItemCommentRepository,
+	notificationService notification.INotificationService,
+	checklistOwnershipChecker guardrail.IChecklistOwnershipChecker,
+) IChecklistItemCommentService {
+	return &checklistItemCommentService{
+		repository:                repository,
+		notifier:                  notificationService,
+		checklistOwnershipChecker: checklistOwnershipChecker,
 	}
 }
 
@@ -45,13 +77,35 @@ func CreateChecklistInviteService(
 	inviteRepo repository.IChecklistInviteRepository,
 	checklistRepo repository.IChecklistRepository,
 	ownershipChecker guardrail.IChecklistOwnershipChecker,
+	activityService IChecklistActivityService,
+	notificationService notification.INotificationService,
 ) IChecklistInviteService {
-	return newChecklistInviteService(inviteRepo, checklistRepo, ownershipChecker)
+	return newChecklistInviteService(inviteRepo, checklistRepo, ownershipChecker, activityService, notificationService)
+}
+
+func CreateChecklistActivityService(
+	activityRepository repository.IChecklistActivityRepository,
+	checklistOwnershipChecker guardrail.IChecklistOwnershipChecker,
+) IChecklistActivityService {
+	return &checklistActivityService{
+		repository:                activityRepository,
+		checklistOwnershipChecker: checklistOwnershipChecker,
+	}
+}
+
+func CreateChecklistHistoryService(
+	historyRepository repository.IChecklistHistoryRepository,
+	checklistOwnershipChecker guardrail.IChecklistOwnershipChecker,
+) IChecklistHistoryService {
+	return &checklistHistoryService{
+		repository:                historyRepository,
+		checklistOwnershipChecker: checklistOwnershipChecker,
+	}
 }
 
 // CreateRebalanceService factory function for dependency injection
-func CreateRebalanceService(repo repository.IChecklistItemsRepository) IRebalanceService {
-	return NewRebalanceService(repo)
+func CreateRebalanceService(repo repository.IChecklistItemsRepository, notifier notification.INotificationService) IRebalanceService {
+	return NewRebalanceService(repo, notifier)
 }
 
 func CreateTemplateInviteService(
@@ -61,3 +115,13 @@ func CreateTemplateInviteService(
 ) ITemplateInviteService {
 	return NewTemplateInviteService(inviteRepo, templateRepo, ownershipChecker)
 }
+
+func CreateChecklistRunService(
+	runRepository repository.IChecklistRunRepository,
+	checklistOwnershipChecker guardrail.IChecklistOwnershipChecker,
+) IChecklistRunService {
+	return &checklistRunService{
+		repository:                runRepository,
+		checklistOwnershipChecker: checklistOwnershipChecker,
+	}
+}
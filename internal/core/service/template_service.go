@@ -28,6 +28,7 @@ type templateService struct {
 	checklistItemService      IChecklistItemsService
 	checklistOwnershipChecker guardrail.IChecklistOwnershipChecker
 	workspaceOwnershipChecker guardrail.IWorkspaceOwnershipChecker
+	workspaceActivityService  IWorkspaceActivityService
 }
 
 func (service *templateService) SaveTemplate(ctx context.Context, template domain.Template) (domain.Template, domain.Error) {
@@ -36,6 +37,10 @@ func (service *templateService) SaveTemplate(ctx context.Context, template domai
 		return domain.Template{}, err
 	}
 
+	if err := validateNotes("Template", template.Notes); err != nil {
+		return domain.Template{}, err
+	}
+
 	template.UserId = userId
 	return service.templateRepository.SaveTemplate(ctx, template)
 }
@@ -54,6 +59,9 @@ func (service *templateService) FindAllTemplates(ctx context.Context) ([]domain.
 }
 
 func (service *templateService) UpdateTemplate(ctx context.Context, template domain.Template) (domain.Template, domain.Error) {
+	if err := validateNotes("Template", template.Notes); err != nil {
+		return domain.Template{}, err
+	}
 	return service.templateRepository.UpdateTemplate(ctx, template)
 }
 
@@ -76,18 +84,16 @@ func (service *templateService) CreateTemplateFromItem(ctx context.Context, chec
 		return domain.Template{}, domain.NewError("Checklist item not found", 404)
 	}
 
-	// Build template rows from the item's rows
+	// Build template rows from the item's rows, in the same order
 	rows := make([]domain.TemplateRow, len(item.Rows))
 	for i, row := range item.Rows {
-		rows[i] = domain.TemplateRow{
-			Name:     row.Name,
-			Position: float64(i+1) * domain.DefaultGapSize,
-		}
+		rows[i] = domain.TemplateRow{Name: row.Name}
 	}
 
 	template := domain.Template{
 		Name:        name,
 		Description: description,
+		Notes:       item.Notes,
 		Rows:        rows,
 	}
 
@@ -114,6 +120,7 @@ func (service *templateService) ApplyTemplateToChecklist(ctx context.Context, ch
 	newItem := domain.ChecklistItem{
 		Name:      template.Name,
 		Completed: false,
+		Notes:     template.Notes,
 	}
 
 	savedItem, domainErr := service.checklistItemService.SaveChecklistItem(ctx, checklistId, newItem)
@@ -167,14 +174,38 @@ func (service *templateService) AssignTemplateToWorkspace(ctx context.Context, t
 	if err := service.workspaceOwnershipChecker.IsMember(ctx, workspaceId); err != nil {
 		return err
 	}
-	return service.templateRepository.AssignTemplateToWorkspace(ctx, templateId, workspaceId)
+	if err := service.templateRepository.AssignTemplateToWorkspace(ctx, templateId, workspaceId); err != nil {
+		return err
+	}
+	service.recordWorkspaceActivity(ctx, workspaceId, templateId, domain.WorkspaceTemplateAssigned)
+	return nil
 }
 
 func (service *templateService) UnassignTemplateFromWorkspace(ctx context.Context, templateId uint, workspaceId uint) domain.Error {
 	if err := service.templateOwnershipChecker.IsTemplateOwner(ctx, templateId); err != nil {
 		return coreError.NewTemplateNotFoundError(templateId)
 	}
-	return service.templateRepository.UnassignTemplateFromWorkspace(ctx, templateId, workspaceId)
+	if err := service.templateRepository.UnassignTemplateFromWorkspace(ctx, templateId, workspaceId); err != nil {
+		return err
+	}
+	service.recordWorkspaceActivity(ctx, workspaceId, templateId, domain.WorkspaceTemplateUnassigned)
+	return nil
+}
+
+func (service *templateService) recordWorkspaceActivity(ctx context.Context, workspaceId uint, templateId uint, eventType domain.WorkspaceActivityEventType) {
+	if service.workspaceActivityService == nil {
+		return
+	}
+	var summary *string
+	if template, _ := service.templateRepository.FindTemplateById(ctx, templateId); template != nil {
+		summary = &template.Name
+	}
+	service.workspaceActivityService.RecordActivity(ctx, domain.WorkspaceActivity{
+		WorkspaceId: workspaceId,
+		EventType:   eventType,
+		TemplateId:  &templateId,
+		Summary:     summary,
+	})
 }
 
 func CreateTemplateService(
@@ -183,6 +214,7 @@ func CreateTemplateService(
 	checklistItemService IChecklistItemsService,
 	checklistOwnershipChecker guardrail.IChecklistOwnershipChecker,
 	workspaceOwnershipChecker guardrail.IWorkspaceOwnershipChecker,
+	workspaceActivityService IWorkspaceActivityService,
 ) ITemplateService {
 	return &templateService{
 		templateRepository:        templateRepository,
@@ -190,5 +222,6 @@ func CreateTemplateService(
 		checklistItemService:      checklistItemService,
 		checklistOwnershipChecker: checklistOwnershipChecker,
 		workspaceOwnershipChecker: workspaceOwnershipChecker,
+		workspaceActivityService:  workspaceActivityService,
 	}
 }
@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+	"log"
+
+	"com.raunlo.checklist/internal/core/domain"
+	guardrail "com.raunlo.checklist/internal/core/guard_rail"
+	"com.raunlo.checklist/internal/core/repository"
+)
+
+type IWorkspaceActivityService interface {
+	// RecordActivity appends an entry to the workspace activity feed on behalf of the current user.
+	// Failures are logged and never fail the mutation that produced the entry.
+	RecordActivity(ctx context.Context, activity domain.WorkspaceActivity)
+	FindWorkspaceActivity(ctx context.Context, workspaceId uint, filter domain.WorkspaceActivityFilter, before *uint, limit *int) (domain.WorkspaceActivityPage, domain.Error)
+}
+
+type workspaceActivityService struct {
+	repository       repository.IWorkspaceActivityRepository
+	ownershipChecker guardrail.IWorkspaceOwnershipChecker
+}
+
+func (s *workspaceActivityService) RecordActivity(ctx context.Context, activity domain.WorkspaceActivity) {
+	if activity.Actor == "" {
+		userId, err := domain.GetUserIdFromContext(ctx)
+		if err != nil {
+			log.Printf("Workspace activity not recorded: workspaceId=%d, eventType=%s, no user in context", activity.WorkspaceId, activity.EventType)
+			return
+		}
+		activity.Actor = userId
+	}
+
+	if err := s.repository.AppendActivity(ctx, activity); err != nil {
+		log.Printf("Workspace activity not recorded: workspaceId=%d, eventType=%s: %v", activity.WorkspaceId, activity.EventType, err)
+	}
+}
+
+func (s *workspaceActivityService) FindWorkspaceActivity(ctx context.Context, workspaceId uint, filter domain.WorkspaceActivityFilter, before *uint, limit *int) (domain.WorkspaceActivityPage, domain.Error) {
+	if err := s.ownershipChecker.IsMember(ctx, workspaceId); err != nil {
+		return domain.WorkspaceActivityPage{}, err
+	}
+	return s.repository.FindActivityPage(ctx, workspaceId, filter, before, domain.NormalizeActivityPageSize(limit))
+}
+
+func CreateWorkspaceActivityService(
+	activityRepository repository.IWorkspaceActivityRepository,
+	ownershipChecker guardrail.IWorkspaceOwnershipChecker,
+) IWorkspaceActivityService {
+	return &workspaceActivityService{
+		repository:       activityRepository,
+		ownershipChecker: ownershipChecker,
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"com.raunlo.checklist/internal/core/domain"
 	domainError "com.raunlo.checklist/internal/core/error"
 	guardrail "com.raunlo.checklist/internal/core/guard_rail"
+	"com.raunlo.checklist/internal/core/notification"
 	"com.raunlo.checklist/internal/core/repository"
 	"com.raunlo.checklist/internal/util"
 )
@@ -23,6 +24,8 @@ type workspaceInviteService struct {
 	inviteRepository    repository.IWorkspaceInviteRepository
 	workspaceRepository repository.IWorkspaceRepository
 	ownershipChecker    guardrail.IWorkspaceOwnershipChecker
+	activityService     IWorkspaceActivityService
+	notifier            notification.INotificationService
 }
 
 func (s *workspaceInviteService) CreateInvite(ctx context.Context, workspaceId uint, name *string, expiresInHours *int, isSingleUse bool) (domain.WorkspaceInvite, domain.Error) {
@@ -77,6 +80,12 @@ func (s *workspaceInviteService) CreateInvite(ctx context.Context, workspaceId u
 	}
 
 	log.Printf("Workspace invite created: workspaceId=%d, token=%s..., createdBy=%s", workspaceId, token[:8], domain.GetHashedUserIdFromContext(ctx))
+	s.recordActivity(ctx, domain.WorkspaceActivity{
+		WorkspaceId: workspaceId,
+		EventType:   domain.WorkspaceInviteCreated,
+		InviteId:    &created.Id,
+		Summary:     name,
+	})
 	return created, nil
 }
 
@@ -148,17 +157,37 @@ func (s *workspaceInviteService) ClaimInvite(ctx context.Context, token string)
 	}
 
 	log.Printf("Workspace invite claimed: token=%s..., workspaceId=%d, claimedBy=%s", token[:8], invite.WorkspaceId, domain.GetHashedUserIdFromContext(ctx))
+	s.recordActivity(ctx, domain.WorkspaceActivity{
+		WorkspaceId:   invite.WorkspaceId,
+		EventType:     domain.WorkspaceInviteClaimed,
+		SubjectUserId: &userId,
+		InviteId:      &invite.Id,
+		Summary:       invite.Name,
+	})
+	if s.notifier != nil {
+		s.notifier.NotifyAccessChanged(ctx, []string{userId})
+	}
 	return invite.WorkspaceId, nil
 }
 
+func (s *workspaceInviteService) recordActivity(ctx context.Context, activity domain.WorkspaceActivity) {
+	if s.activityService != nil {
+		s.activityService.RecordActivity(ctx, activity)
+	}
+}
+
 func CreateWorkspaceInviteService(
 	inviteRepository repository.IWorkspaceInviteRepository,
 	workspaceRepository repository.IWorkspaceRepository,
 	ownershipChecker guardrail.IWorkspaceOwnershipChecker,
+	activityService IWorkspaceActivityService,
+	notificationService notification.INotificationService,
 ) IWorkspaceInviteService {
 	return &workspaceInviteService{
 		inviteRepository:    inviteRepository,
 		workspaceRepository: workspaceRepository,
 		ownershipChecker:    ownershipChecker,
+		activityService:     activityService,
+		notifier:            notificationService,
 	}
 }
@@ -6,6 +6,7 @@ import (
 	"com.raunlo.checklist/internal/core/domain"
 	domainErr "com.raunlo.checklist/internal/core/error"
 	guardrail "com.raunlo.checklist/internal/core/guard_rail"
+	"com.raunlo.checklist/internal/core/notification"
 	"com.raunlo.checklist/internal/core/repository"
 )
 
@@ -29,6 +30,8 @@ type workspaceService struct {
 	checklistRepository repository.IChecklistRepository
 	templateRepository  repository.ITemplateRepository
 	ownershipChecker    guardrail.IWorkspaceOwnershipChecker
+	activityService     IWorkspaceActivityService
+	notifier            notification.INotificationService
 }
 
 func (s *workspaceService) CreateWorkspace(ctx context.Context, workspace domain.Workspace) (domain.Workspace, domain.Error) {
@@ -47,6 +50,11 @@ func (s *workspaceService) CreateWorkspace(ctx context.Context, workspace domain
 	if addErr := s.workspaceRepository.AddMember(ctx, created.Id, userId); addErr != nil {
 		return domain.Workspace{}, addErr
 	}
+	s.recordActivity(ctx, domain.WorkspaceActivity{
+		WorkspaceId:   created.Id,
+		EventType:     domain.WorkspaceMemberAdded,
+		SubjectUserId: &userId,
+	})
 
 	created.IsOwner = true
 	created.MemberCount = 1
@@ -86,7 +94,21 @@ func (s *workspaceService) DeleteWorkspace(ctx context.Context, id uint) domain.
 	if ws != nil && ws.IsDefault {
 		return domain.NewError("Cannot delete the default personal workspace", 400)
 	}
-	return s.workspaceRepository.DeleteWorkspace(ctx, id)
+
+	// Members lose access to the workspace checklists, resolve them before the membership rows are gone
+	var memberUserIds []string
+	if s.notifier != nil {
+		members, _ := s.workspaceRepository.GetWorkspaceMembers(ctx, id)
+		for _, member := range members {
+			memberUserIds = append(memberUserIds, member.UserId)
+		}
+	}
+
+	if err := s.workspaceRepository.DeleteWorkspace(ctx, id); err != nil {
+		return err
+	}
+	s.notifyAccessChanged(ctx, memberUserIds...)
+	return nil
 }
 
 func (s *workspaceService) GetMembers(ctx context.Context, workspaceId uint) ([]domain.WorkspaceMember, domain.Error) {
@@ -100,7 +122,31 @@ func (s *workspaceService) RemoveMember(ctx context.Context, workspaceId uint, m
 	if err := s.ownershipChecker.IsWorkspaceOwner(ctx, workspaceId); err != nil {
 		return err
 	}
-	return s.workspaceRepository.RemoveMember(ctx, workspaceId, memberId)
+
+	// Resolve the removed user before the membership row is gone
+	var removedUserId *string
+	if s.activityService != nil || s.notifier != nil {
+		members, _ := s.workspaceRepository.GetWorkspaceMembers(ctx, workspaceId)
+		for _, member := range members {
+			if member.MemberId == memberId {
+				removedUserId = &member.UserId
+				break
+			}
+		}
+	}
+
+	if err := s.workspaceRepository.RemoveMember(ctx, workspaceId, memberId); err != nil {
+		return err
+	}
+	s.recordActivity(ctx, domain.WorkspaceActivity{
+		WorkspaceId:   workspaceId,
+		EventType:     domain.WorkspaceMemberRemoved,
+		SubjectUserId: removedUserId,
+	})
+	if removedUserId != nil {
+		s.notifyAccessChanged(ctx, *removedUserId)
+	}
+	return nil
 }
 
 func (s *workspaceService) LeaveWorkspace(ctx context.Context, workspaceId uint) domain.Error {
@@ -121,7 +167,16 @@ func (s *workspaceService) LeaveWorkspace(ctx context.Context, workspaceId uint)
 		return domain.NewError("Workspace owners cannot leave. Delete the workspace instead.", 400)
 	}
 
-	return s.workspaceRepository.RemoveSelf(ctx, workspaceId, userId)
+	if err := s.workspaceRepository.RemoveSelf(ctx, workspaceId, userId); err != nil {
+		return err
+	}
+	s.recordActivity(ctx, domain.WorkspaceActivity{
+		WorkspaceId:   workspaceId,
+		EventType:     domain.WorkspaceMemberLeft,
+		SubjectUserId: &userId,
+	})
+	s.notifyAccessChanged(ctx, userId)
+	return nil
 }
 
 func (s *workspaceService) GetWorkspaceTemplates(ctx context.Context, workspaceId uint) ([]domain.Template, domain.Error) {
@@ -150,16 +205,33 @@ func (s *workspaceService) FindDefaultWorkspace(ctx context.Context, userId stri
 	return s.workspaceRepository.FindDefaultWorkspace(ctx, userId)
 }
 
+func (s *workspaceService) recordActivity(ctx context.Context, activity domain.WorkspaceActivity) {
+	if s.activityService != nil {
+		s.activityService.RecordActivity(ctx, activity)
+	}
+}
+
+// notifyAccessChanged updates the user streams of users who gained or lost the workspace checklists
+func (s *workspaceService) notifyAccessChanged(ctx context.Context, userIds ...string) {
+	if s.notifier != nil {
+		s.notifier.NotifyAccessChanged(ctx, userIds)
+	}
+}
+
 func CreateWorkspaceService(
 	workspaceRepository repository.IWorkspaceRepository,
 	checklistRepository repository.IChecklistRepository,
 	templateRepository repository.ITemplateRepository,
 	ownershipChecker guardrail.IWorkspaceOwnershipChecker,
+	activityService IWorkspaceActivityService,
+	notificationService notification.INotificationService,
 ) IWorkspaceService {
 	return &workspaceService{
 		workspaceRepository: workspaceRepository,
 		checklistRepository: checklistRepository,
 		templateRepository:  templateRepository,
 		ownershipChecker:    ownershipChecker,
+		activityService:     activityService,
+		notifier:            notificationService,
 	}
 }
@@ -1,7 +1,10 @@
 package deployment
 
 import (
+	"expvar"
 	"fmt"
+	"log"
+	"net/http"
 
 	"com.raunlo.checklist/internal/job"
 	"com.raunlo.checklist/internal/server"
@@ -13,14 +16,17 @@ type Application struct {
 	router     *gin.Engine
 	config     ServerConfiguration
 	cleanupJob *job.CleanupJob
+	sweeper    *job.RebalanceSweeper
 }
 
-func CreateApplication(routes server.IRoutes, router *gin.Engine, configuration ServerConfiguration, cleanupJob *job.CleanupJob) Application {
+func CreateApplication(routes server.IRoutes, router *gin.Engine, configuration ServerConfiguration, cleanupJob *job.CleanupJob,
+	sweeper *job.RebalanceSweeper) Application {
 	return Application{
 		routes:     routes,
 		router:     router,
 		config:     configuration,
 		cleanupJob: cleanupJob,
+		sweeper:    sweeper,
 	}
 }
 
@@ -31,7 +37,24 @@ func (application Application) StartApplication() error {
 	if application.cleanupJob != nil {
 		application.cleanupJob.Start()
 	}
+	if application.sweeper != nil {
+		application.sweeper.Start()
+	}
+
+	if application.config.MetricsPort != "" {
+		go application.serveMetrics()
+	}
 
 	err := application.router.Run(fmt.Sprintf(":%s", application.config.Port))
 	return err
 }
+
+// serveMetrics serves the expvar metrics, such as the "sse" event queue counters, on the internal metrics port,
+// apart from the public router
+func (application Application) serveMetrics() {
+	mux := http.NewServeMux()
+	mux.Handle("/debug/vars", expvar.Handler())
+	if err := http.ListenAndServe(fmt.Sprintf(":%s", application.config.MetricsPort), mux); err != nil {
+		log.Printf("Metrics server stopped: %v", err)
+	}
+}
@@ -1,6 +1,10 @@
 package deployment
 
-import "github.com/raunlo/pgx-with-automapper/pool"
+import (
+	"time"
+
+	"github.com/raunlo/pgx-with-automapper/pool"
+)
 
 type ApplicationConfiguration struct {
 	ServerConfiguration        `yaml:"serverConfiguration"`
@@ -8,6 +12,8 @@ type ApplicationConfiguration struct {
 	CorsConfiguration          `yaml:"corsConfiguration"`
 	GoogleSSOConfiguration     `yaml:"googleSSOConfiguration"`
 	SessionAuthConfiguration   `yaml:"sessionAuthConfiguration"`
+	CleanupConfiguration       `yaml:"cleanupConfiguration"`
+	SSEConfiguration           `yaml:"sseConfiguration"`
 }
 
 type (
@@ -19,6 +25,8 @@ type (
 		Port        string `yaml:"port"`
 		BaseUrl     string `yaml:"baseUrl"`
 		FrontendUrl string `yaml:"frontendUrl"`
+		// MetricsPort serves the expvar metrics on /debug/vars when set; it must not be reachable from outside
+		MetricsPort string `yaml:"metricsPort"`
 	}
 	CorsConfiguration struct {
 		Hostname string `yaml:"hostname"`
@@ -30,4 +38,20 @@ type (
 	SessionAuthConfiguration struct {
 		EncryptionKey string `yaml:"encryptionKey"`
 	}
+	CleanupConfiguration struct {
+		Interval               time.Duration `yaml:"interval"`
+		SoftDeleteRetention    time.Duration `yaml:"softDeleteRetention"`
+		ActivityLogRetention   time.Duration `yaml:"activityLogRetention"`
+		ChangeHistoryRetention time.Duration `yaml:"changeHistoryRetention"`
+		RebalanceSweepInterval time.Duration `yaml:"rebalanceSweepInterval"`
+	}
+	SSEConfiguration struct {
+		// Broker is "memory" (events reach clients of the same instance only) or "postgres"
+		Broker         string        `yaml:"broker"`
+		EventRetention time.Duration `yaml:"eventRetention"`
+		// ClientBufferSize is how many events a client can fall behind after coalescing
+		ClientBufferSize int `yaml:"clientBufferSize"`
+		// SlowClientPolicy is "disconnect" or "resync", applied when a client falls further behind
+		SlowClientPolicy string `yaml:"slowClientPolicy"`
+	}
 )
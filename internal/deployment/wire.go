@@ -20,6 +20,7 @@ import (
 	templateV1 "com.raunlo.checklist/internal/server/v1/template"
 	userV1 "com.raunlo.checklist/internal/server/v1/user"
 	workspaceV1 "com.raunlo.checklist/internal/server/v1/workspace"
+	"com.raunlo.checklist/internal/server/v1/ws"
 	wire "github.com/google/wire"
 )
 
@@ -52,9 +53,92 @@ func provideGoogleOAuthConfig(googleConfig GoogleSSOConfiguration, serverConfig
 	}
 }
 
-// provideCleanupJob creates the cleanup job with default configuration
-func provideCleanupJob(repo coreRepo.IChecklistItemsRepository) *job.CleanupJob {
-	return job.NewCleanupJob(repo, job.DefaultCleanupJobConfig())
+// provideCleanupJob creates the cleanup job; zero values in the configuration fall back to the defaults
+func provideCleanupJob(
+	repo coreRepo.IChecklistItemsRepository,
+	activityRepo coreRepo.IChecklistActivityRepository,
+	workspaceActivityRepo coreRepo.IWorkspaceActivityRepository,
+	historyRepo coreRepo.IChecklistHistoryRepository,
+	eventRepo coreRepo.IChecklistEventRepository,
+	config CleanupConfiguration,
+	sseConfig SSEConfiguration,
+) *job.CleanupJob {
+	jobConfig := job.DefaultCleanupJobConfig()
+	if config.SoftDeleteRetention > 0 {
+		jobConfig.RetentionPeriod = config.SoftDeleteRetention
+	}
+	if config.Interval > 0 {
+		jobConfig.Interval = config.Interval
+	}
+
+	activityLogRetention := job.DefaultActivityLogRetentionPeriod
+	if config.ActivityLogRetention > 0 {
+		activityLogRetention = config.ActivityLogRetention
+	}
+	changeHistoryRetention := job.DefaultChangeHistoryRetentionPeriod
+	if config.ChangeHistoryRetention > 0 {
+		changeHistoryRetention = config.ChangeHistoryRetention
+	}
+	eventRetention := job.DefaultChecklistEventRetentionPeriod
+	if sseConfig.EventRetention > 0 {
+		eventRetention = sseConfig.EventRetention
+	}
+
+	return job.NewCleanupJob(repo, jobConfig,
+		job.RetentionPolicy{
+			Name:            "checklist activity entries",
+			RetentionPeriod: activityLogRetention,
+			Purge:           activityRepo.PurgeActivityOlderThan,
+		},
+		job.RetentionPolicy{
+			Name:            "workspace activity entries",
+			RetentionPeriod: activityLogRetention,
+			Purge:           workspaceActivityRepo.PurgeActivityOlderThan,
+		},
+		job.RetentionPolicy{
+			Name:            "checklist item history entries",
+			RetentionPeriod: changeHistoryRetention,
+			Purge:           historyRepo.PurgeHistoryOlderThan,
+		},
+		job.RetentionPolicy{
+			Name:            "shared checklist events",
+			RetentionPeriod: eventRetention,
+			Purge:           eventRepo.PurgeEventsOlderThan,
+		},
+	)
+}
+
+// provideRebalanceSweeper creates the sweeper for pending rebalances; a zero interval falls back to the default
+func provideRebalanceSweeper(rebalanceService service.IRebalanceService, config CleanupConfiguration) *job.RebalanceSweeper {
+	return job.NewRebalanceSweeper(rebalanceService, config.RebalanceSweepInterval)
+}
+
+// provideBroker selects how events reach SSE clients. Panics on an unknown broker or slow client policy since
+// live updates would silently stop working otherwise.
+func provideBroker(
+	config SSEConfiguration,
+	checklistGuardrail guardrail.IChecklistOwnershipChecker,
+	checklistRepo coreRepo.IChecklistRepository,
+	userRepo coreRepo.IUserRepository,
+	eventRepo coreRepo.IChecklistEventRepository,
+) notification.IBroker {
+	switch config.SlowClientPolicy {
+	case "", notification.SlowClientDisconnect, notification.SlowClientResync:
+	default:
+		panic("Unknown SSE slow client policy: " + config.SlowClientPolicy)
+	}
+	queueConfig := notification.ClientQueueConfiguration{
+		BufferSize:       config.ClientBufferSize,
+		SlowClientPolicy: config.SlowClientPolicy,
+	}
+	switch config.Broker {
+	case "", "memory":
+		return notification.NewBroker(checklistGuardrail, checklistRepo, userRepo, queueConfig)
+	case "postgres":
+		return notification.NewDatabaseBroker(checklistGuardrail, checklistRepo, userRepo, eventRepo, queueConfig)
+	default:
+		panic("Unknown SSE broker: " + config.Broker)
+	}
 }
 
 func Init(configuration ApplicationConfiguration) Application {
@@ -65,23 +149,35 @@ func Init(configuration ApplicationConfiguration) Application {
 		provideBaseUrl,
 		provideFrontendUrl,
 		provideCleanupJob,
+		provideRebalanceSweeper,
 		guardrail.NewChecklistOwnershipCheckerService,
 		// checklist resource set
 		wire.NewSet(
 			checklistV1.NewChecklistController,
 			service.CreateChecklistService,
 			service.CreateChecklistInviteService,
+			service.CreateChecklistRunService,
+			service.CreateChecklistActivityService,
+			service.CreateChecklistHistoryService,
 			repository.CreateChecklistRepository,
 			repository.CreateChecklistInviteRepository,
+			repository.CreateChecklistRunRepository,
+			repository.CreateChecklistActivityRepository,
+			repository.CreateChecklistHistoryRepository,
 		),
 		// checklist item resource set
 		wire.NewSet(
 			checklistItemV1.NewChecklistItemController,
 			service.CreateChecklistItemService,
 			service.CreateRebalanceService,
+			service.CreateChecklistSectionService,
+			service.CreateChecklistItemCommentService,
 			repository.CreateChecklistItemRepository,
+			repository.CreateChecklistSectionRepository,
+			repository.CreateChecklistItemCommentRepository,
+			repository.CreateChecklistEventRepository,
 			notification.NewNotificationService,
-			notification.NewBroker,
+			provideBroker,
 		),
 		// user resource set (GDPR endpoints)
 		wire.NewSet(
@@ -97,6 +193,7 @@ func Init(configuration ApplicationConfiguration) Application {
 			repository.NewSessionRepository,
 			provideTokenEncryptor,
 			provideGoogleOAuthConfig,
+			auth.NewAPIRateLimit,
 		),
 		// template resource set
 		wire.NewSet(
@@ -111,16 +208,21 @@ func Init(configuration ApplicationConfiguration) Application {
 			workspaceV1.NewWorkspaceController,
 			service.CreateWorkspaceService,
 			service.CreateWorkspaceInviteService,
+			service.CreateWorkspaceActivityService,
 			repository.CreateWorkspaceRepository,
 			repository.CreateWorkspaceInviteRepository,
+			repository.CreateWorkspaceActivityRepository,
 			guardrail.NewWorkspaceOwnershipCheckerService,
 		),
 		connection.NewDatabaseConnection,
 		sse.NewSSEController,
+		ws.NewWebSocketController,
 		wire.FieldsOf(new(ApplicationConfiguration), "DatabaseConfiguration"),
 		wire.FieldsOf(new(ApplicationConfiguration), "ServerConfiguration"),
 		wire.FieldsOf(new(ApplicationConfiguration), "CorsConfiguration"),
 		wire.FieldsOf(new(ApplicationConfiguration), "GoogleSSOConfiguration"),
 		wire.FieldsOf(new(ApplicationConfiguration), "SessionAuthConfiguration"),
+		wire.FieldsOf(new(ApplicationConfiguration), "CleanupConfiguration"),
+		wire.FieldsOf(new(ApplicationConfiguration), "SSEConfiguration"),
 	))
 }
@@ -5,9 +5,19 @@ import (
 	"log"
 	"time"
 
+	"com.raunlo.checklist/internal/core/domain"
 	"com.raunlo.checklist/internal/core/repository"
 )
 
+const (
+	// DefaultActivityLogRetentionPeriod is how long checklist activity log entries are kept by default
+	DefaultActivityLogRetentionPeriod = 90 * 24 * time.Hour
+	// DefaultChangeHistoryRetentionPeriod is how long checklist history snapshots are kept by default
+	DefaultChangeHistoryRetentionPeriod = 90 * 24 * time.Hour
+	// DefaultChecklistEventRetentionPeriod is how long events shared between instances are kept by default
+	DefaultChecklistEventRetentionPeriod = 24 * time.Hour
+)
+
 // CleanupJob handles periodic cleanup of soft-deleted items.
 // It is designed to work in serverless/multi-instance environments like Cloud Run by:
 // 1. Using database-level locking to prevent concurrent runs
@@ -16,9 +26,21 @@ type CleanupJob struct {
 	repo            repository.IChecklistItemsRepository
 	retentionPeriod time.Duration
 	interval        time.Duration
+	policies        []RetentionPolicy
 	stopCh          chan struct{}
 }
 
+// RetentionPolicy is an additional purge step executed on every cleanup run while the cleanup lock is held.
+// It lets other append-only data (e.g. the activity log) reuse the same coordination as soft-deleted items.
+type RetentionPolicy struct {
+	// Name is used in log messages
+	Name string
+	// RetentionPeriod is how long records are kept before they are purged
+	RetentionPeriod time.Duration
+	// Purge permanently deletes records older than the retention period and returns the number of deleted records
+	Purge func(ctx context.Context, retentionPeriod time.Duration) (int64, domain.Error)
+}
+
 // CleanupJobConfig holds configuration for the cleanup job
 type CleanupJobConfig struct {
 	// RetentionPeriod is how long soft-deleted items are kept before permanent deletion
@@ -38,8 +60,8 @@ func DefaultCleanupJobConfig() CleanupJobConfig {
 	}
 }
 
-// NewCleanupJob creates a new cleanup job
-func NewCleanupJob(repo repository.IChecklistItemsRepository, config CleanupJobConfig) *CleanupJob {
+// NewCleanupJob creates a new cleanup job. Optional retention policies run after soft-deleted items are purged.
+func NewCleanupJob(repo repository.IChecklistItemsRepository, config CleanupJobConfig, policies ...RetentionPolicy) *CleanupJob {
 	if config.RetentionPeriod == 0 {
 		config.RetentionPeriod = 30 * 24 * time.Hour
 	}
@@ -51,6 +73,7 @@ func NewCleanupJob(repo repository.IChecklistItemsRepository, config CleanupJobC
 		repo:            repo,
 		retentionPeriod: config.RetentionPeriod,
 		interval:        config.Interval,
+		policies:        policies,
 		stopCh:          make(chan struct{}),
 	}
 }
@@ -62,6 +85,9 @@ func NewCleanupJob(repo repository.IChecklistItemsRepository, config CleanupJobC
 func (j *CleanupJob) Start() {
 	go j.run()
 	log.Printf("Cleanup job started: will purge items deleted more than %v ago, running every %v", j.retentionPeriod, j.interval)
+	for _, policy := range j.policies {
+		log.Printf("Cleanup job: will purge %s older than %v", policy.Name, policy.RetentionPeriod)
+	}
 }
 
 // Stop gracefully stops the cleanup job
@@ -117,6 +143,18 @@ func (j *CleanupJob) tryRunCleanup() {
 		return
 	}
 
+	// Additional retention policies; a failing policy does not prevent the others from running
+	for _, policy := range j.policies {
+		purgedCount, err := policy.Purge(ctx, policy.RetentionPeriod)
+		if err != nil {
+			log.Printf("Cleanup job error: failed to purge %s: %v", policy.Name, err)
+			continue
+		}
+		if purgedCount > 0 {
+			log.Printf("Cleanup job: permanently deleted %d %s older than %v", purgedCount, policy.Name, policy.RetentionPeriod)
+		}
+	}
+
 	// Update last run time and release lock
 	if err := j.repo.UpdateCleanupLastRun(ctx); err != nil {
 		log.Printf("Cleanup job: failed to update last run time: %v", err)
@@ -62,12 +62,39 @@ func (m *mockRepository) FindAllChecklistItems(ctx context.Context, checklistId
 func (m *mockRepository) ChangeChecklistItemOrder(ctx context.Context, request domain.ChangeOrderRequest) (domain.ChangeOrderResponse, domain.Error) {
 	return domain.ChangeOrderResponse{}, nil
 }
-func (m *mockRepository) ToggleItemCompleted(ctx context.Context, checklistId uint, checklistItemId uint, completed bool) (domain.ChecklistItem, domain.Error) {
-	return domain.ChecklistItem{}, nil
+func (m *mockRepository) ToggleItemCompleted(ctx context.Context, checklistId uint, checklistItemId uint, completed bool) (domain.ChecklistItemToggleResult, domain.Error) {
+	return domain.ChecklistItemToggleResult{}, nil
+}
+func (m *mockRepository) ApplyBatch(ctx context.Context, checklistId uint, operations []domain.ChecklistItemBatchOperation) (domain.ChecklistItemBatchResult, domain.Error) {
+	return domain.ChecklistItemBatchResult{}, nil
+}
+func (m *mockRepository) ClearCompletedItems(ctx context.Context, checklistId uint) (domain.ChecklistItemDeletionGroup, domain.Error) {
+	return domain.ChecklistItemDeletionGroup{}, nil
+}
+func (m *mockRepository) RestoreDeletionGroup(ctx context.Context, checklistId uint, groupId uint) (domain.ChecklistItemBatchResult, domain.Error) {
+	return domain.ChecklistItemBatchResult{}, nil
+}
+func (m *mockRepository) ResetChecklistItems(ctx context.Context, checklistId uint) (domain.ChecklistItemBatchResult, domain.Error) {
+	return domain.ChecklistItemBatchResult{}, nil
+}
+func (m *mockRepository) SortChecklistItems(ctx context.Context, request domain.ChecklistItemSortRequest) ([]domain.ChecklistItem, domain.Error) {
+	return nil, nil
 }
-func (m *mockRepository) RebalancePositions(ctx context.Context, checklistId uint) domain.Error {
+func (m *mockRepository) MoveChecklistItem(ctx context.Context, request domain.ChecklistItemTransferRequest) (domain.ChecklistItemTransferResult, domain.Error) {
+	return domain.ChecklistItemTransferResult{}, nil
+}
+func (m *mockRepository) CopyChecklistItem(ctx context.Context, request domain.ChecklistItemTransferRequest) (domain.ChecklistItemTransferResult, domain.Error) {
+	return domain.ChecklistItemTransferResult{}, nil
+}
+func (m *mockRepository) RebalancePositions(ctx context.Context, checklistId uint) (map[uint]string, bool, domain.Error) {
+	return nil, false, nil
+}
+func (m *mockRepository) ScheduleRebalance(ctx context.Context, checklistId uint, debounce time.Duration, maxWait time.Duration) domain.Error {
 	return nil
 }
+func (m *mockRepository) FindDueRebalances(ctx context.Context) ([]uint, domain.Error) {
+	return nil, nil
+}
 func (m *mockRepository) RestoreChecklistItem(ctx context.Context, checklistId uint, itemId uint) (domain.ChecklistItem, domain.Error) {
 	return domain.ChecklistItem{}, nil
 }
@@ -153,3 +180,50 @@ func TestNewCleanupJob_DefaultsZeroValues(t *testing.T) {
 		t.Errorf("expected default interval of 24 hours, got %v", job.interval)
 	}
 }
+
+func TestCleanupJob_RunsRetentionPolicies(t *testing.T) {
+	repo := &mockRepository{tryAcquireLockReturn: true}
+
+	var policyCalls atomic.Int32
+	var receivedRetention atomic.Int64
+	policy := RetentionPolicy{
+		Name:            "activity log entries",
+		RetentionPeriod: 90 * 24 * time.Hour,
+		Purge: func(ctx context.Context, retentionPeriod time.Duration) (int64, domain.Error) {
+			policyCalls.Add(1)
+			receivedRetention.Store(int64(retentionPeriod))
+			return 3, nil
+		},
+	}
+
+	job := NewCleanupJob(repo, CleanupJobConfig{Interval: time.Hour}, policy)
+	job.tryRunCleanup()
+
+	if policyCalls.Load() != 1 {
+		t.Errorf("expected retention policy to run once, got %d", policyCalls.Load())
+	}
+	if time.Duration(receivedRetention.Load()) != 90*24*time.Hour {
+		t.Errorf("expected retention period of 90 days, got %v", time.Duration(receivedRetention.Load()))
+	}
+}
+
+func TestCleanupJob_SkipsRetentionPoliciesWhenLockNotAcquired(t *testing.T) {
+	repo := &mockRepository{tryAcquireLockReturn: false}
+
+	var policyCalls atomic.Int32
+	policy := RetentionPolicy{
+		Name:            "activity log entries",
+		RetentionPeriod: time.Hour,
+		Purge: func(ctx context.Context, retentionPeriod time.Duration) (int64, domain.Error) {
+			policyCalls.Add(1)
+			return 0, nil
+		},
+	}
+
+	job := NewCleanupJob(repo, CleanupJobConfig{Interval: time.Hour}, policy)
+	job.tryRunCleanup()
+
+	if policyCalls.Load() != 0 {
+		t.Errorf("expected retention policy not to run without the lock, got %d calls", policyCalls.Load())
+	}
+}
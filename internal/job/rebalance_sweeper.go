@@ -0,0 +1,69 @@
+package job
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"com.raunlo.checklist/internal/core/service"
+)
+
+// DefaultRebalanceSweepInterval is how often pending rebalances are checked by default
+const DefaultRebalanceSweepInterval = 30 * time.Second
+
+// RebalanceSweeper runs the pending position rebalances that are due. Instances normally run the rebalances
+// they trigger themselves; the sweeper picks up the ones that were lost when an instance stopped or a rebalance
+// failed. Every instance sweeps, the rebalance itself is locked per checklist in the database.
+type RebalanceSweeper struct {
+	rebalanceService service.IRebalanceService
+	interval         time.Duration
+	stopCh           chan struct{}
+}
+
+// NewRebalanceSweeper creates a new rebalance sweeper; a zero interval falls back to the default
+func NewRebalanceSweeper(rebalanceService service.IRebalanceService, interval time.Duration) *RebalanceSweeper {
+	if interval == 0 {
+		interval = DefaultRebalanceSweepInterval
+	}
+	return &RebalanceSweeper{
+		rebalanceService: rebalanceService,
+		interval:         interval,
+		stopCh:           make(chan struct{}),
+	}
+}
+
+// Start begins sweeping in a goroutine, right away and then every interval
+func (s *RebalanceSweeper) Start() {
+	go s.run()
+	log.Printf("Rebalance sweeper started: running due rebalances every %v", s.interval)
+}
+
+// Stop gracefully stops the rebalance sweeper
+func (s *RebalanceSweeper) Stop() {
+	close(s.stopCh)
+	log.Println("Rebalance sweeper stopped")
+}
+
+func (s *RebalanceSweeper) run() {
+	// Rebalances that were pending when the previous instance stopped
+	s.sweep()
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.sweep()
+		case <-s.stopCh:
+			return
+		}
+	}
+}
+
+func (s *RebalanceSweeper) sweep() {
+	// Use a timeout to prevent hanging if database is slow/unresponsive
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	s.rebalanceService.RunDueRebalances(ctx)
+}
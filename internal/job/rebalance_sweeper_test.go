@@ -0,0 +1,33 @@
+package job
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type countingRebalanceService struct {
+	runs atomic.Int32
+}
+
+func (s *countingRebalanceService) TriggerRebalance(ctx context.Context, checklistId uint) {}
+
+func (s *countingRebalanceService) RunDueRebalances(ctx context.Context) {
+	s.runs.Add(1)
+}
+
+func TestRebalanceSweeper_RunsOnStartAndPeriodically(t *testing.T) {
+	rebalanceService := &countingRebalanceService{}
+
+	sweeper := NewRebalanceSweeper(rebalanceService, 100*time.Millisecond)
+	sweeper.Start()
+
+	// Wait for initial run + at least one periodic run
+	time.Sleep(250 * time.Millisecond)
+	sweeper.Stop()
+
+	if runs := int(rebalanceService.runs.Load()); runs < 2 {
+		t.Errorf("expected at least 2 sweeps, got %d", runs)
+	}
+}
@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"com.raunlo.checklist/internal/core/domain"
+	coreRepo "com.raunlo.checklist/internal/core/repository"
+	"com.raunlo.checklist/internal/repository/dbo"
+	"github.com/jackc/pgx/v5"
+	"github.com/raunlo/pgx-with-automapper/pool"
+)
+
+type checklistActivityRepository struct {
+	connection pool.Conn
+}
+
+func (r *checklistActivityRepository) AppendActivity(ctx context.Context, activity domain.ChecklistActivity) domain.Error {
+	_, err := r.connection.Exec(ctx,
+		`INSERT INTO CHECKLIST_ACTIVITY(CHECKLIST_ID, ITEM_ID, ACTION, ACTOR, CREATED_AT, BEFORE_SUMMARY, AFTER_SUMMARY)
+		 VALUES(@checklistId, @itemId, @action, @actor, CURRENT_TIMESTAMP, @before, @after)`,
+		pgx.NamedArgs{
+			"checklistId": activity.ChecklistId,
+			"itemId":      activity.ItemId,
+			"action":      string(activity.Action),
+			"actor":       activity.Actor,
+			"before":      activity.Before,
+			"after":       activity.After,
+		})
+	if err != nil {
+		return domain.Wrap(err, fmt.Sprintf("Could not append activity for checklist(id=%d)", activity.ChecklistId), 500)
+	}
+	return nil
+}
+
+func (r *checklistActivityRepository) FindActivityPage(ctx context.Context, checklistId uint, before *uint, limit int) (domain.ChecklistActivityPage, domain.Error) {
+	// Fetch one extra entry to know whether another page exists
+	var dbos []dbo.ChecklistActivityDBO
+	err := r.connection.QueryList(ctx,
+		`SELECT ID, CHECKLIST_ID, ITEM_ID, ACTION, ACTOR, CREATED_AT, BEFORE_SUMMARY, AFTER_SUMMARY
+		 FROM CHECKLIST_ACTIVITY
+		 WHERE CHECKLIST_ID = @checklistId
+		   AND (CAST(@before AS BIGINT) IS NULL OR ID < @before)
+		 ORDER BY ID DESC
+		 LIMIT @limit`,
+		&dbos,
+		pgx.NamedArgs{"checklistId": checklistId, "before": before, "limit": limit + 1})
+	if err != nil {
+		return domain.ChecklistActivityPage{}, domain.Wrap(err, fmt.Sprintf("Could not find activity for checklist(id=%d)", checklistId), 500)
+	}
+
+	page := domain.ChecklistActivityPage{Entries: make([]domain.ChecklistActivity, 0, len(dbos))}
+	for index := range dbos {
+		if index == limit {
+			cursor := page.Entries[limit-1].Id
+			page.NextCursor = &cursor
+			break
+		}
+		page.Entries = append(page.Entries, dbos[index].ToDomain())
+	}
+	return page, nil
+}
+
+func (r *checklistActivityRepository) PurgeActivityOlderThan(ctx context.Context, retentionPeriod time.Duration) (int64, domain.Error) {
+	result, err := r.connection.Exec(ctx,
+		`DELETE FROM CHECKLIST_ACTIVITY
+		 WHERE CREATED_AT < NOW() - INTERVAL '1 hour' * @retentionHours`,
+		pgx.NamedArgs{"retentionHours": int(retentionPeriod.Hours())})
+	if err != nil {
+		return 0, domain.Wrap(err, "Could not purge checklist activity", 500)
+	}
+	return result.RowsAffected(), nil
+}
+
+func CreateChecklistActivityRepository(conn pool.Conn) coreRepo.IChecklistActivityRepository {
+	return &checklistActivityRepository{connection: conn}
+}
@@ -0,0 +1,232 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"com.raunlo.checklist/internal/core/domain"
+	coreRepo "com.raunlo.checklist/internal/core/repository"
+	"com.raunlo.checklist/internal/repository/connection"
+	"github.com/jackc/pgx/v5"
+	"github.com/raunlo/pgx-with-automapper/pool"
+)
+
+// checklistEventChannel is the Postgres notification channel. The payload of a notification is the event id,
+// because NOTIFY payloads are limited to 8000 bytes.
+const checklistEventChannel = "checklist_events"
+
+// checklistPresenceChannel carries presence changes as JSON. They are small and not stored.
+const checklistPresenceChannel = "checklist_presence"
+
+type checklistEventRepository struct {
+	connection pool.Conn
+	config     pool.DatabaseConfiguration
+}
+
+func (r *checklistEventRepository) SaveEvent(ctx context.Context, event domain.ChecklistEventRecord, replayLogSize int) domain.Error {
+	// The counter row stays locked until the insert commits, so the events of a checklist are committed and
+	// notified in the order of their event ids
+	_, err := r.connection.Exec(ctx,
+		`WITH counter AS (
+		     INSERT INTO CHECKLIST_EVENT_COUNTER(CHECKLIST_ID, LAST_EVENT_ID)
+		     VALUES(@checklistId, 1)
+		     ON CONFLICT (CHECKLIST_ID) DO UPDATE SET LAST_EVENT_ID = CHECKLIST_EVENT_COUNTER.LAST_EVENT_ID + 1
+		     RETURNING LAST_EVENT_ID
+		 ), saved AS (
+		     INSERT INTO CHECKLIST_EVENT(CHECKLIST_ID, EVENT_ID, CLIENT_ID, EVENT_TYPE, PAYLOAD, ACCESS_CHANGED_FOR)
+		     SELECT @checklistId, LAST_EVENT_ID, @clientId, @eventType, @payload, @accessChangedFor FROM counter
+		     RETURNING ID, EVENT_ID
+		 ), trimmed AS (
+		     DELETE FROM CHECKLIST_EVENT e
+		     USING saved
+		     WHERE e.CHECKLIST_ID = @checklistId AND e.EVENT_ID <= saved.EVENT_ID - @replayLogSize
+		 )
+		 SELECT pg_notify(@channel, CAST(ID AS TEXT)) FROM saved`,
+		pgx.NamedArgs{
+			"checklistId":      event.ChecklistId,
+			"clientId":         event.ClientId,
+			"eventType":        event.EventType,
+			"payload":          string(event.Payload),
+			"accessChangedFor": event.AccessChangedFor,
+			"replayLogSize":    replayLogSize,
+			"channel":          checklistEventChannel,
+		})
+	if err != nil {
+		return domain.Wrap(err, fmt.Sprintf("Could not save %s event for checklist(id=%d)", event.EventType, event.ChecklistId), 500)
+	}
+	return nil
+}
+
+func (r *checklistEventRepository) FindEventsAfter(ctx context.Context, checklistId uint, afterEventId uint64) ([]domain.ChecklistEventRecord, uint64, domain.Error) {
+	var lastEventId uint64
+	err := r.connection.QueryRow(ctx,
+		`SELECT COALESCE(MAX(LAST_EVENT_ID), 0) FROM CHECKLIST_EVENT_COUNTER WHERE CHECKLIST_ID = @checklistId`,
+		pgx.NamedArgs{"checklistId": checklistId}).Scan(&lastEventId)
+	if err != nil {
+		return nil, 0, domain.Wrap(err, fmt.Sprintf("Could not find last event of checklist(id=%d)", checklistId), 500)
+	}
+
+	rows, err := r.connection.Query(ctx,
+		checklistEventSelect+`
+		 WHERE CHECKLIST_ID = @checklistId AND EVENT_ID > @afterEventId
+		 ORDER BY EVENT_ID ASC`,
+		pgx.NamedArgs{"checklistId": checklistId, "afterEventId": afterEventId})
+	if err != nil {
+		return nil, 0, domain.Wrap(err, fmt.Sprintf("Could not find events of checklist(id=%d)", checklistId), 500)
+	}
+	events, err := scanChecklistEvents(rows)
+	if err != nil {
+		return nil, 0, domain.Wrap(err, fmt.Sprintf("Could not find events of checklist(id=%d)", checklistId), 500)
+	}
+	return events, lastEventId, nil
+}
+
+func (r *checklistEventRepository) PublishPresence(ctx context.Context, record domain.ChecklistPresenceRecord) domain.Error {
+	payload, err := json.Marshal(record)
+	if err != nil {
+		return domain.Wrap(err, "Could not encode presence change", 500)
+	}
+	_, err = r.connection.Exec(ctx, `SELECT pg_notify(@channel, @payload)`,
+		pgx.NamedArgs{"channel": checklistPresenceChannel, "payload": string(payload)})
+	if err != nil {
+		return domain.Wrap(err, fmt.Sprintf("Could not publish presence change of checklist(id=%d)", record.ChecklistId), 500)
+	}
+	return nil
+}
+
+func (r *checklistEventRepository) ListenForEvents(ctx context.Context, afterId uint64, handler func(event domain.ChecklistEventRecord),
+	presenceHandler func(record domain.ChecklistPresenceRecord)) domain.Error {
+	listener, err := connection.NewListenerConnection(ctx, r.config)
+	if err != nil {
+		return domain.Wrap(err, "Could not open checklist event listener connection", 500)
+	}
+	defer listener.Close(context.Background())
+
+	for _, channel := range []string{checklistEventChannel, checklistPresenceChannel} {
+		if _, err := listener.Exec(ctx, "LISTEN "+channel); err != nil {
+			return domain.Wrap(err, "Could not listen for checklist events", 500)
+		}
+	}
+	// Catch up after listening, so no event falls between the two. Notifications of caught up events are skipped.
+	caughtUp, catchUpErr := r.catchUpEvents(ctx, afterId, handler)
+	if catchUpErr != nil {
+		return catchUpErr
+	}
+	for {
+		notification, err := listener.WaitForNotification(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return domain.Wrap(err, "Lost checklist event listener connection", 500)
+		}
+		if notification.Channel == checklistPresenceChannel {
+			var record domain.ChecklistPresenceRecord
+			if err := json.Unmarshal([]byte(notification.Payload), &record); err != nil {
+				log.Printf("sse: skipping invalid presence change: %v", err)
+				continue
+			}
+			presenceHandler(record)
+			continue
+		}
+		eventId, err := strconv.ParseUint(notification.Payload, 10, 64)
+		if err != nil {
+			return domain.Wrap(err, fmt.Sprintf("Invalid checklist event notification %q", notification.Payload), 500)
+		}
+		if caughtUp[eventId] {
+			continue
+		}
+		event, findErr := r.findEvent(ctx, eventId)
+		if findErr != nil && findErr.ResponseCode() == 404 {
+			// already trimmed from the replay log by newer events of the checklist
+			continue
+		} else if findErr != nil {
+			return findErr
+		}
+		handler(event)
+	}
+}
+
+// catchUpEvents handles the stored events with an id after afterId, oldest first, and returns their ids.
+// afterId lies before the last event the caller handled, which skips the events it already handled.
+func (r *checklistEventRepository) catchUpEvents(ctx context.Context, afterId uint64,
+	handler func(event domain.ChecklistEventRecord)) (map[uint64]bool, domain.Error) {
+	if afterId == 0 {
+		return nil, nil
+	}
+	rows, err := r.connection.Query(ctx,
+		checklistEventSelect+`
+		 WHERE ID > @afterId
+		 ORDER BY ID ASC`,
+		pgx.NamedArgs{"afterId": afterId})
+	if err != nil {
+		return nil, domain.Wrap(err, fmt.Sprintf("Could not find checklist events after event(id=%d)", afterId), 500)
+	}
+	events, err := scanChecklistEvents(rows)
+	if err != nil {
+		return nil, domain.Wrap(err, fmt.Sprintf("Could not find checklist events after event(id=%d)", afterId), 500)
+	}
+
+	caughtUp := make(map[uint64]bool, len(events))
+	for _, event := range events {
+		caughtUp[event.Id] = true
+		handler(event)
+	}
+	return caughtUp, nil
+}
+
+func (r *checklistEventRepository) findEvent(ctx context.Context, id uint64) (domain.ChecklistEventRecord, domain.Error) {
+	rows, err := r.connection.Query(ctx,
+		checklistEventSelect+`
+		 WHERE ID = @id`,
+		pgx.NamedArgs{"id": id})
+	if err != nil {
+		return domain.ChecklistEventRecord{}, domain.Wrap(err, fmt.Sprintf("Could not find checklist event(id=%d)", id), 500)
+	}
+	events, err := scanChecklistEvents(rows)
+	if err != nil {
+		return domain.ChecklistEventRecord{}, domain.Wrap(err, fmt.Sprintf("Could not find checklist event(id=%d)", id), 500)
+	} else if len(events) == 0 {
+		return domain.ChecklistEventRecord{}, domain.NewError(fmt.Sprintf("Checklist event(id=%d) not found", id), 404)
+	}
+	return events[0], nil
+}
+
+const checklistEventSelect = `
+	SELECT ID, CHECKLIST_ID, EVENT_ID, CLIENT_ID, EVENT_TYPE, CAST(PAYLOAD AS TEXT), ACCESS_CHANGED_FOR
+	FROM CHECKLIST_EVENT`
+
+func scanChecklistEvents(rows pgx.Rows) ([]domain.ChecklistEventRecord, error) {
+	defer rows.Close()
+
+	events := []domain.ChecklistEventRecord{}
+	for rows.Next() {
+		var event domain.ChecklistEventRecord
+		var payload string
+		if err := rows.Scan(&event.Id, &event.ChecklistId, &event.EventId, &event.ClientId, &event.EventType, &payload, &event.AccessChangedFor); err != nil {
+			return nil, err
+		}
+		event.Payload = []byte(payload)
+		events = append(events, event)
+	}
+	return events, rows.Err()
+}
+
+func (r *checklistEventRepository) PurgeEventsOlderThan(ctx context.Context, retentionPeriod time.Duration) (int64, domain.Error) {
+	result, err := r.connection.Exec(ctx,
+		`DELETE FROM CHECKLIST_EVENT
+		 WHERE CREATED_AT < NOW() - INTERVAL '1 hour' * @retentionHours`,
+		pgx.NamedArgs{"retentionHours": int(retentionPeriod.Hours())})
+	if err != nil {
+		return 0, domain.Wrap(err, "Could not purge checklist events", 500)
+	}
+	return result.RowsAffected(), nil
+}
+
+func CreateChecklistEventRepository(conn pool.Conn, config pool.DatabaseConfiguration) coreRepo.IChecklistEventRepository {
+	return &checklistEventRepository{connection: conn, config: config}
+}
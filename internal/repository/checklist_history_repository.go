@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"com.raunlo.checklist/internal/core/domain"
+	coreRepo "com.raunlo.checklist/internal/core/repository"
+	"com.raunlo.checklist/internal/repository/dbo"
+	"github.com/jackc/pgx/v5"
+	"github.com/raunlo/pgx-with-automapper/pool"
+)
+
+// latestItemHistory selects the newest entry recorded at or before @at of every item that belonged to the
+// checklist by then. An item moved to another checklist afterwards has its newest entry under that checklist.
+const latestItemHistory = `
+	WITH latest AS (
+		SELECT DISTINCT ON (h.CHECKLIST_ITEM_ID) h.*
+		FROM CHECKLIST_ITEM_HISTORY h
+		WHERE h.RECORDED_AT <= @at
+		  AND h.CHECKLIST_ITEM_ID IN (
+		      SELECT CHECKLIST_ITEM_ID FROM CHECKLIST_ITEM_HISTORY
+		      WHERE CHECKLIST_ID = @checklistId AND RECORDED_AT <= @at
+		  )
+		ORDER BY h.CHECKLIST_ITEM_ID, h.RECORDED_AT DESC, h.ID DESC
+	)`
+
+type checklistHistoryRepository struct {
+	connection pool.Conn
+}
+
+func (r *checklistHistoryRepository) FindStateAsOf(ctx context.Context, checklistId uint, at time.Time) (domain.ChecklistState, domain.Error) {
+	state := domain.ChecklistState{ChecklistId: checklistId, AsOf: at, Items: []domain.ChecklistItem{}}
+	args := pgx.NamedArgs{"checklistId": checklistId, "at": at}
+
+	err := r.connection.QueryRow(ctx, latestItemHistory+` SELECT MAX(RECORDED_AT) FROM latest`, args).Scan(&state.RecordedAt)
+	if err != nil {
+		return domain.ChecklistState{}, domain.Wrap(err, fmt.Sprintf("Could not find history of checklist(id=%d)", checklistId), 500)
+	}
+	if state.RecordedAt == nil {
+		return state, nil
+	}
+
+	rows, err := r.connection.Query(ctx, latestItemHistory+`
+		SELECT l.CHECKLIST_ITEM_ID, l.NAME, l.COMPLETED, l.COMPLETED_BY, l.COMPLETED_AT, l.SECTION_ID, l.ROWS,
+		       ROW_NUMBER() OVER (
+		           PARTITION BY l.SECTION_ID
+		           ORDER BY CASE WHEN c.ORDERING_MODE = 'KEEP_IN_PLACE' THEN FALSE ELSE l.COMPLETED END ASC, l.POSITION ASC, l.CHECKLIST_ITEM_ID ASC
+		       ) AS ORDER_NUMBER
+		FROM latest l
+		JOIN CHECKLIST c ON c.ID = l.CHECKLIST_ID
+		LEFT JOIN CHECKLIST_SECTION s ON s.ID = l.SECTION_ID
+		WHERE l.CHECKLIST_ID = @checklistId AND NOT l.DELETED
+		ORDER BY s.POSITION ASC NULLS FIRST, s.ID ASC NULLS FIRST, ORDER_NUMBER ASC`, args)
+	if err != nil {
+		return domain.ChecklistState{}, domain.Wrap(err, fmt.Sprintf("Could not find history of checklist(id=%d)", checklistId), 500)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var item domain.ChecklistItem
+		var itemRows []byte
+		if err := rows.Scan(&item.Id, &item.Name, &item.Completed, &item.CompletedBy, &item.CompletedAt,
+			&item.SectionId, &itemRows, &item.OrderNumber); err != nil {
+			return domain.ChecklistState{}, domain.Wrap(err, fmt.Sprintf("Could not read history of checklist(id=%d)", checklistId), 500)
+		}
+		if item.Rows, err = dbo.UnmarshalHistoryRows(itemRows); err != nil {
+			return domain.ChecklistState{}, domain.Wrap(err, fmt.Sprintf("Could not decode history of item(id=%d)", item.Id), 500)
+		}
+		state.Items = append(state.Items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return domain.ChecklistState{}, domain.Wrap(err, fmt.Sprintf("Could not read history of checklist(id=%d)", checklistId), 500)
+	}
+	return state, nil
+}
+
+func (r *checklistHistoryRepository) PurgeHistoryOlderThan(ctx context.Context, retentionPeriod time.Duration) (int64, domain.Error) {
+	result, err := r.connection.Exec(ctx,
+		`DELETE FROM CHECKLIST_ITEM_HISTORY h
+		 WHERE h.RECORDED_AT < NOW() - INTERVAL '1 hour' * @retentionHours
+		   AND EXISTS (
+		       SELECT 1 FROM CHECKLIST_ITEM_HISTORY newer
+		       WHERE newer.CHECKLIST_ITEM_ID = h.CHECKLIST_ITEM_ID
+		         AND newer.RECORDED_AT < NOW() - INTERVAL '1 hour' * @retentionHours
+		         AND (newer.RECORDED_AT, newer.ID) > (h.RECORDED_AT, h.ID)
+		   )`,
+		pgx.NamedArgs{"retentionHours": int(retentionPeriod.Hours())})
+	if err != nil {
+		return 0, domain.Wrap(err, "Could not purge checklist item history", 500)
+	}
+	return result.RowsAffected(), nil
+}
+
+func CreateChecklistHistoryRepository(conn pool.Conn) coreRepo.IChecklistHistoryRepository {
+	return &checklistHistoryRepository{connection: conn}
+}
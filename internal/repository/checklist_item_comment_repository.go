@@ -0,0 +1,103 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"com.raunlo.checklist/internal/core/domain"
+	"com.raunlo.checklist/internal/repository/connection"
+	"com.raunlo.checklist/internal/repository/query"
+	"github.com/jackc/pgx/v5"
+	"github.com/raunlo/pgx-with-automapper/pool"
+)
+
+type checklistItemCommentRepository struct {
+	conn pool.Conn
+}
+
+func (r *checklistItemCommentRepository) FindItemComments(ctx context.Context, checklistId uint, itemId uint) ([]domain.ChecklistItemComment, domain.Error) {
+	res, err := connection.RunInTransaction(connection.TransactionProps[[]domain.ChecklistItemComment]{
+		Ctx:        ctx,
+		Query:      query.NewGetChecklistItemCommentsQueryFunction(checklistId, itemId).GetTransactionalQueryFunction(),
+		TxOptions:  connection.TxReadCommitted,
+		Connection: r.conn,
+	})
+	if err != nil {
+		return nil, domain.Wrap(err, fmt.Sprintf("Failed to find comments of checklistItem(id=%d)", itemId), 500)
+	}
+	return res, nil
+}
+
+func (r *checklistItemCommentRepository) FindItemCommentById(ctx context.Context, checklistId uint, itemId uint, commentId uint) (*domain.ChecklistItemComment, domain.Error) {
+	res, err := connection.RunInTransaction(connection.TransactionProps[domain.ChecklistItemComment]{
+		Ctx:        ctx,
+		Query:      query.NewFindChecklistItemCommentQueryFunction(checklistId, itemId, commentId).GetTransactionalQueryFunction(),
+		TxOptions:  connection.TxReadCommitted,
+		Connection: r.conn,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	} else if err != nil {
+		return nil, domain.Wrap(err, fmt.Sprintf("Failed to find comment(id=%d)", commentId), 500)
+	}
+	return &res, nil
+}
+
+func (r *checklistItemCommentRepository) SaveItemComment(ctx context.Context, comment domain.ChecklistItemComment) (domain.ChecklistItemComment, domain.Error) {
+	res, err := connection.RunInTransaction(connection.TransactionProps[domain.ChecklistItemComment]{
+		Ctx:        ctx,
+		Query:      query.NewPersistChecklistItemCommentQueryFunction(comment).GetTransactionalQueryFunction(),
+		TxOptions:  connection.TxReadCommitted,
+		Connection: r.conn,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.ChecklistItemComment{}, domain.NewError("Checklist item or parent comment not found", 404)
+	} else if err != nil {
+		return domain.ChecklistItemComment{}, domain.Wrap(err, "Could not save comment", 500)
+	}
+	return res, nil
+}
+
+func (r *checklistItemCommentRepository) UpdateItemComment(ctx context.Context, comment domain.ChecklistItemComment) (domain.ChecklistItemComment, domain.Error) {
+	res, err := connection.RunInTransaction(connection.TransactionProps[domain.ChecklistItemComment]{
+		Ctx:        ctx,
+		Query:      query.NewUpdateChecklistItemCommentQueryFunction(comment).GetTransactionalQueryFunction(),
+		TxOptions:  connection.TxReadCommitted,
+		Connection: r.conn,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.ChecklistItemComment{}, domain.NewError(fmt.Sprintf("Comment(id=%d) not found", comment.Id), 404)
+	} else if err != nil {
+		return domain.ChecklistItemComment{}, domain.Wrap(err, fmt.Sprintf("Could not update comment(id=%d)", comment.Id), 500)
+	}
+	return res, nil
+}
+
+func (r *checklistItemCommentRepository) DeleteItemComment(ctx context.Context, checklistId uint, itemId uint, commentId uint, author string) domain.Error {
+	ok, err := connection.RunInTransaction(connection.TransactionProps[bool]{
+		Ctx:        ctx,
+		Query:      query.NewDeleteChecklistItemCommentQueryFunction(checklistId, itemId, commentId, author).GetTransactionalQueryFunction(),
+		TxOptions:  connection.TxReadCommitted,
+		Connection: r.conn,
+	})
+	if err != nil {
+		return domain.Wrap(err, fmt.Sprintf("Could not delete comment(id=%d)", commentId), 500)
+	} else if !ok {
+		return domain.NewError(fmt.Sprintf("Comment(id=%d) not found", commentId), 404)
+	}
+	return nil
+}
+
+func (r *checklistItemCommentRepository) FindUsersWithAccess(ctx context.Context, checklistId uint, userIds []string) ([]string, domain.Error) {
+	res, err := connection.RunInTransaction(connection.TransactionProps[[]string]{
+		Ctx:        ctx,
+		Query:      query.NewFindUsersWithChecklistAccessQueryFunction(checklistId, userIds).GetTransactionalQueryFunction(),
+		TxOptions:  connection.TxReadCommitted,
+		Connection: r.conn,
+	})
+	if err != nil {
+		return nil, domain.Wrap(err, fmt.Sprintf("Failed to check access of mentioned users to checklist(id=%d)", checklistId), 500)
+	}
+	return res, nil
+}
@@ -10,6 +10,7 @@ import (
 	"com.raunlo.checklist/internal/repository/connection"
 	"com.raunlo.checklist/internal/repository/dbo"
 	"com.raunlo.checklist/internal/repository/query"
+	"github.com/jackc/pgx/v5"
 	"github.com/raunlo/pgx-with-automapper/mapper"
 	"github.com/raunlo/pgx-with-automapper/pool"
 )
@@ -18,6 +19,31 @@ type checklistItemRepository struct {
 	conn pool.Conn
 }
 
+// appendItemHistory records the current state of the items in the change history, in the surrounding transaction
+func appendItemHistory(tx pool.TransactionWrapper, itemIds ...uint) (bool, error) {
+	return query.NewAppendItemHistoryQueryFunction(itemIds...).GetTransactionalQueryFunction()(tx)
+}
+
+// withItemHistory records the items a transactional query changed in the change history, in the same
+// transaction as the change. changedItemIds picks the changed items from the result of the query.
+func withItemHistory[K any](queryFunction func(tx pool.TransactionWrapper) (K, error), changedItemIds func(K) []uint) func(tx pool.TransactionWrapper) (K, error) {
+	return func(tx pool.TransactionWrapper) (K, error) {
+		result, err := queryFunction(tx)
+		if err != nil {
+			return result, err
+		}
+		_, err = appendItemHistory(tx, changedItemIds(result)...)
+		return result, err
+	}
+}
+
+func itemIdIf(changed bool, itemId uint) []uint {
+	if !changed {
+		return nil
+	}
+	return []uint{itemId}
+}
+
 func (r *checklistItemRepository) FindChecklistItemById(ctx context.Context, checklistId uint, id uint) (*domain.ChecklistItem, domain.Error) {
 	result, err := query.NewFindChecklistItemByIdQueryFunction(checklistId, id).GetQueryFunction(ctx)(r.conn)
 
@@ -32,14 +58,17 @@ func (r *checklistItemRepository) FindChecklistItemById(ctx context.Context, che
 }
 
 func (r *checklistItemRepository) UpdateChecklistItem(ctx context.Context, checklistId uint, checklistItem domain.ChecklistItem) (domain.ChecklistItem, domain.Error) {
+	userId, _ := domain.GetUserIdFromContext(ctx)
 	queryFunction := func(tx pool.TransactionWrapper) (bool, error) {
-		_, err := query.NewUpdateChecklistItemQueryFunction(checklistId, checklistItem).GetTransactionalQueryFunction()(tx)
+		_, err := query.NewUpdateChecklistItemQueryFunction(checklistId, checklistItem, userId).GetTransactionalQueryFunction()(tx)
 		if err != nil {
 			return false, err
 		}
-		ok, err := query.NewUpdateChecklistItemRowsQueryFunction(checklistItem.Id, checklistItem.Rows).GetTransactionalQueryFunction()(tx)
-
-		return ok, err
+		ok, err := query.NewUpdateChecklistItemRowsQueryFunction(checklistItem.Id, checklistItem.Rows, userId).GetTransactionalQueryFunction()(tx)
+		if err != nil || !ok {
+			return ok, err
+		}
+		return appendItemHistory(tx, checklistItem.Id)
 	}
 
 	res, err := connection.RunInTransaction(connection.TransactionProps[bool]{
@@ -55,17 +84,25 @@ func (r *checklistItemRepository) UpdateChecklistItem(ctx context.Context, check
 		return domain.ChecklistItem{}, domain.NewError("ChecklistItem was not found", 404)
 	}
 
+	// Re-read the item so the response carries the completion audit fields set by the database
+	if updated, findErr := r.FindChecklistItemById(ctx, checklistId, checklistItem.Id); findErr == nil && updated != nil {
+		return *updated, nil
+	}
 	return checklistItem, nil
 }
 
 func (r *checklistItemRepository) SaveChecklistItem(ctx context.Context, checklistId uint, checklistItem domain.ChecklistItem) (domain.ChecklistItem, domain.Error) {
+	userId, _ := domain.GetUserIdFromContext(ctx)
 	queryFunction := func(tx pool.TransactionWrapper) (domain.ChecklistItem, error) {
-		savedChecklistItem, err := query.NewPersistChecklistItemQueryFunction(checklistId, checklistItem).GetTransactionalQueryFunction()(tx)
+		savedChecklistItem, err := query.NewPersistChecklistItemQueryFunction(checklistId, checklistItem, userId).GetTransactionalQueryFunction()(tx)
 		if err == nil {
 			var rows []domain.ChecklistItemRow
-			rows, err = query.NewPersistChecklistItemRowsQueryFunction(savedChecklistItem.Id, checklistItem.Rows).GetTransactionalQueryFunction()(tx)
+			rows, err = query.NewPersistChecklistItemRowsQueryFunction(savedChecklistItem.Id, checklistItem.Rows, userId).GetTransactionalQueryFunction()(tx)
 			savedChecklistItem.Rows = rows
 		}
+		if err == nil {
+			_, err = appendItemHistory(tx, savedChecklistItem.Id)
+		}
 		return savedChecklistItem, err
 	}
 
@@ -75,7 +112,9 @@ func (r *checklistItemRepository) SaveChecklistItem(ctx context.Context, checkli
 		Query:      queryFunction,
 		Connection: r.conn,
 	})
-	if err != nil {
+	if errors.Is(err, pgx.ErrNoRows) && checklistItem.SectionId != nil {
+		return domain.ChecklistItem{}, domain.NewError(fmt.Sprintf("Checklist section(id=%d) not found", *checklistItem.SectionId), 404)
+	} else if err != nil {
 		return domain.ChecklistItem{}, domain.Wrap(err, "Could not save checklistItem", 500)
 	}
 
@@ -91,9 +130,10 @@ func (r *checklistItemRepository) SaveChecklistItemRow(ctx context.Context, chec
 			500)
 	}
 
-	queryFunction := func(tx pool.TransactionWrapper) ([]domain.ChecklistItemRow, error) {
-		return query.NewPersistChecklistItemRowsQueryFunction(checklistItemId, []domain.ChecklistItemRow{row}).GetTransactionalQueryFunction()(tx)
-	}
+	userId, _ := domain.GetUserIdFromContext(ctx)
+	queryFunction := withItemHistory(
+		query.NewPersistChecklistItemRowsQueryFunction(checklistItemId, []domain.ChecklistItemRow{row}, userId).GetTransactionalQueryFunction(),
+		func([]domain.ChecklistItemRow) []uint { return []uint{checklistItemId} })
 
 	res, err := connection.RunInTransaction(connection.TransactionProps[[]domain.ChecklistItemRow]{
 		Ctx:        ctx,
@@ -112,11 +152,13 @@ func (r *checklistItemRepository) SaveChecklistItemRow(ctx context.Context, chec
 }
 
 func (r *checklistItemRepository) DeleteChecklistItemById(ctx context.Context, checklistId uint, id uint) domain.Error {
+	queryFunction := withItemHistory(query.NewDeleteChecklistItemByIdQueryFunction(checklistId, id).GetTransactionalQueryFunction(),
+		func(deleted bool) []uint { return itemIdIf(deleted, id) })
 	result, err := connection.RunInTransaction(connection.TransactionProps[bool]{
 		Ctx:        ctx,
 		TxOptions:  connection.TxSerializable, // Serializable for SELECT...FOR UPDATE locking
 		Connection: r.conn,
-		Query:      query.NewDeleteChecklistItemByIdQueryFunction(checklistId, id).GetTransactionalQueryFunction(),
+		Query:      queryFunction,
 	})
 
 	if err != nil {
@@ -128,11 +170,16 @@ func (r *checklistItemRepository) DeleteChecklistItemById(ctx context.Context, c
 }
 
 func (r *checklistItemRepository) DeleteChecklistItemRowAndAutoComplete(ctx context.Context, checklistId uint, checklistItemId uint, rowId uint) (domain.ChecklistItemRowDeletionResult, domain.Error) {
+	userId, _ := domain.GetUserIdFromContext(ctx)
+	queryFunction := withItemHistory(query.NewDeleteChecklistItemRowAndAutoCompleteQueryFunction(checklistId, checklistItemId, rowId, userId).GetTransactionalQueryFunction(),
+		func(result domain.ChecklistItemRowDeletionResult) []uint {
+			return itemIdIf(result.Success, checklistItemId)
+		})
 	result, err := connection.RunInTransaction(connection.TransactionProps[domain.ChecklistItemRowDeletionResult]{
 		Ctx:        ctx,
 		TxOptions:  connection.TxSerializable, // Multi-row atomic: delete + auto-complete check
 		Connection: r.conn,
-		Query:      query.NewDeleteChecklistItemRowAndAutoCompleteQueryFunction(checklistId, checklistItemId, rowId).GetTransactionalQueryFunction(),
+		Query:      queryFunction,
 	})
 
 	if err != nil {
@@ -157,36 +204,262 @@ func (r *checklistItemRepository) FindAllChecklistItems(ctx context.Context, che
 }
 
 func (r *checklistItemRepository) ChangeChecklistItemOrder(ctx context.Context, request domain.ChangeOrderRequest) (domain.ChangeOrderResponse, domain.Error) {
+	queryFunction := withItemHistory(query.NewChangeChecklistItemOrderQueryFunction(request).GetTransactionalQueryFunction(),
+		func(domain.ChangeOrderResponse) []uint { return []uint{request.ChecklistItemId} })
 	response, err := connection.RunInTransaction(connection.TransactionProps[domain.ChangeOrderResponse]{
 		Ctx:        ctx,
 		Connection: r.conn,
-		Query:      query.NewChangeChecklistItemOrderQueryFunction(request).GetTransactionalQueryFunction(),
+		Query:      queryFunction,
 		TxOptions:  connection.TxSerializable, // Ordering requires strict consistency
 	})
 
-	if err != nil {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.ChangeOrderResponse{}, domain.NewError("Checklist item or section not found", 404)
+	} else if err != nil {
 		return domain.ChangeOrderResponse{}, domain.Wrap(err, "Error happened during changing checklist item order number", 500)
 	}
 	return response, nil
 }
 
-func (r *checklistItemRepository) ToggleItemCompleted(ctx context.Context, checklistId uint, checklistItemId uint, completed bool) (domain.ChecklistItem, domain.Error) {
-	queryFunction := query.NewToggleCompletionQueryFunction(checklistId, checklistItemId, completed)
+func (r *checklistItemRepository) ToggleItemCompleted(ctx context.Context, checklistId uint, checklistItemId uint, completed bool) (domain.ChecklistItemToggleResult, domain.Error) {
+	userId, _ := domain.GetUserIdFromContext(ctx)
+	queryFunction := withItemHistory(query.NewToggleCompletionQueryFunction(checklistId, checklistItemId, completed, userId).GetTransactionalQueryFunction(),
+		func(domain.ChecklistItemToggleResult) []uint { return []uint{checklistItemId} })
 
-	res, err := connection.RunInTransaction(connection.TransactionProps[domain.ChecklistItem]{
+	res, err := connection.RunInTransaction(connection.TransactionProps[domain.ChecklistItemToggleResult]{
 		Ctx:        ctx,
-		Query:      queryFunction.GetTransactionalQueryFunction(),
+		Query:      queryFunction,
 		TxOptions:  connection.TxReadCommitted, // Simple single-row toggle
 		Connection: r.conn,
 	})
 	if err != nil {
-		return domain.ChecklistItem{}, domain.Wrap(err, "Failed to mark item as completed", 500)
+		return domain.ChecklistItemToggleResult{}, domain.Wrap(err, "Failed to mark item as completed", 500)
 	}
 	return res, nil
 }
 
-func (r *checklistItemRepository) RebalancePositions(ctx context.Context, checklistId uint) domain.Error {
+func (r *checklistItemRepository) ApplyBatch(ctx context.Context, checklistId uint, operations []domain.ChecklistItemBatchOperation) (domain.ChecklistItemBatchResult, domain.Error) {
+	userId, _ := domain.GetUserIdFromContext(ctx)
+	queryFunction := func(tx pool.TransactionWrapper) (domain.ChecklistItemBatchResult, error) {
+		result := domain.ChecklistItemBatchResult{ChecklistId: checklistId}
+		for index, operation := range operations {
+			rebalanceNeeded, err := applyBatchOperation(tx, checklistId, operation, userId)
+			if err != nil {
+				return domain.ChecklistItemBatchResult{}, batchOperationError(index, operation, err)
+			}
+			result.RebalanceNeeded = result.RebalanceNeeded || rebalanceNeeded
+		}
+		itemIds := make([]uint, 0, len(operations))
+		for _, operation := range operations {
+			itemIds = append(itemIds, operation.ItemId)
+		}
+		_, err := appendItemHistory(tx, itemIds...)
+		return result, err
+	}
+
+	result, err := connection.RunInTransaction(connection.TransactionProps[domain.ChecklistItemBatchResult]{
+		Ctx:        ctx,
+		TxOptions:  connection.TxSerializable, // All operations are applied atomically or not at all
+		Connection: r.conn,
+		Query:      queryFunction,
+	})
+	if err != nil {
+		var domainErr domain.Error
+		if errors.As(err, &domainErr) {
+			return domain.ChecklistItemBatchResult{}, domainErr
+		}
+		return domain.ChecklistItemBatchResult{}, domain.Wrap(err, "Could not apply checklistItem batch", 500)
+	}
+
+	// Re-read the checklist so the result carries order numbers and audit fields of the final state
+	items, findErr := r.FindAllChecklistItems(ctx, checklistId, nil, domain.AscSort)
+	if findErr != nil {
+		return domain.ChecklistItemBatchResult{}, findErr
+	}
+	active := make(map[uint]domain.ChecklistItem, len(items))
+	for _, item := range items {
+		active[item.Id] = item
+	}
+	seen := make(map[uint]bool, len(operations))
+	for _, operation := range operations {
+		if seen[operation.ItemId] {
+			continue
+		}
+		seen[operation.ItemId] = true
+		if item, ok := active[operation.ItemId]; ok {
+			result.Items = append(result.Items, item)
+		} else {
+			result.DeletedItemIds = append(result.DeletedItemIds, operation.ItemId)
+		}
+	}
+	return result, nil
+}
+
+func (r *checklistItemRepository) ClearCompletedItems(ctx context.Context, checklistId uint) (domain.ChecklistItemDeletionGroup, domain.Error) {
+	userId, _ := domain.GetUserIdFromContext(ctx)
+	queryFunction := withItemHistory(query.NewClearCompletedItemsQueryFunction(checklistId, userId).GetTransactionalQueryFunction(),
+		func(group domain.ChecklistItemDeletionGroup) []uint { return group.ItemIds })
+	group, err := connection.RunInTransaction(connection.TransactionProps[domain.ChecklistItemDeletionGroup]{
+		Ctx:        ctx,
+		TxOptions:  connection.TxReadCommitted, // Single UPDATE over the checklist
+		Connection: r.conn,
+		Query:      queryFunction,
+	})
+	if err != nil {
+		return domain.ChecklistItemDeletionGroup{}, domain.Wrap(err, "Could not clear completed checklistItems", 500)
+	}
+	return group, nil
+}
+
+func (r *checklistItemRepository) RestoreDeletionGroup(ctx context.Context, checklistId uint, groupId uint) (domain.ChecklistItemBatchResult, domain.Error) {
+	queryFunction := withItemHistory(query.NewRestoreDeletionGroupQueryFunction(checklistId, groupId).GetTransactionalQueryFunction(),
+		func(itemIds []uint) []uint { return itemIds })
+	itemIds, err := connection.RunInTransaction(connection.TransactionProps[[]uint]{
+		Ctx:        ctx,
+		TxOptions:  connection.TxReadCommitted, // Single UPDATE over the group
+		Connection: r.conn,
+		Query:      queryFunction,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.ChecklistItemBatchResult{}, domain.NewError(
+			fmt.Sprintf("Deletion group %d has no items left to restore in checklist %d", groupId, checklistId), 404)
+	} else if err != nil {
+		return domain.ChecklistItemBatchResult{}, domain.Wrap(err, "Could not restore checklistItems", 500)
+	}
+	return r.findBulkResult(ctx, checklistId, itemIds)
+}
+
+func (r *checklistItemRepository) ResetChecklistItems(ctx context.Context, checklistId uint) (domain.ChecklistItemBatchResult, domain.Error) {
+	queryFunction := withItemHistory(query.NewResetChecklistItemsQueryFunction(checklistId).GetTransactionalQueryFunction(),
+		func(itemIds []uint) []uint { return itemIds })
+	itemIds, err := connection.RunInTransaction(connection.TransactionProps[[]uint]{
+		Ctx:        ctx,
+		TxOptions:  connection.TxSerializable, // Positions are computed from the rest of the checklist
+		Connection: r.conn,
+		Query:      queryFunction,
+	})
+	if err != nil {
+		return domain.ChecklistItemBatchResult{}, domain.Wrap(err, "Could not reset checklistItems", 500)
+	}
+	return r.findBulkResult(ctx, checklistId, itemIds)
+}
+
+func (r *checklistItemRepository) SortChecklistItems(ctx context.Context, request domain.ChecklistItemSortRequest) ([]domain.ChecklistItem, domain.Error) {
 	_, err := connection.RunInTransaction(connection.TransactionProps[bool]{
+		Ctx:        ctx,
+		TxOptions:  connection.TxSerializable, // Every position of the checklist is rewritten
+		Connection: r.conn,
+		Query:      query.NewSortChecklistItemsQueryFunction(request).GetTransactionalQueryFunction(),
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.NewError(fmt.Sprintf("Checklist(id=%d) not found", request.ChecklistId), 404)
+	} else if err != nil {
+		return nil, domain.Wrap(err, "Could not sort checklistItems", 500)
+	}
+	return r.FindAllChecklistItems(ctx, request.ChecklistId, nil, domain.AscSort)
+}
+
+// findBulkResult re-reads the checklist after a bulk change and returns the final state of the given items
+func (r *checklistItemRepository) findBulkResult(ctx context.Context, checklistId uint, itemIds []uint) (domain.ChecklistItemBatchResult, domain.Error) {
+	items, err := r.FindAllChecklistItems(ctx, checklistId, nil, domain.AscSort)
+	if err != nil {
+		return domain.ChecklistItemBatchResult{}, err
+	}
+	touched := make(map[uint]bool, len(itemIds))
+	for _, itemId := range itemIds {
+		touched[itemId] = true
+	}
+	result := domain.ChecklistItemBatchResult{ChecklistId: checklistId, Items: []domain.ChecklistItem{}, DeletedItemIds: []uint{}}
+	for _, item := range items {
+		if touched[item.Id] {
+			result.Items = append(result.Items, item)
+		}
+	}
+	return result, nil
+}
+
+// applyBatchOperation runs a single batch operation inside the surrounding transaction.
+// Returns whether positions of the checklist need rebalancing afterwards.
+func applyBatchOperation(tx pool.TransactionWrapper, checklistId uint, operation domain.ChecklistItemBatchOperation, userId string) (bool, error) {
+	switch operation.Type {
+	case domain.BatchOperationToggle:
+		_, err := query.NewToggleCompletionQueryFunction(checklistId, operation.ItemId, *operation.Completed, userId).GetTransactionalQueryFunction()(tx)
+		return false, err
+	case domain.BatchOperationRename:
+		ok, err := query.NewRenameChecklistItemQueryFunction(checklistId, operation.ItemId, *operation.Name).GetTransactionalQueryFunction()(tx)
+		if err == nil && !ok {
+			err = pgx.ErrNoRows
+		}
+		return false, err
+	case domain.BatchOperationDelete:
+		ok, err := query.NewDeleteChecklistItemByIdQueryFunction(checklistId, operation.ItemId).GetTransactionalQueryFunction()(tx)
+		if err == nil && !ok {
+			err = pgx.ErrNoRows
+		}
+		return false, err
+	case domain.BatchOperationRestore:
+		_, err := query.NewRestoreChecklistItemQueryFunction(checklistId, operation.ItemId).GetTransactionalQueryFunction()(tx)
+		return false, err
+	case domain.BatchOperationMove:
+		response, err := query.NewChangeChecklistItemOrderQueryFunction(domain.ChangeOrderRequest{
+			NewOrderNumber:  *operation.OrderNumber,
+			ChecklistId:     checklistId,
+			ChecklistItemId: operation.ItemId,
+			SortOrder:       domain.AscSort,
+		}).GetTransactionalQueryFunction()(tx)
+		return response.RebalanceNeeded, err
+	default:
+		return false, fmt.Errorf("unsupported batch operation type %s", operation.Type)
+	}
+}
+
+func batchOperationError(index int, operation domain.ChecklistItemBatchOperation, err error) domain.Error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.NewError(fmt.Sprintf("operation %d: checklistItem(checklistItemId=%d) was not found", index, operation.ItemId), 404)
+	}
+	return domain.Wrap(err, fmt.Sprintf("operation %d: could not apply %s to checklistItem(checklistItemId=%d)", index, operation.Type, operation.ItemId), 500)
+}
+
+func (r *checklistItemRepository) MoveChecklistItem(ctx context.Context, request domain.ChecklistItemTransferRequest) (domain.ChecklistItemTransferResult, domain.Error) {
+	return r.transferChecklistItem(ctx, request, query.NewMoveChecklistItemQueryFunction(request))
+}
+
+func (r *checklistItemRepository) CopyChecklistItem(ctx context.Context, request domain.ChecklistItemTransferRequest) (domain.ChecklistItemTransferResult, domain.Error) {
+	return r.transferChecklistItem(ctx, request, query.NewCopyChecklistItemQueryFunction(request))
+}
+
+func (r *checklistItemRepository) transferChecklistItem(ctx context.Context, request domain.ChecklistItemTransferRequest, transferQuery query.TransactionalQuery[domain.ChecklistItemTransferResult]) (domain.ChecklistItemTransferResult, domain.Error) {
+	queryFunction := withItemHistory(transferQuery.GetTransactionalQueryFunction(),
+		func(result domain.ChecklistItemTransferResult) []uint { return []uint{result.Item.Id} })
+	result, err := connection.RunInTransaction(connection.TransactionProps[domain.ChecklistItemTransferResult]{
+		Ctx:        ctx,
+		TxOptions:  connection.TxSerializable, // Positions of the target checklist require strict consistency
+		Connection: r.conn,
+		Query:      queryFunction,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.ChecklistItemTransferResult{}, domain.NewError(
+			fmt.Sprintf("ChecklistItem(checklistId=%d, checklistItemId=%d) was not found", request.SourceChecklistId, request.ChecklistItemId), 404)
+	} else if err != nil {
+		return domain.ChecklistItemTransferResult{}, domain.Wrap(err, "Could not transfer checklistItem", 500)
+	}
+
+	// Re-read the target checklist so the item carries its rows and order number
+	items, findErr := r.FindAllChecklistItems(ctx, request.TargetChecklistId, nil, domain.AscSort)
+	if findErr != nil {
+		return domain.ChecklistItemTransferResult{}, findErr
+	}
+	for _, item := range items {
+		if item.Id == result.Item.Id {
+			result.Item = item
+			break
+		}
+	}
+	return result, nil
+}
+
+func (r *checklistItemRepository) RebalancePositions(ctx context.Context, checklistId uint) (map[uint]string, bool, domain.Error) {
+	positions, err := connection.RunInTransaction(connection.TransactionProps[map[uint]string]{
 		Ctx:        ctx,
 		Connection: r.conn,
 		Query:      query.NewRebalancePositionsQueryFunction(checklistId).GetTransactionalQueryFunction(),
@@ -194,17 +467,48 @@ func (r *checklistItemRepository) RebalancePositions(ctx context.Context, checkl
 	})
 
 	if err != nil {
-		return domain.Wrap(err, "Error happened during rebalancing positions", 500)
+		return nil, false, domain.Wrap(err, "Error happened during rebalancing positions", 500)
+	}
+	return positions, positions != nil, nil
+}
+
+func (r *checklistItemRepository) ScheduleRebalance(ctx context.Context, checklistId uint, debounce time.Duration, maxWait time.Duration) domain.Error {
+	_, err := connection.RunInTransaction(connection.TransactionProps[bool]{
+		Ctx:        ctx,
+		TxOptions:  connection.TxReadCommitted, // Single upsert
+		Connection: r.conn,
+		Query:      query.NewScheduleRebalanceQueryFunction(checklistId, debounce, maxWait).GetTransactionalQueryFunction(),
+	})
+	if err != nil {
+		return domain.Wrap(err, fmt.Sprintf("Could not schedule rebalance of checklist %d", checklistId), 500)
 	}
 	return nil
 }
 
+func (r *checklistItemRepository) FindDueRebalances(ctx context.Context) ([]uint, domain.Error) {
+	rows, err := r.conn.Query(ctx, `
+		SELECT CHECKLIST_ID FROM CHECKLIST_REBALANCE
+		WHERE RUN_AFTER <= CURRENT_TIMESTAMP
+		ORDER BY RUN_AFTER ASC
+		LIMIT 100`)
+	if err != nil {
+		return nil, domain.Wrap(err, "Could not find due rebalances", 500)
+	}
+	checklistIds, err := pgx.CollectRows(rows, pgx.RowTo[uint])
+	if err != nil {
+		return nil, domain.Wrap(err, "Could not scan due rebalances", 500)
+	}
+	return checklistIds, nil
+}
+
 func (r *checklistItemRepository) RestoreChecklistItem(ctx context.Context, checklistId uint, itemId uint) (domain.ChecklistItem, domain.Error) {
+	queryFunction := withItemHistory(query.NewRestoreChecklistItemQueryFunction(checklistId, itemId).GetTransactionalQueryFunction(),
+		func(dbo.ChecklistItemDbo) []uint { return []uint{itemId} })
 	result, err := connection.RunInTransaction(connection.TransactionProps[dbo.ChecklistItemDbo]{
 		Ctx:        ctx,
 		TxOptions:  connection.TxSerializable, // Serializable for SELECT...FOR UPDATE locking
 		Connection: r.conn,
-		Query:      query.NewRestoreChecklistItemQueryFunction(checklistId, itemId).GetTransactionalQueryFunction(),
+		Query:      queryFunction,
 	})
 
 	if err != nil {
@@ -9,6 +9,7 @@ import (
 	"com.raunlo.checklist/internal/core/domain"
 	"com.raunlo.checklist/internal/repository/connection"
 	"com.raunlo.checklist/internal/repository/dbo"
+	"com.raunlo.checklist/internal/repository/query"
 	"github.com/jackc/pgx/v5"
 	"github.com/pkg/errors"
 	"github.com/raunlo/pgx-with-automapper/mapper"
@@ -20,22 +21,30 @@ type checklistRepository struct {
 }
 
 func (repository *checklistRepository) UpdateChecklist(ctx context.Context, checklist domain.Checklist) (domain.Checklist, domain.Error) {
+	var currentMode domain.ChecklistOrderingMode
 	queryFunc := func(tx pool.TransactionWrapper) (bool, error) {
-		query := `UPDATE checklist
+		updateQuery := `UPDATE checklist
 				  SET NAME = @checklist_name, workspace_id = @workspace_id
-				  WHERE ID = @checklist_id`
-		succeeded, err := tx.Exec(ctx, query, pgx.NamedArgs{
+				  WHERE ID = @checklist_id
+				  RETURNING ORDERING_MODE`
+		err := tx.QueryRow(ctx, updateQuery, pgx.NamedArgs{
 			"checklist_name": checklist.Name,
 			"workspace_id":   checklist.WorkspaceId,
 			"checklist_id":   checklist.Id,
-		})
+		}).Scan(&currentMode)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		} else if err != nil || checklist.OrderingMode == "" || checklist.OrderingMode == currentMode {
+			return err == nil, err
+		}
 
-		return succeeded.RowsAffected() == 1, err
+		// Switching the ordering mode may renumber item positions, so it runs in the same transaction
+		return query.NewChangeOrderingModeQueryFunction(checklist.Id, checklist.OrderingMode).GetTransactionalQueryFunction()(tx)
 	}
 	res, err := connection.RunInTransaction(connection.TransactionProps[bool]{
 		Ctx:        ctx,
 		Query:      queryFunc,
-		TxOptions:  connection.TxReadCommitted, // Simple single-row update
+		TxOptions:  connection.TxReadCommitted, // Single-row update; a mode switch renumbers items under row locks
 		Connection: repository.connection,
 	})
 
@@ -46,6 +55,9 @@ func (repository *checklistRepository) UpdateChecklist(ctx context.Context, chec
 			fmt.Sprintf("Could not update checklist(id=%d) because it was non-existant", checklist.Id),
 			500)
 	} else {
+		if checklist.OrderingMode == "" {
+			checklist.OrderingMode = currentMode
+		}
 		return checklist, nil
 	}
 }
@@ -57,12 +69,16 @@ func (repository *checklistRepository) SaveChecklist(ctx context.Context, checkl
 	}
 
 	queryFunc := func(tx pool.TransactionWrapper) (domain.Checklist, error) {
-		query := `INSERT INTO checklist(ID, NAME, OWNER, workspace_id)
-				  VALUES (nextval('checklist_id_sequence'), @checklist_name, @owner, @workspace_id) RETURNING ID`
+		if checklist.OrderingMode == "" {
+			checklist.OrderingMode = domain.OrderingModeSinkCompleted
+		}
+		query := `INSERT INTO checklist(ID, NAME, OWNER, workspace_id, ORDERING_MODE)
+				  VALUES (nextval('checklist_id_sequence'), @checklist_name, @owner, @workspace_id, @ordering_mode) RETURNING ID`
 		row := tx.QueryRow(ctx, query, pgx.NamedArgs{
 			"checklist_name": checklist.Name,
 			"owner":          owner,
 			"workspace_id":   checklist.WorkspaceId,
+			"ordering_mode":  string(checklist.OrderingMode),
 		})
 
 		err := row.Scan(&checklist.Id)
@@ -83,8 +99,116 @@ func (repository *checklistRepository) SaveChecklist(ctx context.Context, checkl
 	}
 }
 
+func (repository *checklistRepository) CloneChecklist(ctx context.Context, sourceChecklistId uint, name string, options domain.ChecklistCloneOptions) (domain.Checklist, domain.Error) {
+	owner, userIdError := domain.GetUserIdFromContext(ctx)
+	if userIdError != nil {
+		return domain.Checklist{}, userIdError
+	}
+
+	checklist, err := connection.RunInTransaction(connection.TransactionProps[domain.Checklist]{
+		Ctx:        ctx,
+		Query:      query.NewCloneChecklistQueryFunction(sourceChecklistId, name, owner, options).GetTransactionalQueryFunction(),
+		Connection: repository.connection,
+		TxOptions:  connection.TxReadCommitted, // Inserts only; the source checklist is read once
+	})
+	if err != nil {
+		return domain.Checklist{}, domain.Wrap(err, fmt.Sprintf("Could not clone checklist(id=%d)", sourceChecklistId), 500)
+	}
+
+	return checklist, nil
+}
+
+func (repository *checklistRepository) MergeChecklists(ctx context.Context, targetChecklistId uint, sourceChecklistId uint) (domain.ChecklistMergeResult, domain.Error) {
+	userId, userIdError := domain.GetUserIdFromContext(ctx)
+	if userIdError != nil {
+		return domain.ChecklistMergeResult{}, userIdError
+	}
+
+	touchedItemIds, err := connection.RunInTransaction(connection.TransactionProps[[]uint]{
+		Ctx:        ctx,
+		Query:      query.NewMergeChecklistsQueryFunction(targetChecklistId, sourceChecklistId, userId).GetTransactionalQueryFunction(),
+		Connection: repository.connection,
+		TxOptions:  connection.TxSerializable, // Reads and rewrites items of two checklists
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.ChecklistMergeResult{}, domain.NewError(
+			fmt.Sprintf("Could not merge checklist(id=%d) into checklist(id=%d): checklist not found or archived", sourceChecklistId, targetChecklistId), 404)
+	} else if err != nil {
+		return domain.ChecklistMergeResult{}, domain.Wrap(err,
+			fmt.Sprintf("Could not merge checklist(id=%d) into checklist(id=%d)", sourceChecklistId, targetChecklistId), 500)
+	}
+
+	items, domainErr := repository.findChecklistItems(ctx, targetChecklistId, touchedItemIds)
+	if domainErr != nil {
+		return domain.ChecklistMergeResult{}, domainErr
+	}
+	return domain.ChecklistMergeResult{
+		TargetChecklistId: targetChecklistId,
+		SourceChecklistId: sourceChecklistId,
+		Items:             items,
+	}, nil
+}
+
+func (repository *checklistRepository) SplitChecklist(ctx context.Context, sourceChecklistId uint, request domain.ChecklistSplitRequest) (domain.ChecklistSplitResult, domain.Error) {
+	owner, userIdError := domain.GetUserIdFromContext(ctx)
+	if userIdError != nil {
+		return domain.ChecklistSplitResult{}, userIdError
+	}
+
+	checklist, err := connection.RunInTransaction(connection.TransactionProps[domain.Checklist]{
+		Ctx:        ctx,
+		Query:      query.NewSplitChecklistQueryFunction(sourceChecklistId, request, owner).GetTransactionalQueryFunction(),
+		Connection: repository.connection,
+		TxOptions:  connection.TxReadCommitted, // Selected items are locked before they are moved
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.ChecklistSplitResult{}, domain.NewError(
+			fmt.Sprintf("Could not split checklist(id=%d): some of the selected items were not found", sourceChecklistId), 404)
+	} else if err != nil {
+		return domain.ChecklistSplitResult{}, domain.Wrap(err, fmt.Sprintf("Could not split checklist(id=%d)", sourceChecklistId), 500)
+	}
+
+	items, domainErr := repository.findChecklistItems(ctx, checklist.Id, nil)
+	if domainErr != nil {
+		return domain.ChecklistSplitResult{}, domainErr
+	}
+	checklist.Stats.TotalItems = uint(len(items))
+	for _, item := range items {
+		if item.Completed {
+			checklist.Stats.CompletedItems++
+		}
+	}
+	return domain.ChecklistSplitResult{
+		SourceChecklistId: sourceChecklistId,
+		Checklist:         checklist,
+		Items:             items,
+	}, nil
+}
+
+// findChecklistItems reads the active items of a checklist after a committed change. When itemIds is
+// given only those items are returned, in display order.
+func (repository *checklistRepository) findChecklistItems(ctx context.Context, checklistId uint, itemIds []uint) ([]domain.ChecklistItem, domain.Error) {
+	itemDbos, err := query.NewGetAllChecklistItemsWithRowsQueryFunction(checklistId, nil, domain.AscSort).
+		GetQueryFunction(ctx)(repository.connection)
+	if err != nil {
+		return nil, domain.Wrap(err, fmt.Sprintf("Failed to read items of checklist(id=%d)", checklistId), 500)
+	}
+
+	wanted := make(map[uint]bool, len(itemIds))
+	for _, itemId := range itemIds {
+		wanted[itemId] = true
+	}
+	items := make([]domain.ChecklistItem, 0, len(itemDbos))
+	for _, itemDbo := range itemDbos {
+		if itemIds == nil || wanted[itemDbo.Id] {
+			items = append(items, dbo.MapChecklistItemDboToDomain(itemDbo))
+		}
+	}
+	return items, nil
+}
+
 func (repository *checklistRepository) FindChecklistById(ctx context.Context, id uint) (*domain.Checklist, domain.Error) {
-	const query = "SELECT id, name FROM checklist where ID = @checklist_id"
+	const query = "SELECT id, name, workspace_id, ordering_mode, archived_at FROM checklist where ID = @checklist_id"
 	var checklistDbo dbo.ChecklistDbo
 	err := repository.connection.QueryOne(ctx, query, &checklistDbo, pgx.NamedArgs{
 		"checklist_id": id,
@@ -157,6 +281,7 @@ func (repository *checklistRepository) FindAllChecklists(ctx context.Context) ([
 			c.NAME as name,
 			c.OWNER as owner,
 			c.workspace_id as workspace_id,
+			c.ORDERING_MODE as ordering_mode,
 			COALESCE(COUNT(ci.checklist_item_id), 0) as total_items,
 			COALESCE(COUNT(ci.checklist_item_id) FILTER (WHERE ci.checklist_item_completed = true), 0) as completed_items,
 			COALESCE(ARRAY_AGG(DISTINCT cs.SHARED_WITH_USER_ID) FILTER (WHERE cs.SHARED_WITH_USER_ID IS NOT NULL), ARRAY[]::VARCHAR[]) as shared_with,
@@ -165,7 +290,8 @@ func (repository *checklistRepository) FindAllChecklists(ctx context.Context) ([
 		JOIN CHECKLIST c ON c.ID = uc.id
 		LEFT JOIN CHECKLIST_SHARE cs ON c.ID = cs.CHECKLIST_ID
 		LEFT JOIN CHECKLIST_ITEM ci ON c.ID = ci.CHECKLIST_ID
-		GROUP BY c.ID, c.NAME, c.OWNER, c.workspace_id
+		WHERE c.ARCHIVED_AT IS NULL
+		GROUP BY c.ID, c.NAME, c.OWNER, c.workspace_id, c.ORDERING_MODE
 		ORDER BY last_activity DESC NULLS LAST, c.ID DESC
 	`
 
@@ -188,12 +314,13 @@ func (repository *checklistRepository) FindAllChecklists(ctx context.Context) ([
 		var name string
 		var owner string
 		var workspaceId *uint
+		var orderingMode domain.ChecklistOrderingMode
 		var totalItems int64
 		var completedItems int64
 		var sharedWith []string
 		var lastActivity any // Can be NULL for checklists with no items, only used for sorting
 
-		err := rows.Scan(&id, &name, &owner, &workspaceId, &totalItems, &completedItems, &sharedWith, &lastActivity)
+		err := rows.Scan(&id, &name, &owner, &workspaceId, &orderingMode, &totalItems, &completedItems, &sharedWith, &lastActivity)
 		if err != nil {
 			return nil, domain.Wrap(err, "Failed to scan checklist row", 500)
 		}
@@ -207,11 +334,12 @@ func (repository *checklistRepository) FindAllChecklists(ctx context.Context) ([
 		}
 
 		checklist := domain.Checklist{
-			Id:          id,
-			Name:        name,
-			Owner:       owner,
-			WorkspaceId: workspaceId,
-			SharedWith:  sharedWith,
+			Id:           id,
+			Name:         name,
+			Owner:        owner,
+			WorkspaceId:  workspaceId,
+			OrderingMode: orderingMode,
+			SharedWith:   sharedWith,
 			Stats: domain.ChecklistStats{
 				TotalItems:     uint(totalItems),
 				CompletedItems: uint(completedItems),
@@ -382,14 +510,15 @@ func (repository *checklistRepository) FindChecklistsByWorkspaceId(ctx context.C
 			c.ID as id,
 			c.NAME as name,
 			c.OWNER as owner,
+			c.ORDERING_MODE as ordering_mode,
 			COALESCE(COUNT(ci.checklist_item_id), 0) as total_items,
 			COALESCE(COUNT(ci.checklist_item_id) FILTER (WHERE ci.checklist_item_completed = true), 0) as completed_items,
 			COALESCE(ARRAY_AGG(DISTINCT cs.SHARED_WITH_USER_ID) FILTER (WHERE cs.SHARED_WITH_USER_ID IS NOT NULL), ARRAY[]::VARCHAR[]) as shared_with
 		FROM CHECKLIST c
 		LEFT JOIN CHECKLIST_SHARE cs ON c.ID = cs.CHECKLIST_ID
 		LEFT JOIN CHECKLIST_ITEM ci ON c.ID = ci.CHECKLIST_ID
-		WHERE c.workspace_id = @workspaceId
-		GROUP BY c.ID, c.NAME, c.OWNER
+		WHERE c.workspace_id = @workspaceId AND c.ARCHIVED_AT IS NULL
+		GROUP BY c.ID, c.NAME, c.OWNER, c.ORDERING_MODE
 		ORDER BY c.ID DESC
 	`
 
@@ -406,21 +535,23 @@ func (repository *checklistRepository) FindChecklistsByWorkspaceId(ctx context.C
 		var id uint
 		var name string
 		var owner string
+		var orderingMode domain.ChecklistOrderingMode
 		var totalItems int64
 		var completedItems int64
 		var sharedWith []string
 
-		if err := rows.Scan(&id, &name, &owner, &totalItems, &completedItems, &sharedWith); err != nil {
+		if err := rows.Scan(&id, &name, &owner, &orderingMode, &totalItems, &completedItems, &sharedWith); err != nil {
 			return nil, domain.Wrap(err, "Failed to scan workspace checklist row", 500)
 		}
 
 		wsId := workspaceId
 		checklists = append(checklists, domain.Checklist{
-			Id:          id,
-			Name:        name,
-			Owner:       owner,
-			WorkspaceId: &wsId,
-			SharedWith:  sharedWith,
+			Id:           id,
+			Name:         name,
+			Owner:        owner,
+			WorkspaceId:  &wsId,
+			OrderingMode: orderingMode,
+			SharedWith:   sharedWith,
 			Stats: domain.ChecklistStats{
 				TotalItems:     uint(totalItems),
 				CompletedItems: uint(completedItems),
@@ -437,3 +568,113 @@ func (repository *checklistRepository) FindChecklistsByWorkspaceId(ctx context.C
 	}
 	return checklists, nil
 }
+
+func (repository *checklistRepository) FindAccessibleChecklistIds(ctx context.Context, userId string) ([]uint, domain.Error) {
+	query := `
+		SELECT c.ID FROM CHECKLIST c
+		WHERE c.OWNER = @user_id AND c.ARCHIVED_AT IS NULL
+
+		UNION
+
+		SELECT c.ID FROM CHECKLIST c
+		JOIN CHECKLIST_SHARE cs ON cs.CHECKLIST_ID = c.ID
+		WHERE cs.SHARED_WITH_USER_ID = @user_id AND c.ARCHIVED_AT IS NULL
+
+		UNION
+
+		SELECT c.ID FROM CHECKLIST c
+		JOIN workspace_member wm ON c.workspace_id = wm.workspace_id
+		WHERE wm.user_id = @user_id AND c.ARCHIVED_AT IS NULL
+	`
+
+	rows, err := repository.connection.Query(ctx, query, pgx.NamedArgs{"user_id": userId})
+	if err != nil {
+		return nil, domain.Wrap(err, "Failed to query accessible checklists", 500)
+	}
+	checklistIds, err := pgx.CollectRows(rows, pgx.RowTo[uint])
+	if err != nil {
+		return nil, domain.Wrap(err, "Failed to scan accessible checklists", 500)
+	}
+	return checklistIds, nil
+}
+
+func (repository *checklistRepository) FindUserIdsWithAccess(ctx context.Context, checklistId uint) ([]string, domain.Error) {
+	query := `
+		SELECT c.OWNER FROM CHECKLIST c
+		WHERE c.ID = @checklist_id
+
+		UNION
+
+		SELECT cs.SHARED_WITH_USER_ID FROM CHECKLIST_SHARE cs
+		WHERE cs.CHECKLIST_ID = @checklist_id
+
+		UNION
+
+		SELECT wm.user_id FROM CHECKLIST c
+		JOIN workspace_member wm ON c.workspace_id = wm.workspace_id
+		WHERE c.ID = @checklist_id
+	`
+
+	rows, err := repository.connection.Query(ctx, query, pgx.NamedArgs{"checklist_id": checklistId})
+	if err != nil {
+		return nil, domain.Wrap(err, fmt.Sprintf("Failed to query users with access to checklist %d", checklistId), 500)
+	}
+	userIds, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, domain.Wrap(err, fmt.Sprintf("Failed to scan users with access to checklist %d", checklistId), 500)
+	}
+	return userIds, nil
+}
+
+func (repository *checklistRepository) FindChecklistStats(ctx context.Context, checklistId uint) (domain.ChecklistStats, domain.Error) {
+	query := `
+		SELECT
+			COUNT(*) as total_items,
+			COUNT(*) FILTER (WHERE ci.checklist_item_completed = true) as completed_items
+		FROM CHECKLIST_ITEM ci
+		WHERE ci.CHECKLIST_ID = @checklist_id AND ci.DELETED_AT IS NULL
+	`
+
+	var totalItems, completedItems int64
+	err := repository.connection.QueryRow(ctx, query, pgx.NamedArgs{"checklist_id": checklistId}).Scan(&totalItems, &completedItems)
+	if err != nil {
+		return domain.ChecklistStats{}, domain.Wrap(err, fmt.Sprintf("Failed to count items of checklist %d", checklistId), 500)
+	}
+	return domain.ChecklistStats{
+		TotalItems:     uint(totalItems),
+		CompletedItems: uint(completedItems),
+	}, nil
+}
+
+func (repository *checklistRepository) FindChecklistItemOrder(ctx context.Context, checklistId uint) ([]domain.ChecklistItemOrder, domain.Error) {
+	query := `
+		SELECT
+			ci.CHECKLIST_ITEM_ID,
+			ci.SECTION_ID,
+			ROW_NUMBER() OVER (
+				PARTITION BY ci.SECTION_ID
+				ORDER BY CASE WHEN c.ORDERING_MODE = 'KEEP_IN_PLACE' THEN FALSE ELSE ci.CHECKLIST_ITEM_COMPLETED END ASC, ci.POSITION ASC
+			) AS ORDER_NUMBER
+		FROM CHECKLIST_ITEM ci
+		JOIN CHECKLIST c ON c.ID = ci.CHECKLIST_ID
+		LEFT JOIN CHECKLIST_SECTION s ON s.ID = ci.SECTION_ID
+		WHERE ci.CHECKLIST_ID = @checklist_id AND ci.DELETED_AT IS NULL
+		ORDER BY s.POSITION ASC NULLS FIRST, s.ID ASC NULLS FIRST, ORDER_NUMBER ASC
+	`
+
+	rows, err := repository.connection.Query(ctx, query, pgx.NamedArgs{"checklist_id": checklistId})
+	if err != nil {
+		return nil, domain.Wrap(err, fmt.Sprintf("Failed to query item order of checklist %d", checklistId), 500)
+	}
+	items, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (domain.ChecklistItemOrder, error) {
+		var item domain.ChecklistItemOrder
+		var orderNumber int64
+		err := row.Scan(&item.ItemId, &item.SectionId, &orderNumber)
+		item.OrderNumber = uint(orderNumber)
+		return item, err
+	})
+	if err != nil {
+		return nil, domain.Wrap(err, fmt.Sprintf("Failed to scan item order of checklist %d", checklistId), 500)
+	}
+	return items, nil
+}
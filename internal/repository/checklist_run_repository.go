@@ -0,0 +1,302 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"com.raunlo.checklist/internal/core/domain"
+	coreRepo "com.raunlo.checklist/internal/core/repository"
+	"com.raunlo.checklist/internal/repository/connection"
+	"com.raunlo.checklist/internal/repository/dbo"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+	"github.com/raunlo/pgx-with-automapper/mapper"
+	"github.com/raunlo/pgx-with-automapper/pool"
+)
+
+const checklistRunColumns = `ID, CHECKLIST_ID, STARTED_BY, STARTED_AT, FINISHED_BY, FINISHED_AT, DURATION_SECONDS, COMPLETION_PERCENTAGE`
+
+const runStepsQuery = `SELECT s.ID AS step_id, s.SOURCE_ITEM_ID, s.NAME AS step_name, s.ORDER_NUMBER,
+        s.STATUS AS step_status, s.CHECKED_BY AS step_checked_by, s.CHECKED_AT AS step_checked_at,
+        r.ID AS row_id, r.SOURCE_ROW_ID, r.NAME AS row_name,
+        r.STATUS AS row_status, r.CHECKED_BY AS row_checked_by, r.CHECKED_AT AS row_checked_at
+ FROM CHECKLIST_RUN_STEP s
+ LEFT JOIN CHECKLIST_RUN_STEP_ROW r ON r.STEP_ID = s.ID
+ WHERE s.RUN_ID = @runId
+ ORDER BY s.ORDER_NUMBER ASC, r.ID ASC`
+
+type checklistRunRepository struct {
+	connection pool.Conn
+}
+
+// errRunFinished is returned from a transaction that found the run already finished
+var errRunFinished = errors.New("run is finished")
+
+// lockActiveRun locks the run row until the transaction ends, so a run cannot be finished while a step is updated
+func lockActiveRun(ctx context.Context, tx pool.TransactionWrapper, runId uint) error {
+	var finished bool
+	err := tx.QueryRow(ctx,
+		`SELECT FINISHED_AT IS NOT NULL FROM CHECKLIST_RUN WHERE ID = @runId FOR UPDATE`,
+		pgx.NamedArgs{"runId": runId},
+	).Scan(&finished)
+	if err == nil && finished {
+		return errRunFinished
+	}
+	return err
+}
+
+func (r *checklistRunRepository) CreateRunFromChecklist(ctx context.Context, checklistId uint, startedBy string) (domain.ChecklistRun, domain.Error) {
+	queryFunc := func(tx pool.TransactionWrapper) (dbo.ChecklistRunDBO, error) {
+		var d dbo.ChecklistRunDBO
+		err := tx.QueryRow(ctx,
+			`INSERT INTO CHECKLIST_RUN(CHECKLIST_ID, STARTED_BY, STARTED_AT)
+			 VALUES(@checklistId, @startedBy, CURRENT_TIMESTAMP)
+			 RETURNING `+checklistRunColumns,
+			pgx.NamedArgs{"checklistId": checklistId, "startedBy": startedBy},
+		).Scan(&d.Id, &d.ChecklistId, &d.StartedBy, &d.StartedAt, &d.FinishedBy, &d.FinishedAt, &d.DurationSeconds, &d.CompletionPercentage)
+		if err != nil {
+			return d, err
+		}
+
+		// Snapshot items in the order the user currently sees them; every step starts as pending
+		_, err = tx.Exec(ctx,
+			`INSERT INTO CHECKLIST_RUN_STEP(RUN_ID, SOURCE_ITEM_ID, NAME, ORDER_NUMBER)
+			 SELECT @runId, ci.CHECKLIST_ITEM_ID, ci.CHECKLIST_ITEM_NAME,
+			        ROW_NUMBER() OVER (ORDER BY s.POSITION ASC NULLS FIRST, s.ID ASC NULLS FIRST,
+			                                    CASE WHEN c.ORDERING_MODE = 'KEEP_IN_PLACE' THEN FALSE ELSE ci.CHECKLIST_ITEM_COMPLETED END ASC,
+			                                    ci.POSITION ASC)
+			 FROM CHECKLIST_ITEM ci
+			 JOIN CHECKLIST c ON c.ID = ci.CHECKLIST_ID
+			 LEFT JOIN CHECKLIST_SECTION s ON s.ID = ci.SECTION_ID
+			 WHERE ci.CHECKLIST_ID = @checklistId AND ci.DELETED_AT IS NULL`,
+			pgx.NamedArgs{"runId": d.Id, "checklistId": checklistId})
+		if err != nil {
+			return d, err
+		}
+
+		_, err = tx.Exec(ctx,
+			`INSERT INTO CHECKLIST_RUN_STEP_ROW(STEP_ID, SOURCE_ROW_ID, NAME)
+			 SELECT s.ID, r.CHECKLIST_ITEM_ROW_ID, r.CHECKLIST_ITEM_ROW_NAME
+			 FROM CHECKLIST_RUN_STEP s
+			 JOIN CHECKLIST_ITEM_ROW r ON r.CHECKLIST_ITEM_ID = s.SOURCE_ITEM_ID
+			 WHERE s.RUN_ID = @runId
+			 ORDER BY s.ORDER_NUMBER ASC, r.CHECKLIST_ITEM_ROW_POSITION ASC`,
+			pgx.NamedArgs{"runId": d.Id})
+		return d, err
+	}
+
+	res, err := connection.RunInTransaction(connection.TransactionProps[dbo.ChecklistRunDBO]{
+		Ctx:        ctx,
+		Query:      queryFunc,
+		Connection: r.connection,
+		TxOptions:  connection.TxReadCommitted,
+	})
+	if err != nil {
+		return domain.ChecklistRun{}, domain.Wrap(err, fmt.Sprintf("Could not start run for checklist(id=%d)", checklistId), 500)
+	}
+
+	run := res.ToDomain()
+	steps, stepsErr := r.findRunSteps(ctx, run.Id)
+	if stepsErr != nil {
+		return domain.ChecklistRun{}, stepsErr
+	}
+	run.Steps = steps
+	return run, nil
+}
+
+func (r *checklistRunRepository) FindRunsByChecklistId(ctx context.Context, checklistId uint) ([]domain.ChecklistRun, domain.Error) {
+	var dbos []dbo.ChecklistRunDBO
+	err := r.connection.QueryList(ctx,
+		`SELECT `+checklistRunColumns+`
+		 FROM CHECKLIST_RUN
+		 WHERE CHECKLIST_ID = @checklistId
+		 ORDER BY STARTED_AT DESC, ID DESC`,
+		&dbos,
+		pgx.NamedArgs{"checklistId": checklistId},
+	)
+	if err != nil {
+		return nil, domain.Wrap(err, fmt.Sprintf("Failed to find runs for checklist(id=%d)", checklistId), 500)
+	}
+
+	result := make([]domain.ChecklistRun, 0, len(dbos))
+	for _, d := range dbos {
+		result = append(result, d.ToDomain())
+	}
+	return result, nil
+}
+
+func (r *checklistRunRepository) FindRunById(ctx context.Context, checklistId uint, runId uint) (*domain.ChecklistRun, domain.Error) {
+	var d dbo.ChecklistRunDBO
+	err := r.connection.QueryOne(ctx,
+		`SELECT `+checklistRunColumns+`
+		 FROM CHECKLIST_RUN
+		 WHERE ID = @runId AND CHECKLIST_ID = @checklistId`,
+		&d,
+		pgx.NamedArgs{"runId": runId, "checklistId": checklistId},
+	)
+	if errors.Is(err, mapper.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, domain.Wrap(err, fmt.Sprintf("Failed to find run(id=%d)", runId), 500)
+	}
+
+	run := d.ToDomain()
+	steps, stepsErr := r.findRunSteps(ctx, run.Id)
+	if stepsErr != nil {
+		return nil, stepsErr
+	}
+	run.Steps = steps
+	return &run, nil
+}
+
+func (r *checklistRunRepository) findRunSteps(ctx context.Context, runId uint) ([]domain.ChecklistRunStep, domain.Error) {
+	var dbos []dbo.ChecklistRunStepDBO
+	err := r.connection.QueryList(ctx, runStepsQuery, &dbos, pgx.NamedArgs{"runId": runId})
+	if err != nil {
+		return nil, domain.Wrap(err, fmt.Sprintf("Failed to find steps for run(id=%d)", runId), 500)
+	}
+	return mapRunSteps(dbos), nil
+}
+
+func mapRunSteps(dbos []dbo.ChecklistRunStepDBO) []domain.ChecklistRunStep {
+	result := make([]domain.ChecklistRunStep, 0, len(dbos))
+	for _, d := range dbos {
+		result = append(result, d.ToDomain())
+	}
+	return result
+}
+
+func (r *checklistRunRepository) UpdateRunStepStatus(ctx context.Context, runId uint, stepId uint, status domain.ChecklistRunStepStatus, checkedBy string) (domain.ChecklistRunStep, domain.Error) {
+	queryFunc := func(tx pool.TransactionWrapper) (dbo.ChecklistRunStepDBO, error) {
+		var d dbo.ChecklistRunStepDBO
+		if err := lockActiveRun(ctx, tx, runId); err != nil {
+			return d, err
+		}
+		err := tx.QueryRow(ctx,
+			`UPDATE CHECKLIST_RUN_STEP
+			 SET STATUS = @status,
+			     CHECKED_BY = CASE WHEN @status = 'PENDING' THEN NULL ELSE @checkedBy END,
+			     CHECKED_AT = CASE WHEN @status = 'PENDING' THEN NULL ELSE CURRENT_TIMESTAMP END
+			 WHERE ID = @stepId AND RUN_ID = @runId
+			 RETURNING ID, SOURCE_ITEM_ID, NAME, ORDER_NUMBER, STATUS, CHECKED_BY, CHECKED_AT`,
+			pgx.NamedArgs{"status": string(status), "checkedBy": checkedBy, "stepId": stepId, "runId": runId},
+		).Scan(&d.Id, &d.SourceItemId, &d.Name, &d.OrderNumber, &d.Status, &d.CheckedBy, &d.CheckedAt)
+		return d, err
+	}
+
+	res, err := connection.RunInTransaction(connection.TransactionProps[dbo.ChecklistRunStepDBO]{
+		Ctx:        ctx,
+		Query:      queryFunc,
+		Connection: r.connection,
+		TxOptions:  connection.TxReadCommitted,
+	})
+	if errors.Is(err, errRunFinished) {
+		return domain.ChecklistRunStep{}, domain.NewError(fmt.Sprintf("Run(id=%d) is finished and can no longer be changed", runId), 400)
+	}
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.ChecklistRunStep{}, domain.NewError(fmt.Sprintf("Run step(id=%d) not found", stepId), 404)
+	}
+	if err != nil {
+		return domain.ChecklistRunStep{}, domain.Wrap(err, fmt.Sprintf("Could not update run step(id=%d)", stepId), 500)
+	}
+	return res.ToDomain(), nil
+}
+
+func (r *checklistRunRepository) UpdateRunStepRowStatus(ctx context.Context, runId uint, stepId uint, rowId uint, status domain.ChecklistRunStepStatus, checkedBy string) (domain.ChecklistRunStepRow, domain.Error) {
+	queryFunc := func(tx pool.TransactionWrapper) (dbo.ChecklistRunStepRowDBO, error) {
+		var d dbo.ChecklistRunStepRowDBO
+		if err := lockActiveRun(ctx, tx, runId); err != nil {
+			return d, err
+		}
+		err := tx.QueryRow(ctx,
+			`UPDATE CHECKLIST_RUN_STEP_ROW r
+			 SET STATUS = @status,
+			     CHECKED_BY = CASE WHEN @status = 'PENDING' THEN NULL ELSE @checkedBy END,
+			     CHECKED_AT = CASE WHEN @status = 'PENDING' THEN NULL ELSE CURRENT_TIMESTAMP END
+			 FROM CHECKLIST_RUN_STEP s
+			 WHERE r.ID = @rowId AND r.STEP_ID = @stepId AND s.ID = r.STEP_ID AND s.RUN_ID = @runId
+			 RETURNING r.ID, r.SOURCE_ROW_ID, r.NAME, r.STATUS, r.CHECKED_BY, r.CHECKED_AT`,
+			pgx.NamedArgs{"status": string(status), "checkedBy": checkedBy, "rowId": rowId, "stepId": stepId, "runId": runId},
+		).Scan(&d.Id, &d.SourceRowId, &d.Name, &d.Status, &d.CheckedBy, &d.CheckedAt)
+		return d, err
+	}
+
+	res, err := connection.RunInTransaction(connection.TransactionProps[dbo.ChecklistRunStepRowDBO]{
+		Ctx:        ctx,
+		Query:      queryFunc,
+		Connection: r.connection,
+		TxOptions:  connection.TxReadCommitted,
+	})
+	if errors.Is(err, errRunFinished) {
+		return domain.ChecklistRunStepRow{}, domain.NewError(fmt.Sprintf("Run(id=%d) is finished and can no longer be changed", runId), 400)
+	}
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.ChecklistRunStepRow{}, domain.NewError(fmt.Sprintf("Run step row(id=%d) not found", rowId), 404)
+	}
+	if err != nil {
+		return domain.ChecklistRunStepRow{}, domain.Wrap(err, fmt.Sprintf("Could not update run step row(id=%d)", rowId), 500)
+	}
+	return res.ToDomain(), nil
+}
+
+type finishedRun struct {
+	run   dbo.ChecklistRunDBO
+	steps []dbo.ChecklistRunStepDBO
+}
+
+// FinishRun locks the run and computes its completion from the steps in the same transaction, so a step update
+// cannot commit between reading the steps and storing the result
+func (r *checklistRunRepository) FinishRun(ctx context.Context, checklistId uint, runId uint, finishedBy string) (domain.ChecklistRun, domain.Error) {
+	queryFunc := func(tx pool.TransactionWrapper) (finishedRun, error) {
+		var f finishedRun
+		if err := lockActiveRun(ctx, tx, runId); err != nil {
+			return f, err
+		}
+		if err := tx.QueryList(ctx, runStepsQuery, &f.steps, pgx.NamedArgs{"runId": runId}); err != nil {
+			return f, err
+		}
+		completion := domain.CalculateRunCompletion(mapRunSteps(f.steps))
+
+		d := &f.run
+		err := tx.QueryRow(ctx,
+			`UPDATE CHECKLIST_RUN
+			 SET FINISHED_BY = @finishedBy, FINISHED_AT = CURRENT_TIMESTAMP,
+			     DURATION_SECONDS = CAST(GREATEST(FLOOR(EXTRACT(EPOCH FROM CAST(CURRENT_TIMESTAMP AS TIMESTAMP) - STARTED_AT)), 0) AS BIGINT),
+			     COMPLETION_PERCENTAGE = @completionPercentage
+			 WHERE ID = @runId AND CHECKLIST_ID = @checklistId
+			 RETURNING `+checklistRunColumns,
+			pgx.NamedArgs{
+				"finishedBy":           finishedBy,
+				"completionPercentage": completion,
+				"runId":                runId,
+				"checklistId":          checklistId,
+			},
+		).Scan(&d.Id, &d.ChecklistId, &d.StartedBy, &d.StartedAt, &d.FinishedBy, &d.FinishedAt, &d.DurationSeconds, &d.CompletionPercentage)
+		return f, err
+	}
+
+	res, err := connection.RunInTransaction(connection.TransactionProps[finishedRun]{
+		Ctx:        ctx,
+		Query:      queryFunc,
+		Connection: r.connection,
+		TxOptions:  connection.TxReadCommitted,
+	})
+	if errors.Is(err, errRunFinished) {
+		return domain.ChecklistRun{}, domain.NewError(fmt.Sprintf("Run(id=%d) is already finished", runId), 400)
+	}
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.ChecklistRun{}, domain.NewError(fmt.Sprintf("Run(id=%d) not found", runId), 404)
+	}
+	if err != nil {
+		return domain.ChecklistRun{}, domain.Wrap(err, fmt.Sprintf("Could not finish run(id=%d)", runId), 500)
+	}
+
+	finished := res.run.ToDomain()
+	finished.Steps = mapRunSteps(res.steps)
+	return finished, nil
+}
+
+func CreateChecklistRunRepository(conn pool.Conn) coreRepo.IChecklistRunRepository {
+	return &checklistRunRepository{connection: conn}
+}
@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"com.raunlo.checklist/internal/core/domain"
+	"com.raunlo.checklist/internal/repository/connection"
+	"com.raunlo.checklist/internal/repository/query"
+	"github.com/jackc/pgx/v5"
+	"github.com/raunlo/pgx-with-automapper/pool"
+)
+
+type checklistSectionRepository struct {
+	conn pool.Conn
+}
+
+func (r *checklistSectionRepository) FindChecklistSections(ctx context.Context, checklistId uint) ([]domain.ChecklistSection, domain.Error) {
+	dbos, err := query.NewGetChecklistSectionsQueryFunction(checklistId).GetQueryFunction(ctx)(r.conn)
+	if err != nil {
+		return nil, domain.Wrap(err, fmt.Sprintf("Failed to find sections of checklist(id=%d)", checklistId), 500)
+	}
+
+	sections := make([]domain.ChecklistSection, 0, len(dbos))
+	for _, d := range dbos {
+		sections = append(sections, d.ToDomain())
+	}
+	return sections, nil
+}
+
+func (r *checklistSectionRepository) SaveChecklistSection(ctx context.Context, section domain.ChecklistSection) (domain.ChecklistSection, domain.Error) {
+	res, err := connection.RunInTransaction(connection.TransactionProps[domain.ChecklistSection]{
+		Ctx:        ctx,
+		Query:      query.NewPersistChecklistSectionQueryFunction(section).GetTransactionalQueryFunction(),
+		TxOptions:  connection.TxSerializable, // Appending reads the last position
+		Connection: r.conn,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.ChecklistSection{}, domain.NewError(fmt.Sprintf("Checklist(id=%d) not found", section.ChecklistId), 404)
+	} else if err != nil {
+		return domain.ChecklistSection{}, domain.Wrap(err, "Could not save checklist section", 500)
+	}
+	return res, nil
+}
+
+func (r *checklistSectionRepository) UpdateChecklistSection(ctx context.Context, section domain.ChecklistSection) (domain.ChecklistSection, domain.Error) {
+	res, err := connection.RunInTransaction(connection.TransactionProps[domain.ChecklistSection]{
+		Ctx:        ctx,
+		Query:      query.NewUpdateChecklistSectionQueryFunction(section).GetTransactionalQueryFunction(),
+		TxOptions:  connection.TxReadCommitted,
+		Connection: r.conn,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.ChecklistSection{}, domain.NewError(fmt.Sprintf("Checklist section(id=%d) not found", section.Id), 404)
+	} else if err != nil {
+		return domain.ChecklistSection{}, domain.Wrap(err, fmt.Sprintf("Could not update checklist section(id=%d)", section.Id), 500)
+	}
+	return res, nil
+}
+
+func (r *checklistSectionRepository) DeleteChecklistSection(ctx context.Context, checklistId uint, sectionId uint) (domain.ChecklistSectionDeletionResult, domain.Error) {
+	res, err := connection.RunInTransaction(connection.TransactionProps[domain.ChecklistSectionDeletionResult]{
+		Ctx:        ctx,
+		Query:      query.NewDeleteChecklistSectionQueryFunction(checklistId, sectionId).GetTransactionalQueryFunction(),
+		TxOptions:  connection.TxSerializable, // Items are repositioned among the items without a section
+		Connection: r.conn,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.ChecklistSectionDeletionResult{}, domain.NewError(fmt.Sprintf("Checklist section(id=%d) not found", sectionId), 404)
+	} else if err != nil {
+		return domain.ChecklistSectionDeletionResult{}, domain.Wrap(err, fmt.Sprintf("Could not delete checklist section(id=%d)", sectionId), 500)
+	}
+	return res, nil
+}
+
+func (r *checklistSectionRepository) ChangeChecklistSectionOrder(ctx context.Context, request domain.ChangeSectionOrderRequest) (domain.ChecklistSection, domain.Error) {
+	res, err := connection.RunInTransaction(connection.TransactionProps[domain.ChecklistSection]{
+		Ctx:        ctx,
+		Query:      query.NewChangeChecklistSectionOrderQueryFunction(request).GetTransactionalQueryFunction(),
+		TxOptions:  connection.TxSerializable, // Ordering requires strict consistency
+		Connection: r.conn,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.ChecklistSection{}, domain.NewError(fmt.Sprintf("Checklist section(id=%d) not found", request.SectionId), 404)
+	} else if err != nil {
+		return domain.ChecklistSection{}, domain.Wrap(err, fmt.Sprintf("Could not change order of checklist section(id=%d)", request.SectionId), 500)
+	}
+	return res, nil
+}
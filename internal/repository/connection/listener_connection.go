@@ -0,0 +1,35 @@
+package connection
+
+import (
+	"context"
+	"net"
+	"net/url"
+	"strconv"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/raunlo/pgx-with-automapper/pool"
+)
+
+// NewListenerConnection opens a connection outside of the pool. LISTEN registrations belong to a session,
+// so a listener needs a connection of its own that is never handed back to the pool.
+func NewListenerConnection(ctx context.Context, cfg pool.DatabaseConfiguration) (*pgx.Conn, error) {
+	query := make(url.Values)
+	if cfg.Schema != nil {
+		query.Set("search_path", *cfg.Schema)
+	}
+	if cfg.Sslmode != nil {
+		query.Set("sslmode", *cfg.Sslmode)
+	}
+	if cfg.ConnTimeout != nil {
+		query.Set("connect_timeout", strconv.Itoa(int(cfg.ConnTimeout.Seconds())))
+	}
+
+	dsn := url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(*cfg.User, *cfg.Password),
+		Host:     net.JoinHostPort(*cfg.Host, *cfg.Port),
+		Path:     *cfg.Name,
+		RawQuery: query.Encode(),
+	}
+	return pgx.Connect(ctx, dsn.String())
+}
@@ -0,0 +1,31 @@
+package dbo
+
+import (
+	"time"
+
+	"com.raunlo.checklist/internal/core/domain"
+)
+
+type ChecklistActivityDBO struct {
+	Id            uint64    `primaryKey:"id"`
+	ChecklistId   uint64    `db:"checklist_id"`
+	ItemId        *uint64   `db:"item_id"`
+	Action        string    `db:"action"`
+	Actor         string    `db:"actor"`
+	CreatedAt     time.Time `db:"created_at"`
+	BeforeSummary *string   `db:"before_summary"`
+	AfterSummary  *string   `db:"after_summary"`
+}
+
+func (d *ChecklistActivityDBO) ToDomain() domain.ChecklistActivity {
+	return domain.ChecklistActivity{
+		Id:          uint(d.Id),
+		ChecklistId: uint(d.ChecklistId),
+		ItemId:      toUintPointer(d.ItemId),
+		Action:      domain.ChecklistActivityAction(d.Action),
+		Actor:       d.Actor,
+		CreatedAt:   d.CreatedAt,
+		Before:      d.BeforeSummary,
+		After:       d.AfterSummary,
+	}
+}
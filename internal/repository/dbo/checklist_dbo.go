@@ -1,15 +1,25 @@
 package dbo
 
-import "com.raunlo.checklist/internal/core/domain"
+import (
+	"time"
+
+	"com.raunlo.checklist/internal/core/domain"
+)
 
 type ChecklistDbo struct {
-	Id   uint   `primaryKey:"id"`
-	Name string `db:"name"`
+	Id           uint       `primaryKey:"id"`
+	Name         string     `db:"name"`
+	WorkspaceId  *uint64    `db:"workspace_id"`
+	OrderingMode string     `db:"ordering_mode"`
+	ArchivedAt   *time.Time `db:"archived_at"`
 }
 
 func MapChecklistDboToDomain(checklistDbo ChecklistDbo) domain.Checklist {
 	return domain.Checklist{
-		Id:   checklistDbo.Id,
-		Name: checklistDbo.Name,
+		Id:           checklistDbo.Id,
+		Name:         checklistDbo.Name,
+		WorkspaceId:  toUintPointer(checklistDbo.WorkspaceId),
+		OrderingMode: domain.ChecklistOrderingMode(checklistDbo.OrderingMode),
+		ArchivedAt:   checklistDbo.ArchivedAt,
 	}
 }
@@ -0,0 +1,35 @@
+package dbo
+
+import (
+	"encoding/json"
+	"time"
+
+	"com.raunlo.checklist/internal/core/domain"
+)
+
+// ChecklistHistoryRowDBO is the JSON representation of an item row stored in CHECKLIST_ITEM_HISTORY.ROWS
+type ChecklistHistoryRowDBO struct {
+	Id          uint       `json:"id"`
+	Name        string     `json:"name"`
+	Completed   bool       `json:"completed"`
+	CompletedBy *string    `json:"completedBy,omitempty"`
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+}
+
+func UnmarshalHistoryRows(data []byte) ([]domain.ChecklistItemRow, error) {
+	var dbos []ChecklistHistoryRowDBO
+	if err := json.Unmarshal(data, &dbos); err != nil {
+		return nil, err
+	}
+	rows := make([]domain.ChecklistItemRow, 0, len(dbos))
+	for _, row := range dbos {
+		rows = append(rows, domain.ChecklistItemRow{
+			Id:          row.Id,
+			Name:        row.Name,
+			Completed:   row.Completed,
+			CompletedBy: row.CompletedBy,
+			CompletedAt: row.CompletedAt,
+		})
+	}
+	return rows, nil
+}
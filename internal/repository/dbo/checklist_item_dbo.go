@@ -1,6 +1,10 @@
 package dbo
 
-import "com.raunlo.checklist/internal/core/domain"
+import (
+	"time"
+
+	"com.raunlo.checklist/internal/core/domain"
+)
 
 type ChecklistItemDbo struct {
 	Id          uint                  `primaryKey:"checklist_item_id"`
@@ -8,13 +12,20 @@ type ChecklistItemDbo struct {
 	Completed   bool                  `db:"checklist_item_completed"`
 	Rows        []ChecklistItemRowDbo `relationship:"oneToMany"`
 	OrderNumber uint                  `db:"order_number"`
-	Position    float64               `db:"position"`
+	Position    string                `db:"position"`
+	CompletedBy *string               `db:"checklist_item_completed_by"`
+	CompletedAt *time.Time            `db:"checklist_item_completed_at"`
+	SectionId   *uint64               `db:"section_id"`
+	Notes       *string               `db:"notes"`
 }
 
 type ChecklistItemRowDbo struct {
-	Id        uint   `primaryKey:"checklist_item_row_id"`
-	Name      string `db:"checklist_item_row_name"`
-	Completed bool   `db:"checklist_item_row_completed"`
+	Id          uint       `primaryKey:"checklist_item_row_id"`
+	Name        string     `db:"checklist_item_row_name"`
+	Completed   bool       `db:"checklist_item_row_completed"`
+	Position    string     `db:"checklist_item_row_position"`
+	CompletedBy *string    `db:"checklist_item_row_completed_by"`
+	CompletedAt *time.Time `db:"checklist_item_row_completed_at"`
 }
 
 func MapChecklistItemDboToDomain(checklistItemDbo ChecklistItemDbo) domain.ChecklistItem {
@@ -30,13 +41,20 @@ func MapChecklistItemDboToDomain(checklistItemDbo ChecklistItemDbo) domain.Check
 		Rows:        checklistItemRows,
 		OrderNumber: checklistItemDbo.OrderNumber,
 		Position:    checklistItemDbo.Position,
+		CompletedBy: checklistItemDbo.CompletedBy,
+		CompletedAt: checklistItemDbo.CompletedAt,
+		SectionId:   toUintPointer(checklistItemDbo.SectionId),
+		Notes:       checklistItemDbo.Notes,
 	}
 }
 
 func MapChecklistItemRowsDboToDomain(checklistItemRowDbo ChecklistItemRowDbo) domain.ChecklistItemRow {
 	return domain.ChecklistItemRow{
-		Id:        checklistItemRowDbo.Id,
-		Name:      checklistItemRowDbo.Name,
-		Completed: checklistItemRowDbo.Completed,
+		Id:          checklistItemRowDbo.Id,
+		Name:        checklistItemRowDbo.Name,
+		Completed:   checklistItemRowDbo.Completed,
+		Position:    checklistItemRowDbo.Position,
+		CompletedBy: checklistItemRowDbo.CompletedBy,
+		CompletedAt: checklistItemRowDbo.CompletedAt,
 	}
 }
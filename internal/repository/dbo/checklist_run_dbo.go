@@ -0,0 +1,87 @@
+package dbo
+
+import (
+	"time"
+
+	"com.raunlo.checklist/internal/core/domain"
+)
+
+type ChecklistRunDBO struct {
+	Id                   uint64     `primaryKey:"id"`
+	ChecklistId          uint64     `db:"checklist_id"`
+	StartedBy            string     `db:"started_by"`
+	StartedAt            time.Time  `db:"started_at"`
+	FinishedBy           *string    `db:"finished_by"`
+	FinishedAt           *time.Time `db:"finished_at"`
+	DurationSeconds      *int64     `db:"duration_seconds"`
+	CompletionPercentage *float64   `db:"completion_percentage"`
+}
+
+func (d *ChecklistRunDBO) ToDomain() domain.ChecklistRun {
+	return domain.ChecklistRun{
+		Id:                   uint(d.Id),
+		ChecklistId:          uint(d.ChecklistId),
+		StartedBy:            d.StartedBy,
+		StartedAt:            d.StartedAt,
+		FinishedBy:           d.FinishedBy,
+		FinishedAt:           d.FinishedAt,
+		DurationSeconds:      d.DurationSeconds,
+		CompletionPercentage: d.CompletionPercentage,
+	}
+}
+
+type ChecklistRunStepDBO struct {
+	Id           uint64                   `primaryKey:"step_id"`
+	SourceItemId *uint64                  `db:"source_item_id"`
+	Name         string                   `db:"step_name"`
+	OrderNumber  uint                     `db:"order_number"`
+	Status       string                   `db:"step_status"`
+	CheckedBy    *string                  `db:"step_checked_by"`
+	CheckedAt    *time.Time               `db:"step_checked_at"`
+	Rows         []ChecklistRunStepRowDBO `relationship:"oneToMany"`
+}
+
+type ChecklistRunStepRowDBO struct {
+	Id          uint64     `primaryKey:"row_id"`
+	SourceRowId *uint64    `db:"source_row_id"`
+	Name        string     `db:"row_name"`
+	Status      string     `db:"row_status"`
+	CheckedBy   *string    `db:"row_checked_by"`
+	CheckedAt   *time.Time `db:"row_checked_at"`
+}
+
+func (d *ChecklistRunStepDBO) ToDomain() domain.ChecklistRunStep {
+	rows := make([]domain.ChecklistRunStepRow, 0, len(d.Rows))
+	for _, row := range d.Rows {
+		rows = append(rows, row.ToDomain())
+	}
+	return domain.ChecklistRunStep{
+		Id:           uint(d.Id),
+		SourceItemId: toUintPointer(d.SourceItemId),
+		Name:         d.Name,
+		OrderNumber:  d.OrderNumber,
+		Status:       domain.ChecklistRunStepStatus(d.Status),
+		CheckedBy:    d.CheckedBy,
+		CheckedAt:    d.CheckedAt,
+		Rows:         rows,
+	}
+}
+
+func (d *ChecklistRunStepRowDBO) ToDomain() domain.ChecklistRunStepRow {
+	return domain.ChecklistRunStepRow{
+		Id:          uint(d.Id),
+		SourceRowId: toUintPointer(d.SourceRowId),
+		Name:        d.Name,
+		Status:      domain.ChecklistRunStepStatus(d.Status),
+		CheckedBy:   d.CheckedBy,
+		CheckedAt:   d.CheckedAt,
+	}
+}
+
+func toUintPointer(value *uint64) *uint {
+	if value == nil {
+		return nil
+	}
+	result := uint(*value)
+	return &result
+}
@@ -0,0 +1,23 @@
+package dbo
+
+import "com.raunlo.checklist/internal/core/domain"
+
+type ChecklistSectionDbo struct {
+	Id          uint    `primaryKey:"id"`
+	ChecklistId uint    `db:"checklist_id"`
+	Name        string  `db:"name"`
+	Position    float64 `db:"position"`
+	Collapsed   bool    `db:"collapsed"`
+	OrderNumber uint    `db:"order_number"`
+}
+
+func (d *ChecklistSectionDbo) ToDomain() domain.ChecklistSection {
+	return domain.ChecklistSection{
+		Id:          d.Id,
+		ChecklistId: d.ChecklistId,
+		Name:        d.Name,
+		Position:    d.Position,
+		Collapsed:   d.Collapsed,
+		OrderNumber: d.OrderNumber,
+	}
+}
@@ -11,6 +11,7 @@ type TemplateDBO struct {
 	UserID      string    `db:"USER_ID"`
 	Name        string    `db:"NAME"`
 	Description *string   `db:"DESCRIPTION"`
+	Notes       *string   `db:"NOTES"`
 	CreatedAt   time.Time `db:"CREATED_AT"`
 	UpdatedAt   time.Time `db:"UPDATED_AT"`
 	IsOwner     bool      `db:"IS_OWNER"`
@@ -20,7 +21,7 @@ type TemplateRowDBO struct {
 	ID         uint64    `db:"ID"`
 	TemplateID uint64    `db:"TEMPLATE_ID"`
 	Name       string    `db:"NAME"`
-	Position   float64   `db:"POSITION"`
+	Position   string    `db:"POSITION"`
 	CreatedAt  time.Time `db:"CREATED_AT"`
 	UpdatedAt  time.Time `db:"UPDATED_AT"`
 	IsOwner    bool      `db:"IS_OWNER"`
@@ -36,6 +37,7 @@ func (t *TemplateDBO) ToDomain() domain.Template {
 		UserId:       t.UserID,
 		Name:         t.Name,
 		Description:  t.Description,
+		Notes:        t.Notes,
 		WorkspaceIds: []uint{},
 		CreatedAt:    t.CreatedAt,
 		UpdatedAt:    t.UpdatedAt,
@@ -49,6 +51,7 @@ func (t *TemplateDBO) FromDomain(template domain.Template) {
 	t.UserID = template.UserId
 	t.Name = template.Name
 	t.Description = template.Description
+	t.Notes = template.Notes
 	t.CreatedAt = template.CreatedAt
 	t.UpdatedAt = template.UpdatedAt
 }
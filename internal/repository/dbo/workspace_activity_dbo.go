@@ -0,0 +1,35 @@
+package dbo
+
+import (
+	"time"
+
+	"com.raunlo.checklist/internal/core/domain"
+)
+
+type WorkspaceActivityDBO struct {
+	Id            uint64    `primaryKey:"id"`
+	WorkspaceId   uint64    `db:"workspace_id"`
+	EventType     string    `db:"event_type"`
+	Actor         string    `db:"actor"`
+	SubjectUserId *string   `db:"subject_user_id"`
+	ChecklistId   *uint64   `db:"checklist_id"`
+	TemplateId    *uint64   `db:"template_id"`
+	InviteId      *uint64   `db:"invite_id"`
+	Summary       *string   `db:"summary"`
+	CreatedAt     time.Time `db:"created_at"`
+}
+
+func (d *WorkspaceActivityDBO) ToDomain() domain.WorkspaceActivity {
+	return domain.WorkspaceActivity{
+		Id:            uint(d.Id),
+		WorkspaceId:   uint(d.WorkspaceId),
+		EventType:     domain.WorkspaceActivityEventType(d.EventType),
+		Actor:         d.Actor,
+		SubjectUserId: d.SubjectUserId,
+		ChecklistId:   toUintPointer(d.ChecklistId),
+		TemplateId:    toUintPointer(d.TemplateId),
+		InviteId:      toUintPointer(d.InviteId),
+		Summary:       d.Summary,
+		CreatedAt:     d.CreatedAt,
+	}
+}
@@ -0,0 +1,209 @@
+package query
+
+import (
+	"context"
+
+	"com.raunlo.checklist/internal/core/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/raunlo/pgx-with-automapper/pool"
+)
+
+// ClearCompletedItemsQueryFunction soft-deletes every completed item of a checklist under one deletion
+// group, so the whole clear can be undone at once. Positions are left untouched.
+type ClearCompletedItemsQueryFunction struct {
+	checklistId uint
+	userId      string
+}
+
+func NewClearCompletedItemsQueryFunction(checklistId uint, userId string) *ClearCompletedItemsQueryFunction {
+	return &ClearCompletedItemsQueryFunction{checklistId: checklistId, userId: userId}
+}
+
+func (c *ClearCompletedItemsQueryFunction) GetTransactionalQueryFunction() func(tx pool.TransactionWrapper) (domain.ChecklistItemDeletionGroup, error) {
+	return func(tx pool.TransactionWrapper) (domain.ChecklistItemDeletionGroup, error) {
+		group := domain.ChecklistItemDeletionGroup{ChecklistId: c.checklistId, ItemIds: []uint{}}
+		err := tx.QueryRow(context.Background(), `SELECT nextval('checklist_item_deletion_group_id_sequence')`).Scan(&group.Id)
+		if err != nil {
+			return domain.ChecklistItemDeletionGroup{}, err
+		}
+
+		rows, err := tx.Query(context.Background(),
+			`UPDATE CHECKLIST_ITEM
+			 SET DELETED_AT = CURRENT_TIMESTAMP, DELETED_BY = @userId, DELETION_GROUP_ID = @groupId
+			 WHERE CHECKLIST_ID = @checklistId AND CHECKLIST_ITEM_COMPLETED = TRUE AND DELETED_AT IS NULL
+			 RETURNING CHECKLIST_ITEM_ID`,
+			pgx.NamedArgs{
+				"checklistId": c.checklistId,
+				"userId":      c.userId,
+				"groupId":     group.Id,
+			})
+		if err != nil {
+			return domain.ChecklistItemDeletionGroup{}, err
+		}
+		group.ItemIds, err = scanItemIds(rows)
+		if err != nil {
+			return domain.ChecklistItemDeletionGroup{}, err
+		}
+		if err := softDeleteItemComments(tx, c.checklistId, group.ItemIds); err != nil {
+			return domain.ChecklistItemDeletionGroup{}, err
+		}
+
+		if len(group.ItemIds) == 0 {
+			// Nothing was cleared, so there is nothing to undo either
+			group.Id = 0
+		}
+		return group, nil
+	}
+}
+
+// RestoreDeletionGroupQueryFunction restores every item that is still soft-deleted under a deletion group
+type RestoreDeletionGroupQueryFunction struct {
+	checklistId uint
+	groupId     uint
+}
+
+func NewRestoreDeletionGroupQueryFunction(checklistId uint, groupId uint) *RestoreDeletionGroupQueryFunction {
+	return &RestoreDeletionGroupQueryFunction{checklistId: checklistId, groupId: groupId}
+}
+
+// GetTransactionalQueryFunction returns the ids of the restored items, or pgx.ErrNoRows when the group has
+// nothing left to restore
+func (r *RestoreDeletionGroupQueryFunction) GetTransactionalQueryFunction() func(tx pool.TransactionWrapper) ([]uint, error) {
+	return func(tx pool.TransactionWrapper) ([]uint, error) {
+		// Restore the comments deleted together with the items, before their deletion timestamps are cleared
+		_, err := tx.Exec(context.Background(),
+			`UPDATE CHECKLIST_ITEM_COMMENT cm
+			 SET DELETED_AT = NULL
+			 FROM CHECKLIST_ITEM ci
+			 WHERE ci.CHECKLIST_ITEM_ID = cm.CHECKLIST_ITEM_ID AND ci.CHECKLIST_ID = @checklistId
+			   AND ci.DELETION_GROUP_ID = @groupId AND cm.DELETED_AT = ci.DELETED_AT`,
+			pgx.NamedArgs{
+				"checklistId": r.checklistId,
+				"groupId":     r.groupId,
+			})
+		if err != nil {
+			return nil, err
+		}
+
+		rows, err := tx.Query(context.Background(),
+			`UPDATE CHECKLIST_ITEM
+			 SET DELETED_AT = NULL, DELETED_BY = NULL, DELETION_GROUP_ID = NULL
+			 WHERE CHECKLIST_ID = @checklistId AND DELETION_GROUP_ID = @groupId AND DELETED_AT IS NOT NULL
+			 RETURNING CHECKLIST_ITEM_ID`,
+			pgx.NamedArgs{
+				"checklistId": r.checklistId,
+				"groupId":     r.groupId,
+			})
+		if err != nil {
+			return nil, err
+		}
+		itemIds, err := scanItemIds(rows)
+		if err != nil {
+			return nil, err
+		} else if len(itemIds) == 0 {
+			return nil, pgx.ErrNoRows
+		}
+		return itemIds, nil
+	}
+}
+
+// ResetChecklistItemsQueryFunction unchecks every active item and row of a checklist. Completed items are
+// appended to the incomplete section of their checklist section in their current order, unless the checklist
+// keeps items in place.
+type ResetChecklistItemsQueryFunction struct {
+	checklistId uint
+}
+
+func NewResetChecklistItemsQueryFunction(checklistId uint) *ResetChecklistItemsQueryFunction {
+	return &ResetChecklistItemsQueryFunction{checklistId: checklistId}
+}
+
+// GetTransactionalQueryFunction returns the ids of the items whose completion or rows changed
+func (r *ResetChecklistItemsQueryFunction) GetTransactionalQueryFunction() func(tx pool.TransactionWrapper) ([]uint, error) {
+	return func(tx pool.TransactionWrapper) ([]uint, error) {
+		// 1. Uncheck the rows of active items
+		rows, err := tx.Query(context.Background(),
+			`UPDATE CHECKLIST_ITEM_ROW r
+			 SET CHECKLIST_ITEM_ROW_COMPLETED = FALSE, CHECKLIST_ITEM_ROW_COMPLETED_BY = NULL, CHECKLIST_ITEM_ROW_COMPLETED_AT = NULL
+			 FROM CHECKLIST_ITEM ci
+			 WHERE ci.CHECKLIST_ITEM_ID = r.CHECKLIST_ITEM_ID
+			   AND ci.CHECKLIST_ID = @checklistId AND ci.DELETED_AT IS NULL
+			   AND r.CHECKLIST_ITEM_ROW_COMPLETED = TRUE
+			 RETURNING r.CHECKLIST_ITEM_ID`,
+			pgx.NamedArgs{"checklistId": r.checklistId})
+		if err != nil {
+			return nil, err
+		}
+		rowItemIds, err := scanItemIds(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		mode, err := lockChecklistPositions(tx, r.checklistId)
+		if err != nil {
+			return nil, err
+		}
+
+		// 2. Uncheck the items, placing them after the last incomplete item in their current order.
+		// Checklists that keep items in place leave positions untouched.
+		if mode.SinksCompleted() {
+			rows, err = tx.Query(context.Background(),
+				`SELECT ci.CHECKLIST_ITEM_ID, COALESCE(last_incomplete.POSITION, '')
+				FROM CHECKLIST_ITEM ci
+				LEFT JOIN LATERAL (
+					SELECT MAX(o.POSITION) AS POSITION FROM CHECKLIST_ITEM o
+					WHERE o.CHECKLIST_ID = @checklistId AND o.CHECKLIST_ITEM_COMPLETED = FALSE
+					  AND o.SECTION_ID IS NOT DISTINCT FROM ci.SECTION_ID
+				) last_incomplete ON TRUE
+				WHERE ci.CHECKLIST_ID = @checklistId AND ci.CHECKLIST_ITEM_COMPLETED = TRUE AND ci.DELETED_AT IS NULL
+				ORDER BY ci.SECTION_ID, ci.POSITION ASC
+				FOR UPDATE OF ci`,
+				pgx.NamedArgs{"checklistId": r.checklistId})
+			if err != nil {
+				return nil, err
+			}
+			if _, err := placeItemsAfter(tx, rows); err != nil {
+				return nil, err
+			}
+		}
+		rows, err = tx.Query(context.Background(),
+			`UPDATE CHECKLIST_ITEM
+			SET CHECKLIST_ITEM_COMPLETED = FALSE, CHECKLIST_ITEM_COMPLETED_BY = NULL, CHECKLIST_ITEM_COMPLETED_AT = NULL,
+			    UPDATED_AT = CURRENT_TIMESTAMP
+			WHERE CHECKLIST_ID = @checklistId AND CHECKLIST_ITEM_COMPLETED = TRUE AND DELETED_AT IS NULL
+			RETURNING CHECKLIST_ITEM_ID`,
+			pgx.NamedArgs{"checklistId": r.checklistId})
+		if err != nil {
+			return nil, err
+		}
+		itemIds, err := scanItemIds(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		seen := make(map[uint]bool, len(itemIds))
+		for _, itemId := range itemIds {
+			seen[itemId] = true
+		}
+		for _, itemId := range rowItemIds {
+			if !seen[itemId] {
+				seen[itemId] = true
+				itemIds = append(itemIds, itemId)
+			}
+		}
+		return itemIds, nil
+	}
+}
+
+func scanItemIds(rows pgx.Rows) ([]uint, error) {
+	defer rows.Close()
+	itemIds := make([]uint, 0)
+	for rows.Next() {
+		var itemId uint
+		if err := rows.Scan(&itemId); err != nil {
+			return nil, err
+		}
+		itemIds = append(itemIds, itemId)
+	}
+	return itemIds, rows.Err()
+}
@@ -2,148 +2,139 @@ package query
 
 import (
 	"context"
+	"errors"
 
 	"com.raunlo.checklist/internal/core/domain"
 	"github.com/jackc/pgx/v5"
 	"github.com/raunlo/pgx-with-automapper/pool"
 )
 
-// ChangeChecklistItemOrderQueryFunction moves item to different order number using gap-based positioning
+// ChangeChecklistItemOrderQueryFunction moves item to different order number. The item gets a fractional key
+// between its new neighbours, the other items keep their positions.
 type ChangeChecklistItemOrderQueryFunction struct {
 	newOrderNumber  uint
 	checklistId     uint
 	checklistItemId uint
 	sortOrder       domain.SortOrder
-}
-
-// positionQueryResult holds the result of position queries
-type positionQueryResult struct {
-	Position float64
+	sectionId       *uint
 }
 
 func (c *ChangeChecklistItemOrderQueryFunction) GetTransactionalQueryFunction() func(tx pool.TransactionWrapper) (domain.ChangeOrderResponse, error) {
 	return func(tx pool.TransactionWrapper) (domain.ChangeOrderResponse, error) {
-		// 1. Get the target item's current completed status (with lock)
+		// 1. Lock the checklist so that concurrent moves do not read the same neighbours, then get the target
+		// item's current completed status and checklist section (with lock)
+		mode, err := lockChecklistPositions(tx, c.checklistId)
+		if err != nil {
+			return domain.ChangeOrderResponse{}, err
+		}
 		var itemCompleted bool
-		err := tx.QueryRow(context.Background(),
-			`SELECT CHECKLIST_ITEM_COMPLETED FROM CHECKLIST_ITEM
+		var sectionId *uint
+		err = tx.QueryRow(context.Background(),
+			`SELECT CHECKLIST_ITEM_COMPLETED, SECTION_ID FROM CHECKLIST_ITEM
 			 WHERE CHECKLIST_ID = @checklistId AND CHECKLIST_ITEM_ID = @itemId FOR UPDATE`,
 			pgx.NamedArgs{
 				"checklistId": c.checklistId,
 				"itemId":      c.checklistItemId,
-			}).Scan(&itemCompleted)
+			}).Scan(&itemCompleted, &sectionId)
 		if err != nil {
 			return domain.ChangeOrderResponse{}, err
 		}
+		section := positionSection(mode, itemCompleted)
+
+		// 2. Resolve the checklist section the item is moved to
+		if c.sectionId != nil {
+			sectionId, err = findTargetSection(tx, c.checklistId, *c.sectionId)
+			if err != nil {
+				return domain.ChangeOrderResponse{}, err
+			}
+		}
 
-		// 2. Calculate new position based on target order number
-		newPosition, err := c.calculateNewPosition(tx, itemCompleted)
+		// 3. Calculate new position based on target order number. Neighbours that share a position, written
+		// before positions were locked, are renumbered first; the other clients get the new positions from the
+		// rebalance that follows.
+		renumbered := false
+		newPosition, err := c.calculateNewPosition(tx, section, sectionId)
+		if errors.Is(err, errEqualNeighbourPositions) {
+			renumbered = true
+			if err = renumberChecklistPositions(tx, c.checklistId, mode); err == nil {
+				newPosition, err = c.calculateNewPosition(tx, section, sectionId)
+			}
+		}
 		if err != nil {
 			return domain.ChangeOrderResponse{}, err
 		}
 
-		// 3. Update the item's position
+		// 4. Update the item's position and section
 		_, err = tx.Exec(context.Background(),
-			`UPDATE CHECKLIST_ITEM SET POSITION = @newPosition, UPDATED_AT = CURRENT_TIMESTAMP
+			`UPDATE CHECKLIST_ITEM SET POSITION = @newPosition, SECTION_ID = @sectionId, UPDATED_AT = CURRENT_TIMESTAMP
 			 WHERE CHECKLIST_ID = @checklistId AND CHECKLIST_ITEM_ID = @itemId`,
 			pgx.NamedArgs{
 				"checklistId": c.checklistId,
 				"itemId":      c.checklistItemId,
 				"newPosition": newPosition,
+				"sectionId":   sectionId,
 			})
 		if err != nil {
 			return domain.ChangeOrderResponse{}, err
 		}
 
-		// 4. Check if rebalancing is needed
-		rebalanceNeeded := c.checkRebalanceNeeded(tx, itemCompleted)
+		// 5. Keys grow with every move into the same gap; long ones are compacted afterwards
+		rebalanceNeeded := renumbered || len(newPosition) > domain.PositionCompactionLength
 
 		return domain.ChangeOrderResponse{
 			OrderNumber:     c.newOrderNumber,
 			ChecklistItemId: c.checklistItemId,
 			ChecklistId:     c.checklistId,
+			SectionId:       sectionId,
 			Position:        newPosition,
 			RebalanceNeeded: rebalanceNeeded,
 		}, nil
 	}
 }
 
-func (c *ChangeChecklistItemOrderQueryFunction) calculateNewPosition(tx pool.TransactionWrapper, completed bool) (float64, error) {
-	// Get ordered positions in the same completion section, excluding the moving item
+func (c *ChangeChecklistItemOrderQueryFunction) calculateNewPosition(tx pool.TransactionWrapper, section *bool, sectionId *uint) (string, error) {
+	// Get ordered positions in the same completion section of the checklist section, excluding the moving item
 	rows, err := tx.Query(context.Background(),
 		`SELECT POSITION FROM CHECKLIST_ITEM
 		 WHERE CHECKLIST_ID = @checklistId
-		   AND CHECKLIST_ITEM_COMPLETED = @completed
+		   AND SECTION_ID IS NOT DISTINCT FROM @sectionId
+		   AND (CAST(@section AS BOOLEAN) IS NULL OR CHECKLIST_ITEM_COMPLETED = @section)
 		   AND CHECKLIST_ITEM_ID != @itemId
 		 ORDER BY POSITION ASC`,
 		pgx.NamedArgs{
 			"checklistId": c.checklistId,
-			"completed":   completed,
+			"sectionId":   sectionId,
+			"section":     section,
 			"itemId":      c.checklistItemId,
 		})
 	if err != nil {
-		return 0, err
+		return "", err
 	}
 	defer rows.Close()
 
-	var positions []float64
+	var positions []string
 	for rows.Next() {
-		var result positionQueryResult
-		if err := rows.Scan(&result.Position); err != nil {
-			return 0, err
+		var position string
+		if err := rows.Scan(&position); err != nil {
+			return "", err
 		}
-		positions = append(positions, result.Position)
+		positions = append(positions, position)
 	}
 	if err := rows.Err(); err != nil {
-		return 0, err
+		return "", err
 	}
 
-	// Target order number is 1-based
-	targetIndex := int(c.newOrderNumber) - 1
-
-	// Calculate new position based on target index
-	if len(positions) == 0 {
-		// No other items, use default position
-		return domain.FirstItemPosition, nil
+	// Target order number is 1-based; an empty neighbour is the start or the end of the list
+	targetIndex := min(max(int(c.newOrderNumber)-1, 0), len(positions))
+	var before, after string
+	if targetIndex > 0 {
+		before = positions[targetIndex-1]
 	}
-
-	if targetIndex <= 0 {
-		// Insert at the beginning
-		return positions[0] - domain.DefaultGapSize, nil
+	if targetIndex < len(positions) {
+		after = positions[targetIndex]
 	}
-
-	if targetIndex >= len(positions) {
-		// Insert at the end
-		return positions[len(positions)-1] + domain.DefaultGapSize, nil
+	if before != "" && before == after {
+		return "", errEqualNeighbourPositions
 	}
-
-	// Insert between two items
-	prevPosition := positions[targetIndex-1]
-	nextPosition := positions[targetIndex]
-	return (prevPosition + nextPosition) / 2, nil
-}
-
-func (c *ChangeChecklistItemOrderQueryFunction) checkRebalanceNeeded(tx pool.TransactionWrapper, completed bool) bool {
-	// Check if any adjacent gap is too small
-	var minGap float64
-	err := tx.QueryRow(context.Background(),
-		`WITH positions AS (
-			SELECT POSITION,
-				   LAG(POSITION) OVER (ORDER BY POSITION) as prev_pos
-			FROM CHECKLIST_ITEM
-			WHERE CHECKLIST_ID = @checklistId AND CHECKLIST_ITEM_COMPLETED = @completed
-		)
-		SELECT COALESCE(MIN(POSITION - prev_pos), @defaultGap)
-		FROM positions WHERE prev_pos IS NOT NULL`,
-		pgx.NamedArgs{
-			"checklistId": c.checklistId,
-			"completed":   completed,
-			"defaultGap":  domain.DefaultGapSize,
-		}).Scan(&minGap)
-
-	if err != nil {
-		return false
-	}
-
-	return minGap < domain.MinGapThreshold
+	return domain.PositionBetween(before, after)
 }
@@ -22,25 +22,26 @@ type mockRow struct {
 
 func (r mockRow) Scan(dest ...any) error { return r.scan(dest...) }
 
-// mockRows implements pgx.Rows for returning multiple position values.
+// mockRows implements pgx.Rows for returning multiple position values or item ids.
 type mockRows struct {
-	positions []float64
+	positions []string
+	itemIds   []uint
 	index     int
 	closed    bool
 }
 
 func (r *mockRows) Next() bool {
-	if r.index >= len(r.positions) {
-		return false
-	}
-	return true
+	return r.index < len(r.positions) || r.index < len(r.itemIds)
 }
 
 func (r *mockRows) Scan(dest ...any) error {
-	if r.index < len(r.positions) {
-		*(dest[0].(*float64)) = r.positions[r.index]
-		r.index++
+	switch d := dest[0].(type) {
+	case *string:
+		*d = r.positions[r.index]
+	case *uint:
+		*d = r.itemIds[r.index]
 	}
+	r.index++
 	return nil
 }
 
@@ -84,7 +85,7 @@ func (m *mockTx) Query(ctx context.Context, sql string, args ...any) (pgx.Rows,
 		m.rowsIndex++
 		return rows, nil
 	}
-	return &mockRows{positions: []float64{}}, nil
+	return &mockRows{positions: []string{}}, nil
 }
 
 // Unused TransactionWrapper methods.
@@ -109,26 +110,30 @@ func (m *mockTx) QueryList(ctx context.Context, sql string, dest any, args pgx.N
 	return nil
 }
 
+// lockedChecklist serves the ordering mode read while locking the checklist positions
+func lockedChecklist(mode domain.ChecklistOrderingMode) func(dest ...any) error {
+	return func(dest ...any) error {
+		*(dest[0].(*domain.ChecklistOrderingMode)) = mode
+		return nil
+	}
+}
+
 func TestChangeChecklistItemOrder_InsertBetween(t *testing.T) {
-	// Setup: items at positions 1000, 2000, 3000
-	// Move item at position 3000 to position 2 (between 1000 and 2000)
-	// Expected new position: (1000 + 2000) / 2 = 1500
+	// Setup: items at positions a0, a1, a2
+	// Move item at position a2 to position 2 (between a0 and a1)
+	// Expected new position: a0V, halfway between the two keys
 
 	tx := newMockTx(
-		// First QueryRow: get item's completed status
+		lockedChecklist(domain.OrderingModeSinkCompleted),
+		// Second QueryRow: get item's completed status
 		func(dest ...any) error {
 			*(dest[0].(*bool)) = false // uncompleted
 			return nil
 		},
-		// Third QueryRow: check min gap for rebalancing
-		func(dest ...any) error {
-			*(dest[0].(*float64)) = 500.0 // gap is big, no rebalance needed
-			return nil
-		},
 	)
 	// Second Query: get positions (excluding moving item)
 	tx.rowsResults = []*mockRows{
-		{positions: []float64{1000.0, 2000.0}}, // other items
+		{positions: []string{"a0", "a1"}}, // other items
 	}
 
 	fn := NewChangeChecklistItemOrderQueryFunction(domain.ChangeOrderRequest{
@@ -143,10 +148,9 @@ func TestChangeChecklistItemOrder_InsertBetween(t *testing.T) {
 		t.Fatalf("change order failed: %v", err)
 	}
 
-	// Expected position: (1000 + 2000) / 2 = 1500
-	expectedPosition := 1500.0
+	expectedPosition := "a0V"
 	if response.Position != expectedPosition {
-		t.Errorf("expected position %f, got %f", expectedPosition, response.Position)
+		t.Errorf("expected position %q, got %q", expectedPosition, response.Position)
 	}
 	if response.RebalanceNeeded {
 		t.Error("expected no rebalance needed")
@@ -154,24 +158,20 @@ func TestChangeChecklistItemOrder_InsertBetween(t *testing.T) {
 }
 
 func TestChangeChecklistItemOrder_InsertAtStart(t *testing.T) {
-	// Setup: items at positions 1000, 2000
+	// Setup: items at positions a0, a1
 	// Move an item to position 1 (at the start)
-	// Expected new position: 1000 - 1000 = 0
+	// Expected new position: Zz, the integer before a0
 
 	tx := newMockTx(
-		// First QueryRow: get item's completed status
+		lockedChecklist(domain.OrderingModeSinkCompleted),
+		// Second QueryRow: get item's completed status
 		func(dest ...any) error {
 			*(dest[0].(*bool)) = false // uncompleted
 			return nil
 		},
-		// Third QueryRow: check min gap for rebalancing
-		func(dest ...any) error {
-			*(dest[0].(*float64)) = 1000.0
-			return nil
-		},
 	)
 	tx.rowsResults = []*mockRows{
-		{positions: []float64{1000.0, 2000.0}},
+		{positions: []string{"a0", "a1"}},
 	}
 
 	fn := NewChangeChecklistItemOrderQueryFunction(domain.ChangeOrderRequest{
@@ -186,32 +186,27 @@ func TestChangeChecklistItemOrder_InsertAtStart(t *testing.T) {
 		t.Fatalf("change order failed: %v", err)
 	}
 
-	// Expected position: 1000 - DefaultGapSize = 0
-	expectedPosition := 0.0
+	expectedPosition := "Zz"
 	if response.Position != expectedPosition {
-		t.Errorf("expected position %f, got %f", expectedPosition, response.Position)
+		t.Errorf("expected position %q, got %q", expectedPosition, response.Position)
 	}
 }
 
 func TestChangeChecklistItemOrder_InsertAtEnd(t *testing.T) {
-	// Setup: items at positions 1000, 2000
+	// Setup: items at positions a0, a1
 	// Move an item to position 3 (at the end)
-	// Expected new position: 2000 + 1000 = 3000
+	// Expected new position: a2, the integer after a1
 
 	tx := newMockTx(
-		// First QueryRow: get item's completed status
+		lockedChecklist(domain.OrderingModeSinkCompleted),
+		// Second QueryRow: get item's completed status
 		func(dest ...any) error {
 			*(dest[0].(*bool)) = false // uncompleted
 			return nil
 		},
-		// Third QueryRow: check min gap for rebalancing
-		func(dest ...any) error {
-			*(dest[0].(*float64)) = 1000.0
-			return nil
-		},
 	)
 	tx.rowsResults = []*mockRows{
-		{positions: []float64{1000.0, 2000.0}},
+		{positions: []string{"a0", "a1"}},
 	}
 
 	fn := NewChangeChecklistItemOrderQueryFunction(domain.ChangeOrderRequest{
@@ -226,31 +221,26 @@ func TestChangeChecklistItemOrder_InsertAtEnd(t *testing.T) {
 		t.Fatalf("change order failed: %v", err)
 	}
 
-	// Expected position: 2000 + DefaultGapSize = 3000
-	expectedPosition := 3000.0
+	expectedPosition := "a2"
 	if response.Position != expectedPosition {
-		t.Errorf("expected position %f, got %f", expectedPosition, response.Position)
+		t.Errorf("expected position %q, got %q", expectedPosition, response.Position)
 	}
 }
 
 func TestChangeChecklistItemOrder_EmptyList(t *testing.T) {
 	// Setup: no other items in checklist
-	// Expected new position: FirstItemPosition (1000)
+	// Expected new position: a0, the key of a list's first item
 
 	tx := newMockTx(
-		// First QueryRow: get item's completed status
+		lockedChecklist(domain.OrderingModeSinkCompleted),
+		// Second QueryRow: get item's completed status
 		func(dest ...any) error {
 			*(dest[0].(*bool)) = false // uncompleted
 			return nil
 		},
-		// Third QueryRow: check min gap for rebalancing
-		func(dest ...any) error {
-			*(dest[0].(*float64)) = 1000.0
-			return nil
-		},
 	)
 	tx.rowsResults = []*mockRows{
-		{positions: []float64{}}, // no other items
+		{positions: []string{}}, // no other items
 	}
 
 	fn := NewChangeChecklistItemOrderQueryFunction(domain.ChangeOrderRequest{
@@ -265,36 +255,64 @@ func TestChangeChecklistItemOrder_EmptyList(t *testing.T) {
 		t.Fatalf("change order failed: %v", err)
 	}
 
-	// Expected position: FirstItemPosition = 1000
-	expectedPosition := domain.FirstItemPosition
+	expectedPosition := "a0"
 	if response.Position != expectedPosition {
-		t.Errorf("expected position %f, got %f", expectedPosition, response.Position)
+		t.Errorf("expected position %q, got %q", expectedPosition, response.Position)
 	}
 }
 
 func TestChangeChecklistItemOrder_TriggersRebalance(t *testing.T) {
-	// Setup: items with very small gaps
-	// Expected: RebalanceNeeded = true
+	// Setup: the item is moved into a gap that many moves already narrowed
+	// Expected: the new key is longer than PositionCompactionLength, RebalanceNeeded = true
 
 	tx := newMockTx(
-		// First QueryRow: get item's completed status
+		lockedChecklist(domain.OrderingModeSinkCompleted),
+		// Second QueryRow: get item's completed status
 		func(dest ...any) error {
 			*(dest[0].(*bool)) = false
 			return nil
 		},
-		// Third QueryRow: check min gap - very small
+	)
+	tx.rowsResults = []*mockRows{
+		{positions: []string{"a0", "a0" + strings.Repeat("z", 31), "a1"}},
+	}
+
+	fn := NewChangeChecklistItemOrderQueryFunction(domain.ChangeOrderRequest{
+		ChecklistId:     1,
+		ChecklistItemId: 4,
+		NewOrderNumber:  3, // right after the long key
+		SortOrder:       domain.AscSort,
+	}).GetTransactionalQueryFunction()
+
+	response, err := fn(tx)
+	if err != nil {
+		t.Fatalf("change order failed: %v", err)
+	}
+
+	if !response.RebalanceNeeded {
+		t.Error("expected rebalance needed due to a long key")
+	}
+}
+
+func TestChangeChecklistItemOrder_KeepInPlaceUsesWholeList(t *testing.T) {
+	// Setup: a completed item in a checklist that keeps items in place
+	// Expected: positions are read across both completion states
+
+	tx := newMockTx(
+		lockedChecklist(domain.OrderingModeKeepInPlace),
+		// Second QueryRow: get item's completed status
 		func(dest ...any) error {
-			*(dest[0].(*float64)) = 0.0001 // smaller than MinGapThreshold (0.001)
+			*(dest[0].(*bool)) = true
 			return nil
 		},
 	)
 	tx.rowsResults = []*mockRows{
-		{positions: []float64{1000.0, 1000.0001, 1000.0002}},
+		{positions: []string{"a0", "a1"}},
 	}
 
 	fn := NewChangeChecklistItemOrderQueryFunction(domain.ChangeOrderRequest{
 		ChecklistId:     1,
-		ChecklistItemId: 4,
+		ChecklistItemId: 3,
 		NewOrderNumber:  2,
 		SortOrder:       domain.AscSort,
 	}).GetTransactionalQueryFunction()
@@ -304,7 +322,99 @@ func TestChangeChecklistItemOrder_TriggersRebalance(t *testing.T) {
 		t.Fatalf("change order failed: %v", err)
 	}
 
+	if response.Position != "a0V" {
+		t.Errorf("expected position %q, got %q", "a0V", response.Position)
+	}
+	if !strings.Contains(tx.queries[2], "CAST(@section AS BOOLEAN) IS NULL") {
+		t.Errorf("expected section filter in position query, got %q", tx.queries[2])
+	}
+}
+
+func TestChangeChecklistItemOrder_MovesItemIntoSection(t *testing.T) {
+	// Setup: an unsectioned item is moved between the two items of section 4
+	// Expected: positions are read within the target section and the item is assigned to it
+
+	tx := newMockTx(
+		lockedChecklist(domain.OrderingModeSinkCompleted),
+		// Second QueryRow: get item's completed status and current section
+		func(dest ...any) error {
+			*(dest[0].(*bool)) = false
+			return nil
+		},
+		// Third QueryRow: resolve the target section
+		func(dest ...any) error {
+			*(dest[0].(*uint)) = 4
+			return nil
+		},
+	)
+	tx.rowsResults = []*mockRows{
+		{positions: []string{"a0", "a1"}},
+	}
+
+	fn := NewChangeChecklistItemOrderQueryFunction(domain.ChangeOrderRequest{
+		ChecklistId:     1,
+		ChecklistItemId: 3,
+		NewOrderNumber:  2,
+		SortOrder:       domain.AscSort,
+		SectionId:       new(uint(4)),
+	}).GetTransactionalQueryFunction()
+
+	response, err := fn(tx)
+	if err != nil {
+		t.Fatalf("change order failed: %v", err)
+	}
+
+	if response.SectionId == nil || *response.SectionId != 4 {
+		t.Fatalf("expected section 4, got %v", response.SectionId)
+	}
+	if response.Position != "a0V" {
+		t.Errorf("expected position %q, got %q", "a0V", response.Position)
+	}
+	if !strings.Contains(tx.queries[3], "SECTION_ID IS NOT DISTINCT FROM @sectionId") {
+		t.Errorf("expected checklist section filter in position query, got %q", tx.queries[3])
+	}
+}
+
+func TestChangeChecklistItemOrder_RenumbersEqualNeighbours(t *testing.T) {
+	// Setup: two items share position a1, written before positions were locked, and an item is moved between them
+	// Expected: the checklist is renumbered, the move lands between the new keys and clients get the new positions
+
+	tx := newMockTx(
+		lockedChecklist(domain.OrderingModeSinkCompleted),
+		// Second QueryRow: get item's completed status
+		func(dest ...any) error {
+			*(dest[0].(*bool)) = false
+			return nil
+		},
+	)
+	tx.rowsResults = []*mockRows{
+		{positions: []string{"a0", "a1", "a1"}},
+		{itemIds: []uint{1, 2, 3, 4}},
+		{positions: []string{"a0", "a1", "a2"}},
+	}
+
+	fn := NewChangeChecklistItemOrderQueryFunction(domain.ChangeOrderRequest{
+		ChecklistId:     1,
+		ChecklistItemId: 4,
+		NewOrderNumber:  3, // between the items that share a1
+		SortOrder:       domain.AscSort,
+	}).GetTransactionalQueryFunction()
+
+	response, err := fn(tx)
+	if err != nil {
+		t.Fatalf("change order failed: %v", err)
+	}
+
+	if response.Position != "a1V" {
+		t.Errorf("expected position %q, got %q", "a1V", response.Position)
+	}
 	if !response.RebalanceNeeded {
-		t.Error("expected rebalance needed due to small gap")
+		t.Error("expected rebalance needed after renumbering")
+	}
+	if !strings.Contains(tx.execs[0], "unnest(") {
+		t.Errorf("expected the positions to be renumbered, got %q", tx.execs[0])
+	}
+	if !strings.Contains(tx.execs[1], "CHECKLIST_ITEM_HISTORY") {
+		t.Errorf("expected the renumbered items to be recorded in the history, got %q", tx.execs[1])
 	}
 }
@@ -0,0 +1,54 @@
+package query
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/raunlo/pgx-with-automapper/pool"
+)
+
+// appendItemHistory records the current state of the items, with their rows, in the change history of the
+// checklist each item belongs to. It runs inside the transaction of the change, after the items were written,
+// so the entries commit or roll back with it. The entries are stamped with the database clock at the time of
+// the write; a concurrent change of the same item waits for the row lock and is therefore recorded later.
+func appendItemHistory(tx pool.TransactionWrapper, itemIds ...uint) error {
+	if len(itemIds) == 0 {
+		return nil
+	}
+	_, err := tx.Exec(context.Background(),
+		`INSERT INTO CHECKLIST_ITEM_HISTORY(CHECKLIST_ID, CHECKLIST_ITEM_ID, RECORDED_AT, DELETED, NAME, COMPLETED,
+		                                    COMPLETED_BY, COMPLETED_AT, POSITION, SECTION_ID, ROWS)
+		 SELECT ci.CHECKLIST_ID, ci.CHECKLIST_ITEM_ID, clock_timestamp(), ci.DELETED_AT IS NOT NULL, ci.CHECKLIST_ITEM_NAME,
+		        ci.CHECKLIST_ITEM_COMPLETED, ci.CHECKLIST_ITEM_COMPLETED_BY, ci.CHECKLIST_ITEM_COMPLETED_AT, ci.POSITION, ci.SECTION_ID,
+		        COALESCE((
+		            SELECT jsonb_agg(jsonb_build_object(
+		                       'id', r.CHECKLIST_ITEM_ROW_ID,
+		                       'name', r.CHECKLIST_ITEM_ROW_NAME,
+		                       'completed', r.CHECKLIST_ITEM_ROW_COMPLETED,
+		                       'completedBy', r.CHECKLIST_ITEM_ROW_COMPLETED_BY,
+		                       'completedAt', r.CHECKLIST_ITEM_ROW_COMPLETED_AT AT TIME ZONE 'UTC')
+		                   ORDER BY r.CHECKLIST_ITEM_ROW_POSITION)
+		            FROM CHECKLIST_ITEM_ROW r
+		            WHERE r.CHECKLIST_ITEM_ID = ci.CHECKLIST_ITEM_ID
+		        ), '[]')
+		 FROM CHECKLIST_ITEM ci
+		 WHERE ci.CHECKLIST_ITEM_ID = ANY(CAST(@itemIds AS BIGINT[]))`,
+		pgx.NamedArgs{"itemIds": itemIds})
+	return err
+}
+
+// AppendItemHistoryQueryFunction records the items changed by a repository transaction in the change history
+type AppendItemHistoryQueryFunction struct {
+	itemIds []uint
+}
+
+func NewAppendItemHistoryQueryFunction(itemIds ...uint) *AppendItemHistoryQueryFunction {
+	return &AppendItemHistoryQueryFunction{itemIds: itemIds}
+}
+
+func (a *AppendItemHistoryQueryFunction) GetTransactionalQueryFunction() func(tx pool.TransactionWrapper) (bool, error) {
+	return func(tx pool.TransactionWrapper) (bool, error) {
+		err := appendItemHistory(tx, a.itemIds...)
+		return err == nil, err
+	}
+}
@@ -0,0 +1,33 @@
+package query
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestAppendItemHistory_RecordsItemsWithDatabaseClock(t *testing.T) {
+	tx := newMockTx()
+
+	if err := appendItemHistory(tx, 1, 2); err != nil {
+		t.Fatalf("append history failed: %v", err)
+	}
+
+	if len(tx.execs) != 1 {
+		t.Fatalf("expected a single insert, got %d statements", len(tx.execs))
+	}
+	if !strings.Contains(tx.execs[0], "INSERT INTO CHECKLIST_ITEM_HISTORY") || !strings.Contains(tx.execs[0], "clock_timestamp()") {
+		t.Errorf("expected the entries to be stamped by the database, got %q", tx.execs[0])
+	}
+}
+
+func TestAppendItemHistory_SkipsEmptyChange(t *testing.T) {
+	tx := newMockTx()
+
+	if err := appendItemHistory(tx); err != nil {
+		t.Fatalf("append history failed: %v", err)
+	}
+
+	if len(tx.execs) != 0 {
+		t.Errorf("expected no statements, got %v", tx.execs)
+	}
+}
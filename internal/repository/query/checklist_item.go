@@ -2,6 +2,7 @@ package query
 
 import (
 	"context"
+	"time"
 
 	"com.raunlo.checklist/internal/core/domain"
 	"com.raunlo.checklist/internal/repository/dbo"
@@ -10,29 +11,60 @@ import (
 	"github.com/raunlo/pgx-with-automapper/pool"
 )
 
-// PersistChecklistItemQueryFunction Persist checklistItem struct using gap-based positioning
+// PersistChecklistItemQueryFunction Persist checklistItem struct, placing it before the first open item
 type PersistChecklistItemQueryFunction struct {
 	checklistId   uint
 	checklistItem domain.ChecklistItem
+	userId        string
 }
 
 func (p *PersistChecklistItemQueryFunction) GetTransactionalQueryFunction() func(tx pool.TransactionWrapper) (domain.ChecklistItem, error) {
 	return func(tx pool.TransactionWrapper) (domain.ChecklistItem, error) {
-		// Get the minimum position for uncompleted items (new items go at the front)
-		var minPosition float64
-		err := tx.QueryRow(context.Background(),
-			`SELECT COALESCE(MIN(POSITION), @defaultPosition) FROM CHECKLIST_ITEM
-			 WHERE CHECKLIST_ID = @checklistId AND CHECKLIST_ITEM_COMPLETED = FALSE`,
-			pgx.NamedArgs{"checklistId": p.checklistId, "defaultPosition": domain.FirstItemPosition}).Scan(&minPosition)
+		mode, err := lockChecklistPositions(tx, p.checklistId)
 		if err != nil {
 			return domain.ChecklistItem{}, err
 		}
 
-		newPosition := minPosition - domain.DefaultGapSize
+		if p.checklistItem.SectionId != nil {
+			p.checklistItem.SectionId, err = findTargetSection(tx, p.checklistId, *p.checklistItem.SectionId)
+			if err != nil {
+				return domain.ChecklistItem{}, err
+			}
+		}
+
+		// Get the minimum position for uncompleted items of the section (new items go at the front)
+		var minPosition string
+		err = tx.QueryRow(context.Background(),
+			`SELECT COALESCE(MIN(POSITION), '') FROM CHECKLIST_ITEM
+			 WHERE CHECKLIST_ID = @checklistId AND SECTION_ID IS NOT DISTINCT FROM @sectionId
+			   AND (CAST(@section AS BOOLEAN) IS NULL OR CHECKLIST_ITEM_COMPLETED = @section)`,
+			pgx.NamedArgs{
+				"checklistId": p.checklistId,
+				"sectionId":   p.checklistItem.SectionId,
+				"section":     positionSection(mode, false),
+			}).Scan(&minPosition)
+		if err != nil {
+			return domain.ChecklistItem{}, err
+		}
+
+		newPosition, err := domain.PositionBetween("", minPosition)
+		if err != nil {
+			return domain.ChecklistItem{}, err
+		}
+
+		// Items created as completed are attributed to the creating user
+		p.checklistItem.CompletedBy, p.checklistItem.CompletedAt = nil, nil
+		if p.checklistItem.Completed {
+			completedAt := time.Now().UTC()
+			p.checklistItem.CompletedBy = &p.userId
+			p.checklistItem.CompletedAt = &completedAt
+		}
 
 		// Insert new item at the front
-		insertSql := `INSERT INTO CHECKLIST_ITEM(CHECKLIST_ITEM_ID, CHECKLIST_ID, CHECKLIST_ITEM_NAME, CHECKLIST_ITEM_COMPLETED, POSITION, UPDATED_AT)
-					  VALUES(nextval('checklist_item_id_sequence'), @checklistId, @checklistItemName, @checklistItemCompleted, @position, CURRENT_TIMESTAMP)
+		insertSql := `INSERT INTO CHECKLIST_ITEM(CHECKLIST_ITEM_ID, CHECKLIST_ID, CHECKLIST_ITEM_NAME, CHECKLIST_ITEM_COMPLETED, POSITION, UPDATED_AT,
+					                           CHECKLIST_ITEM_COMPLETED_BY, CHECKLIST_ITEM_COMPLETED_AT, SECTION_ID, NOTES)
+					  VALUES(nextval('checklist_item_id_sequence'), @checklistId, @checklistItemName, @checklistItemCompleted, @position, CURRENT_TIMESTAMP,
+					         @completedBy, @completedAt, @sectionId, @notes)
 					  RETURNING CHECKLIST_ITEM_ID`
 
 		err = tx.QueryRow(context.Background(), insertSql, pgx.NamedArgs{
@@ -40,6 +72,10 @@ func (p *PersistChecklistItemQueryFunction) GetTransactionalQueryFunction() func
 			"checklistItemName":      p.checklistItem.Name,
 			"checklistItemCompleted": p.checklistItem.Completed,
 			"position":               newPosition,
+			"completedBy":            p.checklistItem.CompletedBy,
+			"completedAt":            p.checklistItem.CompletedAt,
+			"sectionId":              p.checklistItem.SectionId,
+			"notes":                  p.checklistItem.Notes,
 		}).Scan(&p.checklistItem.Id)
 
 		if err != nil {
@@ -51,6 +87,39 @@ func (p *PersistChecklistItemQueryFunction) GetTransactionalQueryFunction() func
 	}
 }
 
+// checklistItemsWithRowsQuery selects the active items of a checklist with their rows in display order,
+// optionally filtered by completion
+const checklistItemsWithRowsQuery = `
+		SELECT
+			ci.CHECKLIST_ITEM_ID,
+			ci.CHECKLIST_ITEM_NAME,
+			ci.CHECKLIST_ITEM_COMPLETED,
+			ci.CHECKLIST_ITEM_COMPLETED_BY,
+			ci.CHECKLIST_ITEM_COMPLETED_AT,
+			ci.POSITION,
+			ci.SECTION_ID,
+			ci.NOTES,
+			ROW_NUMBER() OVER (
+				PARTITION BY ci.CHECKLIST_ID, ci.SECTION_ID
+				ORDER BY CASE WHEN c.ORDERING_MODE = 'KEEP_IN_PLACE' THEN FALSE ELSE ci.CHECKLIST_ITEM_COMPLETED END ASC, ci.POSITION ASC
+			) AS ORDER_NUMBER,
+			ROWS.CHECKLIST_ITEM_ROW_ID,
+			ROWS.CHECKLIST_ITEM_ROW_NAME,
+			ROWS.CHECKLIST_ITEM_ROW_COMPLETED,
+			ROWS.CHECKLIST_ITEM_ROW_COMPLETED_BY,
+			ROWS.CHECKLIST_ITEM_ROW_COMPLETED_AT,
+			ROWS.CHECKLIST_ITEM_ROW_POSITION
+		FROM CHECKLIST_ITEM ci
+		JOIN CHECKLIST c ON c.ID = ci.CHECKLIST_ID
+		LEFT JOIN CHECKLIST_SECTION s ON s.ID = ci.SECTION_ID
+		LEFT JOIN CHECKLIST_ITEM_ROW AS ROWS ON ROWS.CHECKLIST_ITEM_ID = ci.CHECKLIST_ITEM_ID
+		WHERE (CAST(@checklist_item_completed as Boolean) IS NULL OR ci.CHECKLIST_ITEM_COMPLETED = @checklist_item_completed)
+		  AND ci.CHECKLIST_ID = @checklist_id
+		  AND ci.DELETED_AT IS NULL
+		ORDER BY s.POSITION ASC NULLS FIRST, s.ID ASC NULLS FIRST,
+		         CASE WHEN c.ORDERING_MODE = 'KEEP_IN_PLACE' THEN FALSE ELSE ci.CHECKLIST_ITEM_COMPLETED END ASC, ci.POSITION ASC,
+		         ROWS.CHECKLIST_ITEM_ROW_COMPLETED ASC, ROWS.CHECKLIST_ITEM_ROW_POSITION ASC`
+
 // GetAllChecklistItemsQueryFunction Get all checklist queries struct
 type GetAllChecklistItemsQueryFunction struct {
 	checklistId uint
@@ -60,28 +129,8 @@ type GetAllChecklistItemsQueryFunction struct {
 
 func (p *GetAllChecklistItemsQueryFunction) GetQueryFunction(ctx context.Context) func(connection pool.Conn) ([]dbo.ChecklistItemDbo, error) {
 	return func(connection pool.Conn) ([]dbo.ChecklistItemDbo, error) {
-		query := `
-			SELECT
-				ci.CHECKLIST_ITEM_ID,
-				ci.CHECKLIST_ITEM_NAME,
-				ci.CHECKLIST_ITEM_COMPLETED,
-				ci.POSITION,
-				ROW_NUMBER() OVER (
-					PARTITION BY ci.CHECKLIST_ID
-					ORDER BY ci.CHECKLIST_ITEM_COMPLETED ASC, ci.POSITION ASC
-				) AS ORDER_NUMBER,
-				ROWS.CHECKLIST_ITEM_ROW_ID,
-				ROWS.CHECKLIST_ITEM_ROW_NAME,
-				ROWS.CHECKLIST_ITEM_ROW_COMPLETED
-			FROM CHECKLIST_ITEM ci
-			LEFT JOIN CHECKLIST_ITEM_ROW AS ROWS ON ROWS.CHECKLIST_ITEM_ID = ci.CHECKLIST_ITEM_ID
-			WHERE (CAST(@checklist_item_completed as Boolean) IS NULL OR ci.CHECKLIST_ITEM_COMPLETED = @checklist_item_completed)
-			  AND ci.CHECKLIST_ID = @checklist_id
-			  AND ci.DELETED_AT IS NULL
-			ORDER BY ci.CHECKLIST_ITEM_COMPLETED ASC, ci.POSITION ASC, ROWS.CHECKLIST_ITEM_ROW_COMPLETED ASC`
-
 		var result []dbo.ChecklistItemDbo
-		err := connection.QueryList(context.Background(), query, &result, pgx.NamedArgs{
+		err := connection.QueryList(context.Background(), checklistItemsWithRowsQuery, &result, pgx.NamedArgs{
 			"checklist_id":             p.checklistId,
 			"checklist_item_completed": p.completed,
 		})
@@ -133,8 +182,15 @@ func (d *DeleteChecklistItemQueryFunction) GetTransactionalQueryFunction() func(
 
 		if result.RowsAffected() > 1 {
 			return false, errors.New("softDeleteChecklistItem affected more than one row")
+		} else if result.RowsAffected() == 0 {
+			return false, nil
+		}
+
+		// Comments are deleted together with their item
+		if err := softDeleteItemComments(tx, d.checklistId, []uint{d.checklistItemId}); err != nil {
+			return false, err
 		}
-		return result.RowsAffected() == 1, nil
+		return true, nil
 	}
 }
 
@@ -150,9 +206,16 @@ func (f *FindChecklistItemById) GetQueryFunction(ctx context.Context) func(conne
 					ci.CHECKLIST_ITEM_ID,
 					ci.CHECKLIST_ITEM_NAME,
 					ci.CHECKLIST_ITEM_COMPLETED,
+					ci.CHECKLIST_ITEM_COMPLETED_BY,
+					ci.CHECKLIST_ITEM_COMPLETED_AT,
 					ci.POSITION,
+					ci.SECTION_ID,
+					ci.NOTES,
 					CIR.CHECKLIST_ITEM_ROW_NAME,
 					CIR.CHECKLIST_ITEM_ROW_COMPLETED,
+					CIR.CHECKLIST_ITEM_ROW_COMPLETED_BY,
+					CIR.CHECKLIST_ITEM_ROW_COMPLETED_AT,
+					CIR.CHECKLIST_ITEM_ROW_POSITION,
 					CIR.CHECKLIST_ITEM_ROW_ID
 				FROM CHECKLIST_ITEM ci
 				LEFT JOIN CHECKLIST_ITEM_ROW CIR ON ci.CHECKLIST_ITEM_ID = CIR.CHECKLIST_ITEM_ID
@@ -173,6 +236,7 @@ func (f *FindChecklistItemById) GetQueryFunction(ctx context.Context) func(conne
 type UpdateChecklistItemFunction struct {
 	checklistId   uint
 	checklistItem domain.ChecklistItem
+	userId        string
 }
 
 func (u *UpdateChecklistItemFunction) GetTransactionalQueryFunction() func(tx pool.TransactionWrapper) (bool, error) {
@@ -192,16 +256,30 @@ func (u *UpdateChecklistItemFunction) GetTransactionalQueryFunction() func(tx po
 			return false, err
 		}
 
-		// Perform the update
+		// Perform the update. Completion audit fields are set only when the item transitions to completed
+		// and cleared on uncheck. Absent notes keep the stored ones, empty notes clear them.
 		sql := `UPDATE CHECKLIST_ITEM
-				SET CHECKLIST_ITEM_NAME = @checklistItemName, CHECKLIST_ITEM_COMPLETED = @checklistItemCompleted, UPDATED_AT = CURRENT_TIMESTAMP
+				SET CHECKLIST_ITEM_COMPLETED_BY = CASE
+				        WHEN @checklistItemCompleted AND CHECKLIST_ITEM_COMPLETED THEN CHECKLIST_ITEM_COMPLETED_BY
+				        WHEN @checklistItemCompleted THEN @userId
+				        ELSE NULL
+				    END,
+				    CHECKLIST_ITEM_COMPLETED_AT = CASE
+				        WHEN @checklistItemCompleted AND CHECKLIST_ITEM_COMPLETED THEN CHECKLIST_ITEM_COMPLETED_AT
+				        WHEN @checklistItemCompleted THEN CURRENT_TIMESTAMP
+				        ELSE NULL
+				    END,
+				    CHECKLIST_ITEM_NAME = @checklistItemName, CHECKLIST_ITEM_COMPLETED = @checklistItemCompleted,
+				    NOTES = NULLIF(COALESCE(CAST(@notes AS TEXT), NOTES), ''), UPDATED_AT = CURRENT_TIMESTAMP
 				WHERE CHECKLIST_ID = @checklistId and CHECKLIST_ITEM_ID = @checklistItemId`
 
 		args := pgx.NamedArgs{
 			"checklistItemName":      u.checklistItem.Name,
 			"checklistItemCompleted": u.checklistItem.Completed,
+			"notes":                  u.checklistItem.Notes,
 			"checklistId":            u.checklistId,
 			"checklistItemId":        u.checklistItem.Id,
+			"userId":                 u.userId,
 		}
 		res, err := tx.Exec(context.Background(), sql, args)
 
@@ -209,6 +287,40 @@ func (u *UpdateChecklistItemFunction) GetTransactionalQueryFunction() func(tx po
 	}
 }
 
+// RenameChecklistItemQueryFunction changes only the name of an active item
+type RenameChecklistItemQueryFunction struct {
+	checklistId     uint
+	checklistItemId uint
+	name            string
+}
+
+func NewRenameChecklistItemQueryFunction(checklistId uint, checklistItemId uint, name string) *RenameChecklistItemQueryFunction {
+	return &RenameChecklistItemQueryFunction{
+		checklistId:     checklistId,
+		checklistItemId: checklistItemId,
+		name:            name,
+	}
+}
+
+func (r *RenameChecklistItemQueryFunction) GetTransactionalQueryFunction() func(tx pool.TransactionWrapper) (bool, error) {
+	return func(tx pool.TransactionWrapper) (bool, error) {
+		sql := `UPDATE CHECKLIST_ITEM
+				SET CHECKLIST_ITEM_NAME = @checklistItemName, UPDATED_AT = CURRENT_TIMESTAMP
+				WHERE CHECKLIST_ID = @checklistId AND CHECKLIST_ITEM_ID = @checklistItemId
+				AND DELETED_AT IS NULL`
+
+		res, err := tx.Exec(context.Background(), sql, pgx.NamedArgs{
+			"checklistItemName": r.name,
+			"checklistId":       r.checklistId,
+			"checklistItemId":   r.checklistItemId,
+		})
+		if err != nil {
+			return false, err
+		}
+		return res.RowsAffected() == 1, nil
+	}
+}
+
 // RestoreChecklistItemQueryFunction restores a soft-deleted item (undo)
 type RestoreChecklistItemQueryFunction struct {
 	checklistId     uint
@@ -239,9 +351,14 @@ func (r *RestoreChecklistItemQueryFunction) GetTransactionalQueryFunction() func
 			return dbo.ChecklistItemDbo{}, err
 		}
 
+		// Restore the comments deleted together with the item, before its deletion timestamp is cleared
+		if err := restoreItemComments(tx, r.checklistId, []uint{r.checklistItemId}); err != nil {
+			return dbo.ChecklistItemDbo{}, err
+		}
+
 		// Restore the item (clear deleted_at)
 		restoreSQL := `UPDATE CHECKLIST_ITEM 
-					SET DELETED_AT = NULL, DELETED_BY = NULL
+					SET DELETED_AT = NULL, DELETED_BY = NULL, DELETION_GROUP_ID = NULL
 					WHERE CHECKLIST_ID = @checklist_id AND CHECKLIST_ITEM_ID = @checklist_item_id
 					AND DELETED_AT IS NOT NULL`
 
@@ -258,14 +375,21 @@ func (r *RestoreChecklistItemQueryFunction) GetTransactionalQueryFunction() func
 				ci.CHECKLIST_ITEM_ID,
 				ci.CHECKLIST_ITEM_NAME,
 				ci.CHECKLIST_ITEM_COMPLETED,
+				ci.CHECKLIST_ITEM_COMPLETED_BY,
+				ci.CHECKLIST_ITEM_COMPLETED_AT,
 				ci.POSITION,
+				ci.SECTION_ID,
+				ci.NOTES,
 				ROWS.CHECKLIST_ITEM_ROW_ID,
 				ROWS.CHECKLIST_ITEM_ROW_NAME,
-				ROWS.CHECKLIST_ITEM_ROW_COMPLETED
+				ROWS.CHECKLIST_ITEM_ROW_COMPLETED,
+				ROWS.CHECKLIST_ITEM_ROW_COMPLETED_BY,
+				ROWS.CHECKLIST_ITEM_ROW_COMPLETED_AT,
+				ROWS.CHECKLIST_ITEM_ROW_POSITION
 			FROM CHECKLIST_ITEM ci
 			LEFT JOIN CHECKLIST_ITEM_ROW AS ROWS ON ROWS.CHECKLIST_ITEM_ID = ci.CHECKLIST_ITEM_ID
 			WHERE ci.CHECKLIST_ID = @checklist_id AND ci.CHECKLIST_ITEM_ID = @checklist_item_id
-			ORDER BY ROWS.CHECKLIST_ITEM_ROW_COMPLETED ASC`
+			ORDER BY ROWS.CHECKLIST_ITEM_ROW_COMPLETED ASC, ROWS.CHECKLIST_ITEM_ROW_POSITION ASC`
 
 		var results []dbo.ChecklistItemDbo
 		err = tx.QueryList(context.Background(), selectSQL, &results, pgx.NamedArgs{
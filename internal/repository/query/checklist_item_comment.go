@@ -0,0 +1,329 @@
+package query
+
+import (
+	"context"
+
+	"com.raunlo.checklist/internal/core/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/raunlo/pgx-with-automapper/pool"
+)
+
+// checklistItemCommentSelect selects comments with their author name and mentions. Deleted comments are
+// returned without content or mentions.
+const checklistItemCommentSelect = `
+	SELECT cm.ID, ci.CHECKLIST_ID, cm.CHECKLIST_ITEM_ID, cm.PARENT_ID, cm.AUTHOR, u.name,
+	       CASE WHEN cm.DELETED_AT IS NULL THEN cm.CONTENT ELSE '' END,
+	       cm.CREATED_AT, cm.EDITED_AT, cm.DELETED_AT IS NOT NULL,
+	       COALESCE(ARRAY_AGG(m.USER_ID ORDER BY m.USER_ID) FILTER (WHERE m.USER_ID IS NOT NULL AND cm.DELETED_AT IS NULL), '{}'),
+	       COALESCE(ARRAY_AGG(mu.name ORDER BY m.USER_ID) FILTER (WHERE m.USER_ID IS NOT NULL AND cm.DELETED_AT IS NULL), '{}')
+	FROM CHECKLIST_ITEM_COMMENT cm
+	JOIN CHECKLIST_ITEM ci ON ci.CHECKLIST_ITEM_ID = cm.CHECKLIST_ITEM_ID
+	LEFT JOIN app_user u ON u.user_id = cm.AUTHOR
+	LEFT JOIN CHECKLIST_ITEM_COMMENT_MENTION m ON m.COMMENT_ID = cm.ID
+	LEFT JOIN app_user mu ON mu.user_id = m.USER_ID`
+
+const checklistItemCommentGroupBy = `
+	GROUP BY cm.ID, ci.CHECKLIST_ID, u.name`
+
+// GetChecklistItemCommentsQueryFunction returns the comments of an active item in creation order. Deleted
+// comments are kept while the thread below them still has comments, so replies never lose their parent.
+type GetChecklistItemCommentsQueryFunction struct {
+	checklistId uint
+	itemId      uint
+}
+
+func NewGetChecklistItemCommentsQueryFunction(checklistId uint, itemId uint) *GetChecklistItemCommentsQueryFunction {
+	return &GetChecklistItemCommentsQueryFunction{checklistId: checklistId, itemId: itemId}
+}
+
+func (g *GetChecklistItemCommentsQueryFunction) GetTransactionalQueryFunction() func(tx pool.TransactionWrapper) ([]domain.ChecklistItemComment, error) {
+	return func(tx pool.TransactionWrapper) ([]domain.ChecklistItemComment, error) {
+		rows, err := tx.Query(context.Background(),
+			`WITH RECURSIVE visible AS (
+			     SELECT ID, PARENT_ID FROM CHECKLIST_ITEM_COMMENT
+			     WHERE CHECKLIST_ITEM_ID = @itemId AND DELETED_AT IS NULL
+			     UNION
+			     SELECT p.ID, p.PARENT_ID FROM CHECKLIST_ITEM_COMMENT p
+			     JOIN visible v ON v.PARENT_ID = p.ID
+			 )`+checklistItemCommentSelect+`
+			 WHERE ci.CHECKLIST_ID = @checklistId AND ci.CHECKLIST_ITEM_ID = @itemId AND ci.DELETED_AT IS NULL
+			   AND cm.ID IN (SELECT ID FROM visible)`+checklistItemCommentGroupBy+`
+			 ORDER BY cm.CREATED_AT ASC, cm.ID ASC`,
+			pgx.NamedArgs{"checklistId": g.checklistId, "itemId": g.itemId})
+		if err != nil {
+			return nil, err
+		}
+		return scanChecklistItemComments(rows)
+	}
+}
+
+// FindChecklistItemCommentQueryFunction finds an active comment, or returns pgx.ErrNoRows
+type FindChecklistItemCommentQueryFunction struct {
+	checklistId uint
+	itemId      uint
+	commentId   uint
+}
+
+func NewFindChecklistItemCommentQueryFunction(checklistId uint, itemId uint, commentId uint) *FindChecklistItemCommentQueryFunction {
+	return &FindChecklistItemCommentQueryFunction{checklistId: checklistId, itemId: itemId, commentId: commentId}
+}
+
+func (f *FindChecklistItemCommentQueryFunction) GetTransactionalQueryFunction() func(tx pool.TransactionWrapper) (domain.ChecklistItemComment, error) {
+	return func(tx pool.TransactionWrapper) (domain.ChecklistItemComment, error) {
+		return findChecklistItemComment(tx, f.checklistId, f.itemId, f.commentId)
+	}
+}
+
+// PersistChecklistItemCommentQueryFunction adds a comment to an active item. A reply must answer an active
+// comment of the same item.
+type PersistChecklistItemCommentQueryFunction struct {
+	comment domain.ChecklistItemComment
+}
+
+func NewPersistChecklistItemCommentQueryFunction(comment domain.ChecklistItemComment) *PersistChecklistItemCommentQueryFunction {
+	return &PersistChecklistItemCommentQueryFunction{comment: comment}
+}
+
+func (p *PersistChecklistItemCommentQueryFunction) GetTransactionalQueryFunction() func(tx pool.TransactionWrapper) (domain.ChecklistItemComment, error) {
+	return func(tx pool.TransactionWrapper) (domain.ChecklistItemComment, error) {
+		var itemId uint
+		err := tx.QueryRow(context.Background(),
+			`SELECT CHECKLIST_ITEM_ID FROM CHECKLIST_ITEM
+			 WHERE CHECKLIST_ID = @checklistId AND CHECKLIST_ITEM_ID = @itemId AND DELETED_AT IS NULL
+			 FOR SHARE`,
+			pgx.NamedArgs{"checklistId": p.comment.ChecklistId, "itemId": p.comment.ItemId}).Scan(&itemId)
+		if err != nil {
+			return domain.ChecklistItemComment{}, err
+		}
+
+		if p.comment.ParentId != nil {
+			var parentId uint
+			err = tx.QueryRow(context.Background(),
+				`SELECT ID FROM CHECKLIST_ITEM_COMMENT
+				 WHERE ID = @parentId AND CHECKLIST_ITEM_ID = @itemId AND DELETED_AT IS NULL`,
+				pgx.NamedArgs{"parentId": *p.comment.ParentId, "itemId": p.comment.ItemId}).Scan(&parentId)
+			if err != nil {
+				return domain.ChecklistItemComment{}, err
+			}
+		}
+
+		var commentId uint
+		err = tx.QueryRow(context.Background(),
+			`INSERT INTO CHECKLIST_ITEM_COMMENT(CHECKLIST_ITEM_ID, PARENT_ID, AUTHOR, CONTENT)
+			 VALUES(@itemId, @parentId, @author, @content)
+			 RETURNING ID`,
+			pgx.NamedArgs{
+				"itemId":   p.comment.ItemId,
+				"parentId": p.comment.ParentId,
+				"author":   p.comment.Author,
+				"content":  p.comment.Content,
+			}).Scan(&commentId)
+		if err != nil {
+			return domain.ChecklistItemComment{}, err
+		}
+
+		if err := saveCommentMentions(tx, commentId, p.comment.MentionedUserIds()); err != nil {
+			return domain.ChecklistItemComment{}, err
+		}
+		return findChecklistItemComment(tx, p.comment.ChecklistId, p.comment.ItemId, commentId)
+	}
+}
+
+// UpdateChecklistItemCommentQueryFunction replaces the content and mentions of an active comment of its author
+type UpdateChecklistItemCommentQueryFunction struct {
+	comment domain.ChecklistItemComment
+}
+
+func NewUpdateChecklistItemCommentQueryFunction(comment domain.ChecklistItemComment) *UpdateChecklistItemCommentQueryFunction {
+	return &UpdateChecklistItemCommentQueryFunction{comment: comment}
+}
+
+func (u *UpdateChecklistItemCommentQueryFunction) GetTransactionalQueryFunction() func(tx pool.TransactionWrapper) (domain.ChecklistItemComment, error) {
+	return func(tx pool.TransactionWrapper) (domain.ChecklistItemComment, error) {
+		res, err := tx.Exec(context.Background(),
+			`UPDATE CHECKLIST_ITEM_COMMENT cm
+			 SET CONTENT = @content, EDITED_AT = CURRENT_TIMESTAMP
+			 FROM CHECKLIST_ITEM ci
+			 WHERE ci.CHECKLIST_ITEM_ID = cm.CHECKLIST_ITEM_ID AND ci.CHECKLIST_ID = @checklistId
+			   AND cm.CHECKLIST_ITEM_ID = @itemId AND cm.ID = @commentId AND cm.AUTHOR = @author
+			   AND cm.DELETED_AT IS NULL`,
+			pgx.NamedArgs{
+				"content":     u.comment.Content,
+				"checklistId": u.comment.ChecklistId,
+				"itemId":      u.comment.ItemId,
+				"commentId":   u.comment.Id,
+				"author":      u.comment.Author,
+			})
+		if err != nil {
+			return domain.ChecklistItemComment{}, err
+		} else if res.RowsAffected() == 0 {
+			return domain.ChecklistItemComment{}, pgx.ErrNoRows
+		}
+
+		_, err = tx.Exec(context.Background(),
+			`DELETE FROM CHECKLIST_ITEM_COMMENT_MENTION WHERE COMMENT_ID = @commentId`,
+			pgx.NamedArgs{"commentId": u.comment.Id})
+		if err != nil {
+			return domain.ChecklistItemComment{}, err
+		}
+		if err := saveCommentMentions(tx, u.comment.Id, u.comment.MentionedUserIds()); err != nil {
+			return domain.ChecklistItemComment{}, err
+		}
+		return findChecklistItemComment(tx, u.comment.ChecklistId, u.comment.ItemId, u.comment.Id)
+	}
+}
+
+// DeleteChecklistItemCommentQueryFunction soft-deletes an active comment of its author. Replies stay in place.
+type DeleteChecklistItemCommentQueryFunction struct {
+	checklistId uint
+	itemId      uint
+	commentId   uint
+	author      string
+}
+
+func NewDeleteChecklistItemCommentQueryFunction(checklistId uint, itemId uint, commentId uint, author string) *DeleteChecklistItemCommentQueryFunction {
+	return &DeleteChecklistItemCommentQueryFunction{checklistId: checklistId, itemId: itemId, commentId: commentId, author: author}
+}
+
+func (d *DeleteChecklistItemCommentQueryFunction) GetTransactionalQueryFunction() func(tx pool.TransactionWrapper) (bool, error) {
+	return func(tx pool.TransactionWrapper) (bool, error) {
+		res, err := tx.Exec(context.Background(),
+			`UPDATE CHECKLIST_ITEM_COMMENT cm
+			 SET DELETED_AT = CURRENT_TIMESTAMP
+			 FROM CHECKLIST_ITEM ci
+			 WHERE ci.CHECKLIST_ITEM_ID = cm.CHECKLIST_ITEM_ID AND ci.CHECKLIST_ID = @checklistId
+			   AND cm.CHECKLIST_ITEM_ID = @itemId AND cm.ID = @commentId AND cm.AUTHOR = @author
+			   AND cm.DELETED_AT IS NULL`,
+			pgx.NamedArgs{
+				"checklistId": d.checklistId,
+				"itemId":      d.itemId,
+				"commentId":   d.commentId,
+				"author":      d.author,
+			})
+		if err != nil {
+			return false, err
+		}
+		return res.RowsAffected() == 1, nil
+	}
+}
+
+// FindUsersWithChecklistAccessQueryFunction returns which of the given users can access the checklist: its
+// owner, users it is shared with and members of its circle
+type FindUsersWithChecklistAccessQueryFunction struct {
+	checklistId uint
+	userIds     []string
+}
+
+func NewFindUsersWithChecklistAccessQueryFunction(checklistId uint, userIds []string) *FindUsersWithChecklistAccessQueryFunction {
+	return &FindUsersWithChecklistAccessQueryFunction{checklistId: checklistId, userIds: userIds}
+}
+
+func (f *FindUsersWithChecklistAccessQueryFunction) GetTransactionalQueryFunction() func(tx pool.TransactionWrapper) ([]string, error) {
+	return func(tx pool.TransactionWrapper) ([]string, error) {
+		rows, err := tx.Query(context.Background(),
+			`SELECT u.user_id
+			 FROM app_user u
+			 JOIN CHECKLIST c ON c.ID = @checklistId
+			 WHERE u.user_id = ANY(@userIds)
+			   AND (
+			     c.OWNER = u.user_id
+			     OR EXISTS (SELECT 1 FROM CHECKLIST_SHARE cs WHERE cs.CHECKLIST_ID = c.ID AND cs.SHARED_WITH_USER_ID = u.user_id)
+			     OR EXISTS (SELECT 1 FROM workspace_member wm WHERE wm.workspace_id = c.WORKSPACE_ID AND wm.user_id = u.user_id)
+			   )`,
+			pgx.NamedArgs{"checklistId": f.checklistId, "userIds": f.userIds})
+		if err != nil {
+			return nil, err
+		}
+		defer rows.Close()
+
+		userIds := []string{}
+		for rows.Next() {
+			var userId string
+			if err := rows.Scan(&userId); err != nil {
+				return nil, err
+			}
+			userIds = append(userIds, userId)
+		}
+		return userIds, rows.Err()
+	}
+}
+
+// softDeleteItemComments marks the active comments of soft-deleted items as deleted with the deletion timestamp
+// of their item, which tells them apart from comments deleted by their author when the item is restored
+func softDeleteItemComments(tx pool.TransactionWrapper, checklistId uint, itemIds []uint) error {
+	_, err := tx.Exec(context.Background(),
+		`UPDATE CHECKLIST_ITEM_COMMENT cm
+		 SET DELETED_AT = ci.DELETED_AT
+		 FROM CHECKLIST_ITEM ci
+		 WHERE ci.CHECKLIST_ITEM_ID = cm.CHECKLIST_ITEM_ID AND ci.CHECKLIST_ID = @checklistId
+		   AND ci.CHECKLIST_ITEM_ID = ANY(@itemIds) AND ci.DELETED_AT IS NOT NULL
+		   AND cm.DELETED_AT IS NULL`,
+		pgx.NamedArgs{"checklistId": checklistId, "itemIds": itemIds})
+	return err
+}
+
+// restoreItemComments restores the comments that were deleted together with their item. It must run before
+// the deletion timestamp of the item is cleared.
+func restoreItemComments(tx pool.TransactionWrapper, checklistId uint, itemIds []uint) error {
+	_, err := tx.Exec(context.Background(),
+		`UPDATE CHECKLIST_ITEM_COMMENT cm
+		 SET DELETED_AT = NULL
+		 FROM CHECKLIST_ITEM ci
+		 WHERE ci.CHECKLIST_ITEM_ID = cm.CHECKLIST_ITEM_ID AND ci.CHECKLIST_ID = @checklistId
+		   AND ci.CHECKLIST_ITEM_ID = ANY(@itemIds) AND cm.DELETED_AT = ci.DELETED_AT`,
+		pgx.NamedArgs{"checklistId": checklistId, "itemIds": itemIds})
+	return err
+}
+
+func saveCommentMentions(tx pool.TransactionWrapper, commentId uint, userIds []string) error {
+	if len(userIds) == 0 {
+		return nil
+	}
+	_, err := tx.Exec(context.Background(),
+		`INSERT INTO CHECKLIST_ITEM_COMMENT_MENTION(COMMENT_ID, USER_ID)
+		 SELECT @commentId, UNNEST(CAST(@userIds AS VARCHAR[]))
+		 ON CONFLICT DO NOTHING`,
+		pgx.NamedArgs{"commentId": commentId, "userIds": userIds})
+	return err
+}
+
+func findChecklistItemComment(tx pool.TransactionWrapper, checklistId uint, itemId uint, commentId uint) (domain.ChecklistItemComment, error) {
+	rows, err := tx.Query(context.Background(),
+		checklistItemCommentSelect+`
+		 WHERE ci.CHECKLIST_ID = @checklistId AND ci.CHECKLIST_ITEM_ID = @itemId AND ci.DELETED_AT IS NULL
+		   AND cm.ID = @commentId AND cm.DELETED_AT IS NULL`+checklistItemCommentGroupBy,
+		pgx.NamedArgs{"checklistId": checklistId, "itemId": itemId, "commentId": commentId})
+	if err != nil {
+		return domain.ChecklistItemComment{}, err
+	}
+	comments, err := scanChecklistItemComments(rows)
+	if err != nil {
+		return domain.ChecklistItemComment{}, err
+	} else if len(comments) == 0 {
+		return domain.ChecklistItemComment{}, pgx.ErrNoRows
+	}
+	return comments[0], nil
+}
+
+func scanChecklistItemComments(rows pgx.Rows) ([]domain.ChecklistItemComment, error) {
+	defer rows.Close()
+
+	comments := []domain.ChecklistItemComment{}
+	for rows.Next() {
+		var comment domain.ChecklistItemComment
+		var mentionIds []string
+		var mentionNames []*string
+		err := rows.Scan(&comment.Id, &comment.ChecklistId, &comment.ItemId, &comment.ParentId, &comment.Author,
+			&comment.AuthorName, &comment.Content, &comment.CreatedAt, &comment.EditedAt, &comment.Deleted,
+			&mentionIds, &mentionNames)
+		if err != nil {
+			return nil, err
+		}
+		comment.Mentions = make([]domain.ChecklistItemCommentMention, 0, len(mentionIds))
+		for index, userId := range mentionIds {
+			comment.Mentions = append(comment.Mentions, domain.ChecklistItemCommentMention{UserId: userId, Name: mentionNames[index]})
+		}
+		comments = append(comments, comment)
+	}
+	return comments, rows.Err()
+}
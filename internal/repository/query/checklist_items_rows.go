@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"com.raunlo.checklist/internal/core/domain"
 	"github.com/jackc/pgx/v5"
@@ -15,6 +16,7 @@ import (
 type PersistChecklistItemRowQueryFunction struct {
 	checklistItemId   uint
 	checklistItemRows []domain.ChecklistItemRow
+	userId            string
 }
 
 func (q *PersistChecklistItemRowQueryFunction) GetTransactionalQueryFunction() func(tx pool.TransactionWrapper) ([]domain.ChecklistItemRow, error) {
@@ -24,7 +26,7 @@ func (q *PersistChecklistItemRowQueryFunction) GetTransactionalQueryFunction() f
 		}
 		namedArgumentsMap := pgx.NamedArgs{}
 		var query strings.Builder
-		query.WriteString("INSERT INTO CHECKLIST_ITEM_ROW(CHECKLIST_ITEM_ROW_ID, CHECKLIST_ITEM_ID, CHECKLIST_ITEM_ROW_NAME, CHECKLIST_ITEM_ROW_COMPLETED) VALUES ")
+		query.WriteString("INSERT INTO CHECKLIST_ITEM_ROW(CHECKLIST_ITEM_ROW_ID, CHECKLIST_ITEM_ID, CHECKLIST_ITEM_ROW_NAME, CHECKLIST_ITEM_ROW_COMPLETED, CHECKLIST_ITEM_ROW_COMPLETED_BY, CHECKLIST_ITEM_ROW_COMPLETED_AT, CHECKLIST_ITEM_ROW_POSITION) VALUES ")
 		getSequenceValuesQuery := GetSequenceValuesQuery{
 			sequenceName:   "checklist_item_row_id_sequence",
 			numberOfValues: len(q.checklistItemRows),
@@ -34,16 +36,34 @@ func (q *PersistChecklistItemRowQueryFunction) GetTransactionalQueryFunction() f
 			return nil, err
 		}
 
+		// New rows are appended after the existing rows of the item
+		var lastPosition string
+		err = tx.QueryRow(context.Background(),
+			`SELECT COALESCE(MAX(CHECKLIST_ITEM_ROW_POSITION), '') FROM CHECKLIST_ITEM_ROW WHERE CHECKLIST_ITEM_ID = @itemId`,
+			pgx.NamedArgs{"itemId": q.checklistItemId}).Scan(&lastPosition)
+		if err != nil {
+			return nil, err
+		}
+		positions, err := domain.PositionsBetween(lastPosition, "", len(q.checklistItemRows))
+		if err != nil {
+			return nil, err
+		}
+
 		for index := range q.checklistItemRows {
 			rowPointer := &q.checklistItemRows[index]
 			rowPointer.Id = ids[index]
+			rowPointer.Position = positions[index]
 
 			itemRowIdParamName := getIndexedSQLValueParamName(index, "checklist_item_row_id")
 			itemIdParamName := getIndexedSQLValueParamName(index, "checklist_item_id")
 			itemRowNameParamName := getIndexedSQLValueParamName(index, "checklist_item_row_name")
 			itemRowCompletedParamName := getIndexedSQLValueParamName(index, "checklist_item_row_completed")
-			query.WriteString(fmt.Sprintf(" (@%s, @%s, @%s, @%s)",
-				itemRowIdParamName, itemIdParamName, itemRowNameParamName, itemRowCompletedParamName))
+			itemRowCompletedByParamName := getIndexedSQLValueParamName(index, "checklist_item_row_completed_by")
+			itemRowCompletedAtParamName := getIndexedSQLValueParamName(index, "checklist_item_row_completed_at")
+			itemRowPositionParamName := getIndexedSQLValueParamName(index, "checklist_item_row_position")
+			query.WriteString(fmt.Sprintf(" (@%s, @%s, @%s, @%s, @%s, @%s, @%s)",
+				itemRowIdParamName, itemIdParamName, itemRowNameParamName, itemRowCompletedParamName,
+				itemRowCompletedByParamName, itemRowCompletedAtParamName, itemRowPositionParamName))
 			if index != len(q.checklistItemRows)-1 {
 				query.WriteString(", ")
 			} else {
@@ -55,6 +75,16 @@ func (q *PersistChecklistItemRowQueryFunction) GetTransactionalQueryFunction() f
 			namedArgumentsMap[itemRowIdParamName] = rowPointer.Id
 			namedArgumentsMap[itemRowNameParamName] = rowPointer.Name
 			namedArgumentsMap[itemRowCompletedParamName] = rowPointer.Completed
+			namedArgumentsMap[itemRowPositionParamName] = rowPointer.Position
+			// Rows created as completed are attributed to the creating user
+			rowPointer.CompletedBy, rowPointer.CompletedAt = nil, nil
+			if rowPointer.Completed {
+				completedAt := time.Now().UTC()
+				rowPointer.CompletedBy = &q.userId
+				rowPointer.CompletedAt = &completedAt
+			}
+			namedArgumentsMap[itemRowCompletedByParamName] = rowPointer.CompletedBy
+			namedArgumentsMap[itemRowCompletedAtParamName] = rowPointer.CompletedAt
 		}
 		_, err = tx.Exec(context.Background(), query.String(), namedArgumentsMap)
 		if err != nil {
@@ -74,6 +104,7 @@ func (q *PersistChecklistItemRowQueryFunction) GetTransactionalQueryFunction() f
 type UpdateChecklistItemRowsQueryFunction struct {
 	checklistItemId   uint
 	checklistItemRows []domain.ChecklistItemRow
+	userId            string
 }
 
 func (u *UpdateChecklistItemRowsQueryFunction) GetTransactionalQueryFunction() func(tx pool.TransactionWrapper) (bool, error) {
@@ -83,15 +114,29 @@ func (u *UpdateChecklistItemRowsQueryFunction) GetTransactionalQueryFunction() f
 		checklistItemIdParamName := getIndexedSQLValueParamName(index, "checklistItemId")
 		checklistItemRowIdParamName := getIndexedSQLValueParamName(index, "checklistItemRowId")
 
+		userIdParamName := getIndexedSQLValueParamName(index, "userId")
+
+		// Completion audit fields are set only when the row transitions to completed and cleared on uncheck
 		sql := `UPDATE CHECKLIST_ITEM_ROW 
-				 SET CHECKLIST_ITEM_ROW_NAME = @%s , CHECKLIST_ITEM_ROW_COMPLETED = @%s
-				 WHERE CHECKLIST_ITEM_ROW_ID = @%s AND CHECKLIST_ITEM_ID = @%s`
-		sql = fmt.Sprintf(sql, rowNameParamName, rowParamCompletedName, checklistItemRowIdParamName, checklistItemIdParamName)
+				 SET CHECKLIST_ITEM_ROW_COMPLETED_BY = CASE
+				         WHEN @%[2]s AND CHECKLIST_ITEM_ROW_COMPLETED THEN CHECKLIST_ITEM_ROW_COMPLETED_BY
+				         WHEN @%[2]s THEN @%[5]s
+				         ELSE NULL
+				     END,
+				     CHECKLIST_ITEM_ROW_COMPLETED_AT = CASE
+				         WHEN @%[2]s AND CHECKLIST_ITEM_ROW_COMPLETED THEN CHECKLIST_ITEM_ROW_COMPLETED_AT
+				         WHEN @%[2]s THEN CURRENT_TIMESTAMP
+				         ELSE NULL
+				     END,
+				     CHECKLIST_ITEM_ROW_NAME = @%[1]s , CHECKLIST_ITEM_ROW_COMPLETED = @%[2]s
+				 WHERE CHECKLIST_ITEM_ROW_ID = @%[3]s AND CHECKLIST_ITEM_ID = @%[4]s`
+		sql = fmt.Sprintf(sql, rowNameParamName, rowParamCompletedName, checklistItemRowIdParamName, checklistItemIdParamName, userIdParamName)
 		args := pgx.NamedArgs{
 			rowNameParamName:            row.Name,
 			rowParamCompletedName:       row.Completed,
 			checklistItemIdParamName:    u.checklistItemId,
 			checklistItemRowIdParamName: row.Id,
+			userIdParamName:             u.userId,
 		}
 		return sql, args
 	}
@@ -121,6 +166,7 @@ type DeleteChecklistItemRowAndAutoCompleteQueryFunction struct {
 	checklistId     uint
 	checklistItemId uint
 	rowId           uint
+	userId          string
 }
 
 func (d *DeleteChecklistItemRowAndAutoCompleteQueryFunction) GetTransactionalQueryFunction() func(tx pool.TransactionWrapper) (domain.ChecklistItemRowDeletionResult, error) {
@@ -174,18 +220,22 @@ func (d *DeleteChecklistItemRowAndAutoCompleteQueryFunction) GetTransactionalQue
 		}
 
 		// Step 3: Update parent's UPDATED_AT and conditionally auto-complete
-		// Auto-complete if all remaining rows are completed
+		// Auto-complete if all remaining rows are completed; the deleting user is recorded as the completer
 		// Always update UPDATED_AT since we deleted a row
 		updateParentSQL := `
+			WITH AUTO_COMPLETE AS (
+				SELECT CHECKLIST_ITEM_COMPLETED = false
+				  AND EXISTS (SELECT 1 FROM CHECKLIST_ITEM_ROW WHERE CHECKLIST_ITEM_ID = @checklist_item_id)
+				  AND NOT EXISTS (SELECT 1 FROM CHECKLIST_ITEM_ROW WHERE CHECKLIST_ITEM_ID = @checklist_item_id AND CHECKLIST_ITEM_ROW_COMPLETED = false) AS COMPLETE
+				FROM CHECKLIST_ITEM
+				WHERE CHECKLIST_ITEM_ID = @checklist_item_id
+			)
 			UPDATE CHECKLIST_ITEM
 			SET UPDATED_AT = CURRENT_TIMESTAMP,
-				CHECKLIST_ITEM_COMPLETED = CASE
-					WHEN CHECKLIST_ITEM_COMPLETED = false
-					  AND EXISTS (SELECT 1 FROM CHECKLIST_ITEM_ROW WHERE CHECKLIST_ITEM_ID = @checklist_item_id)
-					  AND NOT EXISTS (SELECT 1 FROM CHECKLIST_ITEM_ROW WHERE CHECKLIST_ITEM_ID = @checklist_item_id AND CHECKLIST_ITEM_ROW_COMPLETED = false)
-					THEN true
-					ELSE CHECKLIST_ITEM_COMPLETED
-				END
+				CHECKLIST_ITEM_COMPLETED = CASE WHEN AUTO_COMPLETE.COMPLETE THEN true ELSE CHECKLIST_ITEM_COMPLETED END,
+				CHECKLIST_ITEM_COMPLETED_BY = CASE WHEN AUTO_COMPLETE.COMPLETE THEN @user_id ELSE CHECKLIST_ITEM_COMPLETED_BY END,
+				CHECKLIST_ITEM_COMPLETED_AT = CASE WHEN AUTO_COMPLETE.COMPLETE THEN CURRENT_TIMESTAMP ELSE CHECKLIST_ITEM_COMPLETED_AT END
+			FROM AUTO_COMPLETE
 			WHERE CHECKLIST_ITEM_ID = @checklist_item_id
 			  AND CHECKLIST_ID = @checklist_id
 			RETURNING CHECKLIST_ITEM_COMPLETED`
@@ -194,6 +244,7 @@ func (d *DeleteChecklistItemRowAndAutoCompleteQueryFunction) GetTransactionalQue
 		updateErr := tx.QueryRow(context.Background(), updateParentSQL, pgx.NamedArgs{
 			"checklist_item_id": d.checklistItemId,
 			"checklist_id":      d.checklistId,
+			"user_id":           d.userId,
 		}).Scan(&newCompleted)
 
 		if updateErr != nil {
@@ -0,0 +1,310 @@
+package query
+
+import (
+	"context"
+
+	"com.raunlo.checklist/internal/core/domain"
+	"com.raunlo.checklist/internal/repository/dbo"
+	"github.com/jackc/pgx/v5"
+	"github.com/raunlo/pgx-with-automapper/pool"
+)
+
+const checklistSectionColumns = `ID, CHECKLIST_ID, NAME, POSITION, COLLAPSED`
+
+// findTargetSection resolves the checklist section an item is placed in. Section id 0 means no section and
+// is returned as nil; a section of another checklist results in pgx.ErrNoRows.
+func findTargetSection(tx pool.TransactionWrapper, checklistId uint, sectionId uint) (*uint, error) {
+	if sectionId == 0 {
+		return nil, nil
+	}
+	var id uint
+	err := tx.QueryRow(context.Background(),
+		`SELECT ID FROM CHECKLIST_SECTION WHERE ID = @sectionId AND CHECKLIST_ID = @checklistId`,
+		pgx.NamedArgs{"sectionId": sectionId, "checklistId": checklistId}).Scan(&id)
+	if err != nil {
+		return nil, err
+	}
+	return &id, nil
+}
+
+// GetChecklistSectionsQueryFunction returns the sections of a checklist in display order
+type GetChecklistSectionsQueryFunction struct {
+	checklistId uint
+}
+
+func NewGetChecklistSectionsQueryFunction(checklistId uint) *GetChecklistSectionsQueryFunction {
+	return &GetChecklistSectionsQueryFunction{checklistId: checklistId}
+}
+
+func (g *GetChecklistSectionsQueryFunction) GetQueryFunction(ctx context.Context) func(connection pool.Conn) ([]dbo.ChecklistSectionDbo, error) {
+	return func(connection pool.Conn) ([]dbo.ChecklistSectionDbo, error) {
+		var result []dbo.ChecklistSectionDbo
+		err := connection.QueryList(ctx,
+			`SELECT `+checklistSectionColumns+`, ROW_NUMBER() OVER (ORDER BY POSITION ASC, ID ASC) AS ORDER_NUMBER
+			 FROM CHECKLIST_SECTION
+			 WHERE CHECKLIST_ID = @checklistId
+			 ORDER BY POSITION ASC, ID ASC`,
+			&result,
+			pgx.NamedArgs{"checklistId": g.checklistId})
+		return result, err
+	}
+}
+
+// PersistChecklistSectionQueryFunction adds a section after the last section of a checklist
+type PersistChecklistSectionQueryFunction struct {
+	section domain.ChecklistSection
+}
+
+func NewPersistChecklistSectionQueryFunction(section domain.ChecklistSection) *PersistChecklistSectionQueryFunction {
+	return &PersistChecklistSectionQueryFunction{section: section}
+}
+
+func (p *PersistChecklistSectionQueryFunction) GetTransactionalQueryFunction() func(tx pool.TransactionWrapper) (domain.ChecklistSection, error) {
+	return func(tx pool.TransactionWrapper) (domain.ChecklistSection, error) {
+		section := p.section
+		err := tx.QueryRow(context.Background(),
+			`WITH last_section AS (
+				SELECT COALESCE(MAX(POSITION), @defaultPosition - @gap) AS POSITION, COUNT(*) AS SECTION_COUNT
+				FROM CHECKLIST_SECTION WHERE CHECKLIST_ID = @checklistId
+			)
+			INSERT INTO CHECKLIST_SECTION(CHECKLIST_ID, NAME, POSITION, COLLAPSED)
+			SELECT c.ID, @name, (SELECT POSITION FROM last_section) + @gap, @collapsed
+			FROM CHECKLIST c WHERE c.ID = @checklistId
+			RETURNING ID, POSITION, (SELECT SECTION_COUNT FROM last_section) + 1`,
+			pgx.NamedArgs{
+				"checklistId":     section.ChecklistId,
+				"name":            section.Name,
+				"collapsed":       section.Collapsed,
+				"gap":             domain.DefaultGapSize,
+				"defaultPosition": domain.FirstSectionPosition,
+			}).Scan(&section.Id, &section.Position, &section.OrderNumber)
+		return section, err
+	}
+}
+
+// UpdateChecklistSectionQueryFunction renames a section and collapses or expands it
+type UpdateChecklistSectionQueryFunction struct {
+	section domain.ChecklistSection
+}
+
+func NewUpdateChecklistSectionQueryFunction(section domain.ChecklistSection) *UpdateChecklistSectionQueryFunction {
+	return &UpdateChecklistSectionQueryFunction{section: section}
+}
+
+// GetTransactionalQueryFunction returns the updated section, or pgx.ErrNoRows when it does not exist
+func (u *UpdateChecklistSectionQueryFunction) GetTransactionalQueryFunction() func(tx pool.TransactionWrapper) (domain.ChecklistSection, error) {
+	return func(tx pool.TransactionWrapper) (domain.ChecklistSection, error) {
+		var section domain.ChecklistSection
+		err := tx.QueryRow(context.Background(),
+			`UPDATE CHECKLIST_SECTION SET NAME = @name, COLLAPSED = @collapsed
+			 WHERE ID = @sectionId AND CHECKLIST_ID = @checklistId
+			 RETURNING `+checklistSectionColumns,
+			pgx.NamedArgs{
+				"sectionId":   u.section.Id,
+				"checklistId": u.section.ChecklistId,
+				"name":        u.section.Name,
+				"collapsed":   u.section.Collapsed,
+			}).Scan(&section.Id, &section.ChecklistId, &section.Name, &section.Position, &section.Collapsed)
+		if err != nil {
+			return domain.ChecklistSection{}, err
+		}
+		section.OrderNumber, err = findSectionOrderNumber(tx, section)
+		return section, err
+	}
+}
+
+// DeleteChecklistSectionQueryFunction deletes a section without deleting its items. The items are appended
+// to the items without a section in their current order, keeping completed items in their own completion
+// section unless the checklist keeps items in place.
+type DeleteChecklistSectionQueryFunction struct {
+	checklistId uint
+	sectionId   uint
+}
+
+func NewDeleteChecklistSectionQueryFunction(checklistId uint, sectionId uint) *DeleteChecklistSectionQueryFunction {
+	return &DeleteChecklistSectionQueryFunction{checklistId: checklistId, sectionId: sectionId}
+}
+
+// GetTransactionalQueryFunction returns pgx.ErrNoRows when the section does not exist
+func (d *DeleteChecklistSectionQueryFunction) GetTransactionalQueryFunction() func(tx pool.TransactionWrapper) (domain.ChecklistSectionDeletionResult, error) {
+	return func(tx pool.TransactionWrapper) (domain.ChecklistSectionDeletionResult, error) {
+		result := domain.ChecklistSectionDeletionResult{ChecklistId: d.checklistId, SectionId: d.sectionId}
+
+		var sectionId uint
+		err := tx.QueryRow(context.Background(),
+			`SELECT ID FROM CHECKLIST_SECTION WHERE ID = @sectionId AND CHECKLIST_ID = @checklistId FOR UPDATE`,
+			pgx.NamedArgs{"sectionId": d.sectionId, "checklistId": d.checklistId}).Scan(&sectionId)
+		if err != nil {
+			return domain.ChecklistSectionDeletionResult{}, err
+		}
+
+		mode, err := lockChecklistPositions(tx, d.checklistId)
+		if err != nil {
+			return domain.ChecklistSectionDeletionResult{}, err
+		}
+
+		// Soft-deleted items are released by ON DELETE SET NULL and keep their position
+		rows, err := tx.Query(context.Background(),
+			`SELECT ci.CHECKLIST_ITEM_ID, COALESCE(last_unsectioned.POSITION, '')
+			FROM CHECKLIST_ITEM ci
+			LEFT JOIN LATERAL (
+				SELECT MAX(u.POSITION) AS POSITION
+				FROM CHECKLIST_ITEM u
+				WHERE u.CHECKLIST_ID = @checklistId AND u.SECTION_ID IS NULL
+				  AND (NOT @sinkCompleted OR u.CHECKLIST_ITEM_COMPLETED = ci.CHECKLIST_ITEM_COMPLETED)
+			) last_unsectioned ON TRUE
+			WHERE ci.CHECKLIST_ID = @checklistId AND ci.SECTION_ID = @sectionId AND ci.DELETED_AT IS NULL
+			ORDER BY CASE WHEN @sinkCompleted THEN ci.CHECKLIST_ITEM_COMPLETED END, ci.POSITION ASC
+			FOR UPDATE OF ci`,
+			pgx.NamedArgs{
+				"checklistId":   d.checklistId,
+				"sectionId":     d.sectionId,
+				"sinkCompleted": mode.SinksCompleted(),
+			})
+		if err != nil {
+			return domain.ChecklistSectionDeletionResult{}, err
+		}
+		result.ItemIds, err = placeItemsAfter(tx, rows)
+		if err != nil {
+			return domain.ChecklistSectionDeletionResult{}, err
+		}
+		_, err = tx.Exec(context.Background(),
+			`UPDATE CHECKLIST_ITEM SET SECTION_ID = NULL, UPDATED_AT = CURRENT_TIMESTAMP WHERE CHECKLIST_ITEM_ID = ANY(@itemIds)`,
+			pgx.NamedArgs{"itemIds": result.ItemIds})
+		if err != nil {
+			return domain.ChecklistSectionDeletionResult{}, err
+		}
+		if err := appendItemHistory(tx, result.ItemIds...); err != nil {
+			return domain.ChecklistSectionDeletionResult{}, err
+		}
+
+		_, err = tx.Exec(context.Background(),
+			`DELETE FROM CHECKLIST_SECTION WHERE ID = @sectionId AND CHECKLIST_ID = @checklistId`,
+			pgx.NamedArgs{"sectionId": d.sectionId, "checklistId": d.checklistId})
+		return result, err
+	}
+}
+
+// ChangeChecklistSectionOrderQueryFunction moves a section to a new order number using gap-based positioning.
+// A checklist has few sections, so when the gaps become too small they are renumbered right away instead
+// of scheduling a rebalance.
+type ChangeChecklistSectionOrderQueryFunction struct {
+	request domain.ChangeSectionOrderRequest
+}
+
+func NewChangeChecklistSectionOrderQueryFunction(request domain.ChangeSectionOrderRequest) *ChangeChecklistSectionOrderQueryFunction {
+	return &ChangeChecklistSectionOrderQueryFunction{request: request}
+}
+
+// GetTransactionalQueryFunction returns the moved section, or pgx.ErrNoRows when it does not exist
+func (c *ChangeChecklistSectionOrderQueryFunction) GetTransactionalQueryFunction() func(tx pool.TransactionWrapper) (domain.ChecklistSection, error) {
+	return func(tx pool.TransactionWrapper) (domain.ChecklistSection, error) {
+		// 1. Lock the sections of the checklist and read the positions of the other sections
+		rows, err := tx.Query(context.Background(),
+			`SELECT ID, POSITION FROM CHECKLIST_SECTION
+			 WHERE CHECKLIST_ID = @checklistId
+			 ORDER BY POSITION ASC, ID ASC
+			 FOR UPDATE`,
+			pgx.NamedArgs{"checklistId": c.request.ChecklistId})
+		if err != nil {
+			return domain.ChecklistSection{}, err
+		}
+		positions, found, err := c.scanOtherPositions(rows)
+		if err != nil {
+			return domain.ChecklistSection{}, err
+		} else if !found {
+			return domain.ChecklistSection{}, pgx.ErrNoRows
+		}
+
+		// 2. Calculate the new position and renumber all sections when the gap got too small
+		newPosition, rebalanceNeeded := sectionPosition(positions, c.request.NewOrderNumber)
+		_, err = tx.Exec(context.Background(),
+			`UPDATE CHECKLIST_SECTION SET POSITION = @newPosition WHERE ID = @sectionId`,
+			pgx.NamedArgs{"sectionId": c.request.SectionId, "newPosition": newPosition})
+		if err != nil {
+			return domain.ChecklistSection{}, err
+		}
+		if rebalanceNeeded {
+			_, err = tx.Exec(context.Background(),
+				`WITH ranked AS (
+					SELECT ID, ROW_NUMBER() OVER (ORDER BY POSITION ASC, ID ASC) AS RANK
+					FROM CHECKLIST_SECTION WHERE CHECKLIST_ID = @checklistId
+				)
+				UPDATE CHECKLIST_SECTION s
+				SET POSITION = (@startPosition + (ranked.RANK - 1) * @gap)::DOUBLE PRECISION
+				FROM ranked
+				WHERE s.ID = ranked.ID`,
+				pgx.NamedArgs{
+					"checklistId":   c.request.ChecklistId,
+					"startPosition": domain.FirstSectionPosition,
+					"gap":           domain.DefaultGapSize,
+				})
+			if err != nil {
+				return domain.ChecklistSection{}, err
+			}
+		}
+
+		// 3. Return the section as it is now
+		var section domain.ChecklistSection
+		err = tx.QueryRow(context.Background(),
+			`SELECT `+checklistSectionColumns+` FROM CHECKLIST_SECTION WHERE ID = @sectionId`,
+			pgx.NamedArgs{"sectionId": c.request.SectionId},
+		).Scan(&section.Id, &section.ChecklistId, &section.Name, &section.Position, &section.Collapsed)
+		if err != nil {
+			return domain.ChecklistSection{}, err
+		}
+		section.OrderNumber, err = findSectionOrderNumber(tx, section)
+		return section, err
+	}
+}
+
+// scanOtherPositions returns the ordered positions of every section except the moved one, and whether the
+// moved section was found among them
+func (c *ChangeChecklistSectionOrderQueryFunction) scanOtherPositions(rows pgx.Rows) ([]float64, bool, error) {
+	defer rows.Close()
+	positions := make([]float64, 0)
+	found := false
+	for rows.Next() {
+		var id uint
+		var position float64
+		if err := rows.Scan(&id, &position); err != nil {
+			return nil, false, err
+		}
+		if id == c.request.SectionId {
+			found = true
+			continue
+		}
+		positions = append(positions, position)
+	}
+	return positions, found, rows.Err()
+}
+
+// sectionPosition places a section at a 1-based order number among the other sections and reports
+// whether the new gap is below domain.MinGapThreshold
+func sectionPosition(positions []float64, newOrderNumber uint) (float64, bool) {
+	targetIndex := int(newOrderNumber) - 1
+	switch {
+	case len(positions) == 0:
+		return domain.FirstSectionPosition, false
+	case targetIndex <= 0:
+		return positions[0] - domain.DefaultGapSize, false
+	case targetIndex >= len(positions):
+		return positions[len(positions)-1] + domain.DefaultGapSize, false
+	default:
+		prevPosition, nextPosition := positions[targetIndex-1], positions[targetIndex]
+		return (prevPosition + nextPosition) / 2, (nextPosition-prevPosition)/2 < domain.MinGapThreshold
+	}
+}
+
+func findSectionOrderNumber(tx pool.TransactionWrapper, section domain.ChecklistSection) (uint, error) {
+	var orderNumber uint
+	err := tx.QueryRow(context.Background(),
+		`SELECT COUNT(*) FROM CHECKLIST_SECTION
+		 WHERE CHECKLIST_ID = @checklistId AND (POSITION < @position OR (POSITION = @position AND ID <= @sectionId))`,
+		pgx.NamedArgs{
+			"checklistId": section.ChecklistId,
+			"position":    section.Position,
+			"sectionId":   section.Id,
+		}).Scan(&orderNumber)
+	return orderNumber, err
+}
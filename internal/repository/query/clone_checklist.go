@@ -0,0 +1,135 @@
+package query
+
+import (
+	"context"
+
+	"com.raunlo.checklist/internal/core/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/raunlo/pgx-with-automapper/pool"
+)
+
+// CloneChecklistQueryFunction copies a checklist with its sections, active items and rows into a new checklist
+// owned by the given user. Section and item positions and the ordering mode are copied as-is, so the clone
+// keeps the source order.
+type CloneChecklistQueryFunction struct {
+	sourceChecklistId uint
+	name              string
+	owner             string
+	options           domain.ChecklistCloneOptions
+}
+
+func NewCloneChecklistQueryFunction(sourceChecklistId uint, name string, owner string, options domain.ChecklistCloneOptions) *CloneChecklistQueryFunction {
+	return &CloneChecklistQueryFunction{
+		sourceChecklistId: sourceChecklistId,
+		name:              name,
+		owner:             owner,
+		options:           options,
+	}
+}
+
+func (c *CloneChecklistQueryFunction) GetTransactionalQueryFunction() func(tx pool.TransactionWrapper) (domain.Checklist, error) {
+	return func(tx pool.TransactionWrapper) (domain.Checklist, error) {
+		var checklistId uint
+		var mode domain.ChecklistOrderingMode
+		err := tx.QueryRow(context.Background(),
+			`INSERT INTO checklist(ID, NAME, OWNER, workspace_id, ORDERING_MODE)
+			 SELECT nextval('checklist_id_sequence'), @checklistName, @owner, @workspaceId, ORDERING_MODE
+			 FROM checklist WHERE ID = @sourceChecklistId
+			 RETURNING ID, ORDERING_MODE`,
+			pgx.NamedArgs{
+				"checklistName":     c.name,
+				"owner":             c.owner,
+				"workspaceId":       c.options.WorkspaceId,
+				"sourceChecklistId": c.sourceChecklistId,
+			}).Scan(&checklistId, &mode)
+		if err != nil {
+			return domain.Checklist{}, err
+		}
+
+		// Allocate the new section and item ids up front so items can be attached to their copied section
+		// and rows to their copied item
+		_, err = tx.Exec(context.Background(),
+			`WITH source_sections AS (
+				SELECT ID AS OLD_ID, nextval('checklist_section_id_sequence') AS NEW_ID, NAME, POSITION, COLLAPSED
+				FROM CHECKLIST_SECTION
+				WHERE CHECKLIST_ID = @sourceChecklistId
+			), inserted_sections AS (
+				INSERT INTO CHECKLIST_SECTION(ID, CHECKLIST_ID, NAME, POSITION, COLLAPSED)
+				SELECT NEW_ID, @checklistId, NAME, POSITION, COLLAPSED
+				FROM source_sections
+			), source_items AS (
+				SELECT ci.CHECKLIST_ITEM_ID AS OLD_ID, nextval('checklist_item_id_sequence') AS NEW_ID,
+				       ci.CHECKLIST_ITEM_NAME, ci.CHECKLIST_ITEM_COMPLETED, ci.POSITION,
+				       ci.CHECKLIST_ITEM_COMPLETED_BY, ci.CHECKLIST_ITEM_COMPLETED_AT, ss.NEW_ID AS SECTION_ID, ci.NOTES
+				FROM CHECKLIST_ITEM ci
+				LEFT JOIN source_sections ss ON ss.OLD_ID = ci.SECTION_ID
+				WHERE ci.CHECKLIST_ID = @sourceChecklistId AND ci.DELETED_AT IS NULL
+			), inserted_items AS (
+				INSERT INTO CHECKLIST_ITEM(CHECKLIST_ITEM_ID, CHECKLIST_ID, CHECKLIST_ITEM_NAME, CHECKLIST_ITEM_COMPLETED, POSITION, UPDATED_AT,
+				                           CHECKLIST_ITEM_COMPLETED_BY, CHECKLIST_ITEM_COMPLETED_AT, SECTION_ID, NOTES)
+				SELECT NEW_ID, @checklistId, CHECKLIST_ITEM_NAME,
+				       CASE WHEN @resetCompletion THEN FALSE ELSE CHECKLIST_ITEM_COMPLETED END, POSITION, CURRENT_TIMESTAMP,
+				       CASE WHEN @resetCompletion THEN NULL ELSE CHECKLIST_ITEM_COMPLETED_BY END,
+				       CASE WHEN @resetCompletion THEN NULL ELSE CHECKLIST_ITEM_COMPLETED_AT END,
+				       SECTION_ID, NOTES
+				FROM source_items
+			)
+			INSERT INTO CHECKLIST_ITEM_ROW(CHECKLIST_ITEM_ROW_ID, CHECKLIST_ITEM_ID, CHECKLIST_ITEM_ROW_NAME, CHECKLIST_ITEM_ROW_COMPLETED,
+			                               CHECKLIST_ITEM_ROW_COMPLETED_BY, CHECKLIST_ITEM_ROW_COMPLETED_AT, CHECKLIST_ITEM_ROW_POSITION)
+			SELECT nextval('checklist_item_row_id_sequence'), si.NEW_ID, r.CHECKLIST_ITEM_ROW_NAME,
+			       CASE WHEN @resetCompletion THEN FALSE ELSE r.CHECKLIST_ITEM_ROW_COMPLETED END,
+			       CASE WHEN @resetCompletion THEN NULL ELSE r.CHECKLIST_ITEM_ROW_COMPLETED_BY END,
+			       CASE WHEN @resetCompletion THEN NULL ELSE r.CHECKLIST_ITEM_ROW_COMPLETED_AT END,
+			       r.CHECKLIST_ITEM_ROW_POSITION
+			FROM CHECKLIST_ITEM_ROW r
+			JOIN source_items si ON si.OLD_ID = r.CHECKLIST_ITEM_ID
+			ORDER BY r.CHECKLIST_ITEM_ROW_ID`,
+			pgx.NamedArgs{
+				"sourceChecklistId": c.sourceChecklistId,
+				"checklistId":       checklistId,
+				"resetCompletion":   c.options.ResetCompletion,
+			})
+		if err != nil {
+			return domain.Checklist{}, err
+		}
+
+		if c.options.IncludeShares {
+			_, err = tx.Exec(context.Background(),
+				`INSERT INTO CHECKLIST_SHARE(ID, CHECKLIST_ID, SHARED_BY_USER_ID, SHARED_WITH_USER_ID, PERMISSION_LEVEL, CREATED_AT)
+				 SELECT nextval('checklist_share_id_sequence'), @checklistId, @owner, SHARED_WITH_USER_ID, PERMISSION_LEVEL, CURRENT_TIMESTAMP
+				 FROM CHECKLIST_SHARE
+				 WHERE CHECKLIST_ID = @sourceChecklistId AND SHARED_WITH_USER_ID != @owner`,
+				pgx.NamedArgs{
+					"sourceChecklistId": c.sourceChecklistId,
+					"checklistId":       checklistId,
+					"owner":             c.owner,
+				})
+			if err != nil {
+				return domain.Checklist{}, err
+			}
+		}
+
+		// Record the history of the new checklist with the clone
+		rows, err := tx.Query(context.Background(),
+			`SELECT CHECKLIST_ITEM_ID FROM CHECKLIST_ITEM WHERE CHECKLIST_ID = @checklistId`,
+			pgx.NamedArgs{"checklistId": checklistId})
+		if err != nil {
+			return domain.Checklist{}, err
+		}
+		itemIds, err := scanItemIds(rows)
+		if err != nil {
+			return domain.Checklist{}, err
+		}
+		if err := appendItemHistory(tx, itemIds...); err != nil {
+			return domain.Checklist{}, err
+		}
+
+		return domain.Checklist{
+			Id:           checklistId,
+			Name:         c.name,
+			Owner:        c.owner,
+			WorkspaceId:  c.options.WorkspaceId,
+			OrderingMode: mode,
+		}, nil
+	}
+}
@@ -18,17 +18,19 @@ type Query[K any] interface {
 	GetQueryFunction(ctx context.Context) func(connection pool.Conn) (K, error)
 }
 
-func NewPersistChecklistItemQueryFunction(checklistId uint, checklistItem domain.ChecklistItem) TransactionalQuery[domain.ChecklistItem] {
+func NewPersistChecklistItemQueryFunction(checklistId uint, checklistItem domain.ChecklistItem, userId string) TransactionalQuery[domain.ChecklistItem] {
 	return &PersistChecklistItemQueryFunction{
 		checklistItem: checklistItem,
 		checklistId:   checklistId,
+		userId:        userId,
 	}
 }
 
-func NewPersistChecklistItemRowsQueryFunction(checklistItemId uint, checklistItemRows []domain.ChecklistItemRow) TransactionalQuery[[]domain.ChecklistItemRow] {
+func NewPersistChecklistItemRowsQueryFunction(checklistItemId uint, checklistItemRows []domain.ChecklistItemRow, userId string) TransactionalQuery[[]domain.ChecklistItemRow] {
 	return &PersistChecklistItemRowQueryFunction{
 		checklistItemRows: checklistItemRows,
 		checklistItemId:   checklistItemId,
+		userId:            userId,
 	}
 }
 
@@ -54,25 +56,28 @@ func NewFindChecklistItemByIdQueryFunction(checklistId uint, checklistItemId uin
 	}
 }
 
-func NewUpdateChecklistItemQueryFunction(checklistId uint, checklistItem domain.ChecklistItem) TransactionalQuery[bool] {
+func NewUpdateChecklistItemQueryFunction(checklistId uint, checklistItem domain.ChecklistItem, userId string) TransactionalQuery[bool] {
 	return &UpdateChecklistItemFunction{
 		checklistId:   checklistId,
 		checklistItem: checklistItem,
+		userId:        userId,
 	}
 }
 
-func NewUpdateChecklistItemRowsQueryFunction(checklistItemId uint, rows []domain.ChecklistItemRow) TransactionalQuery[bool] {
+func NewUpdateChecklistItemRowsQueryFunction(checklistItemId uint, rows []domain.ChecklistItemRow, userId string) TransactionalQuery[bool] {
 	return &UpdateChecklistItemRowsQueryFunction{
 		checklistItemId:   checklistItemId,
 		checklistItemRows: rows,
+		userId:            userId,
 	}
 }
 
-func NewDeleteChecklistItemRowByIdQueryFunction(checklistId uint, checklistItemId uint, rowId uint) TransactionalQuery[domain.ChecklistItemRowDeletionResult] {
+func NewDeleteChecklistItemRowByIdQueryFunction(checklistId uint, checklistItemId uint, rowId uint, userId string) TransactionalQuery[domain.ChecklistItemRowDeletionResult] {
 	return &DeleteChecklistItemRowAndAutoCompleteQueryFunction{
 		checklistId:     checklistId,
 		checklistItemId: checklistItemId,
 		rowId:           rowId,
+		userId:          userId,
 	}
 }
 
@@ -82,17 +87,19 @@ func NewChangeChecklistItemOrderQueryFunction(changeOrderRequest domain.ChangeOr
 		checklistItemId: changeOrderRequest.ChecklistItemId,
 		newOrderNumber:  changeOrderRequest.NewOrderNumber,
 		sortOrder:       changeOrderRequest.SortOrder,
+		sectionId:       changeOrderRequest.SectionId,
 	}
 }
 
-func NewToggleCompletionQueryFunction(checklistId uint, checklistItemId uint, completed bool) TransactionalQuery[domain.ChecklistItem] {
+func NewToggleCompletionQueryFunction(checklistId uint, checklistItemId uint, completed bool, userId string) TransactionalQuery[domain.ChecklistItemToggleResult] {
 	return &toggleCompletionQueryFunction{
 		checklistId:     checklistId,
 		checklistItemId: checklistItemId,
 		completed:       completed,
+		userId:          userId,
 	}
 }
 
-func NewDeleteChecklistItemRowAndAutoCompleteQueryFunction(checklistId uint, checklistItemId uint, rowId uint) *DeleteChecklistItemRowAndAutoCompleteQueryFunction {
-	return &DeleteChecklistItemRowAndAutoCompleteQueryFunction{checklistId: checklistId, checklistItemId: checklistItemId, rowId: rowId}
+func NewDeleteChecklistItemRowAndAutoCompleteQueryFunction(checklistId uint, checklistItemId uint, rowId uint, userId string) *DeleteChecklistItemRowAndAutoCompleteQueryFunction {
+	return &DeleteChecklistItemRowAndAutoCompleteQueryFunction{checklistId: checklistId, checklistItemId: checklistItemId, rowId: rowId, userId: userId}
 }
@@ -0,0 +1,397 @@
+package query
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"com.raunlo.checklist/internal/core/domain"
+	"com.raunlo.checklist/internal/repository/dbo"
+	"github.com/jackc/pgx/v5"
+	"github.com/raunlo/pgx-with-automapper/pool"
+)
+
+// MergeChecklistsQueryFunction merges the active items of a source checklist into a target checklist
+// and archives the source. Items whose normalized names match are combined into one item, the rest
+// are moved over and appended to their completion section in the target.
+type MergeChecklistsQueryFunction struct {
+	targetChecklistId uint
+	sourceChecklistId uint
+	userId            string
+}
+
+func NewMergeChecklistsQueryFunction(targetChecklistId uint, sourceChecklistId uint, userId string) *MergeChecklistsQueryFunction {
+	return &MergeChecklistsQueryFunction{
+		targetChecklistId: targetChecklistId,
+		sourceChecklistId: sourceChecklistId,
+		userId:            userId,
+	}
+}
+
+// GetTransactionalQueryFunction returns the ids of the target items that were moved in or changed
+func (m *MergeChecklistsQueryFunction) GetTransactionalQueryFunction() func(tx pool.TransactionWrapper) ([]uint, error) {
+	return func(tx pool.TransactionWrapper) ([]uint, error) {
+		// 1. Lock both checklists; archived checklists can not take part in a merge
+		var lockedCount int
+		err := tx.QueryRow(context.Background(),
+			`WITH locked AS (
+				SELECT ID FROM CHECKLIST
+				WHERE ID IN (@targetChecklistId, @sourceChecklistId) AND ARCHIVED_AT IS NULL
+				ORDER BY ID
+				FOR UPDATE
+			)
+			SELECT COUNT(*) FROM locked`,
+			pgx.NamedArgs{
+				"targetChecklistId": m.targetChecklistId,
+				"sourceChecklistId": m.sourceChecklistId,
+			}).Scan(&lockedCount)
+		if err != nil {
+			return nil, err
+		} else if lockedCount != 2 {
+			return nil, pgx.ErrNoRows
+		}
+
+		targetMode, err := findOrderingMode(tx, m.targetChecklistId)
+		if err != nil {
+			return nil, err
+		}
+		targetItems, err := findActiveItemsWithRows(tx, m.targetChecklistId)
+		if err != nil {
+			return nil, err
+		}
+		sourceItems, err := findActiveItemsWithRows(tx, m.sourceChecklistId)
+		if err != nil {
+			return nil, err
+		}
+
+		itemsByName := make(map[string]*dbo.ChecklistItemDbo, len(targetItems))
+		for i := range targetItems {
+			name := domain.NormalizeItemName(targetItems[i].Name)
+			if _, exists := itemsByName[name]; !exists {
+				itemsByName[name] = &targetItems[i]
+			}
+		}
+
+		// 2. Combine duplicates into the target item, move everything else.
+		// A moved item is registered too, so duplicates within the source collapse into it.
+		touched := make([]uint, 0, len(sourceItems))
+		seen := make(map[uint]bool, len(sourceItems))
+		for i := range sourceItems {
+			sourceItem := &sourceItems[i]
+			name := domain.NormalizeItemName(sourceItem.Name)
+			if targetItem, exists := itemsByName[name]; exists {
+				if err := m.combineItems(tx, targetItem, sourceItem); err != nil {
+					return nil, err
+				}
+				if !seen[targetItem.Id] {
+					seen[targetItem.Id] = true
+					touched = append(touched, targetItem.Id)
+				}
+				continue
+			}
+
+			if err := m.moveItem(tx, sourceItem, targetMode); err != nil {
+				return nil, err
+			}
+			itemsByName[name] = sourceItem
+			seen[sourceItem.Id] = true
+			touched = append(touched, sourceItem.Id)
+		}
+
+		// 3. Archive the source so it disappears from checklist listings
+		_, err = tx.Exec(context.Background(),
+			`UPDATE CHECKLIST SET ARCHIVED_AT = CURRENT_TIMESTAMP WHERE ID = @sourceChecklistId`,
+			pgx.NamedArgs{"sourceChecklistId": m.sourceChecklistId})
+		if err != nil {
+			return nil, err
+		}
+
+		// 4. Record the history of both checklists with the merge: every source item was moved or combined
+		changedItemIds := slices.Clone(touched)
+		for _, sourceItem := range sourceItems {
+			changedItemIds = append(changedItemIds, sourceItem.Id)
+		}
+		if err := appendItemHistory(tx, changedItemIds...); err != nil {
+			return nil, err
+		}
+
+		return touched, nil
+	}
+}
+
+// combineItems folds a duplicate source item into the target item. Rows with a new name are moved over,
+// rows present in both are kept once. Open wins when reconciling completion: the combined item or row
+// is only completed when it was completed on both checklists. Comments move to the target item and notes
+// are appended to its notes; the duplicate itself is soft-deleted.
+func (m *MergeChecklistsQueryFunction) combineItems(tx pool.TransactionWrapper, targetItem *dbo.ChecklistItemDbo, sourceItem *dbo.ChecklistItemDbo) error {
+	rowsByName := make(map[string]int, len(targetItem.Rows))
+	for i := range targetItem.Rows {
+		rowsByName[domain.NormalizeItemName(targetItem.Rows[i].Name)] = i
+	}
+
+	existingRows := len(targetItem.Rows)
+	for _, sourceRow := range sourceItem.Rows {
+		rowIndex, exists := rowsByName[domain.NormalizeItemName(sourceRow.Name)]
+		if !exists {
+			rowsByName[domain.NormalizeItemName(sourceRow.Name)] = len(targetItem.Rows)
+			targetItem.Rows = append(targetItem.Rows, sourceRow)
+			continue
+		}
+
+		targetRow := &targetItem.Rows[rowIndex]
+		if targetRow.Completed && !sourceRow.Completed {
+			_, err := tx.Exec(context.Background(),
+				`UPDATE CHECKLIST_ITEM_ROW
+				 SET CHECKLIST_ITEM_ROW_COMPLETED = FALSE, CHECKLIST_ITEM_ROW_COMPLETED_BY = NULL, CHECKLIST_ITEM_ROW_COMPLETED_AT = NULL
+				 WHERE CHECKLIST_ITEM_ROW_ID = @rowId`,
+				pgx.NamedArgs{"rowId": targetRow.Id})
+			if err != nil {
+				return err
+			}
+			targetRow.Completed = false
+		}
+	}
+
+	if err := moveRows(tx, targetItem.Id, targetItem.Rows[existingRows:]); err != nil {
+		return err
+	}
+
+	if targetItem.Completed && !sourceItem.Completed {
+		if _, err := NewToggleCompletionQueryFunction(m.targetChecklistId, targetItem.Id, false, m.userId).GetTransactionalQueryFunction()(tx); err != nil {
+			return err
+		}
+		targetItem.Completed = false
+	} else {
+		_, err := tx.Exec(context.Background(),
+			`UPDATE CHECKLIST_ITEM SET UPDATED_AT = CURRENT_TIMESTAMP WHERE CHECKLIST_ITEM_ID = @itemId`,
+			pgx.NamedArgs{"itemId": targetItem.Id})
+		if err != nil {
+			return err
+		}
+	}
+
+	if notes := mergeNotes(targetItem.Notes, sourceItem.Notes); notes != targetItem.Notes {
+		_, err := tx.Exec(context.Background(),
+			`UPDATE CHECKLIST_ITEM SET NOTES = @notes WHERE CHECKLIST_ITEM_ID = @itemId`,
+			pgx.NamedArgs{"itemId": targetItem.Id, "notes": notes})
+		if err != nil {
+			return err
+		}
+		targetItem.Notes = notes
+	}
+
+	_, err := tx.Exec(context.Background(),
+		`UPDATE CHECKLIST_ITEM_COMMENT SET CHECKLIST_ITEM_ID = @targetItemId WHERE CHECKLIST_ITEM_ID = @itemId`,
+		pgx.NamedArgs{
+			"targetItemId": targetItem.Id,
+			"itemId":       sourceItem.Id,
+		})
+	if err != nil {
+		return err
+	}
+
+	// Everything worth keeping now lives on the target item
+	_, err = tx.Exec(context.Background(),
+		`UPDATE CHECKLIST_ITEM
+		 SET DELETED_AT = CURRENT_TIMESTAMP, DELETED_BY = @userId
+		 WHERE CHECKLIST_ID = @sourceChecklistId AND CHECKLIST_ITEM_ID = @itemId`,
+		pgx.NamedArgs{
+			"sourceChecklistId": m.sourceChecklistId,
+			"itemId":            sourceItem.Id,
+			"userId":            m.userId,
+		})
+	return err
+}
+
+// moveRows re-parents rows to the target item after its existing rows, in their current order. Their
+// positions came from the source item and say nothing about the rows of the target item.
+func moveRows(tx pool.TransactionWrapper, targetItemId uint, rows []dbo.ChecklistItemRowDbo) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	var lastPosition string
+	err := tx.QueryRow(context.Background(),
+		`SELECT COALESCE(MAX(CHECKLIST_ITEM_ROW_POSITION), '') FROM CHECKLIST_ITEM_ROW WHERE CHECKLIST_ITEM_ID = @itemId`,
+		pgx.NamedArgs{"itemId": targetItemId}).Scan(&lastPosition)
+	if err != nil {
+		return err
+	}
+	positions, err := domain.PositionsBetween(lastPosition, "", len(rows))
+	if err != nil {
+		return err
+	}
+
+	for i := range rows {
+		_, err := tx.Exec(context.Background(),
+			`UPDATE CHECKLIST_ITEM_ROW SET CHECKLIST_ITEM_ID = @targetItemId, CHECKLIST_ITEM_ROW_POSITION = @position
+			 WHERE CHECKLIST_ITEM_ROW_ID = @rowId`,
+			pgx.NamedArgs{
+				"targetItemId": targetItemId,
+				"rowId":        rows[i].Id,
+				"position":     positions[i],
+			})
+		if err != nil {
+			return err
+		}
+		rows[i].Position = positions[i]
+	}
+	return nil
+}
+
+// mergeNotes appends the notes of a duplicate to the notes of the item it is combined into.
+// Returns target unchanged when the duplicate adds nothing.
+func mergeNotes(target *string, duplicate *string) *string {
+	switch {
+	case duplicate == nil || strings.TrimSpace(*duplicate) == "":
+		return target
+	case target == nil || strings.TrimSpace(*target) == "":
+		return duplicate
+	case strings.Contains(*target, *duplicate):
+		return target
+	}
+	return new(*target + "\n\n" + *duplicate)
+}
+
+// moveItem re-parents a source item into the target checklist at the end of its section. Checklist sections
+// belong to the source checklist, so the item ends up among the items without a section.
+func (m *MergeChecklistsQueryFunction) moveItem(tx pool.TransactionWrapper, item *dbo.ChecklistItemDbo, targetMode domain.ChecklistOrderingMode) error {
+	var lastPosition string
+	err := tx.QueryRow(context.Background(),
+		`SELECT COALESCE(MAX(POSITION), '') FROM CHECKLIST_ITEM
+		 WHERE CHECKLIST_ID = @targetChecklistId AND SECTION_ID IS NULL
+		   AND (CAST(@section AS BOOLEAN) IS NULL OR CHECKLIST_ITEM_COMPLETED = @section)`,
+		pgx.NamedArgs{
+			"targetChecklistId": m.targetChecklistId,
+			"section":           positionSection(targetMode, item.Completed),
+		}).Scan(&lastPosition)
+	if err != nil {
+		return err
+	}
+	position, err := domain.PositionBetween(lastPosition, "")
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(context.Background(),
+		`UPDATE CHECKLIST_ITEM
+		 SET CHECKLIST_ID = @targetChecklistId, SECTION_ID = NULL, POSITION = @position, UPDATED_AT = CURRENT_TIMESTAMP
+		 WHERE CHECKLIST_ID = @sourceChecklistId AND CHECKLIST_ITEM_ID = @itemId`,
+		pgx.NamedArgs{
+			"targetChecklistId": m.targetChecklistId,
+			"sourceChecklistId": m.sourceChecklistId,
+			"itemId":            item.Id,
+			"position":          position,
+		})
+	return err
+}
+
+// SplitChecklistQueryFunction moves the selected active items of a checklist into a new checklist owned
+// by the given user. The new checklist stays in the source workspace and items keep their positions.
+type SplitChecklistQueryFunction struct {
+	sourceChecklistId uint
+	request           domain.ChecklistSplitRequest
+	owner             string
+}
+
+func NewSplitChecklistQueryFunction(sourceChecklistId uint, request domain.ChecklistSplitRequest, owner string) *SplitChecklistQueryFunction {
+	return &SplitChecklistQueryFunction{
+		sourceChecklistId: sourceChecklistId,
+		request:           request,
+		owner:             owner,
+	}
+}
+
+func (s *SplitChecklistQueryFunction) GetTransactionalQueryFunction() func(tx pool.TransactionWrapper) (domain.Checklist, error) {
+	return func(tx pool.TransactionWrapper) (domain.Checklist, error) {
+		itemIds := make([]int64, 0, len(s.request.ItemIds))
+		for _, itemId := range s.request.ItemIds {
+			itemIds = append(itemIds, int64(itemId))
+		}
+
+		// 1. Lock the selected items; every one of them must be an active item of the source checklist
+		var lockedCount int
+		err := tx.QueryRow(context.Background(),
+			`WITH locked AS (
+				SELECT CHECKLIST_ITEM_ID FROM CHECKLIST_ITEM
+				WHERE CHECKLIST_ID = @sourceChecklistId AND CHECKLIST_ITEM_ID = ANY(@itemIds) AND DELETED_AT IS NULL
+				FOR UPDATE
+			)
+			SELECT COUNT(*) FROM locked`,
+			pgx.NamedArgs{
+				"sourceChecklistId": s.sourceChecklistId,
+				"itemIds":           itemIds,
+			}).Scan(&lockedCount)
+		if err != nil {
+			return domain.Checklist{}, err
+		} else if lockedCount != len(itemIds) {
+			return domain.Checklist{}, pgx.ErrNoRows
+		}
+
+		// 2. Create the new checklist next to the source
+		checklist := domain.Checklist{Name: s.request.Name, Owner: s.owner}
+		err = tx.QueryRow(context.Background(),
+			`INSERT INTO checklist(ID, NAME, OWNER, workspace_id, ORDERING_MODE)
+			 SELECT nextval('checklist_id_sequence'), @checklistName, @owner, workspace_id, ORDERING_MODE
+			 FROM checklist WHERE ID = @sourceChecklistId AND ARCHIVED_AT IS NULL
+			 RETURNING ID, workspace_id, ORDERING_MODE`,
+			pgx.NamedArgs{
+				"checklistName":     s.request.Name,
+				"owner":             s.owner,
+				"sourceChecklistId": s.sourceChecklistId,
+			}).Scan(&checklist.Id, &checklist.WorkspaceId, &checklist.OrderingMode)
+		if err != nil {
+			return domain.Checklist{}, err
+		}
+
+		// 3. Re-parent the items; rows follow their item. Checklist sections stay with the source checklist.
+		_, err = tx.Exec(context.Background(),
+			`UPDATE CHECKLIST_ITEM SET CHECKLIST_ID = @checklistId, SECTION_ID = NULL, UPDATED_AT = CURRENT_TIMESTAMP
+			 WHERE CHECKLIST_ID = @sourceChecklistId AND CHECKLIST_ITEM_ID = ANY(@itemIds)`,
+			pgx.NamedArgs{
+				"checklistId":       checklist.Id,
+				"sourceChecklistId": s.sourceChecklistId,
+				"itemIds":           itemIds,
+			})
+		if err != nil {
+			return domain.Checklist{}, err
+		}
+
+		// 4. Record the history of both checklists with the split
+		if err := appendItemHistory(tx, s.request.ItemIds...); err != nil {
+			return domain.Checklist{}, err
+		}
+
+		return checklist, nil
+	}
+}
+
+// findActiveItemsWithRows loads the items of a checklist with their rows in display order
+func findActiveItemsWithRows(tx pool.TransactionWrapper, checklistId uint) ([]dbo.ChecklistItemDbo, error) {
+	var items []dbo.ChecklistItemDbo
+	err := tx.QueryList(context.Background(),
+		`SELECT
+			ci.CHECKLIST_ITEM_ID,
+			ci.CHECKLIST_ITEM_NAME,
+			ci.CHECKLIST_ITEM_COMPLETED,
+			ci.CHECKLIST_ITEM_COMPLETED_BY,
+			ci.CHECKLIST_ITEM_COMPLETED_AT,
+			ci.POSITION,
+			ci.SECTION_ID,
+			ci.NOTES,
+			ROWS.CHECKLIST_ITEM_ROW_ID,
+			ROWS.CHECKLIST_ITEM_ROW_NAME,
+			ROWS.CHECKLIST_ITEM_ROW_COMPLETED,
+			ROWS.CHECKLIST_ITEM_ROW_COMPLETED_BY,
+			ROWS.CHECKLIST_ITEM_ROW_COMPLETED_AT,
+			ROWS.CHECKLIST_ITEM_ROW_POSITION
+		FROM CHECKLIST_ITEM ci
+		JOIN CHECKLIST c ON c.ID = ci.CHECKLIST_ID
+		LEFT JOIN CHECKLIST_SECTION s ON s.ID = ci.SECTION_ID
+		LEFT JOIN CHECKLIST_ITEM_ROW AS ROWS ON ROWS.CHECKLIST_ITEM_ID = ci.CHECKLIST_ITEM_ID
+		WHERE ci.CHECKLIST_ID = @checklistId AND ci.DELETED_AT IS NULL
+		ORDER BY s.POSITION ASC NULLS FIRST, s.ID ASC NULLS FIRST,
+		         CASE WHEN c.ORDERING_MODE = 'KEEP_IN_PLACE' THEN FALSE ELSE ci.CHECKLIST_ITEM_COMPLETED END ASC, ci.POSITION ASC,
+		         ROWS.CHECKLIST_ITEM_ROW_POSITION ASC`,
+		&items, pgx.NamedArgs{"checklistId": checklistId})
+	return items, err
+}
@@ -0,0 +1,53 @@
+package query
+
+import (
+	"strings"
+	"testing"
+
+	"com.raunlo.checklist/internal/repository/dbo"
+)
+
+func TestMergeNotes(t *testing.T) {
+	tests := []struct {
+		name              string
+		target, duplicate *string
+		expected          *string
+	}{
+		{"both empty", nil, nil, nil},
+		{"only target", new("buy 2"), nil, new("buy 2")},
+		{"only duplicate", nil, new("organic"), new("organic")},
+		{"blank target", new(" "), new("organic"), new("organic")},
+		{"same notes", new("buy 2"), new("buy 2"), new("buy 2")},
+		{"different notes", new("buy 2"), new("organic"), new("buy 2\n\norganic")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			merged := mergeNotes(tt.target, tt.duplicate)
+			if (merged == nil) != (tt.expected == nil) || (merged != nil && *merged != *tt.expected) {
+				t.Fatalf("expected %v got %v", tt.expected, merged)
+			}
+		})
+	}
+}
+
+func TestMoveRows_PlacesRowsAfterTargetRows(t *testing.T) {
+	// Setup: the target item's last row is at a1, the moved rows bring their source positions a0 and a5
+	// Expected: the rows are placed after a1 in their current order
+
+	tx := newMockTx(func(dest ...any) error {
+		*(dest[0].(*string)) = "a1"
+		return nil
+	})
+	rows := []dbo.ChecklistItemRowDbo{{Id: 7, Position: "a5"}, {Id: 8, Position: "a0"}}
+
+	if err := moveRows(tx, 1, rows); err != nil {
+		t.Fatalf("move rows failed: %v", err)
+	}
+
+	if rows[0].Position != "a2" || rows[1].Position != "a3" {
+		t.Errorf("expected positions a2 and a3, got %q and %q", rows[0].Position, rows[1].Position)
+	}
+	if len(tx.execs) != 2 || !strings.Contains(tx.execs[0], "CHECKLIST_ITEM_ROW_POSITION = @position") {
+		t.Errorf("expected the rows to be re-parented with new positions, got %q", tx.execs)
+	}
+}
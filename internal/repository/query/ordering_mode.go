@@ -0,0 +1,67 @@
+package query
+
+import (
+	"context"
+
+	"com.raunlo.checklist/internal/core/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/raunlo/pgx-with-automapper/pool"
+)
+
+// findOrderingMode reads the ordering mode of a checklist within the current transaction
+func findOrderingMode(tx pool.TransactionWrapper, checklistId uint) (domain.ChecklistOrderingMode, error) {
+	var mode domain.ChecklistOrderingMode
+	err := tx.QueryRow(context.Background(),
+		`SELECT ORDERING_MODE FROM CHECKLIST WHERE ID = @checklistId`,
+		pgx.NamedArgs{"checklistId": checklistId}).Scan(&mode)
+	return mode, err
+}
+
+// positionSection returns the completion section an item is positioned in. Checklists that keep items
+// in place have a single section, which is returned as nil so "(CAST(@section AS BOOLEAN) IS NULL OR ...)"
+// filters match every item.
+func positionSection(mode domain.ChecklistOrderingMode, completed bool) *bool {
+	if !mode.SinksCompleted() {
+		return nil
+	}
+	return &completed
+}
+
+// ChangeOrderingModeQueryFunction switches the ordering mode of a checklist. When items start to keep their
+// place, positions are renumbered in the current display order so the list looks the same afterwards.
+type ChangeOrderingModeQueryFunction struct {
+	checklistId uint
+	mode        domain.ChecklistOrderingMode
+}
+
+func NewChangeOrderingModeQueryFunction(checklistId uint, mode domain.ChecklistOrderingMode) *ChangeOrderingModeQueryFunction {
+	return &ChangeOrderingModeQueryFunction{checklistId: checklistId, mode: mode}
+}
+
+// GetTransactionalQueryFunction returns whether the mode changed
+func (c *ChangeOrderingModeQueryFunction) GetTransactionalQueryFunction() func(tx pool.TransactionWrapper) (bool, error) {
+	return func(tx pool.TransactionWrapper) (bool, error) {
+		tag, err := tx.Exec(context.Background(),
+			`UPDATE CHECKLIST SET ORDERING_MODE = @mode WHERE ID = @checklistId AND ORDERING_MODE != @mode`,
+			pgx.NamedArgs{"checklistId": c.checklistId, "mode": string(c.mode)})
+		if err != nil || tag.RowsAffected() == 0 {
+			return false, err
+		}
+		if c.mode.SinksCompleted() {
+			// Positions are already ascending within each completion section
+			return true, nil
+		}
+
+		rows, err := tx.Query(context.Background(),
+			`SELECT CHECKLIST_ITEM_ID FROM CHECKLIST_ITEM
+			 WHERE CHECKLIST_ID = @checklistId
+			 ORDER BY SECTION_ID, CHECKLIST_ITEM_COMPLETED ASC, POSITION ASC
+			 FOR UPDATE`,
+			pgx.NamedArgs{"checklistId": c.checklistId})
+		if err != nil {
+			return false, err
+		}
+		_, err = renumberItemPositions(tx, rows)
+		return err == nil, err
+	}
+}
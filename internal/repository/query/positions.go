@@ -0,0 +1,118 @@
+package query
+
+import (
+	"context"
+	"errors"
+
+	"com.raunlo.checklist/internal/core/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/raunlo/pgx-with-automapper/pool"
+)
+
+// errEqualNeighbourPositions means that the items an item is moved between share a position, so no key fits
+// between them
+var errEqualNeighbourPositions = errors.New("neighbour items have equal positions")
+
+// lockChecklistPositions reads the ordering mode of a checklist like findOrderingMode and locks the checklist
+// row, so that transactions placing items by the positions of their neighbours run one after another instead
+// of computing the same key. Item inserts are not blocked by the lock.
+func lockChecklistPositions(tx pool.TransactionWrapper, checklistId uint) (domain.ChecklistOrderingMode, error) {
+	var mode domain.ChecklistOrderingMode
+	err := tx.QueryRow(context.Background(),
+		`SELECT ORDERING_MODE FROM CHECKLIST WHERE ID = @checklistId FOR NO KEY UPDATE`,
+		pgx.NamedArgs{"checklistId": checklistId}).Scan(&mode)
+	return mode, err
+}
+
+// renumberChecklistPositions gives every item of the checklist a short position in its current order. Items
+// that share a position keep the order of their ids.
+func renumberChecklistPositions(tx pool.TransactionWrapper, checklistId uint, mode domain.ChecklistOrderingMode) error {
+	rows, err := tx.Query(context.Background(),
+		`SELECT CHECKLIST_ITEM_ID FROM CHECKLIST_ITEM
+		 WHERE CHECKLIST_ID = @checklistId
+		 ORDER BY SECTION_ID, CASE WHEN @sinkCompleted THEN CHECKLIST_ITEM_COMPLETED END, POSITION, CHECKLIST_ITEM_ID
+		 FOR UPDATE`,
+		pgx.NamedArgs{"checklistId": checklistId, "sinkCompleted": mode.SinksCompleted()})
+	if err != nil {
+		return err
+	}
+	_, err = renumberItemPositions(tx, rows)
+	return err
+}
+
+// renumberItemPositions reads item ids in the order the items keep and gives them short, ascending positions.
+// Positions are only compared within a checklist section and completion section, so one sequence across the
+// whole checklist keeps every section in order. Returns the new position of every item.
+func renumberItemPositions(tx pool.TransactionWrapper, rows pgx.Rows) (map[uint]string, error) {
+	itemIds, err := scanItemIds(rows)
+	if err != nil {
+		return nil, err
+	}
+	positions, err := domain.PositionsBetween("", "", len(itemIds))
+	if err != nil {
+		return nil, err
+	}
+	if err := writeItemPositions(tx, itemIds, positions); err != nil {
+		return nil, err
+	}
+	// Positions of the history entries are compared with each other, so the new keys of every item are recorded
+	if err := appendItemHistory(tx, itemIds...); err != nil {
+		return nil, err
+	}
+
+	result := make(map[uint]string, len(itemIds))
+	for i, itemId := range itemIds {
+		result[itemId] = positions[i]
+	}
+	return result, nil
+}
+
+// placeItemsAfter reads rows of an item id and the position the item goes after, in the order the items keep.
+// Items that go after the same position get consecutive positions there. Returns the ids of the placed items.
+func placeItemsAfter(tx pool.TransactionWrapper, rows pgx.Rows) ([]uint, error) {
+	itemIds := make([]uint, 0)
+	afterPositions := make([]string, 0)
+	for rows.Next() {
+		var itemId uint
+		var after string
+		if err := rows.Scan(&itemId, &after); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		itemIds = append(itemIds, itemId)
+		afterPositions = append(afterPositions, after)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	positions := make([]string, 0, len(itemIds))
+	for start := 0; start < len(itemIds); {
+		end := start + 1
+		for end < len(itemIds) && afterPositions[end] == afterPositions[start] {
+			end++
+		}
+		run, err := domain.PositionsBetween(afterPositions[start], "", end-start)
+		if err != nil {
+			return nil, err
+		}
+		positions = append(positions, run...)
+		start = end
+	}
+	return itemIds, writeItemPositions(tx, itemIds, positions)
+}
+
+// writeItemPositions stores the positions of the items in one statement
+func writeItemPositions(tx pool.TransactionWrapper, itemIds []uint, positions []string) error {
+	if len(itemIds) == 0 {
+		return nil
+	}
+	_, err := tx.Exec(context.Background(),
+		`UPDATE CHECKLIST_ITEM ci
+		 SET POSITION = p.POSITION
+		 FROM unnest(CAST(@itemIds AS BIGINT[]), CAST(@positions AS TEXT[])) AS p(CHECKLIST_ITEM_ID, POSITION)
+		 WHERE ci.CHECKLIST_ITEM_ID = p.CHECKLIST_ITEM_ID`,
+		pgx.NamedArgs{"itemIds": itemIds, "positions": positions})
+	return err
+}
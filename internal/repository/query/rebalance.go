@@ -2,13 +2,16 @@ package query
 
 import (
 	"context"
+	"time"
 
-	"com.raunlo.checklist/internal/core/domain"
 	"github.com/jackc/pgx/v5"
 	"github.com/raunlo/pgx-with-automapper/pool"
 )
 
-// RebalancePositionsQueryFunction redistributes positions evenly for all items in a checklist
+// RebalancePositionsQueryFunction claims the pending rebalance of a checklist, rewrites the positions of all its
+// items to short keys and returns the new positions of the active items. It returns nil without changing anything
+// when no rebalance is pending or another instance is running it; a Postgres advisory lock held until the end of
+// the transaction keeps instances from rebalancing the same checklist at once.
 type RebalancePositionsQueryFunction struct {
 	checklistId uint
 }
@@ -19,41 +22,93 @@ func NewRebalancePositionsQueryFunction(checklistId uint) *RebalancePositionsQue
 	}
 }
 
-func (r *RebalancePositionsQueryFunction) GetTransactionalQueryFunction() func(tx pool.TransactionWrapper) (bool, error) {
-	return func(tx pool.TransactionWrapper) (bool, error) {
-		// Lock all items and calculate new positions atomically
-		// Maintains the order: uncompleted items first (by position), then completed items (by position)
-		// Each section gets evenly-spaced positions starting from FirstItemPosition
-		rebalanceSQL := `
-			WITH numbered_items AS (
-				SELECT
-					CHECKLIST_ITEM_ID,
-					CHECKLIST_ITEM_COMPLETED,
-					ROW_NUMBER() OVER (
-						PARTITION BY CHECKLIST_ITEM_COMPLETED
-						ORDER BY POSITION
-					) as row_num
-				FROM CHECKLIST_ITEM
-				WHERE CHECKLIST_ID = @checklistId
-				FOR UPDATE
-			),
-			new_positions AS (
-				SELECT
-					CHECKLIST_ITEM_ID,
-					(@startPosition + (row_num - 1) * @gap)::DOUBLE PRECISION as new_position
-				FROM numbered_items
-			)
-			UPDATE CHECKLIST_ITEM ci
-			SET POSITION = np.new_position
-			FROM new_positions np
-			WHERE ci.CHECKLIST_ITEM_ID = np.CHECKLIST_ITEM_ID`
-
-		_, err := tx.Exec(context.Background(), rebalanceSQL, pgx.NamedArgs{
-			"checklistId":   r.checklistId,
-			"startPosition": domain.FirstItemPosition,
-			"gap":           domain.DefaultGapSize,
-		})
+func (r *RebalancePositionsQueryFunction) GetTransactionalQueryFunction() func(tx pool.TransactionWrapper) (map[uint]string, error) {
+	return func(tx pool.TransactionWrapper) (map[uint]string, error) {
+		var locked bool
+		err := tx.QueryRow(context.Background(),
+			`SELECT pg_try_advisory_xact_lock(hashtextextended('checklist_rebalance', @checklistId))`,
+			pgx.NamedArgs{"checklistId": r.checklistId}).Scan(&locked)
+		if err != nil || !locked {
+			return nil, err
+		}
+		claimed, err := tx.Exec(context.Background(),
+			`DELETE FROM CHECKLIST_REBALANCE WHERE CHECKLIST_ID = @checklistId`,
+			pgx.NamedArgs{"checklistId": r.checklistId})
+		if err != nil || claimed.RowsAffected() == 0 {
+			return nil, err
+		}
+
+		mode, err := lockChecklistPositions(tx, r.checklistId)
+		if err != nil {
+			return nil, err
+		}
+
+		// Lock all items and rewrite their positions in the current order: uncompleted items first, then
+		// completed items, within every checklist section. Checklists that keep items in place have a single
+		// completion section. Soft-deleted items get short keys as well, so that a restore finds them in place.
+		rows, err := tx.Query(context.Background(),
+			`SELECT CHECKLIST_ITEM_ID FROM CHECKLIST_ITEM
+			 WHERE CHECKLIST_ID = @checklistId
+			 ORDER BY SECTION_ID, CASE WHEN @sinkCompleted THEN CHECKLIST_ITEM_COMPLETED END, POSITION
+			 FOR UPDATE`,
+			pgx.NamedArgs{
+				"checklistId":   r.checklistId,
+				"sinkCompleted": mode.SinksCompleted(),
+			})
+		if err != nil {
+			return nil, err
+		}
+		positions, err := renumberItemPositions(tx, rows)
+		if err != nil {
+			return nil, err
+		}
+
+		// Only the active items are sent to clients
+		deleted, err := tx.Query(context.Background(),
+			`SELECT CHECKLIST_ITEM_ID FROM CHECKLIST_ITEM WHERE CHECKLIST_ID = @checklistId AND DELETED_AT IS NOT NULL`,
+			pgx.NamedArgs{"checklistId": r.checklistId})
+		if err != nil {
+			return nil, err
+		}
+		deletedIds, err := scanItemIds(deleted)
+		if err != nil {
+			return nil, err
+		}
+		for _, itemId := range deletedIds {
+			delete(positions, itemId)
+		}
+		return positions, nil
+	}
+}
+
+// ScheduleRebalanceQueryFunction records a pending rebalance that runs after the debounce delay. Repeated requests
+// postpone it, but never further than maxWait after the first request.
+type ScheduleRebalanceQueryFunction struct {
+	checklistId uint
+	debounce    time.Duration
+	maxWait     time.Duration
+}
 
+func NewScheduleRebalanceQueryFunction(checklistId uint, debounce time.Duration, maxWait time.Duration) *ScheduleRebalanceQueryFunction {
+	return &ScheduleRebalanceQueryFunction{
+		checklistId: checklistId,
+		debounce:    debounce,
+		maxWait:     maxWait,
+	}
+}
+
+func (q *ScheduleRebalanceQueryFunction) GetTransactionalQueryFunction() func(tx pool.TransactionWrapper) (bool, error) {
+	return func(tx pool.TransactionWrapper) (bool, error) {
+		_, err := tx.Exec(context.Background(), `
+			INSERT INTO CHECKLIST_REBALANCE (CHECKLIST_ID, REQUESTED_AT, RUN_AFTER)
+			VALUES (@checklistId, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP + @debounceMs * INTERVAL '1 millisecond')
+			ON CONFLICT (CHECKLIST_ID) DO UPDATE
+			SET RUN_AFTER = LEAST(EXCLUDED.RUN_AFTER, CHECKLIST_REBALANCE.REQUESTED_AT + @maxWaitMs * INTERVAL '1 millisecond')`,
+			pgx.NamedArgs{
+				"checklistId": q.checklistId,
+				"debounceMs":  q.debounce.Milliseconds(),
+				"maxWaitMs":   q.maxWait.Milliseconds(),
+			})
 		return err == nil, err
 	}
 }
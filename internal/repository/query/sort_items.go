@@ -0,0 +1,111 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"com.raunlo.checklist/internal/core/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/raunlo/pgx-with-automapper/pool"
+)
+
+// SortChecklistItemsQueryFunction rewrites the positions of all active items of a checklist in the requested
+// order with short keys. Items are sorted within their checklist section, and completed items within their
+// own completion section unless the checklist keeps items in place.
+type SortChecklistItemsQueryFunction struct {
+	request domain.ChecklistItemSortRequest
+}
+
+func NewSortChecklistItemsQueryFunction(request domain.ChecklistItemSortRequest) *SortChecklistItemsQueryFunction {
+	return &SortChecklistItemsQueryFunction{request: request}
+}
+
+func (s *SortChecklistItemsQueryFunction) GetTransactionalQueryFunction() func(tx pool.TransactionWrapper) (bool, error) {
+	return func(tx pool.TransactionWrapper) (bool, error) {
+		mode, err := lockChecklistPositions(tx, s.request.ChecklistId)
+		if err != nil {
+			return false, err
+		}
+		sortExpression, err := s.sortExpression(tx)
+		if err != nil {
+			return false, err
+		}
+
+		// Ties are broken by id so the result is stable
+		rows, err := tx.Query(context.Background(),
+			fmt.Sprintf(`SELECT CHECKLIST_ITEM_ID
+			FROM CHECKLIST_ITEM
+			WHERE CHECKLIST_ID = @checklistId AND DELETED_AT IS NULL
+			ORDER BY SECTION_ID, CASE WHEN @sinkCompleted THEN CHECKLIST_ITEM_COMPLETED END, %s %s, CHECKLIST_ITEM_ID %s
+			FOR UPDATE`,
+				sortExpression, s.direction(), s.direction()),
+			pgx.NamedArgs{
+				"checklistId":   s.request.ChecklistId,
+				"sinkCompleted": mode.SinksCompleted(),
+			})
+		if err != nil {
+			return false, err
+		}
+		positions, err := renumberItemPositions(tx, rows)
+		if err != nil {
+			return false, err
+		}
+
+		_, err = tx.Exec(context.Background(),
+			`UPDATE CHECKLIST_ITEM SET UPDATED_AT = CURRENT_TIMESTAMP WHERE CHECKLIST_ITEM_ID = ANY(@itemIds)`,
+			pgx.NamedArgs{"itemIds": slices.Collect(maps.Keys(positions))})
+		return err == nil, err
+	}
+}
+
+// sortExpression maps the sort key to a column expression. Item ids come from a sequence, so they follow
+// creation order; moved and merged items keep the id they were created with.
+func (s *SortChecklistItemsQueryFunction) sortExpression(tx pool.TransactionWrapper) (string, error) {
+	if s.request.SortBy != domain.ItemSortByName {
+		return "CHECKLIST_ITEM_ID", nil
+	}
+	collation, err := findSortCollation(tx, s.request.Locale)
+	if err != nil || collation == "" {
+		return "CHECKLIST_ITEM_NAME", err
+	}
+	return fmt.Sprintf(`CHECKLIST_ITEM_NAME COLLATE "%s"`, collation), nil
+}
+
+// findSortCollation returns the ICU collation that sorts names for the locale. Without a collation for the
+// whole tag the one of its language is used, then the root collation "und-x-icu", and when the server was
+// built without ICU none, which leaves the default collation of the database. The name comes from
+// pg_collation, so it is safe to quote into the query.
+func findSortCollation(tx pool.TransactionWrapper, locale string) (string, error) {
+	candidates := make([]string, 0, 3)
+	if locale != "" {
+		candidates = append(candidates, locale+"-x-icu")
+		if language, _, found := strings.Cut(locale, "-"); found {
+			candidates = append(candidates, language+"-x-icu")
+		}
+	}
+	candidates = append(candidates, "und-x-icu")
+
+	var collation string
+	err := tx.QueryRow(context.Background(),
+		`SELECT CAST(collname AS TEXT)
+		 FROM pg_collation
+		 WHERE collprovider = 'i' AND CAST(collname AS TEXT) = ANY(CAST(@candidates AS TEXT[]))
+		 ORDER BY array_position(CAST(@candidates AS TEXT[]), CAST(collname AS TEXT))
+		 LIMIT 1`,
+		pgx.NamedArgs{"candidates": candidates}).Scan(&collation)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", nil
+	}
+	return collation, err
+}
+
+func (s *SortChecklistItemsQueryFunction) direction() string {
+	if s.request.SortOrder == domain.DescSort {
+		return "DESC"
+	}
+	return "ASC"
+}
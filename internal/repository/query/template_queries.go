@@ -3,6 +3,7 @@ package query
 import (
 	"context"
 
+	"com.raunlo.checklist/internal/core/domain"
 	"com.raunlo.checklist/internal/repository/dbo"
 	"github.com/jackc/pgx/v5"
 	"github.com/raunlo/pgx-with-automapper/pool"
@@ -17,19 +18,25 @@ type SaveTemplateQueryFunction struct {
 func (q *SaveTemplateQueryFunction) GetTransactionalQueryFunction() func(tx pool.TransactionWrapper) (dbo.TemplateDBO, error) {
 	return func(tx pool.TransactionWrapper) (dbo.TemplateDBO, error) {
 		err := tx.QueryRow(context.Background(),
-			`INSERT INTO TEMPLATE(USER_ID, NAME, DESCRIPTION, CREATED_AT, UPDATED_AT)
-			 VALUES(@userId, @name, @description, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+			`INSERT INTO TEMPLATE(USER_ID, NAME, DESCRIPTION, NOTES, CREATED_AT, UPDATED_AT)
+			 VALUES(@userId, @name, @description, @notes, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
 			 RETURNING ID`,
 			pgx.NamedArgs{
 				"userId":      q.template.UserID,
 				"name":        q.template.Name,
 				"description": q.template.Description,
+				"notes":       q.template.Notes,
 			}).Scan(&q.template.ID)
 		if err != nil {
 			return dbo.TemplateDBO{}, err
 		}
 
-		for _, row := range q.rows {
+		// Rows are stored in the order they are listed
+		positions, err := domain.PositionsBetween("", "", len(q.rows))
+		if err != nil {
+			return dbo.TemplateDBO{}, err
+		}
+		for i, row := range q.rows {
 			row.TemplateID = q.template.ID
 			err := tx.QueryRow(context.Background(),
 				`INSERT INTO TEMPLATE_ROW(TEMPLATE_ID, NAME, POSITION, CREATED_AT, UPDATED_AT)
@@ -38,7 +45,7 @@ func (q *SaveTemplateQueryFunction) GetTransactionalQueryFunction() func(tx pool
 				pgx.NamedArgs{
 					"templateId": row.TemplateID,
 					"name":       row.Name,
-					"position":   row.Position,
+					"position":   positions[i],
 				}).Scan(&row.ID)
 			if err != nil {
 				return dbo.TemplateDBO{}, err
@@ -66,10 +73,10 @@ func (q *FindTemplateByIdQueryFunction) GetTransactionalQueryFunction() func(tx
 	return func(tx pool.TransactionWrapper) (dbo.TemplateDBO, error) {
 		var template dbo.TemplateDBO
 		err := tx.QueryRow(context.Background(),
-			`SELECT ID, USER_ID, NAME, DESCRIPTION, CREATED_AT, UPDATED_AT, (USER_ID = @userId) AS IS_OWNER
+			`SELECT ID, USER_ID, NAME, DESCRIPTION, NOTES, CREATED_AT, UPDATED_AT, (USER_ID = @userId) AS IS_OWNER
 			 FROM TEMPLATE WHERE ID = @templateId`,
 			pgx.NamedArgs{"templateId": q.templateId, "userId": q.userId}).Scan(
-			&template.ID, &template.UserID, &template.Name, &template.Description,
+			&template.ID, &template.UserID, &template.Name, &template.Description, &template.Notes,
 			&template.CreatedAt, &template.UpdatedAt, &template.IsOwner)
 		if err != nil {
 			return dbo.TemplateDBO{}, err
@@ -124,7 +131,7 @@ type FindAllTemplatesByUserIdQueryFunction struct {
 func (q *FindAllTemplatesByUserIdQueryFunction) GetTransactionalQueryFunction() func(tx pool.TransactionWrapper) ([]dbo.TemplateDBO, error) {
 	return func(tx pool.TransactionWrapper) ([]dbo.TemplateDBO, error) {
 		rows, err := tx.Query(context.Background(),
-			`SELECT DISTINCT t.ID, t.USER_ID, t.NAME, t.DESCRIPTION, t.CREATED_AT, t.UPDATED_AT,
+			`SELECT DISTINCT t.ID, t.USER_ID, t.NAME, t.DESCRIPTION, t.NOTES, t.CREATED_AT, t.UPDATED_AT,
 			        (t.USER_ID = @userId) AS IS_OWNER
 			 FROM TEMPLATE t
 			 LEFT JOIN template_workspace tw ON tw.template_id = t.ID
@@ -140,7 +147,7 @@ func (q *FindAllTemplatesByUserIdQueryFunction) GetTransactionalQueryFunction()
 		var templates []dbo.TemplateDBO
 		for rows.Next() {
 			var template dbo.TemplateDBO
-			err := rows.Scan(&template.ID, &template.UserID, &template.Name, &template.Description, &template.CreatedAt, &template.UpdatedAt, &template.IsOwner)
+			err := rows.Scan(&template.ID, &template.UserID, &template.Name, &template.Description, &template.Notes, &template.CreatedAt, &template.UpdatedAt, &template.IsOwner)
 			if err != nil {
 				return nil, err
 			}
@@ -163,12 +170,13 @@ type UpdateTemplateQueryFunction struct {
 func (q *UpdateTemplateQueryFunction) GetTransactionalQueryFunction() func(tx pool.TransactionWrapper) error {
 	return func(tx pool.TransactionWrapper) error {
 		_, err := tx.Exec(context.Background(),
-			`UPDATE TEMPLATE SET NAME = @name, DESCRIPTION = @description, UPDATED_AT = CURRENT_TIMESTAMP
+			`UPDATE TEMPLATE SET NAME = @name, DESCRIPTION = @description, NOTES = @notes, UPDATED_AT = CURRENT_TIMESTAMP
 			 WHERE ID = @id`,
 			pgx.NamedArgs{
 				"id":          q.template.ID,
 				"name":        q.template.Name,
 				"description": q.template.Description,
+				"notes":       q.template.Notes,
 			})
 		if err != nil {
 			return err
@@ -181,14 +189,18 @@ func (q *UpdateTemplateQueryFunction) GetTransactionalQueryFunction() func(tx po
 			return err
 		}
 
-		for _, row := range q.rows {
+		positions, err := domain.PositionsBetween("", "", len(q.rows))
+		if err != nil {
+			return err
+		}
+		for i, row := range q.rows {
 			_, err = tx.Exec(context.Background(),
 				`INSERT INTO TEMPLATE_ROW(TEMPLATE_ID, NAME, POSITION, CREATED_AT, UPDATED_AT)
 				 VALUES(@templateId, @name, @position, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)`,
 				pgx.NamedArgs{
 					"templateId": q.template.ID,
 					"name":       row.Name,
-					"position":   row.Position,
+					"position":   positions[i],
 				})
 			if err != nil {
 				return err
@@ -259,7 +271,7 @@ type FindTemplatesByWorkspaceIdQueryFunction struct {
 func (q *FindTemplatesByWorkspaceIdQueryFunction) GetTransactionalQueryFunction() func(tx pool.TransactionWrapper) ([]dbo.TemplateDBO, error) {
 	return func(tx pool.TransactionWrapper) ([]dbo.TemplateDBO, error) {
 		rows, err := tx.Query(context.Background(),
-			`SELECT t.ID, t.USER_ID, t.NAME, t.DESCRIPTION, t.CREATED_AT, t.UPDATED_AT,
+			`SELECT t.ID, t.USER_ID, t.NAME, t.DESCRIPTION, t.NOTES, t.CREATED_AT, t.UPDATED_AT,
 			        (t.USER_ID = @userId) AS IS_OWNER
 			 FROM TEMPLATE t
 			 JOIN template_workspace tw ON tw.template_id = t.ID
@@ -274,7 +286,7 @@ func (q *FindTemplatesByWorkspaceIdQueryFunction) GetTransactionalQueryFunction(
 		var templates []dbo.TemplateDBO
 		for rows.Next() {
 			var t dbo.TemplateDBO
-			err := rows.Scan(&t.ID, &t.UserID, &t.Name, &t.Description, &t.CreatedAt, &t.UpdatedAt, &t.IsOwner)
+			err := rows.Scan(&t.ID, &t.UserID, &t.Name, &t.Description, &t.Notes, &t.CreatedAt, &t.UpdatedAt, &t.IsOwner)
 			if err != nil {
 				return nil, err
 			}
@@ -14,58 +14,106 @@ type toggleCompletionQueryFunction struct {
 	checklistId     uint
 	checklistItemId uint
 	completed       bool
+	userId          string
 }
 
-// GetTransactionalQueryFunction returns a transaction function to toggle item completion and update its position
-func (m *toggleCompletionQueryFunction) GetTransactionalQueryFunction() func(tx pool.TransactionWrapper) (domain.ChecklistItem, error) {
-	return func(tx pool.TransactionWrapper) (domain.ChecklistItem, error) {
-		// Calculate target position based on completion status
-		var newPosition float64
-		var positionQuery string
-
-		if m.completed {
-			// Move to beginning of completed section (smallest position in completed, or default if none)
-			positionQuery = `SELECT COALESCE(MIN(POSITION) - @gap, @defaultPos)
-							 FROM CHECKLIST_ITEM
-							 WHERE CHECKLIST_ID = @checklistId
-							   AND CHECKLIST_ITEM_COMPLETED = TRUE
-							   AND CHECKLIST_ITEM_ID != @itemId`
-		} else {
-			// Move to end of uncompleted section (largest position in uncompleted, or default if none)
-			positionQuery = `SELECT COALESCE(MAX(POSITION) + @gap, @defaultPos)
-							 FROM CHECKLIST_ITEM
-							 WHERE CHECKLIST_ID = @checklistId
-							   AND CHECKLIST_ITEM_COMPLETED = FALSE
-							   AND CHECKLIST_ITEM_ID != @itemId`
+// GetTransactionalQueryFunction returns a transaction function to toggle item completion and, unless the
+// checklist keeps items in place, move it to the matching section
+func (m *toggleCompletionQueryFunction) GetTransactionalQueryFunction() func(tx pool.TransactionWrapper) (domain.ChecklistItemToggleResult, error) {
+	return func(tx pool.TransactionWrapper) (domain.ChecklistItemToggleResult, error) {
+		mode, err := lockChecklistPositions(tx, m.checklistId)
+		if err != nil {
+			return domain.ChecklistItemToggleResult{}, err
 		}
 
-		err := tx.QueryRow(context.Background(), positionQuery, pgx.NamedArgs{
-			"checklistId": m.checklistId,
-			"itemId":      m.checklistItemId,
-			"gap":         domain.DefaultGapSize,
-			"defaultPos":  domain.FirstItemPosition,
-		}).Scan(&newPosition)
-		if err != nil {
-			return domain.ChecklistItem{}, fmt.Errorf("failed to calculate new position: %w", err)
+		// Calculate target position based on completion status. Checklists that keep items in place
+		// leave the position untouched (nil).
+		var newPosition *string
+		if mode.SinksCompleted() {
+			newPosition, err = m.calculateSectionPosition(tx)
+			if err != nil {
+				return domain.ChecklistItemToggleResult{}, fmt.Errorf("failed to calculate new position: %w", err)
+			}
 		}
 
-		// Update completion status and position atomically
-		var item domain.ChecklistItem
+		// Update completion status, completion audit fields and position atomically.
+		// Completing an already completed item keeps the original completer; unchecking clears them.
+		// The locked previous row gives the completion state before the toggle.
+		var result domain.ChecklistItemToggleResult
+		item := &result.Item
 		err = tx.QueryRow(context.Background(),
-			`UPDATE CHECKLIST_ITEM
-			 SET CHECKLIST_ITEM_COMPLETED = @completed, POSITION = @newPosition, UPDATED_AT = CURRENT_TIMESTAMP
-			 WHERE CHECKLIST_ID = @checklistId AND CHECKLIST_ITEM_ID = @checklistItemId
-			 RETURNING CHECKLIST_ITEM_ID, CHECKLIST_ITEM_NAME, CHECKLIST_ITEM_COMPLETED, POSITION`,
+			`UPDATE CHECKLIST_ITEM ci
+			 SET CHECKLIST_ITEM_COMPLETED_BY = CASE
+			         WHEN @completed AND ci.CHECKLIST_ITEM_COMPLETED THEN ci.CHECKLIST_ITEM_COMPLETED_BY
+			         WHEN @completed THEN @userId
+			         ELSE NULL
+			     END,
+			     CHECKLIST_ITEM_COMPLETED_AT = CASE
+			         WHEN @completed AND ci.CHECKLIST_ITEM_COMPLETED THEN ci.CHECKLIST_ITEM_COMPLETED_AT
+			         WHEN @completed THEN CURRENT_TIMESTAMP
+			         ELSE NULL
+			     END,
+			     CHECKLIST_ITEM_COMPLETED = @completed, POSITION = COALESCE(@newPosition, ci.POSITION), UPDATED_AT = CURRENT_TIMESTAMP
+			 FROM (SELECT CHECKLIST_ITEM_ID, CHECKLIST_ITEM_COMPLETED
+			       FROM CHECKLIST_ITEM
+			       WHERE CHECKLIST_ID = @checklistId AND CHECKLIST_ITEM_ID = @checklistItemId
+			       FOR UPDATE) previous
+			 WHERE ci.CHECKLIST_ITEM_ID = previous.CHECKLIST_ITEM_ID
+			 RETURNING ci.CHECKLIST_ITEM_ID, ci.CHECKLIST_ITEM_NAME, ci.CHECKLIST_ITEM_COMPLETED, ci.POSITION,
+			           ci.CHECKLIST_ITEM_COMPLETED_BY, ci.CHECKLIST_ITEM_COMPLETED_AT, ci.SECTION_ID, ci.NOTES,
+			           previous.CHECKLIST_ITEM_COMPLETED`,
 			pgx.NamedArgs{
 				"checklistId":     m.checklistId,
 				"checklistItemId": m.checklistItemId,
 				"completed":       m.completed,
 				"newPosition":     newPosition,
-			}).Scan(&item.Id, &item.Name, &item.Completed, &item.Position)
+				"userId":          m.userId,
+			}).Scan(&item.Id, &item.Name, &item.Completed, &item.Position, &item.CompletedBy, &item.CompletedAt, &item.SectionId, &item.Notes,
+			&result.WasCompleted)
 		if err != nil {
-			return domain.ChecklistItem{}, fmt.Errorf("failed to toggle item completion: %w", err)
+			return domain.ChecklistItemToggleResult{}, fmt.Errorf("failed to toggle item completion: %w", err)
 		}
 
-		return item, nil
+		return result, nil
+	}
+}
+
+// calculateSectionPosition places a completed item at the top of the completed section and a reopened item
+// at the end of the open section, both within the checklist section the item belongs to
+func (m *toggleCompletionQueryFunction) calculateSectionPosition(tx pool.TransactionWrapper) (*string, error) {
+	var positionQuery string
+	if m.completed {
+		// Move to beginning of completed section (before the smallest position in completed, if any)
+		positionQuery = `SELECT COALESCE(MIN(POSITION), '')
+						 FROM CHECKLIST_ITEM
+						 WHERE CHECKLIST_ID = @checklistId
+						   AND CHECKLIST_ITEM_COMPLETED = TRUE
+						   AND CHECKLIST_ITEM_ID != @itemId
+						   AND SECTION_ID IS NOT DISTINCT FROM (SELECT SECTION_ID FROM CHECKLIST_ITEM WHERE CHECKLIST_ITEM_ID = @itemId)`
+	} else {
+		// Move to end of uncompleted section (after the largest position in uncompleted, if any)
+		positionQuery = `SELECT COALESCE(MAX(POSITION), '')
+						 FROM CHECKLIST_ITEM
+						 WHERE CHECKLIST_ID = @checklistId
+						   AND CHECKLIST_ITEM_COMPLETED = FALSE
+						   AND CHECKLIST_ITEM_ID != @itemId
+						   AND SECTION_ID IS NOT DISTINCT FROM (SELECT SECTION_ID FROM CHECKLIST_ITEM WHERE CHECKLIST_ITEM_ID = @itemId)`
+	}
+
+	var neighbour string
+	err := tx.QueryRow(context.Background(), positionQuery, pgx.NamedArgs{
+		"checklistId": m.checklistId,
+		"itemId":      m.checklistItemId,
+	}).Scan(&neighbour)
+	if err != nil {
+		return nil, err
+	}
+
+	var newPosition string
+	if m.completed {
+		newPosition, err = domain.PositionBetween("", neighbour)
+	} else {
+		newPosition, err = domain.PositionBetween(neighbour, "")
 	}
+	return &newPosition, err
 }
@@ -0,0 +1,141 @@
+package query
+
+import (
+	"context"
+
+	"com.raunlo.checklist/internal/core/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/raunlo/pgx-with-automapper/pool"
+)
+
+// TransferChecklistItemQueryFunction moves or copies an item with its rows into another checklist.
+// The item keeps its completion state and is placed with the gap algorithm in the target checklist.
+type TransferChecklistItemQueryFunction struct {
+	request domain.ChecklistItemTransferRequest
+	copy    bool
+}
+
+func NewMoveChecklistItemQueryFunction(request domain.ChecklistItemTransferRequest) *TransferChecklistItemQueryFunction {
+	return &TransferChecklistItemQueryFunction{request: request}
+}
+
+func NewCopyChecklistItemQueryFunction(request domain.ChecklistItemTransferRequest) *TransferChecklistItemQueryFunction {
+	return &TransferChecklistItemQueryFunction{request: request, copy: true}
+}
+
+func (t *TransferChecklistItemQueryFunction) GetTransactionalQueryFunction() func(tx pool.TransactionWrapper) (domain.ChecklistItemTransferResult, error) {
+	return func(tx pool.TransactionWrapper) (domain.ChecklistItemTransferResult, error) {
+		// 1. Lock the target checklist positions and the source item; deleted items can not be transferred
+		targetMode, err := lockChecklistPositions(tx, t.request.TargetChecklistId)
+		if err != nil {
+			return domain.ChecklistItemTransferResult{}, err
+		}
+		var completed bool
+		err = tx.QueryRow(context.Background(),
+			`SELECT CHECKLIST_ITEM_COMPLETED FROM CHECKLIST_ITEM
+			 WHERE CHECKLIST_ID = @sourceChecklistId AND CHECKLIST_ITEM_ID = @itemId AND DELETED_AT IS NULL
+			 FOR UPDATE`,
+			pgx.NamedArgs{
+				"sourceChecklistId": t.request.SourceChecklistId,
+				"itemId":            t.request.ChecklistItemId,
+			}).Scan(&completed)
+		if err != nil {
+			return domain.ChecklistItemTransferResult{}, err
+		}
+
+		// 2. Place the item at the front of its section in the target checklist
+		var minPosition string
+		err = tx.QueryRow(context.Background(),
+			`SELECT COALESCE(MIN(POSITION), '') FROM CHECKLIST_ITEM
+			 WHERE CHECKLIST_ID = @targetChecklistId AND SECTION_ID IS NULL
+			   AND (CAST(@section AS BOOLEAN) IS NULL OR CHECKLIST_ITEM_COMPLETED = @section)`,
+			pgx.NamedArgs{
+				"targetChecklistId": t.request.TargetChecklistId,
+				"section":           positionSection(targetMode, completed),
+			}).Scan(&minPosition)
+		if err != nil {
+			return domain.ChecklistItemTransferResult{}, err
+		}
+		position, err := domain.PositionBetween("", minPosition)
+		if err != nil {
+			return domain.ChecklistItemTransferResult{}, err
+		}
+
+		// 3. Re-parent the item, or duplicate it together with its rows
+		var targetItemId uint
+		if t.copy {
+			targetItemId, err = t.copyItem(tx, position)
+		} else {
+			targetItemId, err = t.moveItem(tx, position)
+		}
+		if err != nil {
+			return domain.ChecklistItemTransferResult{}, err
+		}
+
+		// 4. Move to the requested order number within the target checklist
+		result := domain.ChecklistItemTransferResult{Item: domain.ChecklistItem{Id: targetItemId, Position: position}}
+		if t.request.OrderNumber != nil {
+			response, err := NewChangeChecklistItemOrderQueryFunction(domain.ChangeOrderRequest{
+				NewOrderNumber:  *t.request.OrderNumber,
+				ChecklistId:     t.request.TargetChecklistId,
+				ChecklistItemId: targetItemId,
+				SortOrder:       domain.AscSort,
+			}).GetTransactionalQueryFunction()(tx)
+			if err != nil {
+				return domain.ChecklistItemTransferResult{}, err
+			}
+			result.Item.Position = response.Position
+			result.RebalanceNeeded = response.RebalanceNeeded
+		}
+		return result, nil
+	}
+}
+
+func (t *TransferChecklistItemQueryFunction) moveItem(tx pool.TransactionWrapper, position string) (uint, error) {
+	_, err := tx.Exec(context.Background(),
+		`UPDATE CHECKLIST_ITEM SET CHECKLIST_ID = @targetChecklistId, SECTION_ID = NULL, POSITION = @position, UPDATED_AT = CURRENT_TIMESTAMP
+		 WHERE CHECKLIST_ID = @sourceChecklistId AND CHECKLIST_ITEM_ID = @itemId`,
+		pgx.NamedArgs{
+			"targetChecklistId": t.request.TargetChecklistId,
+			"sourceChecklistId": t.request.SourceChecklistId,
+			"itemId":            t.request.ChecklistItemId,
+			"position":          position,
+		})
+	return t.request.ChecklistItemId, err
+}
+
+func (t *TransferChecklistItemQueryFunction) copyItem(tx pool.TransactionWrapper, position string) (uint, error) {
+	// Completion audit fields are copied so the copy keeps who completed the item and when
+	var newItemId uint
+	err := tx.QueryRow(context.Background(),
+		`INSERT INTO CHECKLIST_ITEM(CHECKLIST_ITEM_ID, CHECKLIST_ID, CHECKLIST_ITEM_NAME, CHECKLIST_ITEM_COMPLETED, POSITION, UPDATED_AT,
+		                            CHECKLIST_ITEM_COMPLETED_BY, CHECKLIST_ITEM_COMPLETED_AT, NOTES)
+		 SELECT nextval('checklist_item_id_sequence'), @targetChecklistId, CHECKLIST_ITEM_NAME, CHECKLIST_ITEM_COMPLETED, @position, CURRENT_TIMESTAMP,
+		        CHECKLIST_ITEM_COMPLETED_BY, CHECKLIST_ITEM_COMPLETED_AT, NOTES
+		 FROM CHECKLIST_ITEM
+		 WHERE CHECKLIST_ID = @sourceChecklistId AND CHECKLIST_ITEM_ID = @itemId
+		 RETURNING CHECKLIST_ITEM_ID`,
+		pgx.NamedArgs{
+			"targetChecklistId": t.request.TargetChecklistId,
+			"sourceChecklistId": t.request.SourceChecklistId,
+			"itemId":            t.request.ChecklistItemId,
+			"position":          position,
+		}).Scan(&newItemId)
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = tx.Exec(context.Background(),
+		`INSERT INTO CHECKLIST_ITEM_ROW(CHECKLIST_ITEM_ROW_ID, CHECKLIST_ITEM_ID, CHECKLIST_ITEM_ROW_NAME, CHECKLIST_ITEM_ROW_COMPLETED,
+		                                CHECKLIST_ITEM_ROW_COMPLETED_BY, CHECKLIST_ITEM_ROW_COMPLETED_AT, CHECKLIST_ITEM_ROW_POSITION)
+		 SELECT nextval('checklist_item_row_id_sequence'), @newItemId, CHECKLIST_ITEM_ROW_NAME, CHECKLIST_ITEM_ROW_COMPLETED,
+		        CHECKLIST_ITEM_ROW_COMPLETED_BY, CHECKLIST_ITEM_ROW_COMPLETED_AT, CHECKLIST_ITEM_ROW_POSITION
+		 FROM CHECKLIST_ITEM_ROW
+		 WHERE CHECKLIST_ITEM_ID = @itemId
+		 ORDER BY CHECKLIST_ITEM_ROW_ID`,
+		pgx.NamedArgs{
+			"newItemId": newItemId,
+			"itemId":    t.request.ChecklistItemId,
+		})
+	return newItemId, err
+}
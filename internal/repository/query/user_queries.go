@@ -41,8 +41,12 @@ func GetUserDataExport(ctx context.Context, conn pool.Conn, userId string) (*dom
 				v.CHECKLIST_ITEM_ID,
 				v.CHECKLIST_ITEM_NAME,
 				v.CHECKLIST_ITEM_COMPLETED,
+				ci.CHECKLIST_ITEM_COMPLETED_BY,
+				ci.CHECKLIST_ITEM_COMPLETED_AT,
+				ci.NOTES,
 				v.ORDER_NUMBER
 			FROM CHECKLIST_ITEMS_ORDERED_VIEW v
+			JOIN CHECKLIST_ITEM ci ON ci.CHECKLIST_ITEM_ID = v.CHECKLIST_ITEM_ID
 			WHERE v.CHECKLIST_ID = $1
 			ORDER BY v.ORDER_NUMBER
 		`, checklist.Id)
@@ -53,7 +57,7 @@ func GetUserDataExport(ctx context.Context, conn pool.Conn, userId string) (*dom
 		var items []domain.ExportedChecklistItem
 		for itemRows.Next() {
 			var item domain.ExportedChecklistItem
-			if err := itemRows.Scan(&item.Id, &item.Name, &item.Completed, &item.OrderNumber); err != nil {
+			if err := itemRows.Scan(&item.Id, &item.Name, &item.Completed, &item.CompletedBy, &item.CompletedAt, &item.Notes, &item.OrderNumber); err != nil {
 				itemRows.Close()
 				return nil, fmt.Errorf("failed to scan item: %w", err)
 			}
@@ -63,10 +67,12 @@ func GetUserDataExport(ctx context.Context, conn pool.Conn, userId string) (*dom
 				SELECT
 					CHECKLIST_ITEM_ROW_ID,
 					CHECKLIST_ITEM_ROW_NAME,
-					CHECKLIST_ITEM_ROW_COMPLETED
+					CHECKLIST_ITEM_ROW_COMPLETED,
+					CHECKLIST_ITEM_ROW_COMPLETED_BY,
+					CHECKLIST_ITEM_ROW_COMPLETED_AT
 				FROM CHECKLIST_ITEM_ROW
 				WHERE CHECKLIST_ITEM_ID = $1
-				ORDER BY CHECKLIST_ITEM_ROW_ID
+				ORDER BY CHECKLIST_ITEM_ROW_POSITION
 			`, item.Id)
 			if err != nil {
 				itemRows.Close()
@@ -76,7 +82,7 @@ func GetUserDataExport(ctx context.Context, conn pool.Conn, userId string) (*dom
 			var rows []domain.ExportedChecklistItemRow
 			for rowRows.Next() {
 				var row domain.ExportedChecklistItemRow
-				if err := rowRows.Scan(&row.Id, &row.Name, &row.Completed); err != nil {
+				if err := rowRows.Scan(&row.Id, &row.Name, &row.Completed, &row.CompletedBy, &row.CompletedAt); err != nil {
 					rowRows.Close()
 					itemRows.Close()
 					return nil, fmt.Errorf("failed to scan row: %w", err)
@@ -20,3 +20,15 @@ func CreateChecklistItemRepository(conn pool.Conn) repository.IChecklistItemsRep
 func CreateChecklistInviteRepository(conn pool.Conn) repository.IChecklistInviteRepository {
 	return newChecklistInviteRepository(conn)
 }
+
+func CreateChecklistSectionRepository(conn pool.Conn) repository.IChecklistSectionRepository {
+	return &checklistSectionRepository{
+		conn: conn,
+	}
+}
+
+func CreateChecklistItemCommentRepository(conn pool.Conn) repository.IChecklistItemCommentRepository {
+	return &checklistItemCommentRepository{
+		conn: conn,
+	}
+}
@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"com.raunlo.checklist/internal/core/domain"
+	coreRepo "com.raunlo.checklist/internal/core/repository"
+	"com.raunlo.checklist/internal/repository/dbo"
+	"github.com/jackc/pgx/v5"
+	"github.com/raunlo/pgx-with-automapper/pool"
+)
+
+type workspaceActivityRepository struct {
+	connection pool.Conn
+}
+
+func (r *workspaceActivityRepository) AppendActivity(ctx context.Context, activity domain.WorkspaceActivity) domain.Error {
+	_, err := r.connection.Exec(ctx,
+		`INSERT INTO workspace_activity(workspace_id, event_type, actor, subject_user_id, checklist_id, template_id, invite_id, summary, created_at)
+		 VALUES(@workspaceId, @eventType, @actor, @subjectUserId, @checklistId, @templateId, @inviteId, @summary, CURRENT_TIMESTAMP)`,
+		pgx.NamedArgs{
+			"workspaceId":   activity.WorkspaceId,
+			"eventType":     string(activity.EventType),
+			"actor":         activity.Actor,
+			"subjectUserId": activity.SubjectUserId,
+			"checklistId":   activity.ChecklistId,
+			"templateId":    activity.TemplateId,
+			"inviteId":      activity.InviteId,
+			"summary":       activity.Summary,
+		})
+	if err != nil {
+		return domain.Wrap(err, fmt.Sprintf("Could not append activity for workspace(id=%d)", activity.WorkspaceId), 500)
+	}
+	return nil
+}
+
+func (r *workspaceActivityRepository) FindActivityPage(ctx context.Context, workspaceId uint, filter domain.WorkspaceActivityFilter, before *uint, limit int) (domain.WorkspaceActivityPage, domain.Error) {
+	var eventType *string
+	if filter.EventType != nil {
+		eventType = new(string(*filter.EventType))
+	}
+
+	// Fetch one extra entry to know whether another page exists
+	var dbos []dbo.WorkspaceActivityDBO
+	err := r.connection.QueryList(ctx,
+		`SELECT id, workspace_id, event_type, actor, subject_user_id, checklist_id, template_id, invite_id, summary, created_at
+		 FROM workspace_activity
+		 WHERE workspace_id = @workspaceId
+		   AND (CAST(@eventType AS VARCHAR) IS NULL OR event_type = @eventType)
+		   AND (CAST(@actor AS VARCHAR) IS NULL OR actor = @actor)
+		   AND (CAST(@before AS BIGINT) IS NULL OR id < @before)
+		 ORDER BY id DESC
+		 LIMIT @limit`,
+		&dbos,
+		pgx.NamedArgs{
+			"workspaceId": workspaceId,
+			"eventType":   eventType,
+			"actor":       filter.Actor,
+			"before":      before,
+			"limit":       limit + 1,
+		})
+	if err != nil {
+		return domain.WorkspaceActivityPage{}, domain.Wrap(err, fmt.Sprintf("Could not find activity for workspace(id=%d)", workspaceId), 500)
+	}
+
+	page := domain.WorkspaceActivityPage{Entries: make([]domain.WorkspaceActivity, 0, len(dbos))}
+	for index := range dbos {
+		if index == limit {
+			cursor := page.Entries[limit-1].Id
+			page.NextCursor = &cursor
+			break
+		}
+		page.Entries = append(page.Entries, dbos[index].ToDomain())
+	}
+	return page, nil
+}
+
+func (r *workspaceActivityRepository) PurgeActivityOlderThan(ctx context.Context, retentionPeriod time.Duration) (int64, domain.Error) {
+	result, err := r.connection.Exec(ctx,
+		`DELETE FROM workspace_activity
+		 WHERE created_at < NOW() - INTERVAL '1 hour' * @retentionHours`,
+		pgx.NamedArgs{"retentionHours": int(retentionPeriod.Hours())})
+	if err != nil {
+		return 0, domain.Wrap(err, "Could not purge workspace activity", 500)
+	}
+	return result.RowsAffected(), nil
+}
+
+func CreateWorkspaceActivityRepository(conn pool.Conn) coreRepo.IWorkspaceActivityRepository {
+	return &workspaceActivityRepository{connection: conn}
+}
@@ -87,3 +87,12 @@ func RateLimitMiddleware(limit int, window time.Duration) gin.HandlerFunc {
 		c.Next()
 	}
 }
+
+// APIRateLimit limits the requests to the protected API per client IP. Operations sent through a WebSocket pass
+// the same limiter, so that a socket does not get a budget of its own.
+type APIRateLimit gin.HandlerFunc
+
+// NewAPIRateLimit allows 1000 requests per minute
+func NewAPIRateLimit() APIRateLimit {
+	return APIRateLimit(RateLimitMiddleware(1000, time.Minute))
+}
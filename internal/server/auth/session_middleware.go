@@ -35,6 +35,11 @@ func ExtractUserIdFromGinContext(c *gin.Context) (string, bool) {
 	return userIdStr, ok
 }
 
+// SetUserIdInGinContext stores the authenticated user ID in the Gin context
+func SetUserIdInGinContext(c *gin.Context, userId string) {
+	c.Set(ginContextUserId, userId)
+}
+
 // SessionAuthMiddleware validates session-based authentication
 func SessionAuthMiddleware(authSessionService SessionValidator) gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -104,7 +109,7 @@ func SessionAuthMiddleware(authSessionService SessionValidator) gin.HandlerFunc
 		}
 
 		// Store user ID in context (same key as GoogleAuthMiddleware for compatibility)
-		c.Set(ginContextUserId, session.UserId)
+		SetUserIdInGinContext(c, session.UserId)
 
 		// Async update last activity (fire-and-forget to avoid blocking)
 		// Use background context with timeout to avoid cancellation after response
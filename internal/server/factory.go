@@ -14,6 +14,7 @@ import (
 	templateV1 "com.raunlo.checklist/internal/server/v1/template"
 	userV1 "com.raunlo.checklist/internal/server/v1/user"
 	workspaceV1 "com.raunlo.checklist/internal/server/v1/workspace"
+	"com.raunlo.checklist/internal/server/v1/ws"
 	"github.com/gin-gonic/gin"
 )
 
@@ -28,8 +29,10 @@ type routes struct {
 	templateController      templateV1.ITemplateController
 	userController          userV1.IUserController
 	workspaceController     workspaceV1.IWorkspaceController
+	webSocketController     ws.IWebSocketController
 	authController          *authV1.AuthController
 	authSessionService      auth.SessionValidator
+	apiRateLimit            auth.APIRateLimit
 }
 
 func NewRoutes(
@@ -40,8 +43,10 @@ func NewRoutes(
 	templateController templateV1.ITemplateController,
 	userController userV1.IUserController,
 	workspaceController workspaceV1.IWorkspaceController,
+	webSocketController ws.IWebSocketController,
 	authController *authV1.AuthController,
 	authSessionService auth.SessionValidator,
+	apiRateLimit auth.APIRateLimit,
 ) IRoutes {
 	return &routes{
 		engine:                  engine,
@@ -51,8 +56,10 @@ func NewRoutes(
 		templateController:      templateController,
 		userController:          userController,
 		workspaceController:     workspaceController,
+		webSocketController:     webSocketController,
 		authController:          authController,
 		authSessionService:      authSessionService,
+		apiRateLimit:            apiRateLimit,
 	}
 }
 
@@ -78,7 +85,7 @@ func (server *routes) ConfigureRoutes() {
 	// Protected routes (authentication required)
 	protectedGroup := server.engine.Group("/")
 
-	protectedGroup.Use(auth.RateLimitMiddleware(1000, time.Minute)) // 1000 requests per minute
+	protectedGroup.Use(gin.HandlerFunc(server.apiRateLimit)) // 1000 requests per minute, shared with WebSocket operations
 
 	// Session-based authentication
 	protectedGroup.Use(auth.SessionAuthMiddleware(server.authSessionService))
@@ -108,6 +115,7 @@ func (server *routes) ConfigureRoutes() {
 			TemplateController:      server.templateController,
 			UserController:          server.userController,
 			WorkspaceController:     server.workspaceController,
+			WebSocketController:     server.webSocketController,
 		},
 	)
 }
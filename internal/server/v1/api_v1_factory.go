@@ -7,6 +7,7 @@ import (
 	"com.raunlo.checklist/internal/server/v1/template"
 	"com.raunlo.checklist/internal/server/v1/user"
 	"com.raunlo.checklist/internal/server/v1/workspace"
+	"com.raunlo.checklist/internal/server/v1/ws"
 	"github.com/gin-gonic/gin"
 )
 
@@ -17,6 +18,7 @@ type V1ProtectedEndpointsRegistrationRequest struct {
 	TemplateController      template.ITemplateController
 	UserController          user.IUserController
 	WorkspaceController     workspace.IWorkspaceController
+	WebSocketController     ws.IWebSocketController
 }
 
 func RegisterV1Endpoints(gin *gin.RouterGroup,
@@ -28,4 +30,5 @@ func RegisterV1Endpoints(gin *gin.RouterGroup,
 	template.RegisterHandlers(gin, template.NewStrictHandler(request.TemplateController, nil))
 	user.RegisterHandlers(gin, user.NewStrictHandler(request.UserController, nil))
 	workspace.RegisterHandlers(gin, workspace.NewStrictHandler(request.WorkspaceController, nil))
+	gin.GET("/api/v1/ws", request.WebSocketController.Connect)
 }
@@ -0,0 +1,40 @@
+package checklist
+
+import (
+	"com.raunlo.checklist/internal/core/domain"
+)
+
+type IChecklistActivityDtoMapper interface {
+	ToDTO(activity domain.ChecklistActivity) ChecklistActivityResponse
+	ToPageDTO(page domain.ChecklistActivityPage) ChecklistActivityPageResponse
+}
+
+type checklistActivityDtoMapper struct{}
+
+func NewChecklistActivityDtoMapper() IChecklistActivityDtoMapper {
+	return &checklistActivityDtoMapper{}
+}
+
+func (m *checklistActivityDtoMapper) ToDTO(activity domain.ChecklistActivity) ChecklistActivityResponse {
+	return ChecklistActivityResponse{
+		Id:          activity.Id,
+		ChecklistId: activity.ChecklistId,
+		ItemId:      activity.ItemId,
+		Action:      ChecklistActivityAction(activity.Action),
+		Actor:       activity.Actor,
+		CreatedAt:   activity.CreatedAt,
+		Before:      activity.Before,
+		After:       activity.After,
+	}
+}
+
+func (m *checklistActivityDtoMapper) ToPageDTO(page domain.ChecklistActivityPage) ChecklistActivityPageResponse {
+	entries := make([]ChecklistActivityResponse, 0, len(page.Entries))
+	for _, activity := range page.Entries {
+		entries = append(entries, m.ToDTO(activity))
+	}
+	return ChecklistActivityPageResponse{
+		Entries:    entries,
+		NextCursor: page.NextCursor,
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"log"
 	"net/http"
 
+	"com.raunlo.checklist/internal/core/domain"
 	"com.raunlo.checklist/internal/core/service"
 	serverAuth "com.raunlo.checklist/internal/server/auth"
 	serverutils "com.raunlo.checklist/internal/server/server_utils"
@@ -13,11 +14,17 @@ import (
 type IChecklistController = StrictServerInterface
 
 type checklistController struct {
-	service       service.IChecklistService
-	inviteService service.IChecklistInviteService
-	mapper        IChecklistDtoMapper
-	inviteMapper  IChecklistInviteDtoMapper
-	baseUrl       serverAuth.BaseUrl
+	service         service.IChecklistService
+	inviteService   service.IChecklistInviteService
+	runService      service.IChecklistRunService
+	activityService service.IChecklistActivityService
+	historyService  service.IChecklistHistoryService
+	mapper          IChecklistDtoMapper
+	inviteMapper    IChecklistInviteDtoMapper
+	runMapper       IChecklistRunDtoMapper
+	activityMapper  IChecklistActivityDtoMapper
+	historyMapper   IChecklistHistoryDtoMapper
+	baseUrl         serverAuth.BaseUrl
 }
 
 func (controller *checklistController) DeleteChecklistById(ctx context.Context, request DeleteChecklistByIdRequestObject) (DeleteChecklistByIdResponseObject, error) {
@@ -151,6 +158,10 @@ func (controller *checklistController) CreateChecklistInvite(ctx context.Context
 	if err == nil {
 		dto := controller.inviteMapper.ToDTO(invite, string(controller.baseUrl))
 		return CreateChecklistInvite201JSONResponse(dto), nil
+	} else if err.ResponseCode() == http.StatusBadRequest {
+		return CreateChecklistInvite400JSONResponse{
+			Message: err.Error(),
+		}, nil
 	} else if err.ResponseCode() == http.StatusForbidden {
 		return CreateChecklistInvite403JSONResponse{
 			Message: "You don't have permission to create invites for this checklist",
@@ -243,6 +254,73 @@ func (controller *checklistController) ClaimInvite(ctx context.Context, request
 	}
 }
 
+func (controller *checklistController) CloneChecklist(ctx context.Context, request CloneChecklistRequestObject) (CloneChecklistResponseObject, error) {
+	domainContext := serverutils.CreateContext(ctx)
+	options := domain.ChecklistCloneOptions{
+		Name:            request.Body.Name,
+		WorkspaceId:     request.Body.WorkspaceId,
+		ResetCompletion: request.Body.ResetCompletion != nil && *request.Body.ResetCompletion,
+		IncludeShares:   request.Body.IncludeShares != nil && *request.Body.IncludeShares,
+	}
+
+	if checklist, err := controller.service.CloneChecklist(domainContext, request.ChecklistId, options); err == nil {
+		return CloneChecklist201JSONResponse(controller.mapper.ToDTO(checklist, domainContext)), nil
+	} else {
+		switch err.ResponseCode() {
+		case http.StatusBadRequest:
+			return CloneChecklist400JSONResponse{Message: err.Error()}, nil
+		case http.StatusForbidden:
+			return CloneChecklist403JSONResponse{Message: err.Error()}, nil
+		case http.StatusNotFound:
+			return CloneChecklist404JSONResponse{Message: err.Error()}, nil
+		default:
+			return CloneChecklist500JSONResponse{Message: err.Error()}, nil
+		}
+	}
+}
+
+func (controller *checklistController) MergeChecklist(ctx context.Context, request MergeChecklistRequestObject) (MergeChecklistResponseObject, error) {
+	domainContext := serverutils.CreateContext(ctx)
+
+	if result, err := controller.service.MergeChecklists(domainContext, request.ChecklistId, request.Body.SourceChecklistId); err == nil {
+		return MergeChecklist200JSONResponse(controller.mapper.ToMergeResponse(result)), nil
+	} else {
+		switch err.ResponseCode() {
+		case http.StatusBadRequest:
+			return MergeChecklist400JSONResponse{Message: err.Error()}, nil
+		case http.StatusForbidden:
+			return MergeChecklist403JSONResponse{Message: err.Error()}, nil
+		case http.StatusNotFound:
+			return MergeChecklist404JSONResponse{Message: err.Error()}, nil
+		default:
+			return MergeChecklist500JSONResponse{Message: err.Error()}, nil
+		}
+	}
+}
+
+func (controller *checklistController) SplitChecklist(ctx context.Context, request SplitChecklistRequestObject) (SplitChecklistResponseObject, error) {
+	domainContext := serverutils.CreateContext(ctx)
+	splitRequest := domain.ChecklistSplitRequest{
+		Name:    request.Body.Name,
+		ItemIds: request.Body.ItemIds,
+	}
+
+	if result, err := controller.service.SplitChecklist(domainContext, request.ChecklistId, splitRequest); err == nil {
+		return SplitChecklist201JSONResponse(controller.mapper.ToDTO(result.Checklist, domainContext)), nil
+	} else {
+		switch err.ResponseCode() {
+		case http.StatusBadRequest:
+			return SplitChecklist400JSONResponse{Message: err.Error()}, nil
+		case http.StatusForbidden:
+			return SplitChecklist403JSONResponse{Message: err.Error()}, nil
+		case http.StatusNotFound:
+			return SplitChecklist404JSONResponse{Message: err.Error()}, nil
+		default:
+			return SplitChecklist500JSONResponse{Message: err.Error()}, nil
+		}
+	}
+}
+
 func (controller *checklistController) LeaveSharedChecklist(ctx context.Context, request LeaveSharedChecklistRequestObject) (LeaveSharedChecklistResponseObject, error) {
 	domainContext := serverutils.CreateContext(ctx)
 
@@ -269,12 +347,161 @@ func (controller *checklistController) LeaveSharedChecklist(ctx context.Context,
 	}
 }
 
-func NewChecklistController(service service.IChecklistService, inviteService service.IChecklistInviteService, baseUrl serverAuth.BaseUrl) IChecklistController {
+// Run methods
+
+func (controller *checklistController) GetChecklistRuns(ctx context.Context, request GetChecklistRunsRequestObject) (GetChecklistRunsResponseObject, error) {
+	domainContext := serverutils.CreateContext(ctx)
+
+	runs, err := controller.runService.FindRuns(domainContext, request.ChecklistId)
+	if err == nil {
+		return GetChecklistRuns200JSONResponse(controller.runMapper.ToDTOArray(runs)), nil
+	} else if err.ResponseCode() == http.StatusNotFound {
+		return GetChecklistRuns404JSONResponse{ErrorResponseJSONResponse: ErrorResponseJSONResponse{Message: err.Error()}}, nil
+	} else {
+		return GetChecklistRuns500JSONResponse{Message: err.Error()}, nil
+	}
+}
+
+func (controller *checklistController) StartChecklistRun(ctx context.Context, request StartChecklistRunRequestObject) (StartChecklistRunResponseObject, error) {
+	domainContext := serverutils.CreateContext(ctx)
+
+	run, err := controller.runService.StartRun(domainContext, request.ChecklistId)
+	if err == nil {
+		return StartChecklistRun201JSONResponse(controller.runMapper.ToDTO(run, true)), nil
+	} else if err.ResponseCode() == http.StatusNotFound {
+		return StartChecklistRun404JSONResponse{ErrorResponseJSONResponse: ErrorResponseJSONResponse{Message: err.Error()}}, nil
+	} else {
+		return StartChecklistRun500JSONResponse{Message: err.Error()}, nil
+	}
+}
+
+func (controller *checklistController) GetChecklistRunById(ctx context.Context, request GetChecklistRunByIdRequestObject) (GetChecklistRunByIdResponseObject, error) {
+	domainContext := serverutils.CreateContext(ctx)
+
+	run, err := controller.runService.FindRunById(domainContext, request.ChecklistId, request.RunId)
+	if err == nil && run != nil {
+		return GetChecklistRunById200JSONResponse(controller.runMapper.ToDTO(*run, true)), nil
+	} else if err == nil && run == nil {
+		return GetChecklistRunById404JSONResponse{ErrorResponseJSONResponse: ErrorResponseJSONResponse{Message: "Run not found"}}, nil
+	} else if err.ResponseCode() == http.StatusNotFound {
+		return GetChecklistRunById404JSONResponse{ErrorResponseJSONResponse: ErrorResponseJSONResponse{Message: err.Error()}}, nil
+	} else {
+		return GetChecklistRunById500JSONResponse{Message: err.Error()}, nil
+	}
+}
+
+func (controller *checklistController) FinishChecklistRun(ctx context.Context, request FinishChecklistRunRequestObject) (FinishChecklistRunResponseObject, error) {
+	domainContext := serverutils.CreateContext(ctx)
+
+	run, err := controller.runService.FinishRun(domainContext, request.ChecklistId, request.RunId)
+	if err == nil {
+		return FinishChecklistRun200JSONResponse(controller.runMapper.ToDTO(run, true)), nil
+	} else if err.ResponseCode() == http.StatusBadRequest {
+		return FinishChecklistRun400JSONResponse{ErrorResponseJSONResponse: ErrorResponseJSONResponse{Message: err.Error()}}, nil
+	} else if err.ResponseCode() == http.StatusNotFound {
+		return FinishChecklistRun404JSONResponse{Message: err.Error()}, nil
+	} else {
+		return FinishChecklistRun500JSONResponse{Message: err.Error()}, nil
+	}
+}
+
+func (controller *checklistController) UpdateChecklistRunStep(ctx context.Context, request UpdateChecklistRunStepRequestObject) (UpdateChecklistRunStepResponseObject, error) {
+	domainContext := serverutils.CreateContext(ctx)
+
+	status, statusErr := domain.NewChecklistRunStepStatus(string(request.Body.Status))
+	if statusErr != nil {
+		return UpdateChecklistRunStep400JSONResponse{ErrorResponseJSONResponse: ErrorResponseJSONResponse{Message: statusErr.Error()}}, nil
+	}
+
+	step, err := controller.runService.UpdateRunStep(domainContext, request.ChecklistId, request.RunId, request.StepId, status)
+	if err == nil {
+		return UpdateChecklistRunStep200JSONResponse(controller.runMapper.ToStepDTO(step)), nil
+	} else if err.ResponseCode() == http.StatusBadRequest {
+		return UpdateChecklistRunStep400JSONResponse{ErrorResponseJSONResponse: ErrorResponseJSONResponse{Message: err.Error()}}, nil
+	} else if err.ResponseCode() == http.StatusNotFound {
+		return UpdateChecklistRunStep404JSONResponse{Message: err.Error()}, nil
+	} else {
+		return UpdateChecklistRunStep500JSONResponse{Message: err.Error()}, nil
+	}
+}
+
+func (controller *checklistController) UpdateChecklistRunStepRow(ctx context.Context, request UpdateChecklistRunStepRowRequestObject) (UpdateChecklistRunStepRowResponseObject, error) {
+	domainContext := serverutils.CreateContext(ctx)
+
+	status, statusErr := domain.NewChecklistRunStepStatus(string(request.Body.Status))
+	if statusErr != nil {
+		return UpdateChecklistRunStepRow400JSONResponse{ErrorResponseJSONResponse: ErrorResponseJSONResponse{Message: statusErr.Error()}}, nil
+	}
+
+	row, err := controller.runService.UpdateRunStepRow(domainContext, request.ChecklistId, request.RunId, request.StepId, request.RowId, status)
+	if err == nil {
+		return UpdateChecklistRunStepRow200JSONResponse(controller.runMapper.ToStepRowDTO(row)), nil
+	} else if err.ResponseCode() == http.StatusBadRequest {
+		return UpdateChecklistRunStepRow400JSONResponse{ErrorResponseJSONResponse: ErrorResponseJSONResponse{Message: err.Error()}}, nil
+	} else if err.ResponseCode() == http.StatusNotFound {
+		return UpdateChecklistRunStepRow404JSONResponse{Message: err.Error()}, nil
+	} else {
+		return UpdateChecklistRunStepRow500JSONResponse{Message: err.Error()}, nil
+	}
+}
+
+// Activity methods
+
+func (controller *checklistController) GetChecklistActivity(ctx context.Context, request GetChecklistActivityRequestObject) (GetChecklistActivityResponseObject, error) {
+	domainContext := serverutils.CreateContext(ctx)
+
+	page, err := controller.activityService.FindChecklistActivity(domainContext, request.ChecklistId, request.Params.Before, request.Params.Limit)
+	if err == nil {
+		return GetChecklistActivity200JSONResponse(controller.activityMapper.ToPageDTO(page)), nil
+	} else if err.ResponseCode() == http.StatusNotFound {
+		return GetChecklistActivity404JSONResponse{ErrorResponseJSONResponse: ErrorResponseJSONResponse{Message: err.Error()}}, nil
+	} else {
+		return GetChecklistActivity500JSONResponse{Message: err.Error()}, nil
+	}
+}
+
+// History methods
+
+func (controller *checklistController) GetChecklistStateAsOf(ctx context.Context, request GetChecklistStateAsOfRequestObject) (GetChecklistStateAsOfResponseObject, error) {
+	domainContext := serverutils.CreateContext(ctx)
+
+	state, err := controller.historyService.FindChecklistStateAsOf(domainContext, request.ChecklistId, request.Params.AsOf)
+	if err == nil {
+		return GetChecklistStateAsOf200JSONResponse(controller.historyMapper.ToStateDTO(state)), nil
+	} else if err.ResponseCode() == http.StatusNotFound {
+		return GetChecklistStateAsOf404JSONResponse{ErrorResponseJSONResponse: ErrorResponseJSONResponse{Message: err.Error()}}, nil
+	} else {
+		return GetChecklistStateAsOf500JSONResponse{Message: err.Error()}, nil
+	}
+}
+
+func (controller *checklistController) GetChecklistStateDiff(ctx context.Context, request GetChecklistStateDiffRequestObject) (GetChecklistStateDiffResponseObject, error) {
+	domainContext := serverutils.CreateContext(ctx)
+
+	diff, err := controller.historyService.DiffChecklistState(domainContext, request.ChecklistId, request.Params.From, request.Params.To)
+	if err == nil {
+		return GetChecklistStateDiff200JSONResponse(controller.historyMapper.ToDiffDTO(diff)), nil
+	} else if err.ResponseCode() == http.StatusBadRequest {
+		return GetChecklistStateDiff400JSONResponse{ErrorResponseJSONResponse: ErrorResponseJSONResponse{Message: err.Error()}}, nil
+	} else if err.ResponseCode() == http.StatusNotFound {
+		return GetChecklistStateDiff404JSONResponse{Message: err.Error()}, nil
+	} else {
+		return GetChecklistStateDiff500JSONResponse{Message: err.Error()}, nil
+	}
+}
+
+func NewChecklistController(service service.IChecklistService, inviteService service.IChecklistInviteService, runService service.IChecklistRunService, activityService service.IChecklistActivityService, historyService service.IChecklistHistoryService, baseUrl serverAuth.BaseUrl) IChecklistController {
 	return &checklistController{
-		service:       service,
-		inviteService: inviteService,
-		mapper:        NewChecklistDtoMapper(),
-		inviteMapper:  NewChecklistInviteDtoMapper(),
-		baseUrl:       baseUrl,
+		service:         service,
+		inviteService:   inviteService,
+		runService:      runService,
+		activityService: activityService,
+		historyService:  historyService,
+		mapper:          NewChecklistDtoMapper(),
+		inviteMapper:    NewChecklistInviteDtoMapper(),
+		runMapper:       NewChecklistRunDtoMapper(),
+		activityMapper:  NewChecklistActivityDtoMapper(),
+		historyMapper:   NewChecklistHistoryDtoMapper(),
+		baseUrl:         baseUrl,
 	}
 }
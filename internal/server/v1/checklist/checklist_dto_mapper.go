@@ -12,6 +12,7 @@ type IChecklistDtoMapper interface {
 	ToDTO(source domain.Checklist, ctx context.Context) ChecklistResponse
 	ToDtoArray(checklists []domain.Checklist, ctx context.Context) []ChecklistResponse
 	ToChecklistListResponseWithStats(source []domain.Checklist, ctx context.Context) GetChecklistsWithStatsResponse
+	ToMergeResponse(source domain.ChecklistMergeResult) MergeChecklistResponse
 }
 
 type checklistDtoMapper struct{}
@@ -27,6 +28,10 @@ func (*checklistDtoMapper) ToDomain(source CreateChecklistRequest) domain.Checkl
 		v := uint(*source.WorkspaceId)
 		target.WorkspaceId = &v
 	}
+	// Validated by the service; empty keeps the default on create and the current mode on update
+	if source.OrderingMode != nil {
+		target.OrderingMode = domain.ChecklistOrderingMode(*source.OrderingMode)
+	}
 	return target
 }
 
@@ -43,6 +48,8 @@ func (*checklistDtoMapper) ToDTO(source domain.Checklist, ctx context.Context) C
 
 	// Set owner information
 	target.Owner = source.Owner
+	target.OrderingMode = ChecklistOrderingMode(source.OrderingMode)
+	target.ArchivedAt = source.ArchivedAt
 	target.IsOwner = (source.Owner == currentUserId)
 	target.IsShared = (len(source.SharedWith) > 0)
 
@@ -74,6 +81,7 @@ func (mapper *checklistDtoMapper) ToChecklistListResponseWithStats(source []doma
 
 		dto.Id = checklist.Id
 		dto.Name = checklist.Name
+		dto.OrderingMode = ChecklistOrderingMode(checklist.OrderingMode)
 		dto.Stats.CompletedItems = checklist.Stats.CompletedItems
 		dto.Stats.TotalItems = checklist.Stats.TotalItems
 		// Get current user ID from context
@@ -99,3 +107,15 @@ func (mapper *checklistDtoMapper) ToChecklistListResponseWithStats(source []doma
 
 	return GetChecklistsWithStatsResponse{Checklists: response}
 }
+
+func (*checklistDtoMapper) ToMergeResponse(source domain.ChecklistMergeResult) MergeChecklistResponse {
+	target := MergeChecklistResponse{
+		TargetChecklistId: source.TargetChecklistId,
+		SourceChecklistId: source.SourceChecklistId,
+		Items:             make([]ChecklistItemResponse, len(source.Items)),
+	}
+	for index, item := range source.Items {
+		structsconv.Map(&item, &target.Items[index])
+	}
+	return target
+}
@@ -0,0 +1,79 @@
+package checklist
+
+import (
+	"com.raunlo.checklist/internal/core/domain"
+)
+
+type IChecklistHistoryDtoMapper interface {
+	ToStateDTO(state domain.ChecklistState) ChecklistStateResponse
+	ToDiffDTO(diff domain.ChecklistStateDiff) ChecklistStateDiffResponse
+}
+
+type checklistHistoryDtoMapper struct{}
+
+func NewChecklistHistoryDtoMapper() IChecklistHistoryDtoMapper {
+	return &checklistHistoryDtoMapper{}
+}
+
+func (m *checklistHistoryDtoMapper) ToStateDTO(state domain.ChecklistState) ChecklistStateResponse {
+	return ChecklistStateResponse{
+		ChecklistId: state.ChecklistId,
+		AsOf:        state.AsOf,
+		RecordedAt:  state.RecordedAt,
+		Items:       m.toItemDTOArray(state.Items),
+	}
+}
+
+func (m *checklistHistoryDtoMapper) ToDiffDTO(diff domain.ChecklistStateDiff) ChecklistStateDiffResponse {
+	changed := make([]ChecklistItemChangeResponse, 0, len(diff.Changed))
+	for _, change := range diff.Changed {
+		fields := make([]ChecklistItemChangeField, 0, len(change.Fields))
+		for _, field := range change.Fields {
+			fields = append(fields, ChecklistItemChangeField(field))
+		}
+		changed = append(changed, ChecklistItemChangeResponse{
+			ItemId: change.ItemId,
+			Before: m.toItemDTO(change.Before),
+			After:  m.toItemDTO(change.After),
+			Fields: fields,
+		})
+	}
+	return ChecklistStateDiffResponse{
+		ChecklistId: diff.ChecklistId,
+		From:        diff.From.AsOf,
+		To:          diff.To.AsOf,
+		Added:       m.toItemDTOArray(diff.Added),
+		Removed:     m.toItemDTOArray(diff.Removed),
+		Changed:     changed,
+	}
+}
+
+func (m *checklistHistoryDtoMapper) toItemDTOArray(items []domain.ChecklistItem) []ChecklistItemResponse {
+	dtos := make([]ChecklistItemResponse, 0, len(items))
+	for _, item := range items {
+		dtos = append(dtos, m.toItemDTO(item))
+	}
+	return dtos
+}
+
+func (m *checklistHistoryDtoMapper) toItemDTO(item domain.ChecklistItem) ChecklistItemResponse {
+	rows := make([]ChecklistItemRowResponse, 0, len(item.Rows))
+	for _, row := range item.Rows {
+		rows = append(rows, ChecklistItemRowResponse{
+			Id:          row.Id,
+			Name:        row.Name,
+			Completed:   new(row.Completed),
+			CompletedBy: row.CompletedBy,
+			CompletedAt: row.CompletedAt,
+		})
+	}
+	return ChecklistItemResponse{
+		Id:          item.Id,
+		Name:        item.Name,
+		Completed:   item.Completed,
+		OrderNumber: item.OrderNumber,
+		Rows:        rows,
+		CompletedBy: item.CompletedBy,
+		CompletedAt: item.CompletedAt,
+	}
+}
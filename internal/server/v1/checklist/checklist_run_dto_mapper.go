@@ -0,0 +1,76 @@
+package checklist
+
+import (
+	"com.raunlo.checklist/internal/core/domain"
+)
+
+type IChecklistRunDtoMapper interface {
+	ToDTO(run domain.ChecklistRun, includeSteps bool) ChecklistRunResponse
+	ToDTOArray(runs []domain.ChecklistRun) []ChecklistRunResponse
+	ToStepDTO(step domain.ChecklistRunStep) ChecklistRunStepResponse
+	ToStepRowDTO(row domain.ChecklistRunStepRow) ChecklistRunStepRowResponse
+}
+
+type checklistRunDtoMapper struct{}
+
+func NewChecklistRunDtoMapper() IChecklistRunDtoMapper {
+	return &checklistRunDtoMapper{}
+}
+
+func (m *checklistRunDtoMapper) ToDTO(run domain.ChecklistRun, includeSteps bool) ChecklistRunResponse {
+	response := ChecklistRunResponse{
+		Id:                   run.Id,
+		ChecklistId:          run.ChecklistId,
+		StartedBy:            run.StartedBy,
+		StartedAt:            run.StartedAt,
+		FinishedBy:           run.FinishedBy,
+		FinishedAt:           run.FinishedAt,
+		DurationSeconds:      run.DurationSeconds,
+		CompletionPercentage: run.CompletionPercentage,
+	}
+
+	if includeSteps {
+		steps := make([]ChecklistRunStepResponse, 0, len(run.Steps))
+		for _, step := range run.Steps {
+			steps = append(steps, m.ToStepDTO(step))
+		}
+		response.Steps = &steps
+	}
+	return response
+}
+
+func (m *checklistRunDtoMapper) ToDTOArray(runs []domain.ChecklistRun) []ChecklistRunResponse {
+	responses := make([]ChecklistRunResponse, 0, len(runs))
+	for _, run := range runs {
+		responses = append(responses, m.ToDTO(run, false))
+	}
+	return responses
+}
+
+func (m *checklistRunDtoMapper) ToStepDTO(step domain.ChecklistRunStep) ChecklistRunStepResponse {
+	rows := make([]ChecklistRunStepRowResponse, 0, len(step.Rows))
+	for _, row := range step.Rows {
+		rows = append(rows, m.ToStepRowDTO(row))
+	}
+	return ChecklistRunStepResponse{
+		Id:           step.Id,
+		SourceItemId: step.SourceItemId,
+		Name:         step.Name,
+		OrderNumber:  step.OrderNumber,
+		Status:       ChecklistRunStepStatus(step.Status),
+		CheckedBy:    step.CheckedBy,
+		CheckedAt:    step.CheckedAt,
+		Rows:         rows,
+	}
+}
+
+func (m *checklistRunDtoMapper) ToStepRowDTO(row domain.ChecklistRunStepRow) ChecklistRunStepRowResponse {
+	return ChecklistRunStepRowResponse{
+		Id:          row.Id,
+		SourceRowId: row.SourceRowId,
+		Name:        row.Name,
+		Status:      ChecklistRunStepStatus(row.Status),
+		CheckedBy:   row.CheckedBy,
+		CheckedAt:   row.CheckedAt,
+	}
+}
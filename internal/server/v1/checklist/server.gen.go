@@ -19,25 +19,133 @@ const (
 	CookieAuthScopes = "CookieAuth.Scopes"
 )
 
+// Defines values for ChecklistActivityAction.
+const (
+	CHECKLISTCREATED ChecklistActivityAction = "CHECKLIST_CREATED"
+	CHECKLISTMERGED  ChecklistActivityAction = "CHECKLIST_MERGED"
+	CHECKLISTRENAMED ChecklistActivityAction = "CHECKLIST_RENAMED"
+	CHECKLISTSPLIT   ChecklistActivityAction = "CHECKLIST_SPLIT"
+	ITEMCOPIED       ChecklistActivityAction = "ITEM_COPIED"
+	ITEMCREATED      ChecklistActivityAction = "ITEM_CREATED"
+	ITEMDELETED      ChecklistActivityAction = "ITEM_DELETED"
+	ITEMMOVEDIN      ChecklistActivityAction = "ITEM_MOVED_IN"
+	ITEMMOVEDOUT     ChecklistActivityAction = "ITEM_MOVED_OUT"
+	ITEMREORDERED    ChecklistActivityAction = "ITEM_REORDERED"
+	ITEMRESTORED     ChecklistActivityAction = "ITEM_RESTORED"
+	ITEMTOGGLED      ChecklistActivityAction = "ITEM_TOGGLED"
+	ITEMUPDATED      ChecklistActivityAction = "ITEM_UPDATED"
+	ROWADDED         ChecklistActivityAction = "ROW_ADDED"
+	ROWDELETED       ChecklistActivityAction = "ROW_DELETED"
+	SHAREADDED       ChecklistActivityAction = "SHARE_ADDED"
+	SHAREREMOVED     ChecklistActivityAction = "SHARE_REMOVED"
+)
+
+// Defines values for ChecklistItemChangeField.
+const (
+	ChecklistItemChangeFieldCOMPLETED ChecklistItemChangeField = "COMPLETED"
+	ChecklistItemChangeFieldNAME      ChecklistItemChangeField = "NAME"
+	ChecklistItemChangeFieldORDER     ChecklistItemChangeField = "ORDER"
+	ChecklistItemChangeFieldROWS      ChecklistItemChangeField = "ROWS"
+)
+
+// Defines values for ChecklistOrderingMode.
+const (
+	KEEPINPLACE   ChecklistOrderingMode = "KEEP_IN_PLACE"
+	SINKCOMPLETED ChecklistOrderingMode = "SINK_COMPLETED"
+)
+
+// Defines values for ChecklistRunStepStatus.
+const (
+	ChecklistRunStepStatusCOMPLETED     ChecklistRunStepStatus = "COMPLETED"
+	ChecklistRunStepStatusNOTAPPLICABLE ChecklistRunStepStatus = "NOT_APPLICABLE"
+	ChecklistRunStepStatusPENDING       ChecklistRunStepStatus = "PENDING"
+)
+
+// ChecklistActivityAction defines model for ChecklistActivityAction.
+type ChecklistActivityAction string
+
+// ChecklistActivityPageResponse defines model for ChecklistActivityPageResponse.
+type ChecklistActivityPageResponse struct {
+	Entries []ChecklistActivityResponse `json:"entries"`
+
+	// NextCursor Pass as `before` to fetch the next page (null when there are no more entries)
+	NextCursor *uint `json:"nextCursor"`
+}
+
+// ChecklistActivityResponse defines model for ChecklistActivityResponse.
+type ChecklistActivityResponse struct {
+	Action ChecklistActivityAction `json:"action"`
+
+	// Actor User who performed the change
+	Actor string `json:"actor"`
+
+	// After Summary of the state after the change
+	After *string `json:"after"`
+
+	// Before Summary of the state before the change
+	Before      *string   `json:"before"`
+	ChecklistId uint      `json:"checklistId"`
+	CreatedAt   time.Time `json:"createdAt"`
+	Id          uint      `json:"id"`
+
+	// ItemId Item the entry refers to (null for checklist level entries)
+	ItemId *uint `json:"itemId"`
+}
+
+// ChecklistItemChangeField defines model for ChecklistItemChangeField.
+type ChecklistItemChangeField string
+
+// ChecklistItemChangeResponse defines model for ChecklistItemChangeResponse.
+type ChecklistItemChangeResponse struct {
+	After  ChecklistItemResponse      `json:"after"`
+	Before ChecklistItemResponse      `json:"before"`
+	Fields []ChecklistItemChangeField `json:"fields"`
+	ItemId uint                       `json:"itemId"`
+}
+
 // ChecklistItemResponse defines model for ChecklistItemResponse.
 type ChecklistItemResponse struct {
-	Completed   bool                       `json:"completed"`
-	Id          uint                       `json:"id"`
-	Name        string                     `json:"name"`
+	Completed bool `json:"completed"`
+
+	// CompletedAt When the item was marked as completed (null when not completed)
+	CompletedAt *time.Time `json:"completedAt"`
+
+	// CompletedBy User who marked the item as completed (null when not completed)
+	CompletedBy *string `json:"completedBy"`
+	Id          uint    `json:"id"`
+	Name        string  `json:"name"`
+
+	// Notes Markdown notes of the item (null when the item has no notes)
+	Notes       *string                    `json:"notes"`
 	OrderNumber uint                       `json:"orderNumber"`
 	Rows        []ChecklistItemRowResponse `json:"rows"`
+
+	// SectionId Section the item belongs to (null when it belongs to no section). Order numbers count within the section.
+	SectionId *uint `json:"sectionId"`
 }
 
 // ChecklistItemRowResponse defines model for ChecklistItemRowResponse.
 type ChecklistItemRowResponse struct {
-	Completed *bool  `json:"completed"`
-	Id        uint   `json:"id"`
-	Name      string `json:"name"`
+	Completed *bool `json:"completed"`
+
+	// CompletedAt When the row was marked as completed (null when not completed)
+	CompletedAt *time.Time `json:"completedAt"`
+
+	// CompletedBy User who marked the row as completed (null when not completed)
+	CompletedBy *string `json:"completedBy"`
+	Id          uint    `json:"id"`
+	Name        string  `json:"name"`
 }
 
+// ChecklistOrderingMode SINK_COMPLETED moves completed items below the open ones and reopened items back to the end of the open section.
+// KEEP_IN_PLACE leaves items where they are, for procedural lists where the order must not change.
+type ChecklistOrderingMode string
+
 // ChecklistResponse defines model for ChecklistResponse.
 type ChecklistResponse struct {
-	Id uint `json:"id"`
+	// ArchivedAt Set when the checklist was merged into another checklist. Archived checklists are read-only.
+	ArchivedAt *time.Time `json:"archivedAt,omitempty"`
+	Id         uint       `json:"id"`
 
 	// IsOwner Whether the current user is the owner
 	IsOwner bool `json:"isOwner"`
@@ -49,6 +157,10 @@ type ChecklistResponse struct {
 	Items *[]ChecklistItemResponse `json:"items,omitempty"`
 	Name  string                   `json:"name"`
 
+	// OrderingMode SINK_COMPLETED moves completed items below the open ones and reopened items back to the end of the open section.
+	// KEEP_IN_PLACE leaves items where they are, for procedural lists where the order must not change.
+	OrderingMode ChecklistOrderingMode `json:"orderingMode"`
+
 	// Owner User ID of the checklist owner
 	Owner string `json:"owner"`
 
@@ -65,6 +177,78 @@ type ChecklistResponse struct {
 	} `json:"stats"`
 }
 
+// ChecklistRunResponse defines model for ChecklistRunResponse.
+type ChecklistRunResponse struct {
+	ChecklistId uint `json:"checklistId"`
+
+	// CompletionPercentage Percentage of applicable steps completed, set when the run is finished
+	CompletionPercentage *float64 `json:"completionPercentage"`
+
+	// DurationSeconds Time between start and finish, set when the run is finished
+	DurationSeconds *int64     `json:"durationSeconds"`
+	FinishedAt      *time.Time `json:"finishedAt"`
+	FinishedBy      *string    `json:"finishedBy"`
+	Id              uint       `json:"id"`
+	StartedAt       time.Time  `json:"startedAt"`
+	StartedBy       string     `json:"startedBy"`
+
+	// Steps Only included when fetching a single run
+	Steps *[]ChecklistRunStepResponse `json:"steps,omitempty"`
+}
+
+// ChecklistRunStepResponse defines model for ChecklistRunStepResponse.
+type ChecklistRunStepResponse struct {
+	CheckedAt   *time.Time                    `json:"checkedAt"`
+	CheckedBy   *string                       `json:"checkedBy"`
+	Id          uint                          `json:"id"`
+	Name        string                        `json:"name"`
+	OrderNumber uint                          `json:"orderNumber"`
+	Rows        []ChecklistRunStepRowResponse `json:"rows"`
+
+	// SourceItemId Checklist item this step was copied from
+	SourceItemId *uint `json:"sourceItemId"`
+
+	// Status PENDING until checked. NOT_APPLICABLE steps are excluded from the completion percentage.
+	Status ChecklistRunStepStatus `json:"status"`
+}
+
+// ChecklistRunStepRowResponse defines model for ChecklistRunStepRowResponse.
+type ChecklistRunStepRowResponse struct {
+	CheckedAt *time.Time `json:"checkedAt"`
+	CheckedBy *string    `json:"checkedBy"`
+	Id        uint       `json:"id"`
+	Name      string     `json:"name"`
+
+	// SourceRowId Checklist item row this row was copied from
+	SourceRowId *uint `json:"sourceRowId"`
+
+	// Status PENDING until checked. NOT_APPLICABLE steps are excluded from the completion percentage.
+	Status ChecklistRunStepStatus `json:"status"`
+}
+
+// ChecklistRunStepStatus PENDING until checked. NOT_APPLICABLE steps are excluded from the completion percentage.
+type ChecklistRunStepStatus string
+
+// ChecklistStateDiffResponse defines model for ChecklistStateDiffResponse.
+type ChecklistStateDiffResponse struct {
+	Added       []ChecklistItemResponse       `json:"added"`
+	Changed     []ChecklistItemChangeResponse `json:"changed"`
+	ChecklistId uint                          `json:"checklistId"`
+	From        time.Time                     `json:"from"`
+	Removed     []ChecklistItemResponse       `json:"removed"`
+	To          time.Time                     `json:"to"`
+}
+
+// ChecklistStateResponse defines model for ChecklistStateResponse.
+type ChecklistStateResponse struct {
+	AsOf        time.Time               `json:"asOf"`
+	ChecklistId uint                    `json:"checklistId"`
+	Items       []ChecklistItemResponse `json:"items"`
+
+	// RecordedAt When the change that produced this state was recorded (null when there is no history before asOf)
+	RecordedAt *time.Time `json:"recordedAt"`
+}
+
 // ChecklistWithStats defines model for ChecklistWithStats.
 type ChecklistWithStats struct {
 	Id uint `json:"id"`
@@ -79,6 +263,10 @@ type ChecklistWithStats struct {
 	// NumberOfSharedUsers Number of users this checklist is shared with (only included for owners)
 	NumberOfSharedUsers *float32 `json:"numberOfSharedUsers,omitempty"`
 
+	// OrderingMode SINK_COMPLETED moves completed items below the open ones and reopened items back to the end of the open section.
+	// KEEP_IN_PLACE leaves items where they are, for procedural lists where the order must not change.
+	OrderingMode ChecklistOrderingMode `json:"orderingMode"`
+
 	// Stats Statistics about checklist items
 	Stats struct {
 		// CompletedItems Number of completed items
@@ -98,11 +286,29 @@ type ClaimInviteResponse struct {
 	Message     *string `json:"message,omitempty"`
 }
 
+// CloneChecklistRequest defines model for CloneChecklistRequest.
+type CloneChecklistRequest struct {
+	// IncludeShares Share the new checklist with the same users as the source checklist. Only the owner of the source checklist may set it.
+	IncludeShares *bool `json:"includeShares,omitempty"`
+
+	// Name Name of the new checklist (null = source name with a " (copy)" suffix)
+	Name *string `json:"name"`
+
+	// ResetCompletion Create all items and rows as not completed
+	ResetCompletion *bool `json:"resetCompletion,omitempty"`
+
+	// WorkspaceId Workspace the new checklist belongs to (null = no workspace)
+	WorkspaceId *uint `json:"workspaceId"`
+}
+
 // CreateChecklistRequest defines model for CreateChecklistRequest.
 type CreateChecklistRequest struct {
 	// Name Checklist name (1-200 characters)
 	Name string `json:"name"`
 
+	// OrderingMode Where items go when their completion is toggled (null = SINK_COMPLETED on create, unchanged on update)
+	OrderingMode *ChecklistOrderingMode `json:"orderingMode"`
+
 	// WorkspaceId Optional workspace this checklist belongs to
 	WorkspaceId *int `json:"workspaceId"`
 }
@@ -155,6 +361,35 @@ type InviteResponse struct {
 	Name *string `json:"name"`
 }
 
+// MergeChecklistRequest defines model for MergeChecklistRequest.
+type MergeChecklistRequest struct {
+	// SourceChecklistId Checklist whose items are merged in; it is archived afterwards
+	SourceChecklistId uint `json:"sourceChecklistId"`
+}
+
+// MergeChecklistResponse defines model for MergeChecklistResponse.
+type MergeChecklistResponse struct {
+	// Items Final state of the items that were moved in or combined with an existing item
+	Items             []ChecklistItemResponse `json:"items"`
+	SourceChecklistId uint                    `json:"sourceChecklistId"`
+	TargetChecklistId uint                    `json:"targetChecklistId"`
+}
+
+// SplitChecklistRequest defines model for SplitChecklistRequest.
+type SplitChecklistRequest struct {
+	// ItemIds Items moved into the new checklist
+	ItemIds []uint `json:"itemIds"`
+
+	// Name Name of the new checklist
+	Name string `json:"name"`
+}
+
+// UpdateChecklistRunStepRequest defines model for UpdateChecklistRunStepRequest.
+type UpdateChecklistRunStepRequest struct {
+	// Status PENDING until checked. NOT_APPLICABLE steps are excluded from the completion percentage.
+	Status ChecklistRunStepStatus `json:"status"`
+}
+
 // XClientId defines model for X-Client-Id.
 type XClientId = string
 
@@ -197,6 +432,45 @@ type UpdateChecklistByIdParams struct {
 	XClientId *XClientId `json:"X-Client-Id,omitempty"`
 }
 
+// GetChecklistActivityParams defines parameters for GetChecklistActivity.
+type GetChecklistActivityParams struct {
+	// Before Only return entries older than this entry id
+	Before *uint `form:"before,omitempty" json:"before,omitempty"`
+
+	// Limit Maximum number of entries to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// XClientId Client identifier sent by frontend in headers
+	XClientId *XClientId `json:"X-Client-Id,omitempty"`
+}
+
+// CloneChecklistParams defines parameters for CloneChecklist.
+type CloneChecklistParams struct {
+	// XClientId Client identifier sent by frontend in headers
+	XClientId *XClientId `json:"X-Client-Id,omitempty"`
+}
+
+// GetChecklistStateAsOfParams defines parameters for GetChecklistStateAsOf.
+type GetChecklistStateAsOfParams struct {
+	// AsOf Point in time to show the checklist at
+	AsOf time.Time `form:"asOf" json:"asOf"`
+
+	// XClientId Client identifier sent by frontend in headers
+	XClientId *XClientId `json:"X-Client-Id,omitempty"`
+}
+
+// GetChecklistStateDiffParams defines parameters for GetChecklistStateDiff.
+type GetChecklistStateDiffParams struct {
+	// From Earlier point in time
+	From time.Time `form:"from" json:"from"`
+
+	// To Later point in time
+	To time.Time `form:"to" json:"to"`
+
+	// XClientId Client identifier sent by frontend in headers
+	XClientId *XClientId `json:"X-Client-Id,omitempty"`
+}
+
 // GetChecklistInvitesParams defines parameters for GetChecklistInvites.
 type GetChecklistInvitesParams struct {
 	// XClientId Client identifier sent by frontend in headers
@@ -215,6 +489,54 @@ type LeaveSharedChecklistParams struct {
 	XClientId *XClientId `json:"X-Client-Id,omitempty"`
 }
 
+// MergeChecklistParams defines parameters for MergeChecklist.
+type MergeChecklistParams struct {
+	// XClientId Client identifier sent by frontend in headers
+	XClientId *XClientId `json:"X-Client-Id,omitempty"`
+}
+
+// GetChecklistRunsParams defines parameters for GetChecklistRuns.
+type GetChecklistRunsParams struct {
+	// XClientId Client identifier sent by frontend in headers
+	XClientId *XClientId `json:"X-Client-Id,omitempty"`
+}
+
+// StartChecklistRunParams defines parameters for StartChecklistRun.
+type StartChecklistRunParams struct {
+	// XClientId Client identifier sent by frontend in headers
+	XClientId *XClientId `json:"X-Client-Id,omitempty"`
+}
+
+// GetChecklistRunByIdParams defines parameters for GetChecklistRunById.
+type GetChecklistRunByIdParams struct {
+	// XClientId Client identifier sent by frontend in headers
+	XClientId *XClientId `json:"X-Client-Id,omitempty"`
+}
+
+// FinishChecklistRunParams defines parameters for FinishChecklistRun.
+type FinishChecklistRunParams struct {
+	// XClientId Client identifier sent by frontend in headers
+	XClientId *XClientId `json:"X-Client-Id,omitempty"`
+}
+
+// UpdateChecklistRunStepParams defines parameters for UpdateChecklistRunStep.
+type UpdateChecklistRunStepParams struct {
+	// XClientId Client identifier sent by frontend in headers
+	XClientId *XClientId `json:"X-Client-Id,omitempty"`
+}
+
+// UpdateChecklistRunStepRowParams defines parameters for UpdateChecklistRunStepRow.
+type UpdateChecklistRunStepRowParams struct {
+	// XClientId Client identifier sent by frontend in headers
+	XClientId *XClientId `json:"X-Client-Id,omitempty"`
+}
+
+// SplitChecklistParams defines parameters for SplitChecklist.
+type SplitChecklistParams struct {
+	// XClientId Client identifier sent by frontend in headers
+	XClientId *XClientId `json:"X-Client-Id,omitempty"`
+}
+
 // ClaimInviteParams defines parameters for ClaimInvite.
 type ClaimInviteParams struct {
 	// XClientId Client identifier sent by frontend in headers
@@ -227,9 +549,24 @@ type CreateChecklistJSONRequestBody = CreateChecklistRequest
 // UpdateChecklistByIdJSONRequestBody defines body for UpdateChecklistById for application/json ContentType.
 type UpdateChecklistByIdJSONRequestBody = CreateChecklistRequest
 
+// CloneChecklistJSONRequestBody defines body for CloneChecklist for application/json ContentType.
+type CloneChecklistJSONRequestBody = CloneChecklistRequest
+
 // CreateChecklistInviteJSONRequestBody defines body for CreateChecklistInvite for application/json ContentType.
 type CreateChecklistInviteJSONRequestBody = CreateInviteRequest
 
+// MergeChecklistJSONRequestBody defines body for MergeChecklist for application/json ContentType.
+type MergeChecklistJSONRequestBody = MergeChecklistRequest
+
+// UpdateChecklistRunStepJSONRequestBody defines body for UpdateChecklistRunStep for application/json ContentType.
+type UpdateChecklistRunStepJSONRequestBody = UpdateChecklistRunStepRequest
+
+// UpdateChecklistRunStepRowJSONRequestBody defines body for UpdateChecklistRunStepRow for application/json ContentType.
+type UpdateChecklistRunStepRowJSONRequestBody = UpdateChecklistRunStepRequest
+
+// SplitChecklistJSONRequestBody defines body for SplitChecklist for application/json ContentType.
+type SplitChecklistJSONRequestBody = SplitChecklistRequest
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 	// Get all checklists
@@ -250,6 +587,18 @@ type ServerInterface interface {
 	// Update checklist by ID
 	// (PUT /api/v1/checklists/{checklistId})
 	UpdateChecklistById(c *gin.Context, checklistId uint, params UpdateChecklistByIdParams)
+	// Get the activity log of a checklist
+	// (GET /api/v1/checklists/{checklistId}/activity)
+	GetChecklistActivity(c *gin.Context, checklistId uint, params GetChecklistActivityParams)
+	// Duplicate a checklist
+	// (POST /api/v1/checklists/{checklistId}/clone)
+	CloneChecklist(c *gin.Context, checklistId uint, params CloneChecklistParams)
+	// Get the state of a checklist as of a point in time
+	// (GET /api/v1/checklists/{checklistId}/history)
+	GetChecklistStateAsOf(c *gin.Context, checklistId uint, params GetChecklistStateAsOfParams)
+	// Compare the state of a checklist between two points in time
+	// (GET /api/v1/checklists/{checklistId}/history/diff)
+	GetChecklistStateDiff(c *gin.Context, checklistId uint, params GetChecklistStateDiffParams)
 	// List active invite links for a checklist
 	// (GET /api/v1/checklists/{checklistId}/invites)
 	GetChecklistInvites(c *gin.Context, checklistId uint, params GetChecklistInvitesParams)
@@ -259,6 +608,30 @@ type ServerInterface interface {
 	// Leave a shared checklist
 	// (POST /api/v1/checklists/{checklistId}/leave)
 	LeaveSharedChecklist(c *gin.Context, checklistId uint, params LeaveSharedChecklistParams)
+	// Merge another checklist into this one
+	// (POST /api/v1/checklists/{checklistId}/merge)
+	MergeChecklist(c *gin.Context, checklistId uint, params MergeChecklistParams)
+	// List past and ongoing runs of a checklist
+	// (GET /api/v1/checklists/{checklistId}/runs)
+	GetChecklistRuns(c *gin.Context, checklistId uint, params GetChecklistRunsParams)
+	// Start a new run of a checklist
+	// (POST /api/v1/checklists/{checklistId}/runs)
+	StartChecklistRun(c *gin.Context, checklistId uint, params StartChecklistRunParams)
+	// Get a run with all of its steps
+	// (GET /api/v1/checklists/{checklistId}/runs/{runId})
+	GetChecklistRunById(c *gin.Context, checklistId uint, runId uint, params GetChecklistRunByIdParams)
+	// Finish a run
+	// (POST /api/v1/checklists/{checklistId}/runs/{runId}/finish)
+	FinishChecklistRun(c *gin.Context, checklistId uint, runId uint, params FinishChecklistRunParams)
+	// Update the status of a run step
+	// (PATCH /api/v1/checklists/{checklistId}/runs/{runId}/steps/{stepId})
+	UpdateChecklistRunStep(c *gin.Context, checklistId uint, runId uint, stepId uint, params UpdateChecklistRunStepParams)
+	// Update the status of a row within a run step
+	// (PATCH /api/v1/checklists/{checklistId}/runs/{runId}/steps/{stepId}/rows/{rowId})
+	UpdateChecklistRunStepRow(c *gin.Context, checklistId uint, runId uint, stepId uint, rowId uint, params UpdateChecklistRunStepRowParams)
+	// Split items out into a new checklist
+	// (POST /api/v1/checklists/{checklistId}/split)
+	SplitChecklist(c *gin.Context, checklistId uint, params SplitChecklistParams)
 	// Claim an invite to gain access to a checklist
 	// (POST /api/v1/invites/{token}/claim)
 	ClaimInvite(c *gin.Context, token string, params ClaimInviteParams)
@@ -555,8 +928,8 @@ func (siw *ServerInterfaceWrapper) UpdateChecklistById(c *gin.Context) {
 	siw.Handler.UpdateChecklistById(c, checklistId, params)
 }
 
-// GetChecklistInvites operation middleware
-func (siw *ServerInterfaceWrapper) GetChecklistInvites(c *gin.Context) {
+// GetChecklistActivity operation middleware
+func (siw *ServerInterfaceWrapper) GetChecklistActivity(c *gin.Context) {
 
 	var err error
 
@@ -572,7 +945,23 @@ func (siw *ServerInterfaceWrapper) GetChecklistInvites(c *gin.Context) {
 	c.Set(CookieAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetChecklistInvitesParams
+	var params GetChecklistActivityParams
+
+	// ------------- Optional query parameter "before" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "before", c.Request.URL.Query(), &params.Before)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter before: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	headers := c.Request.Header
 
@@ -602,11 +991,11 @@ func (siw *ServerInterfaceWrapper) GetChecklistInvites(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetChecklistInvites(c, checklistId, params)
+	siw.Handler.GetChecklistActivity(c, checklistId, params)
 }
 
-// CreateChecklistInvite operation middleware
-func (siw *ServerInterfaceWrapper) CreateChecklistInvite(c *gin.Context) {
+// CloneChecklist operation middleware
+func (siw *ServerInterfaceWrapper) CloneChecklist(c *gin.Context) {
 
 	var err error
 
@@ -622,7 +1011,7 @@ func (siw *ServerInterfaceWrapper) CreateChecklistInvite(c *gin.Context) {
 	c.Set(CookieAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params CreateChecklistInviteParams
+	var params CloneChecklistParams
 
 	headers := c.Request.Header
 
@@ -652,11 +1041,11 @@ func (siw *ServerInterfaceWrapper) CreateChecklistInvite(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.CreateChecklistInvite(c, checklistId, params)
+	siw.Handler.CloneChecklist(c, checklistId, params)
 }
 
-// LeaveSharedChecklist operation middleware
-func (siw *ServerInterfaceWrapper) LeaveSharedChecklist(c *gin.Context) {
+// GetChecklistStateAsOf operation middleware
+func (siw *ServerInterfaceWrapper) GetChecklistStateAsOf(c *gin.Context) {
 
 	var err error
 
@@ -672,7 +1061,22 @@ func (siw *ServerInterfaceWrapper) LeaveSharedChecklist(c *gin.Context) {
 	c.Set(CookieAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params LeaveSharedChecklistParams
+	var params GetChecklistStateAsOfParams
+
+	// ------------- Required query parameter "asOf" -------------
+
+	if paramValue := c.Query("asOf"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument asOf is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "asOf", c.Request.URL.Query(), &params.AsOf)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter asOf: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	headers := c.Request.Header
 
@@ -702,27 +1106,57 @@ func (siw *ServerInterfaceWrapper) LeaveSharedChecklist(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.LeaveSharedChecklist(c, checklistId, params)
+	siw.Handler.GetChecklistStateAsOf(c, checklistId, params)
 }
 
-// ClaimInvite operation middleware
-func (siw *ServerInterfaceWrapper) ClaimInvite(c *gin.Context) {
+// GetChecklistStateDiff operation middleware
+func (siw *ServerInterfaceWrapper) GetChecklistStateDiff(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "token" -------------
-	var token string
+	// ------------- Path parameter "checklistId" -------------
+	var checklistId uint
 
-	err = runtime.BindStyledParameterWithOptions("simple", "token", c.Param("token"), &token, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "checklistId", c.Param("checklistId"), &checklistId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter token: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter checklistId: %w", err), http.StatusBadRequest)
 		return
 	}
 
 	c.Set(CookieAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ClaimInviteParams
+	var params GetChecklistStateDiffParams
+
+	// ------------- Required query parameter "from" -------------
+
+	if paramValue := c.Query("from"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument from is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required query parameter "to" -------------
+
+	if paramValue := c.Query("to"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument to is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	headers := c.Request.Header
 
@@ -752,551 +1186,2172 @@ func (siw *ServerInterfaceWrapper) ClaimInvite(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ClaimInvite(c, token, params)
+	siw.Handler.GetChecklistStateDiff(c, checklistId, params)
 }
 
-// GinServerOptions provides options for the Gin server.
-type GinServerOptions struct {
-	BaseURL      string
-	Middlewares  []MiddlewareFunc
-	ErrorHandler func(*gin.Context, error, int)
-}
+// GetChecklistInvites operation middleware
+func (siw *ServerInterfaceWrapper) GetChecklistInvites(c *gin.Context) {
 
-// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
-func RegisterHandlers(router gin.IRouter, si ServerInterface) {
-	RegisterHandlersWithOptions(router, si, GinServerOptions{})
-}
+	var err error
 
-// RegisterHandlersWithOptions creates http.Handler with additional options
-func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
-	errorHandler := options.ErrorHandler
-	if errorHandler == nil {
-		errorHandler = func(c *gin.Context, err error, statusCode int) {
-			c.JSON(statusCode, gin.H{"msg": err.Error()})
-		}
-	}
+	// ------------- Path parameter "checklistId" -------------
+	var checklistId uint
 
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandler:       errorHandler,
+	err = runtime.BindStyledParameterWithOptions("simple", "checklistId", c.Param("checklistId"), &checklistId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter checklistId: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	router.GET(options.BaseURL+"/api/v1/checklists", wrapper.GetAllChecklists)
-	router.POST(options.BaseURL+"/api/v1/checklists", wrapper.CreateChecklist)
-	router.DELETE(options.BaseURL+"/api/v1/checklists/invites/:inviteId", wrapper.RevokeChecklistInvite)
-	router.DELETE(options.BaseURL+"/api/v1/checklists/:checklistId", wrapper.DeleteChecklistById)
-	router.GET(options.BaseURL+"/api/v1/checklists/:checklistId", wrapper.GetChecklistById)
-	router.PUT(options.BaseURL+"/api/v1/checklists/:checklistId", wrapper.UpdateChecklistById)
-	router.GET(options.BaseURL+"/api/v1/checklists/:checklistId/invites", wrapper.GetChecklistInvites)
-	router.POST(options.BaseURL+"/api/v1/checklists/:checklistId/invites", wrapper.CreateChecklistInvite)
-	router.POST(options.BaseURL+"/api/v1/checklists/:checklistId/leave", wrapper.LeaveSharedChecklist)
-	router.POST(options.BaseURL+"/api/v1/invites/:token/claim", wrapper.ClaimInvite)
-}
-
-type ErrorResponseJSONResponse Error
+	c.Set(CookieAuthScopes, []string{})
 
-type GetChecklistsWithStatsResponseJSONResponse GetChecklistsWithStatsResponse
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetChecklistInvitesParams
 
-type GetAllChecklistsRequestObject struct {
-	Params GetAllChecklistsParams
-}
+	headers := c.Request.Header
 
-type GetAllChecklistsResponseObject interface {
-	VisitGetAllChecklistsResponse(w http.ResponseWriter) error
-}
+	// ------------- Optional header parameter "X-Client-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Client-Id")]; found {
+		var XClientId XClientId
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Client-Id, got %d", n), http.StatusBadRequest)
+			return
+		}
 
-type GetAllChecklists200JSONResponse struct {
-	GetChecklistsWithStatsResponseJSONResponse
-}
-
-func (response GetAllChecklists200JSONResponse) VisitGetAllChecklistsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type GetAllChecklists400JSONResponse struct{ ErrorResponseJSONResponse }
-
-func (response GetAllChecklists400JSONResponse) VisitGetAllChecklistsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Client-Id", valueList[0], &XClientId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Client-Id: %w", err), http.StatusBadRequest)
+			return
+		}
 
-	return json.NewEncoder(w).Encode(response)
-}
+		params.XClientId = &XClientId
 
-type GetAllChecklists500JSONResponse Error
+	}
 
-func (response GetAllChecklists500JSONResponse) VisitGetAllChecklistsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.GetChecklistInvites(c, checklistId, params)
 }
 
-type CreateChecklistRequestObject struct {
-	Params CreateChecklistParams
-	Body   *CreateChecklistJSONRequestBody
-}
+// CreateChecklistInvite operation middleware
+func (siw *ServerInterfaceWrapper) CreateChecklistInvite(c *gin.Context) {
 
-type CreateChecklistResponseObject interface {
-	VisitCreateChecklistResponse(w http.ResponseWriter) error
-}
+	var err error
 
-type CreateChecklist201JSONResponse ChecklistResponse
+	// ------------- Path parameter "checklistId" -------------
+	var checklistId uint
 
-func (response CreateChecklist201JSONResponse) VisitCreateChecklistResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	err = runtime.BindStyledParameterWithOptions("simple", "checklistId", c.Param("checklistId"), &checklistId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter checklistId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	c.Set(CookieAuthScopes, []string{})
 
-type CreateChecklist400JSONResponse Error
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateChecklistInviteParams
 
-func (response CreateChecklist400JSONResponse) VisitCreateChecklistResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	headers := c.Request.Header
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional header parameter "X-Client-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Client-Id")]; found {
+		var XClientId XClientId
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Client-Id, got %d", n), http.StatusBadRequest)
+			return
+		}
 
-type CreateChecklist500JSONResponse Error
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Client-Id", valueList[0], &XClientId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Client-Id: %w", err), http.StatusBadRequest)
+			return
+		}
 
-func (response CreateChecklist500JSONResponse) VisitCreateChecklistResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+		params.XClientId = &XClientId
 
-	return json.NewEncoder(w).Encode(response)
-}
+	}
 
-type RevokeChecklistInviteRequestObject struct {
-	InviteId uint `json:"inviteId"`
-	Params   RevokeChecklistInviteParams
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type RevokeChecklistInviteResponseObject interface {
-	VisitRevokeChecklistInviteResponse(w http.ResponseWriter) error
+	siw.Handler.CreateChecklistInvite(c, checklistId, params)
 }
 
-type RevokeChecklistInvite204Response struct {
-}
+// LeaveSharedChecklist operation middleware
+func (siw *ServerInterfaceWrapper) LeaveSharedChecklist(c *gin.Context) {
 
-func (response RevokeChecklistInvite204Response) VisitRevokeChecklistInviteResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+	var err error
 
-type RevokeChecklistInvite403JSONResponse Error
+	// ------------- Path parameter "checklistId" -------------
+	var checklistId uint
 
-func (response RevokeChecklistInvite403JSONResponse) VisitRevokeChecklistInviteResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	err = runtime.BindStyledParameterWithOptions("simple", "checklistId", c.Param("checklistId"), &checklistId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter checklistId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	c.Set(CookieAuthScopes, []string{})
 
-type RevokeChecklistInvite404JSONResponse Error
+	// Parameter object where we will unmarshal all parameters from the context
+	var params LeaveSharedChecklistParams
 
-func (response RevokeChecklistInvite404JSONResponse) VisitRevokeChecklistInviteResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	headers := c.Request.Header
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional header parameter "X-Client-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Client-Id")]; found {
+		var XClientId XClientId
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Client-Id, got %d", n), http.StatusBadRequest)
+			return
+		}
 
-type RevokeChecklistInvite500JSONResponse Error
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Client-Id", valueList[0], &XClientId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Client-Id: %w", err), http.StatusBadRequest)
+			return
+		}
 
-func (response RevokeChecklistInvite500JSONResponse) VisitRevokeChecklistInviteResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+		params.XClientId = &XClientId
 
-	return json.NewEncoder(w).Encode(response)
-}
+	}
 
-type DeleteChecklistByIdRequestObject struct {
-	ChecklistId uint `json:"checklistId"`
-	Params      DeleteChecklistByIdParams
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type DeleteChecklistByIdResponseObject interface {
-	VisitDeleteChecklistByIdResponse(w http.ResponseWriter) error
+	siw.Handler.LeaveSharedChecklist(c, checklistId, params)
 }
 
-type DeleteChecklistById204JSONResponse ChecklistResponse
-
-func (response DeleteChecklistById204JSONResponse) VisitDeleteChecklistByIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(204)
+// MergeChecklist operation middleware
+func (siw *ServerInterfaceWrapper) MergeChecklist(c *gin.Context) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type DeleteChecklistById404JSONResponse Error
+	// ------------- Path parameter "checklistId" -------------
+	var checklistId uint
 
-func (response DeleteChecklistById404JSONResponse) VisitDeleteChecklistByIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	err = runtime.BindStyledParameterWithOptions("simple", "checklistId", c.Param("checklistId"), &checklistId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter checklistId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	c.Set(CookieAuthScopes, []string{})
 
-type DeleteChecklistById500JSONResponse Error
+	// Parameter object where we will unmarshal all parameters from the context
+	var params MergeChecklistParams
 
-func (response DeleteChecklistById500JSONResponse) VisitDeleteChecklistByIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	headers := c.Request.Header
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional header parameter "X-Client-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Client-Id")]; found {
+		var XClientId XClientId
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Client-Id, got %d", n), http.StatusBadRequest)
+			return
+		}
 
-type GetChecklistByIdRequestObject struct {
-	ChecklistId uint `json:"checklistId"`
-	Params      GetChecklistByIdParams
-}
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Client-Id", valueList[0], &XClientId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Client-Id: %w", err), http.StatusBadRequest)
+			return
+		}
 
-type GetChecklistByIdResponseObject interface {
-	VisitGetChecklistByIdResponse(w http.ResponseWriter) error
-}
+		params.XClientId = &XClientId
 
-type GetChecklistById200JSONResponse ChecklistResponse
+	}
 
-func (response GetChecklistById200JSONResponse) VisitGetChecklistByIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.MergeChecklist(c, checklistId, params)
 }
 
-type GetChecklistById400JSONResponse Error
+// GetChecklistRuns operation middleware
+func (siw *ServerInterfaceWrapper) GetChecklistRuns(c *gin.Context) {
 
-func (response GetChecklistById400JSONResponse) VisitGetChecklistByIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "checklistId" -------------
+	var checklistId uint
 
-type GetChecklistById404JSONResponse Error
+	err = runtime.BindStyledParameterWithOptions("simple", "checklistId", c.Param("checklistId"), &checklistId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter checklistId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response GetChecklistById404JSONResponse) VisitGetChecklistByIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	c.Set(CookieAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetChecklistRunsParams
 
-type GetChecklistById500JSONResponse Error
+	headers := c.Request.Header
 
-func (response GetChecklistById500JSONResponse) VisitGetChecklistByIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// ------------- Optional header parameter "X-Client-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Client-Id")]; found {
+		var XClientId XClientId
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Client-Id, got %d", n), http.StatusBadRequest)
+			return
+		}
 
-	return json.NewEncoder(w).Encode(response)
-}
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Client-Id", valueList[0], &XClientId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Client-Id: %w", err), http.StatusBadRequest)
+			return
+		}
 
-type UpdateChecklistByIdRequestObject struct {
-	ChecklistId uint `json:"checklistId"`
-	Params      UpdateChecklistByIdParams
-	Body        *UpdateChecklistByIdJSONRequestBody
-}
+		params.XClientId = &XClientId
 
-type UpdateChecklistByIdResponseObject interface {
-	VisitUpdateChecklistByIdResponse(w http.ResponseWriter) error
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetChecklistRuns(c, checklistId, params)
 }
 
-type UpdateChecklistById200JSONResponse ChecklistResponse
+// StartChecklistRun operation middleware
+func (siw *ServerInterfaceWrapper) StartChecklistRun(c *gin.Context) {
 
-func (response UpdateChecklistById200JSONResponse) VisitUpdateChecklistByIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "checklistId" -------------
+	var checklistId uint
 
-type UpdateChecklistById400JSONResponse Error
+	err = runtime.BindStyledParameterWithOptions("simple", "checklistId", c.Param("checklistId"), &checklistId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter checklistId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response UpdateChecklistById400JSONResponse) VisitUpdateChecklistByIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	c.Set(CookieAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StartChecklistRunParams
 
-type UpdateChecklistById404JSONResponse Error
+	headers := c.Request.Header
 
-func (response UpdateChecklistById404JSONResponse) VisitUpdateChecklistByIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	// ------------- Optional header parameter "X-Client-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Client-Id")]; found {
+		var XClientId XClientId
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Client-Id, got %d", n), http.StatusBadRequest)
+			return
+		}
 
-	return json.NewEncoder(w).Encode(response)
-}
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Client-Id", valueList[0], &XClientId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Client-Id: %w", err), http.StatusBadRequest)
+			return
+		}
 
-type UpdateChecklistById500JSONResponse Error
+		params.XClientId = &XClientId
 
-func (response UpdateChecklistById500JSONResponse) VisitUpdateChecklistByIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type GetChecklistInvitesRequestObject struct {
-	ChecklistId uint `json:"checklistId"`
-	Params      GetChecklistInvitesParams
+	siw.Handler.StartChecklistRun(c, checklistId, params)
 }
 
-type GetChecklistInvitesResponseObject interface {
-	VisitGetChecklistInvitesResponse(w http.ResponseWriter) error
-}
+// GetChecklistRunById operation middleware
+func (siw *ServerInterfaceWrapper) GetChecklistRunById(c *gin.Context) {
 
-type GetChecklistInvites200JSONResponse []InviteResponse
+	var err error
 
-func (response GetChecklistInvites200JSONResponse) VisitGetChecklistInvitesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Path parameter "checklistId" -------------
+	var checklistId uint
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "checklistId", c.Param("checklistId"), &checklistId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter checklistId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type GetChecklistInvites403JSONResponse Error
+	// ------------- Path parameter "runId" -------------
+	var runId uint
 
-func (response GetChecklistInvites403JSONResponse) VisitGetChecklistInvitesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	err = runtime.BindStyledParameterWithOptions("simple", "runId", c.Param("runId"), &runId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter runId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	c.Set(CookieAuthScopes, []string{})
 
-type GetChecklistInvites404JSONResponse Error
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetChecklistRunByIdParams
 
-func (response GetChecklistInvites404JSONResponse) VisitGetChecklistInvitesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	headers := c.Request.Header
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional header parameter "X-Client-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Client-Id")]; found {
+		var XClientId XClientId
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Client-Id, got %d", n), http.StatusBadRequest)
+			return
+		}
 
-type GetChecklistInvites500JSONResponse Error
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Client-Id", valueList[0], &XClientId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Client-Id: %w", err), http.StatusBadRequest)
+			return
+		}
 
-func (response GetChecklistInvites500JSONResponse) VisitGetChecklistInvitesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+		params.XClientId = &XClientId
 
-	return json.NewEncoder(w).Encode(response)
-}
+	}
 
-type CreateChecklistInviteRequestObject struct {
-	ChecklistId uint `json:"checklistId"`
-	Params      CreateChecklistInviteParams
-	Body        *CreateChecklistInviteJSONRequestBody
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type CreateChecklistInviteResponseObject interface {
-	VisitCreateChecklistInviteResponse(w http.ResponseWriter) error
+	siw.Handler.GetChecklistRunById(c, checklistId, runId, params)
 }
 
-type CreateChecklistInvite201JSONResponse InviteResponse
+// FinishChecklistRun operation middleware
+func (siw *ServerInterfaceWrapper) FinishChecklistRun(c *gin.Context) {
 
-func (response CreateChecklistInvite201JSONResponse) VisitCreateChecklistInviteResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "checklistId" -------------
+	var checklistId uint
 
-type CreateChecklistInvite403JSONResponse Error
+	err = runtime.BindStyledParameterWithOptions("simple", "checklistId", c.Param("checklistId"), &checklistId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter checklistId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response CreateChecklistInvite403JSONResponse) VisitCreateChecklistInviteResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	// ------------- Path parameter "runId" -------------
+	var runId uint
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "runId", c.Param("runId"), &runId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter runId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type CreateChecklistInvite404JSONResponse Error
+	c.Set(CookieAuthScopes, []string{})
 
-func (response CreateChecklistInvite404JSONResponse) VisitCreateChecklistInviteResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params FinishChecklistRunParams
 
-	return json.NewEncoder(w).Encode(response)
-}
+	headers := c.Request.Header
 
-type CreateChecklistInvite500JSONResponse Error
+	// ------------- Optional header parameter "X-Client-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Client-Id")]; found {
+		var XClientId XClientId
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Client-Id, got %d", n), http.StatusBadRequest)
+			return
+		}
 
-func (response CreateChecklistInvite500JSONResponse) VisitCreateChecklistInviteResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Client-Id", valueList[0], &XClientId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Client-Id: %w", err), http.StatusBadRequest)
+			return
+		}
 
-	return json.NewEncoder(w).Encode(response)
-}
+		params.XClientId = &XClientId
 
-type LeaveSharedChecklistRequestObject struct {
-	ChecklistId uint `json:"checklistId"`
-	Params      LeaveSharedChecklistParams
-}
+	}
 
-type LeaveSharedChecklistResponseObject interface {
-	VisitLeaveSharedChecklistResponse(w http.ResponseWriter) error
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type LeaveSharedChecklist204Response struct {
+	siw.Handler.FinishChecklistRun(c, checklistId, runId, params)
 }
 
-func (response LeaveSharedChecklist204Response) VisitLeaveSharedChecklistResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+// UpdateChecklistRunStep operation middleware
+func (siw *ServerInterfaceWrapper) UpdateChecklistRunStep(c *gin.Context) {
 
-type LeaveSharedChecklist400JSONResponse Error
+	var err error
 
-func (response LeaveSharedChecklist400JSONResponse) VisitLeaveSharedChecklistResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// ------------- Path parameter "checklistId" -------------
+	var checklistId uint
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "checklistId", c.Param("checklistId"), &checklistId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter checklistId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type LeaveSharedChecklist401JSONResponse Error
+	// ------------- Path parameter "runId" -------------
+	var runId uint
 
-func (response LeaveSharedChecklist401JSONResponse) VisitLeaveSharedChecklistResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	err = runtime.BindStyledParameterWithOptions("simple", "runId", c.Param("runId"), &runId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter runId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "stepId" -------------
+	var stepId uint
 
-type LeaveSharedChecklist404JSONResponse Error
+	err = runtime.BindStyledParameterWithOptions("simple", "stepId", c.Param("stepId"), &stepId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter stepId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response LeaveSharedChecklist404JSONResponse) VisitLeaveSharedChecklistResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	c.Set(CookieAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateChecklistRunStepParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "X-Client-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Client-Id")]; found {
+		var XClientId XClientId
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Client-Id, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Client-Id", valueList[0], &XClientId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Client-Id: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XClientId = &XClientId
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateChecklistRunStep(c, checklistId, runId, stepId, params)
 }
 
-type LeaveSharedChecklist500JSONResponse Error
+// UpdateChecklistRunStepRow operation middleware
+func (siw *ServerInterfaceWrapper) UpdateChecklistRunStepRow(c *gin.Context) {
 
-func (response LeaveSharedChecklist500JSONResponse) VisitLeaveSharedChecklistResponse(w http.ResponseWriter) error {
+	var err error
+
+	// ------------- Path parameter "checklistId" -------------
+	var checklistId uint
+
+	err = runtime.BindStyledParameterWithOptions("simple", "checklistId", c.Param("checklistId"), &checklistId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter checklistId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "runId" -------------
+	var runId uint
+
+	err = runtime.BindStyledParameterWithOptions("simple", "runId", c.Param("runId"), &runId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter runId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "stepId" -------------
+	var stepId uint
+
+	err = runtime.BindStyledParameterWithOptions("simple", "stepId", c.Param("stepId"), &stepId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter stepId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "rowId" -------------
+	var rowId uint
+
+	err = runtime.BindStyledParameterWithOptions("simple", "rowId", c.Param("rowId"), &rowId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter rowId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateChecklistRunStepRowParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "X-Client-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Client-Id")]; found {
+		var XClientId XClientId
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Client-Id, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Client-Id", valueList[0], &XClientId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Client-Id: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XClientId = &XClientId
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateChecklistRunStepRow(c, checklistId, runId, stepId, rowId, params)
+}
+
+// SplitChecklist operation middleware
+func (siw *ServerInterfaceWrapper) SplitChecklist(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "checklistId" -------------
+	var checklistId uint
+
+	err = runtime.BindStyledParameterWithOptions("simple", "checklistId", c.Param("checklistId"), &checklistId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter checklistId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SplitChecklistParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "X-Client-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Client-Id")]; found {
+		var XClientId XClientId
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Client-Id, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Client-Id", valueList[0], &XClientId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Client-Id: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XClientId = &XClientId
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.SplitChecklist(c, checklistId, params)
+}
+
+// ClaimInvite operation middleware
+func (siw *ServerInterfaceWrapper) ClaimInvite(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "token" -------------
+	var token string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "token", c.Param("token"), &token, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter token: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ClaimInviteParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "X-Client-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Client-Id")]; found {
+		var XClientId XClientId
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Client-Id, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Client-Id", valueList[0], &XClientId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Client-Id: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XClientId = &XClientId
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ClaimInvite(c, token, params)
+}
+
+// GinServerOptions provides options for the Gin server.
+type GinServerOptions struct {
+	BaseURL      string
+	Middlewares  []MiddlewareFunc
+	ErrorHandler func(*gin.Context, error, int)
+}
+
+// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
+func RegisterHandlers(router gin.IRouter, si ServerInterface) {
+	RegisterHandlersWithOptions(router, si, GinServerOptions{})
+}
+
+// RegisterHandlersWithOptions creates http.Handler with additional options
+func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
+	errorHandler := options.ErrorHandler
+	if errorHandler == nil {
+		errorHandler = func(c *gin.Context, err error, statusCode int) {
+			c.JSON(statusCode, gin.H{"msg": err.Error()})
+		}
+	}
+
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandler:       errorHandler,
+	}
+
+	router.GET(options.BaseURL+"/api/v1/checklists", wrapper.GetAllChecklists)
+	router.POST(options.BaseURL+"/api/v1/checklists", wrapper.CreateChecklist)
+	router.DELETE(options.BaseURL+"/api/v1/checklists/invites/:inviteId", wrapper.RevokeChecklistInvite)
+	router.DELETE(options.BaseURL+"/api/v1/checklists/:checklistId", wrapper.DeleteChecklistById)
+	router.GET(options.BaseURL+"/api/v1/checklists/:checklistId", wrapper.GetChecklistById)
+	router.PUT(options.BaseURL+"/api/v1/checklists/:checklistId", wrapper.UpdateChecklistById)
+	router.GET(options.BaseURL+"/api/v1/checklists/:checklistId/activity", wrapper.GetChecklistActivity)
+	router.POST(options.BaseURL+"/api/v1/checklists/:checklistId/clone", wrapper.CloneChecklist)
+	router.GET(options.BaseURL+"/api/v1/checklists/:checklistId/history", wrapper.GetChecklistStateAsOf)
+	router.GET(options.BaseURL+"/api/v1/checklists/:checklistId/history/diff", wrapper.GetChecklistStateDiff)
+	router.GET(options.BaseURL+"/api/v1/checklists/:checklistId/invites", wrapper.GetChecklistInvites)
+	router.POST(options.BaseURL+"/api/v1/checklists/:checklistId/invites", wrapper.CreateChecklistInvite)
+	router.POST(options.BaseURL+"/api/v1/checklists/:checklistId/leave", wrapper.LeaveSharedChecklist)
+	router.POST(options.BaseURL+"/api/v1/checklists/:checklistId/merge", wrapper.MergeChecklist)
+	router.GET(options.BaseURL+"/api/v1/checklists/:checklistId/runs", wrapper.GetChecklistRuns)
+	router.POST(options.BaseURL+"/api/v1/checklists/:checklistId/runs", wrapper.StartChecklistRun)
+	router.GET(options.BaseURL+"/api/v1/checklists/:checklistId/runs/:runId", wrapper.GetChecklistRunById)
+	router.POST(options.BaseURL+"/api/v1/checklists/:checklistId/runs/:runId/finish", wrapper.FinishChecklistRun)
+	router.PATCH(options.BaseURL+"/api/v1/checklists/:checklistId/runs/:runId/steps/:stepId", wrapper.UpdateChecklistRunStep)
+	router.PATCH(options.BaseURL+"/api/v1/checklists/:checklistId/runs/:runId/steps/:stepId/rows/:rowId", wrapper.UpdateChecklistRunStepRow)
+	router.POST(options.BaseURL+"/api/v1/checklists/:checklistId/split", wrapper.SplitChecklist)
+	router.POST(options.BaseURL+"/api/v1/invites/:token/claim", wrapper.ClaimInvite)
+}
+
+type ErrorResponseJSONResponse Error
+
+type GetChecklistsWithStatsResponseJSONResponse GetChecklistsWithStatsResponse
+
+type GetAllChecklistsRequestObject struct {
+	Params GetAllChecklistsParams
+}
+
+type GetAllChecklistsResponseObject interface {
+	VisitGetAllChecklistsResponse(w http.ResponseWriter) error
+}
+
+type GetAllChecklists200JSONResponse struct {
+	GetChecklistsWithStatsResponseJSONResponse
+}
+
+func (response GetAllChecklists200JSONResponse) VisitGetAllChecklistsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ClaimInviteRequestObject struct {
+type GetAllChecklists400JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response GetAllChecklists400JSONResponse) VisitGetAllChecklistsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAllChecklists500JSONResponse Error
+
+func (response GetAllChecklists500JSONResponse) VisitGetAllChecklistsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateChecklistRequestObject struct {
+	Params CreateChecklistParams
+	Body   *CreateChecklistJSONRequestBody
+}
+
+type CreateChecklistResponseObject interface {
+	VisitCreateChecklistResponse(w http.ResponseWriter) error
+}
+
+type CreateChecklist201JSONResponse ChecklistResponse
+
+func (response CreateChecklist201JSONResponse) VisitCreateChecklistResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateChecklist400JSONResponse Error
+
+func (response CreateChecklist400JSONResponse) VisitCreateChecklistResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateChecklist500JSONResponse Error
+
+func (response CreateChecklist500JSONResponse) VisitCreateChecklistResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeChecklistInviteRequestObject struct {
+	InviteId uint `json:"inviteId"`
+	Params   RevokeChecklistInviteParams
+}
+
+type RevokeChecklistInviteResponseObject interface {
+	VisitRevokeChecklistInviteResponse(w http.ResponseWriter) error
+}
+
+type RevokeChecklistInvite204Response struct {
+}
+
+func (response RevokeChecklistInvite204Response) VisitRevokeChecklistInviteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type RevokeChecklistInvite403JSONResponse Error
+
+func (response RevokeChecklistInvite403JSONResponse) VisitRevokeChecklistInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeChecklistInvite404JSONResponse Error
+
+func (response RevokeChecklistInvite404JSONResponse) VisitRevokeChecklistInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeChecklistInvite500JSONResponse Error
+
+func (response RevokeChecklistInvite500JSONResponse) VisitRevokeChecklistInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteChecklistByIdRequestObject struct {
+	ChecklistId uint `json:"checklistId"`
+	Params      DeleteChecklistByIdParams
+}
+
+type DeleteChecklistByIdResponseObject interface {
+	VisitDeleteChecklistByIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteChecklistById204JSONResponse ChecklistResponse
+
+func (response DeleteChecklistById204JSONResponse) VisitDeleteChecklistByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(204)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteChecklistById404JSONResponse Error
+
+func (response DeleteChecklistById404JSONResponse) VisitDeleteChecklistByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteChecklistById500JSONResponse Error
+
+func (response DeleteChecklistById500JSONResponse) VisitDeleteChecklistByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetChecklistByIdRequestObject struct {
+	ChecklistId uint `json:"checklistId"`
+	Params      GetChecklistByIdParams
+}
+
+type GetChecklistByIdResponseObject interface {
+	VisitGetChecklistByIdResponse(w http.ResponseWriter) error
+}
+
+type GetChecklistById200JSONResponse ChecklistResponse
+
+func (response GetChecklistById200JSONResponse) VisitGetChecklistByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetChecklistById400JSONResponse Error
+
+func (response GetChecklistById400JSONResponse) VisitGetChecklistByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetChecklistById404JSONResponse Error
+
+func (response GetChecklistById404JSONResponse) VisitGetChecklistByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetChecklistById500JSONResponse Error
+
+func (response GetChecklistById500JSONResponse) VisitGetChecklistByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateChecklistByIdRequestObject struct {
+	ChecklistId uint `json:"checklistId"`
+	Params      UpdateChecklistByIdParams
+	Body        *UpdateChecklistByIdJSONRequestBody
+}
+
+type UpdateChecklistByIdResponseObject interface {
+	VisitUpdateChecklistByIdResponse(w http.ResponseWriter) error
+}
+
+type UpdateChecklistById200JSONResponse ChecklistResponse
+
+func (response UpdateChecklistById200JSONResponse) VisitUpdateChecklistByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateChecklistById400JSONResponse Error
+
+func (response UpdateChecklistById400JSONResponse) VisitUpdateChecklistByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateChecklistById404JSONResponse Error
+
+func (response UpdateChecklistById404JSONResponse) VisitUpdateChecklistByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateChecklistById500JSONResponse Error
+
+func (response UpdateChecklistById500JSONResponse) VisitUpdateChecklistByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetChecklistActivityRequestObject struct {
+	ChecklistId uint `json:"checklistId"`
+	Params      GetChecklistActivityParams
+}
+
+type GetChecklistActivityResponseObject interface {
+	VisitGetChecklistActivityResponse(w http.ResponseWriter) error
+}
+
+type GetChecklistActivity200JSONResponse ChecklistActivityPageResponse
+
+func (response GetChecklistActivity200JSONResponse) VisitGetChecklistActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetChecklistActivity404JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response GetChecklistActivity404JSONResponse) VisitGetChecklistActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetChecklistActivity500JSONResponse Error
+
+func (response GetChecklistActivity500JSONResponse) VisitGetChecklistActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CloneChecklistRequestObject struct {
+	ChecklistId uint `json:"checklistId"`
+	Params      CloneChecklistParams
+	Body        *CloneChecklistJSONRequestBody
+}
+
+type CloneChecklistResponseObject interface {
+	VisitCloneChecklistResponse(w http.ResponseWriter) error
+}
+
+type CloneChecklist201JSONResponse ChecklistResponse
+
+func (response CloneChecklist201JSONResponse) VisitCloneChecklistResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CloneChecklist400JSONResponse Error
+
+func (response CloneChecklist400JSONResponse) VisitCloneChecklistResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CloneChecklist403JSONResponse Error
+
+func (response CloneChecklist403JSONResponse) VisitCloneChecklistResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CloneChecklist404JSONResponse Error
+
+func (response CloneChecklist404JSONResponse) VisitCloneChecklistResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CloneChecklist500JSONResponse Error
+
+func (response CloneChecklist500JSONResponse) VisitCloneChecklistResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetChecklistStateAsOfRequestObject struct {
+	ChecklistId uint `json:"checklistId"`
+	Params      GetChecklistStateAsOfParams
+}
+
+type GetChecklistStateAsOfResponseObject interface {
+	VisitGetChecklistStateAsOfResponse(w http.ResponseWriter) error
+}
+
+type GetChecklistStateAsOf200JSONResponse ChecklistStateResponse
+
+func (response GetChecklistStateAsOf200JSONResponse) VisitGetChecklistStateAsOfResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetChecklistStateAsOf404JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response GetChecklistStateAsOf404JSONResponse) VisitGetChecklistStateAsOfResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetChecklistStateAsOf500JSONResponse Error
+
+func (response GetChecklistStateAsOf500JSONResponse) VisitGetChecklistStateAsOfResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetChecklistStateDiffRequestObject struct {
+	ChecklistId uint `json:"checklistId"`
+	Params      GetChecklistStateDiffParams
+}
+
+type GetChecklistStateDiffResponseObject interface {
+	VisitGetChecklistStateDiffResponse(w http.ResponseWriter) error
+}
+
+type GetChecklistStateDiff200JSONResponse ChecklistStateDiffResponse
+
+func (response GetChecklistStateDiff200JSONResponse) VisitGetChecklistStateDiffResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetChecklistStateDiff400JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response GetChecklistStateDiff400JSONResponse) VisitGetChecklistStateDiffResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetChecklistStateDiff404JSONResponse Error
+
+func (response GetChecklistStateDiff404JSONResponse) VisitGetChecklistStateDiffResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetChecklistStateDiff500JSONResponse Error
+
+func (response GetChecklistStateDiff500JSONResponse) VisitGetChecklistStateDiffResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetChecklistInvitesRequestObject struct {
+	ChecklistId uint `json:"checklistId"`
+	Params      GetChecklistInvitesParams
+}
+
+type GetChecklistInvitesResponseObject interface {
+	VisitGetChecklistInvitesResponse(w http.ResponseWriter) error
+}
+
+type GetChecklistInvites200JSONResponse []InviteResponse
+
+func (response GetChecklistInvites200JSONResponse) VisitGetChecklistInvitesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetChecklistInvites403JSONResponse Error
+
+func (response GetChecklistInvites403JSONResponse) VisitGetChecklistInvitesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetChecklistInvites404JSONResponse Error
+
+func (response GetChecklistInvites404JSONResponse) VisitGetChecklistInvitesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetChecklistInvites500JSONResponse Error
+
+func (response GetChecklistInvites500JSONResponse) VisitGetChecklistInvitesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateChecklistInviteRequestObject struct {
+	ChecklistId uint `json:"checklistId"`
+	Params      CreateChecklistInviteParams
+	Body        *CreateChecklistInviteJSONRequestBody
+}
+
+type CreateChecklistInviteResponseObject interface {
+	VisitCreateChecklistInviteResponse(w http.ResponseWriter) error
+}
+
+type CreateChecklistInvite201JSONResponse InviteResponse
+
+func (response CreateChecklistInvite201JSONResponse) VisitCreateChecklistInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateChecklistInvite400JSONResponse Error
+
+func (response CreateChecklistInvite400JSONResponse) VisitCreateChecklistInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateChecklistInvite403JSONResponse Error
+
+func (response CreateChecklistInvite403JSONResponse) VisitCreateChecklistInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateChecklistInvite404JSONResponse Error
+
+func (response CreateChecklistInvite404JSONResponse) VisitCreateChecklistInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateChecklistInvite500JSONResponse Error
+
+func (response CreateChecklistInvite500JSONResponse) VisitCreateChecklistInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LeaveSharedChecklistRequestObject struct {
+	ChecklistId uint `json:"checklistId"`
+	Params      LeaveSharedChecklistParams
+}
+
+type LeaveSharedChecklistResponseObject interface {
+	VisitLeaveSharedChecklistResponse(w http.ResponseWriter) error
+}
+
+type LeaveSharedChecklist204Response struct {
+}
+
+func (response LeaveSharedChecklist204Response) VisitLeaveSharedChecklistResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type LeaveSharedChecklist400JSONResponse Error
+
+func (response LeaveSharedChecklist400JSONResponse) VisitLeaveSharedChecklistResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LeaveSharedChecklist401JSONResponse Error
+
+func (response LeaveSharedChecklist401JSONResponse) VisitLeaveSharedChecklistResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LeaveSharedChecklist404JSONResponse Error
+
+func (response LeaveSharedChecklist404JSONResponse) VisitLeaveSharedChecklistResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LeaveSharedChecklist500JSONResponse Error
+
+func (response LeaveSharedChecklist500JSONResponse) VisitLeaveSharedChecklistResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MergeChecklistRequestObject struct {
+	ChecklistId uint `json:"checklistId"`
+	Params      MergeChecklistParams
+	Body        *MergeChecklistJSONRequestBody
+}
+
+type MergeChecklistResponseObject interface {
+	VisitMergeChecklistResponse(w http.ResponseWriter) error
+}
+
+type MergeChecklist200JSONResponse MergeChecklistResponse
+
+func (response MergeChecklist200JSONResponse) VisitMergeChecklistResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MergeChecklist400JSONResponse Error
+
+func (response MergeChecklist400JSONResponse) VisitMergeChecklistResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MergeChecklist403JSONResponse Error
+
+func (response MergeChecklist403JSONResponse) VisitMergeChecklistResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MergeChecklist404JSONResponse Error
+
+func (response MergeChecklist404JSONResponse) VisitMergeChecklistResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MergeChecklist500JSONResponse Error
+
+func (response MergeChecklist500JSONResponse) VisitMergeChecklistResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetChecklistRunsRequestObject struct {
+	ChecklistId uint `json:"checklistId"`
+	Params      GetChecklistRunsParams
+}
+
+type GetChecklistRunsResponseObject interface {
+	VisitGetChecklistRunsResponse(w http.ResponseWriter) error
+}
+
+type GetChecklistRuns200JSONResponse []ChecklistRunResponse
+
+func (response GetChecklistRuns200JSONResponse) VisitGetChecklistRunsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetChecklistRuns404JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response GetChecklistRuns404JSONResponse) VisitGetChecklistRunsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetChecklistRuns500JSONResponse Error
+
+func (response GetChecklistRuns500JSONResponse) VisitGetChecklistRunsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartChecklistRunRequestObject struct {
+	ChecklistId uint `json:"checklistId"`
+	Params      StartChecklistRunParams
+}
+
+type StartChecklistRunResponseObject interface {
+	VisitStartChecklistRunResponse(w http.ResponseWriter) error
+}
+
+type StartChecklistRun201JSONResponse ChecklistRunResponse
+
+func (response StartChecklistRun201JSONResponse) VisitStartChecklistRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartChecklistRun404JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response StartChecklistRun404JSONResponse) VisitStartChecklistRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartChecklistRun500JSONResponse Error
+
+func (response StartChecklistRun500JSONResponse) VisitStartChecklistRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetChecklistRunByIdRequestObject struct {
+	ChecklistId uint `json:"checklistId"`
+	RunId       uint `json:"runId"`
+	Params      GetChecklistRunByIdParams
+}
+
+type GetChecklistRunByIdResponseObject interface {
+	VisitGetChecklistRunByIdResponse(w http.ResponseWriter) error
+}
+
+type GetChecklistRunById200JSONResponse ChecklistRunResponse
+
+func (response GetChecklistRunById200JSONResponse) VisitGetChecklistRunByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetChecklistRunById404JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response GetChecklistRunById404JSONResponse) VisitGetChecklistRunByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetChecklistRunById500JSONResponse Error
+
+func (response GetChecklistRunById500JSONResponse) VisitGetChecklistRunByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FinishChecklistRunRequestObject struct {
+	ChecklistId uint `json:"checklistId"`
+	RunId       uint `json:"runId"`
+	Params      FinishChecklistRunParams
+}
+
+type FinishChecklistRunResponseObject interface {
+	VisitFinishChecklistRunResponse(w http.ResponseWriter) error
+}
+
+type FinishChecklistRun200JSONResponse ChecklistRunResponse
+
+func (response FinishChecklistRun200JSONResponse) VisitFinishChecklistRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FinishChecklistRun400JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response FinishChecklistRun400JSONResponse) VisitFinishChecklistRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FinishChecklistRun404JSONResponse Error
+
+func (response FinishChecklistRun404JSONResponse) VisitFinishChecklistRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FinishChecklistRun500JSONResponse Error
+
+func (response FinishChecklistRun500JSONResponse) VisitFinishChecklistRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateChecklistRunStepRequestObject struct {
+	ChecklistId uint `json:"checklistId"`
+	RunId       uint `json:"runId"`
+	StepId      uint `json:"stepId"`
+	Params      UpdateChecklistRunStepParams
+	Body        *UpdateChecklistRunStepJSONRequestBody
+}
+
+type UpdateChecklistRunStepResponseObject interface {
+	VisitUpdateChecklistRunStepResponse(w http.ResponseWriter) error
+}
+
+type UpdateChecklistRunStep200JSONResponse ChecklistRunStepResponse
+
+func (response UpdateChecklistRunStep200JSONResponse) VisitUpdateChecklistRunStepResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateChecklistRunStep400JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response UpdateChecklistRunStep400JSONResponse) VisitUpdateChecklistRunStepResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateChecklistRunStep404JSONResponse Error
+
+func (response UpdateChecklistRunStep404JSONResponse) VisitUpdateChecklistRunStepResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateChecklistRunStep500JSONResponse Error
+
+func (response UpdateChecklistRunStep500JSONResponse) VisitUpdateChecklistRunStepResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateChecklistRunStepRowRequestObject struct {
+	ChecklistId uint `json:"checklistId"`
+	RunId       uint `json:"runId"`
+	StepId      uint `json:"stepId"`
+	RowId       uint `json:"rowId"`
+	Params      UpdateChecklistRunStepRowParams
+	Body        *UpdateChecklistRunStepRowJSONRequestBody
+}
+
+type UpdateChecklistRunStepRowResponseObject interface {
+	VisitUpdateChecklistRunStepRowResponse(w http.ResponseWriter) error
+}
+
+type UpdateChecklistRunStepRow200JSONResponse ChecklistRunStepRowResponse
+
+func (response UpdateChecklistRunStepRow200JSONResponse) VisitUpdateChecklistRunStepRowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateChecklistRunStepRow400JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response UpdateChecklistRunStepRow400JSONResponse) VisitUpdateChecklistRunStepRowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateChecklistRunStepRow404JSONResponse Error
+
+func (response UpdateChecklistRunStepRow404JSONResponse) VisitUpdateChecklistRunStepRowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateChecklistRunStepRow500JSONResponse Error
+
+func (response UpdateChecklistRunStepRow500JSONResponse) VisitUpdateChecklistRunStepRowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SplitChecklistRequestObject struct {
+	ChecklistId uint `json:"checklistId"`
+	Params      SplitChecklistParams
+	Body        *SplitChecklistJSONRequestBody
+}
+
+type SplitChecklistResponseObject interface {
+	VisitSplitChecklistResponse(w http.ResponseWriter) error
+}
+
+type SplitChecklist201JSONResponse ChecklistResponse
+
+func (response SplitChecklist201JSONResponse) VisitSplitChecklistResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SplitChecklist400JSONResponse Error
+
+func (response SplitChecklist400JSONResponse) VisitSplitChecklistResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SplitChecklist403JSONResponse Error
+
+func (response SplitChecklist403JSONResponse) VisitSplitChecklistResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SplitChecklist404JSONResponse Error
+
+func (response SplitChecklist404JSONResponse) VisitSplitChecklistResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SplitChecklist500JSONResponse Error
+
+func (response SplitChecklist500JSONResponse) VisitSplitChecklistResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ClaimInviteRequestObject struct {
 	Token  string `json:"token"`
 	Params ClaimInviteParams
 }
 
-type ClaimInviteResponseObject interface {
-	VisitClaimInviteResponse(w http.ResponseWriter) error
-}
+type ClaimInviteResponseObject interface {
+	VisitClaimInviteResponse(w http.ResponseWriter) error
+}
+
+type ClaimInvite200JSONResponse ClaimInviteResponse
+
+func (response ClaimInvite200JSONResponse) VisitClaimInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ClaimInvite400JSONResponse Error
+
+func (response ClaimInvite400JSONResponse) VisitClaimInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ClaimInvite401JSONResponse Error
+
+func (response ClaimInvite401JSONResponse) VisitClaimInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ClaimInvite404JSONResponse Error
+
+func (response ClaimInvite404JSONResponse) VisitClaimInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ClaimInvite500JSONResponse Error
+
+func (response ClaimInvite500JSONResponse) VisitClaimInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Get all checklists
+	// (GET /api/v1/checklists)
+	GetAllChecklists(ctx context.Context, request GetAllChecklistsRequestObject) (GetAllChecklistsResponseObject, error)
+	// Create a new checklist
+	// (POST /api/v1/checklists)
+	CreateChecklist(ctx context.Context, request CreateChecklistRequestObject) (CreateChecklistResponseObject, error)
+	// Revoke an invite link
+	// (DELETE /api/v1/checklists/invites/{inviteId})
+	RevokeChecklistInvite(ctx context.Context, request RevokeChecklistInviteRequestObject) (RevokeChecklistInviteResponseObject, error)
+	// Delete checklist by ID
+	// (DELETE /api/v1/checklists/{checklistId})
+	DeleteChecklistById(ctx context.Context, request DeleteChecklistByIdRequestObject) (DeleteChecklistByIdResponseObject, error)
+	// Get checklist by ID
+	// (GET /api/v1/checklists/{checklistId})
+	GetChecklistById(ctx context.Context, request GetChecklistByIdRequestObject) (GetChecklistByIdResponseObject, error)
+	// Update checklist by ID
+	// (PUT /api/v1/checklists/{checklistId})
+	UpdateChecklistById(ctx context.Context, request UpdateChecklistByIdRequestObject) (UpdateChecklistByIdResponseObject, error)
+	// Get the activity log of a checklist
+	// (GET /api/v1/checklists/{checklistId}/activity)
+	GetChecklistActivity(ctx context.Context, request GetChecklistActivityRequestObject) (GetChecklistActivityResponseObject, error)
+	// Duplicate a checklist
+	// (POST /api/v1/checklists/{checklistId}/clone)
+	CloneChecklist(ctx context.Context, request CloneChecklistRequestObject) (CloneChecklistResponseObject, error)
+	// Get the state of a checklist as of a point in time
+	// (GET /api/v1/checklists/{checklistId}/history)
+	GetChecklistStateAsOf(ctx context.Context, request GetChecklistStateAsOfRequestObject) (GetChecklistStateAsOfResponseObject, error)
+	// Compare the state of a checklist between two points in time
+	// (GET /api/v1/checklists/{checklistId}/history/diff)
+	GetChecklistStateDiff(ctx context.Context, request GetChecklistStateDiffRequestObject) (GetChecklistStateDiffResponseObject, error)
+	// List active invite links for a checklist
+	// (GET /api/v1/checklists/{checklistId}/invites)
+	GetChecklistInvites(ctx context.Context, request GetChecklistInvitesRequestObject) (GetChecklistInvitesResponseObject, error)
+	// Create a new invite link
+	// (POST /api/v1/checklists/{checklistId}/invites)
+	CreateChecklistInvite(ctx context.Context, request CreateChecklistInviteRequestObject) (CreateChecklistInviteResponseObject, error)
+	// Leave a shared checklist
+	// (POST /api/v1/checklists/{checklistId}/leave)
+	LeaveSharedChecklist(ctx context.Context, request LeaveSharedChecklistRequestObject) (LeaveSharedChecklistResponseObject, error)
+	// Merge another checklist into this one
+	// (POST /api/v1/checklists/{checklistId}/merge)
+	MergeChecklist(ctx context.Context, request MergeChecklistRequestObject) (MergeChecklistResponseObject, error)
+	// List past and ongoing runs of a checklist
+	// (GET /api/v1/checklists/{checklistId}/runs)
+	GetChecklistRuns(ctx context.Context, request GetChecklistRunsRequestObject) (GetChecklistRunsResponseObject, error)
+	// Start a new run of a checklist
+	// (POST /api/v1/checklists/{checklistId}/runs)
+	StartChecklistRun(ctx context.Context, request StartChecklistRunRequestObject) (StartChecklistRunResponseObject, error)
+	// Get a run with all of its steps
+	// (GET /api/v1/checklists/{checklistId}/runs/{runId})
+	GetChecklistRunById(ctx context.Context, request GetChecklistRunByIdRequestObject) (GetChecklistRunByIdResponseObject, error)
+	// Finish a run
+	// (POST /api/v1/checklists/{checklistId}/runs/{runId}/finish)
+	FinishChecklistRun(ctx context.Context, request FinishChecklistRunRequestObject) (FinishChecklistRunResponseObject, error)
+	// Update the status of a run step
+	// (PATCH /api/v1/checklists/{checklistId}/runs/{runId}/steps/{stepId})
+	UpdateChecklistRunStep(ctx context.Context, request UpdateChecklistRunStepRequestObject) (UpdateChecklistRunStepResponseObject, error)
+	// Update the status of a row within a run step
+	// (PATCH /api/v1/checklists/{checklistId}/runs/{runId}/steps/{stepId}/rows/{rowId})
+	UpdateChecklistRunStepRow(ctx context.Context, request UpdateChecklistRunStepRowRequestObject) (UpdateChecklistRunStepRowResponseObject, error)
+	// Split items out into a new checklist
+	// (POST /api/v1/checklists/{checklistId}/split)
+	SplitChecklist(ctx context.Context, request SplitChecklistRequestObject) (SplitChecklistResponseObject, error)
+	// Claim an invite to gain access to a checklist
+	// (POST /api/v1/invites/{token}/claim)
+	ClaimInvite(ctx context.Context, request ClaimInviteRequestObject) (ClaimInviteResponseObject, error)
+}
+
+type StrictHandlerFunc = strictgin.StrictGinHandlerFunc
+type StrictMiddlewareFunc = strictgin.StrictGinMiddlewareFunc
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+}
+
+// GetAllChecklists operation middleware
+func (sh *strictHandler) GetAllChecklists(ctx *gin.Context, params GetAllChecklistsParams) {
+	var request GetAllChecklistsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAllChecklists(ctx, request.(GetAllChecklistsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAllChecklists")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetAllChecklistsResponseObject); ok {
+		if err := validResponse.VisitGetAllChecklistsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateChecklist operation middleware
+func (sh *strictHandler) CreateChecklist(ctx *gin.Context, params CreateChecklistParams) {
+	var request CreateChecklistRequestObject
+
+	request.Params = params
+
+	var body CreateChecklistJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateChecklist(ctx, request.(CreateChecklistRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateChecklist")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(CreateChecklistResponseObject); ok {
+		if err := validResponse.VisitCreateChecklistResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RevokeChecklistInvite operation middleware
+func (sh *strictHandler) RevokeChecklistInvite(ctx *gin.Context, inviteId uint, params RevokeChecklistInviteParams) {
+	var request RevokeChecklistInviteRequestObject
+
+	request.InviteId = inviteId
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.RevokeChecklistInvite(ctx, request.(RevokeChecklistInviteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RevokeChecklistInvite")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(RevokeChecklistInviteResponseObject); ok {
+		if err := validResponse.VisitRevokeChecklistInviteResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteChecklistById operation middleware
+func (sh *strictHandler) DeleteChecklistById(ctx *gin.Context, checklistId uint, params DeleteChecklistByIdParams) {
+	var request DeleteChecklistByIdRequestObject
+
+	request.ChecklistId = checklistId
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteChecklistById(ctx, request.(DeleteChecklistByIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteChecklistById")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeleteChecklistByIdResponseObject); ok {
+		if err := validResponse.VisitDeleteChecklistByIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetChecklistById operation middleware
+func (sh *strictHandler) GetChecklistById(ctx *gin.Context, checklistId uint, params GetChecklistByIdParams) {
+	var request GetChecklistByIdRequestObject
+
+	request.ChecklistId = checklistId
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetChecklistById(ctx, request.(GetChecklistByIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetChecklistById")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetChecklistByIdResponseObject); ok {
+		if err := validResponse.VisitGetChecklistByIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateChecklistById operation middleware
+func (sh *strictHandler) UpdateChecklistById(ctx *gin.Context, checklistId uint, params UpdateChecklistByIdParams) {
+	var request UpdateChecklistByIdRequestObject
+
+	request.ChecklistId = checklistId
+	request.Params = params
+
+	var body UpdateChecklistByIdJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateChecklistById(ctx, request.(UpdateChecklistByIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateChecklistById")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(UpdateChecklistByIdResponseObject); ok {
+		if err := validResponse.VisitUpdateChecklistByIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetChecklistActivity operation middleware
+func (sh *strictHandler) GetChecklistActivity(ctx *gin.Context, checklistId uint, params GetChecklistActivityParams) {
+	var request GetChecklistActivityRequestObject
+
+	request.ChecklistId = checklistId
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetChecklistActivity(ctx, request.(GetChecklistActivityRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetChecklistActivity")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetChecklistActivityResponseObject); ok {
+		if err := validResponse.VisitGetChecklistActivityResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CloneChecklist operation middleware
+func (sh *strictHandler) CloneChecklist(ctx *gin.Context, checklistId uint, params CloneChecklistParams) {
+	var request CloneChecklistRequestObject
+
+	request.ChecklistId = checklistId
+	request.Params = params
+
+	var body CloneChecklistJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CloneChecklist(ctx, request.(CloneChecklistRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CloneChecklist")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(CloneChecklistResponseObject); ok {
+		if err := validResponse.VisitCloneChecklistResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetChecklistStateAsOf operation middleware
+func (sh *strictHandler) GetChecklistStateAsOf(ctx *gin.Context, checklistId uint, params GetChecklistStateAsOfParams) {
+	var request GetChecklistStateAsOfRequestObject
 
-type ClaimInvite200JSONResponse ClaimInviteResponse
+	request.ChecklistId = checklistId
+	request.Params = params
 
-func (response ClaimInvite200JSONResponse) VisitClaimInviteResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetChecklistStateAsOf(ctx, request.(GetChecklistStateAsOfRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetChecklistStateAsOf")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(ctx, request)
 
-type ClaimInvite400JSONResponse Error
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetChecklistStateAsOfResponseObject); ok {
+		if err := validResponse.VisitGetChecklistStateAsOfResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-func (response ClaimInvite400JSONResponse) VisitClaimInviteResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// GetChecklistStateDiff operation middleware
+func (sh *strictHandler) GetChecklistStateDiff(ctx *gin.Context, checklistId uint, params GetChecklistStateDiffParams) {
+	var request GetChecklistStateDiffRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.ChecklistId = checklistId
+	request.Params = params
 
-type ClaimInvite401JSONResponse Error
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetChecklistStateDiff(ctx, request.(GetChecklistStateDiffRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetChecklistStateDiff")
+	}
 
-func (response ClaimInvite401JSONResponse) VisitClaimInviteResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetChecklistStateDiffResponseObject); ok {
+		if err := validResponse.VisitGetChecklistStateDiffResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ClaimInvite404JSONResponse Error
-
-func (response ClaimInvite404JSONResponse) VisitClaimInviteResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// GetChecklistInvites operation middleware
+func (sh *strictHandler) GetChecklistInvites(ctx *gin.Context, checklistId uint, params GetChecklistInvitesParams) {
+	var request GetChecklistInvitesRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.ChecklistId = checklistId
+	request.Params = params
 
-type ClaimInvite500JSONResponse Error
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetChecklistInvites(ctx, request.(GetChecklistInvitesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetChecklistInvites")
+	}
 
-func (response ClaimInvite500JSONResponse) VisitClaimInviteResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetChecklistInvitesResponseObject); ok {
+		if err := validResponse.VisitGetChecklistInvitesResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Get all checklists
-	// (GET /api/v1/checklists)
-	GetAllChecklists(ctx context.Context, request GetAllChecklistsRequestObject) (GetAllChecklistsResponseObject, error)
-	// Create a new checklist
-	// (POST /api/v1/checklists)
-	CreateChecklist(ctx context.Context, request CreateChecklistRequestObject) (CreateChecklistResponseObject, error)
-	// Revoke an invite link
-	// (DELETE /api/v1/checklists/invites/{inviteId})
-	RevokeChecklistInvite(ctx context.Context, request RevokeChecklistInviteRequestObject) (RevokeChecklistInviteResponseObject, error)
-	// Delete checklist by ID
-	// (DELETE /api/v1/checklists/{checklistId})
-	DeleteChecklistById(ctx context.Context, request DeleteChecklistByIdRequestObject) (DeleteChecklistByIdResponseObject, error)
-	// Get checklist by ID
-	// (GET /api/v1/checklists/{checklistId})
-	GetChecklistById(ctx context.Context, request GetChecklistByIdRequestObject) (GetChecklistByIdResponseObject, error)
-	// Update checklist by ID
-	// (PUT /api/v1/checklists/{checklistId})
-	UpdateChecklistById(ctx context.Context, request UpdateChecklistByIdRequestObject) (UpdateChecklistByIdResponseObject, error)
-	// List active invite links for a checklist
-	// (GET /api/v1/checklists/{checklistId}/invites)
-	GetChecklistInvites(ctx context.Context, request GetChecklistInvitesRequestObject) (GetChecklistInvitesResponseObject, error)
-	// Create a new invite link
-	// (POST /api/v1/checklists/{checklistId}/invites)
-	CreateChecklistInvite(ctx context.Context, request CreateChecklistInviteRequestObject) (CreateChecklistInviteResponseObject, error)
-	// Leave a shared checklist
-	// (POST /api/v1/checklists/{checklistId}/leave)
-	LeaveSharedChecklist(ctx context.Context, request LeaveSharedChecklistRequestObject) (LeaveSharedChecklistResponseObject, error)
-	// Claim an invite to gain access to a checklist
-	// (POST /api/v1/invites/{token}/claim)
-	ClaimInvite(ctx context.Context, request ClaimInviteRequestObject) (ClaimInviteResponseObject, error)
-}
+// CreateChecklistInvite operation middleware
+func (sh *strictHandler) CreateChecklistInvite(ctx *gin.Context, checklistId uint, params CreateChecklistInviteParams) {
+	var request CreateChecklistInviteRequestObject
 
-type StrictHandlerFunc = strictgin.StrictGinHandlerFunc
-type StrictMiddlewareFunc = strictgin.StrictGinMiddlewareFunc
+	request.ChecklistId = checklistId
+	request.Params = params
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares}
-}
+	var body CreateChecklistInviteJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateChecklistInvite(ctx, request.(CreateChecklistInviteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateChecklistInvite")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(CreateChecklistInviteResponseObject); ok {
+		if err := validResponse.VisitCreateChecklistInviteResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// GetAllChecklists operation middleware
-func (sh *strictHandler) GetAllChecklists(ctx *gin.Context, params GetAllChecklistsParams) {
-	var request GetAllChecklistsRequestObject
+// LeaveSharedChecklist operation middleware
+func (sh *strictHandler) LeaveSharedChecklist(ctx *gin.Context, checklistId uint, params LeaveSharedChecklistParams) {
+	var request LeaveSharedChecklistRequestObject
 
+	request.ChecklistId = checklistId
 	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetAllChecklists(ctx, request.(GetAllChecklistsRequestObject))
+		return sh.ssi.LeaveSharedChecklist(ctx, request.(LeaveSharedChecklistRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetAllChecklists")
+		handler = middleware(handler, "LeaveSharedChecklist")
 	}
 
 	response, err := handler(ctx, request)
@@ -1304,8 +3359,8 @@ func (sh *strictHandler) GetAllChecklists(ctx *gin.Context, params GetAllCheckli
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetAllChecklistsResponseObject); ok {
-		if err := validResponse.VisitGetAllChecklistsResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(LeaveSharedChecklistResponseObject); ok {
+		if err := validResponse.VisitLeaveSharedChecklistResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -1313,13 +3368,14 @@ func (sh *strictHandler) GetAllChecklists(ctx *gin.Context, params GetAllCheckli
 	}
 }
 
-// CreateChecklist operation middleware
-func (sh *strictHandler) CreateChecklist(ctx *gin.Context, params CreateChecklistParams) {
-	var request CreateChecklistRequestObject
+// MergeChecklist operation middleware
+func (sh *strictHandler) MergeChecklist(ctx *gin.Context, checklistId uint, params MergeChecklistParams) {
+	var request MergeChecklistRequestObject
 
+	request.ChecklistId = checklistId
 	request.Params = params
 
-	var body CreateChecklistJSONRequestBody
+	var body MergeChecklistJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -1328,10 +3384,10 @@ func (sh *strictHandler) CreateChecklist(ctx *gin.Context, params CreateChecklis
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateChecklist(ctx, request.(CreateChecklistRequestObject))
+		return sh.ssi.MergeChecklist(ctx, request.(MergeChecklistRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateChecklist")
+		handler = middleware(handler, "MergeChecklist")
 	}
 
 	response, err := handler(ctx, request)
@@ -1339,8 +3395,8 @@ func (sh *strictHandler) CreateChecklist(ctx *gin.Context, params CreateChecklis
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(CreateChecklistResponseObject); ok {
-		if err := validResponse.VisitCreateChecklistResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(MergeChecklistResponseObject); ok {
+		if err := validResponse.VisitMergeChecklistResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -1348,18 +3404,18 @@ func (sh *strictHandler) CreateChecklist(ctx *gin.Context, params CreateChecklis
 	}
 }
 
-// RevokeChecklistInvite operation middleware
-func (sh *strictHandler) RevokeChecklistInvite(ctx *gin.Context, inviteId uint, params RevokeChecklistInviteParams) {
-	var request RevokeChecklistInviteRequestObject
+// GetChecklistRuns operation middleware
+func (sh *strictHandler) GetChecklistRuns(ctx *gin.Context, checklistId uint, params GetChecklistRunsParams) {
+	var request GetChecklistRunsRequestObject
 
-	request.InviteId = inviteId
+	request.ChecklistId = checklistId
 	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.RevokeChecklistInvite(ctx, request.(RevokeChecklistInviteRequestObject))
+		return sh.ssi.GetChecklistRuns(ctx, request.(GetChecklistRunsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "RevokeChecklistInvite")
+		handler = middleware(handler, "GetChecklistRuns")
 	}
 
 	response, err := handler(ctx, request)
@@ -1367,8 +3423,8 @@ func (sh *strictHandler) RevokeChecklistInvite(ctx *gin.Context, inviteId uint,
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(RevokeChecklistInviteResponseObject); ok {
-		if err := validResponse.VisitRevokeChecklistInviteResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetChecklistRunsResponseObject); ok {
+		if err := validResponse.VisitGetChecklistRunsResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -1376,18 +3432,18 @@ func (sh *strictHandler) RevokeChecklistInvite(ctx *gin.Context, inviteId uint,
 	}
 }
 
-// DeleteChecklistById operation middleware
-func (sh *strictHandler) DeleteChecklistById(ctx *gin.Context, checklistId uint, params DeleteChecklistByIdParams) {
-	var request DeleteChecklistByIdRequestObject
+// StartChecklistRun operation middleware
+func (sh *strictHandler) StartChecklistRun(ctx *gin.Context, checklistId uint, params StartChecklistRunParams) {
+	var request StartChecklistRunRequestObject
 
 	request.ChecklistId = checklistId
 	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteChecklistById(ctx, request.(DeleteChecklistByIdRequestObject))
+		return sh.ssi.StartChecklistRun(ctx, request.(StartChecklistRunRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteChecklistById")
+		handler = middleware(handler, "StartChecklistRun")
 	}
 
 	response, err := handler(ctx, request)
@@ -1395,8 +3451,8 @@ func (sh *strictHandler) DeleteChecklistById(ctx *gin.Context, checklistId uint,
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(DeleteChecklistByIdResponseObject); ok {
-		if err := validResponse.VisitDeleteChecklistByIdResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(StartChecklistRunResponseObject); ok {
+		if err := validResponse.VisitStartChecklistRunResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -1404,18 +3460,19 @@ func (sh *strictHandler) DeleteChecklistById(ctx *gin.Context, checklistId uint,
 	}
 }
 
-// GetChecklistById operation middleware
-func (sh *strictHandler) GetChecklistById(ctx *gin.Context, checklistId uint, params GetChecklistByIdParams) {
-	var request GetChecklistByIdRequestObject
+// GetChecklistRunById operation middleware
+func (sh *strictHandler) GetChecklistRunById(ctx *gin.Context, checklistId uint, runId uint, params GetChecklistRunByIdParams) {
+	var request GetChecklistRunByIdRequestObject
 
 	request.ChecklistId = checklistId
+	request.RunId = runId
 	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetChecklistById(ctx, request.(GetChecklistByIdRequestObject))
+		return sh.ssi.GetChecklistRunById(ctx, request.(GetChecklistRunByIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetChecklistById")
+		handler = middleware(handler, "GetChecklistRunById")
 	}
 
 	response, err := handler(ctx, request)
@@ -1423,8 +3480,8 @@ func (sh *strictHandler) GetChecklistById(ctx *gin.Context, checklistId uint, pa
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetChecklistByIdResponseObject); ok {
-		if err := validResponse.VisitGetChecklistByIdResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetChecklistRunByIdResponseObject); ok {
+		if err := validResponse.VisitGetChecklistRunByIdResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -1432,26 +3489,19 @@ func (sh *strictHandler) GetChecklistById(ctx *gin.Context, checklistId uint, pa
 	}
 }
 
-// UpdateChecklistById operation middleware
-func (sh *strictHandler) UpdateChecklistById(ctx *gin.Context, checklistId uint, params UpdateChecklistByIdParams) {
-	var request UpdateChecklistByIdRequestObject
+// FinishChecklistRun operation middleware
+func (sh *strictHandler) FinishChecklistRun(ctx *gin.Context, checklistId uint, runId uint, params FinishChecklistRunParams) {
+	var request FinishChecklistRunRequestObject
 
 	request.ChecklistId = checklistId
+	request.RunId = runId
 	request.Params = params
 
-	var body UpdateChecklistByIdJSONRequestBody
-	if err := ctx.ShouldBindJSON(&body); err != nil {
-		ctx.Status(http.StatusBadRequest)
-		ctx.Error(err)
-		return
-	}
-	request.Body = &body
-
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateChecklistById(ctx, request.(UpdateChecklistByIdRequestObject))
+		return sh.ssi.FinishChecklistRun(ctx, request.(FinishChecklistRunRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateChecklistById")
+		handler = middleware(handler, "FinishChecklistRun")
 	}
 
 	response, err := handler(ctx, request)
@@ -1459,8 +3509,8 @@ func (sh *strictHandler) UpdateChecklistById(ctx *gin.Context, checklistId uint,
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(UpdateChecklistByIdResponseObject); ok {
-		if err := validResponse.VisitUpdateChecklistByIdResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(FinishChecklistRunResponseObject); ok {
+		if err := validResponse.VisitFinishChecklistRunResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -1468,18 +3518,28 @@ func (sh *strictHandler) UpdateChecklistById(ctx *gin.Context, checklistId uint,
 	}
 }
 
-// GetChecklistInvites operation middleware
-func (sh *strictHandler) GetChecklistInvites(ctx *gin.Context, checklistId uint, params GetChecklistInvitesParams) {
-	var request GetChecklistInvitesRequestObject
+// UpdateChecklistRunStep operation middleware
+func (sh *strictHandler) UpdateChecklistRunStep(ctx *gin.Context, checklistId uint, runId uint, stepId uint, params UpdateChecklistRunStepParams) {
+	var request UpdateChecklistRunStepRequestObject
 
 	request.ChecklistId = checklistId
+	request.RunId = runId
+	request.StepId = stepId
 	request.Params = params
 
+	var body UpdateChecklistRunStepJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetChecklistInvites(ctx, request.(GetChecklistInvitesRequestObject))
+		return sh.ssi.UpdateChecklistRunStep(ctx, request.(UpdateChecklistRunStepRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetChecklistInvites")
+		handler = middleware(handler, "UpdateChecklistRunStep")
 	}
 
 	response, err := handler(ctx, request)
@@ -1487,8 +3547,8 @@ func (sh *strictHandler) GetChecklistInvites(ctx *gin.Context, checklistId uint,
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetChecklistInvitesResponseObject); ok {
-		if err := validResponse.VisitGetChecklistInvitesResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(UpdateChecklistRunStepResponseObject); ok {
+		if err := validResponse.VisitUpdateChecklistRunStepResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -1496,14 +3556,17 @@ func (sh *strictHandler) GetChecklistInvites(ctx *gin.Context, checklistId uint,
 	}
 }
 
-// CreateChecklistInvite operation middleware
-func (sh *strictHandler) CreateChecklistInvite(ctx *gin.Context, checklistId uint, params CreateChecklistInviteParams) {
-	var request CreateChecklistInviteRequestObject
+// UpdateChecklistRunStepRow operation middleware
+func (sh *strictHandler) UpdateChecklistRunStepRow(ctx *gin.Context, checklistId uint, runId uint, stepId uint, rowId uint, params UpdateChecklistRunStepRowParams) {
+	var request UpdateChecklistRunStepRowRequestObject
 
 	request.ChecklistId = checklistId
+	request.RunId = runId
+	request.StepId = stepId
+	request.RowId = rowId
 	request.Params = params
 
-	var body CreateChecklistInviteJSONRequestBody
+	var body UpdateChecklistRunStepRowJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -1512,10 +3575,10 @@ func (sh *strictHandler) CreateChecklistInvite(ctx *gin.Context, checklistId uin
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateChecklistInvite(ctx, request.(CreateChecklistInviteRequestObject))
+		return sh.ssi.UpdateChecklistRunStepRow(ctx, request.(UpdateChecklistRunStepRowRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateChecklistInvite")
+		handler = middleware(handler, "UpdateChecklistRunStepRow")
 	}
 
 	response, err := handler(ctx, request)
@@ -1523,8 +3586,8 @@ func (sh *strictHandler) CreateChecklistInvite(ctx *gin.Context, checklistId uin
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(CreateChecklistInviteResponseObject); ok {
-		if err := validResponse.VisitCreateChecklistInviteResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(UpdateChecklistRunStepRowResponseObject); ok {
+		if err := validResponse.VisitUpdateChecklistRunStepRowResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -1532,18 +3595,26 @@ func (sh *strictHandler) CreateChecklistInvite(ctx *gin.Context, checklistId uin
 	}
 }
 
-// LeaveSharedChecklist operation middleware
-func (sh *strictHandler) LeaveSharedChecklist(ctx *gin.Context, checklistId uint, params LeaveSharedChecklistParams) {
-	var request LeaveSharedChecklistRequestObject
+// SplitChecklist operation middleware
+func (sh *strictHandler) SplitChecklist(ctx *gin.Context, checklistId uint, params SplitChecklistParams) {
+	var request SplitChecklistRequestObject
 
 	request.ChecklistId = checklistId
 	request.Params = params
 
+	var body SplitChecklistJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.LeaveSharedChecklist(ctx, request.(LeaveSharedChecklistRequestObject))
+		return sh.ssi.SplitChecklist(ctx, request.(SplitChecklistRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "LeaveSharedChecklist")
+		handler = middleware(handler, "SplitChecklist")
 	}
 
 	response, err := handler(ctx, request)
@@ -1551,8 +3622,8 @@ func (sh *strictHandler) LeaveSharedChecklist(ctx *gin.Context, checklistId uint
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(LeaveSharedChecklistResponseObject); ok {
-		if err := validResponse.VisitLeaveSharedChecklistResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(SplitChecklistResponseObject); ok {
+		if err := validResponse.VisitSplitChecklistResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
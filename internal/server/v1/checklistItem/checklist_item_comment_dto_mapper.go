@@ -0,0 +1,58 @@
+package checklistItem
+
+import (
+	"com.raunlo.checklist/internal/core/domain"
+)
+
+type IChecklistItemCommentDtoMapper interface {
+	ToDTO(comment domain.ChecklistItemComment) ChecklistItemCommentResponse
+	ToDTOArray(comments []domain.ChecklistItemComment) []ChecklistItemCommentResponse
+	ToMentions(userIds *[]string) []domain.ChecklistItemCommentMention
+}
+
+type checklistItemCommentDtoMapper struct{}
+
+func NewChecklistItemCommentDtoMapper() IChecklistItemCommentDtoMapper {
+	return &checklistItemCommentDtoMapper{}
+}
+
+func (m *checklistItemCommentDtoMapper) ToDTO(comment domain.ChecklistItemComment) ChecklistItemCommentResponse {
+	mentions := make([]ChecklistItemCommentMentionResponse, 0, len(comment.Mentions))
+	for _, mention := range comment.Mentions {
+		mentions = append(mentions, ChecklistItemCommentMentionResponse{
+			UserId: mention.UserId,
+			Name:   mention.Name,
+		})
+	}
+	return ChecklistItemCommentResponse{
+		Id:         comment.Id,
+		ItemId:     comment.ItemId,
+		ParentId:   comment.ParentId,
+		Author:     comment.Author,
+		AuthorName: comment.AuthorName,
+		Content:    comment.Content,
+		Mentions:   mentions,
+		CreatedAt:  comment.CreatedAt,
+		EditedAt:   comment.EditedAt,
+		Deleted:    comment.Deleted,
+	}
+}
+
+func (m *checklistItemCommentDtoMapper) ToDTOArray(comments []domain.ChecklistItemComment) []ChecklistItemCommentResponse {
+	result := make([]ChecklistItemCommentResponse, 0, len(comments))
+	for _, comment := range comments {
+		result = append(result, m.ToDTO(comment))
+	}
+	return result
+}
+
+func (m *checklistItemCommentDtoMapper) ToMentions(userIds *[]string) []domain.ChecklistItemCommentMention {
+	if userIds == nil {
+		return []domain.ChecklistItemCommentMention{}
+	}
+	mentions := make([]domain.ChecklistItemCommentMention, 0, len(*userIds))
+	for _, userId := range *userIds {
+		mentions = append(mentions, domain.ChecklistItemCommentMention{UserId: userId})
+	}
+	return mentions
+}
@@ -12,8 +12,12 @@ import (
 type IChecklistItemController = StrictServerInterface
 
 type checklistItemController struct {
-	service service.IChecklistItemsService
-	mapper  IChecklistItemDtoMapper
+	service        service.IChecklistItemsService
+	sectionService service.IChecklistSectionService
+	commentService service.IChecklistItemCommentService
+	mapper         IChecklistItemDtoMapper
+	sectionMapper  IChecklistSectionDtoMapper
+	commentMapper  IChecklistItemCommentDtoMapper
 }
 
 func (controller *checklistItemController) ToggleChecklistItemComplete(ctx context.Context, request ToggleChecklistItemCompleteRequestObject) (ToggleChecklistItemCompleteResponseObject, error) {
@@ -66,6 +70,10 @@ func (controller *checklistItemController) DeleteChecklistItemById(ctx context.C
 	domainContext := serverutils.CreateContext(ctx)
 	if err := controller.service.DeleteChecklistItemById(domainContext, request.ChecklistId, request.ItemId); err == nil {
 		return DeleteChecklistItemById204JSONResponse{}, nil
+	} else if err.ResponseCode() == http.StatusBadRequest {
+		return DeleteChecklistItemById400JSONResponse{
+			Message: err.Error(),
+		}, nil
 	} else if err.ResponseCode() == http.StatusNotFound {
 		return DeleteChecklistItemById404JSONResponse{
 			Message: err.Error(),
@@ -82,6 +90,10 @@ func (controller *checklistItemController) DeleteChecklistItemRow(ctx context.Co
 	// This operation atomically deletes the row and auto-completes the parent item if all remaining rows are completed
 	if err := controller.service.DeleteChecklistItemRow(domainContext, request.ChecklistId, request.ItemId, request.RowId); err == nil {
 		return DeleteChecklistItemRow204Response{}, nil
+	} else if err.ResponseCode() == http.StatusBadRequest {
+		return DeleteChecklistItemRow400JSONResponse{
+			Message: err.Error(),
+		}, nil
 	} else if err.ResponseCode() == http.StatusNotFound {
 		return DeleteChecklistItemRow404JSONResponse{
 			Message: err.Error(),
@@ -107,12 +119,14 @@ func (c *checklistItemController) ChangeChecklistItemOrderNumber(ctx context.Con
 		ChecklistId:     request.ChecklistId,
 		ChecklistItemId: request.ItemId,
 		SortOrder:       sortOrder,
+		SectionId:       request.Body.SectionId,
 	}
 
 	if response, err := c.service.ChangeChecklistItemOrder(domainContext, changeOrderRequest); err == nil {
 		return ChangeChecklistItemOrderNumber200JSONResponse{
 			NewOrderNumber: &response.OrderNumber,
 			OldOrderNumber: nil,
+			SectionId:      response.SectionId,
 		}, nil
 	} else {
 		switch err.ResponseCode() {
@@ -185,7 +199,11 @@ func (c *checklistItemController) UpdateChecklistItemBychecklistIdAndItemId(ctx
 	domainContext := serverutils.CreateContext(ctx)
 	domainObject := c.mapper.MapUpdateRequestToDomain(*request.Body)
 	domainObject.Id = request.ItemId
-	if updatedItem, err := c.service.UpdateChecklistItem(domainContext, request.ChecklistId, domainObject); err != nil && err.ResponseCode() == 404 {
+	if updatedItem, err := c.service.UpdateChecklistItem(domainContext, request.ChecklistId, domainObject); err != nil && err.ResponseCode() == 400 {
+		return UpdateChecklistItemBychecklistIdAndItemId400JSONResponse{
+			Message: err.Error(),
+		}, nil
+	} else if err != nil && err.ResponseCode() == 404 {
 		return UpdateChecklistItemBychecklistIdAndItemId404JSONResponse{
 			Message: err.Error(),
 		}, nil
@@ -199,12 +217,150 @@ func (c *checklistItemController) UpdateChecklistItemBychecklistIdAndItemId(ctx
 	}
 }
 
+func (c *checklistItemController) MoveChecklistItem(ctx context.Context, request MoveChecklistItemRequestObject) (MoveChecklistItemResponseObject, error) {
+	domainContext := serverutils.CreateContext(ctx)
+	transferRequest := domain.ChecklistItemTransferRequest{
+		SourceChecklistId: request.ChecklistId,
+		ChecklistItemId:   request.ItemId,
+		TargetChecklistId: request.Body.TargetChecklistId,
+		OrderNumber:       request.Body.OrderNumber,
+	}
+
+	if movedItem, err := c.service.MoveChecklistItem(domainContext, transferRequest); err == nil {
+		return MoveChecklistItem200JSONResponse(c.mapper.MapDomainToDto(movedItem)), nil
+	} else {
+		switch err.ResponseCode() {
+		case http.StatusBadRequest:
+			return MoveChecklistItem400JSONResponse{Message: err.Error()}, nil
+		case http.StatusNotFound:
+			return MoveChecklistItem404JSONResponse{Message: err.Error()}, nil
+		default:
+			return MoveChecklistItem500JSONResponse{Message: err.Error()}, nil
+		}
+	}
+}
+
+func (c *checklistItemController) CopyChecklistItem(ctx context.Context, request CopyChecklistItemRequestObject) (CopyChecklistItemResponseObject, error) {
+	domainContext := serverutils.CreateContext(ctx)
+	transferRequest := domain.ChecklistItemTransferRequest{
+		SourceChecklistId: request.ChecklistId,
+		ChecklistItemId:   request.ItemId,
+		TargetChecklistId: request.Body.TargetChecklistId,
+		OrderNumber:       request.Body.OrderNumber,
+	}
+
+	if copiedItem, err := c.service.CopyChecklistItem(domainContext, transferRequest); err == nil {
+		return CopyChecklistItem201JSONResponse(c.mapper.MapDomainToDto(copiedItem)), nil
+	} else {
+		switch err.ResponseCode() {
+		case http.StatusBadRequest:
+			return CopyChecklistItem400JSONResponse{Message: err.Error()}, nil
+		case http.StatusNotFound:
+			return CopyChecklistItem404JSONResponse{Message: err.Error()}, nil
+		default:
+			return CopyChecklistItem500JSONResponse{Message: err.Error()}, nil
+		}
+	}
+}
+
+func (c *checklistItemController) BatchUpdateChecklistItems(ctx context.Context, request BatchUpdateChecklistItemsRequestObject) (BatchUpdateChecklistItemsResponseObject, error) {
+	domainContext := serverutils.CreateContext(ctx)
+	operations, err := c.mapper.MapBatchRequestToDomain(*request.Body)
+	if err != nil {
+		return BatchUpdateChecklistItems400JSONResponse{Message: err.Error()}, nil
+	}
+
+	if result, err := c.service.ApplyBatch(domainContext, request.ChecklistId, operations); err == nil {
+		return BatchUpdateChecklistItems200JSONResponse(c.mapper.MapBatchResultToDto(result)), nil
+	} else {
+		switch err.ResponseCode() {
+		case http.StatusBadRequest:
+			return BatchUpdateChecklistItems400JSONResponse{Message: err.Error()}, nil
+		case http.StatusNotFound:
+			return BatchUpdateChecklistItems404JSONResponse{Message: err.Error()}, nil
+		default:
+			return BatchUpdateChecklistItems500JSONResponse{Message: err.Error()}, nil
+		}
+	}
+}
+
+func (c *checklistItemController) ClearCompletedChecklistItems(ctx context.Context, request ClearCompletedChecklistItemsRequestObject) (ClearCompletedChecklistItemsResponseObject, error) {
+	domainContext := serverutils.CreateContext(ctx)
+	if group, err := c.service.ClearCompletedItems(domainContext, request.ChecklistId); err == nil {
+		return ClearCompletedChecklistItems200JSONResponse(c.mapper.MapDeletionGroupToDto(group)), nil
+	} else if err.ResponseCode() == http.StatusBadRequest {
+		return ClearCompletedChecklistItems400JSONResponse{Message: err.Error()}, nil
+	} else if err.ResponseCode() == http.StatusNotFound {
+		return ClearCompletedChecklistItems404JSONResponse{Message: err.Error()}, nil
+	} else {
+		return ClearCompletedChecklistItems500JSONResponse{Message: err.Error()}, nil
+	}
+}
+
+func (c *checklistItemController) RestoreChecklistItemDeletionGroup(ctx context.Context, request RestoreChecklistItemDeletionGroupRequestObject) (RestoreChecklistItemDeletionGroupResponseObject, error) {
+	domainContext := serverutils.CreateContext(ctx)
+	if result, err := c.service.RestoreDeletionGroup(domainContext, request.ChecklistId, request.GroupId); err == nil {
+		return RestoreChecklistItemDeletionGroup200JSONResponse(c.mapper.MapBatchResultToDto(result)), nil
+	} else if err.ResponseCode() == http.StatusBadRequest {
+		return RestoreChecklistItemDeletionGroup400JSONResponse{Message: err.Error()}, nil
+	} else if err.ResponseCode() == http.StatusNotFound {
+		return RestoreChecklistItemDeletionGroup404JSONResponse{Message: err.Error()}, nil
+	} else {
+		return RestoreChecklistItemDeletionGroup500JSONResponse{Message: err.Error()}, nil
+	}
+}
+
+func (c *checklistItemController) ResetChecklistItems(ctx context.Context, request ResetChecklistItemsRequestObject) (ResetChecklistItemsResponseObject, error) {
+	domainContext := serverutils.CreateContext(ctx)
+	if result, err := c.service.ResetChecklistItems(domainContext, request.ChecklistId); err == nil {
+		return ResetChecklistItems200JSONResponse(c.mapper.MapBatchResultToDto(result)), nil
+	} else if err.ResponseCode() == http.StatusBadRequest {
+		return ResetChecklistItems400JSONResponse{Message: err.Error()}, nil
+	} else if err.ResponseCode() == http.StatusNotFound {
+		return ResetChecklistItems404JSONResponse{Message: err.Error()}, nil
+	} else {
+		return ResetChecklistItems500JSONResponse{Message: err.Error()}, nil
+	}
+}
+
+func (c *checklistItemController) SortChecklistItems(ctx context.Context, request SortChecklistItemsRequestObject) (SortChecklistItemsResponseObject, error) {
+	domainContext := serverutils.CreateContext(ctx)
+
+	sortOrder, sortOrderErr := domain.NewSortOrder((*string)(request.Body.Direction))
+	if sortOrderErr != nil {
+		return SortChecklistItems400JSONResponse{Message: sortOrderErr.Error()}, nil
+	}
+
+	sortRequest := domain.ChecklistItemSortRequest{
+		ChecklistId: request.ChecklistId,
+		SortBy:      domain.ChecklistItemSortKey(request.Body.SortBy),
+		SortOrder:   sortOrder,
+	}
+	if request.Body.Locale != nil {
+		sortRequest.Locale = *request.Body.Locale
+	}
+	items, err := c.service.SortChecklistItems(domainContext, sortRequest)
+	if err == nil {
+		return SortChecklistItems200JSONResponse(c.mapper.MapDomainListToDtoList(items)), nil
+	} else if err.ResponseCode() == http.StatusBadRequest {
+		return SortChecklistItems400JSONResponse{Message: err.Error()}, nil
+	} else if err.ResponseCode() == http.StatusNotFound {
+		return SortChecklistItems404JSONResponse{Message: err.Error()}, nil
+	} else {
+		return SortChecklistItems500JSONResponse{Message: err.Error()}, nil
+	}
+}
+
 func (c *checklistItemController) RestoreChecklistItem(ctx context.Context, request RestoreChecklistItemRequestObject) (RestoreChecklistItemResponseObject, error) {
 	domainContext := serverutils.CreateContext(ctx)
 	restoredItem, err := c.service.RestoreChecklistItem(domainContext, request.ChecklistId, request.ItemId)
 	if err == nil {
 		dto := c.mapper.MapDomainToDto(restoredItem)
 		return RestoreChecklistItem200JSONResponse(dto), nil
+	} else if err.ResponseCode() == http.StatusBadRequest {
+		return RestoreChecklistItem400JSONResponse{
+			Message: err.Error(),
+		}, nil
 	} else if err.ResponseCode() == http.StatusNotFound {
 		return RestoreChecklistItem404JSONResponse{
 			Message: err.Error(),
@@ -216,11 +372,184 @@ func (c *checklistItemController) RestoreChecklistItem(ctx context.Context, requ
 	}
 }
 
+func (c *checklistItemController) GetChecklistSections(ctx context.Context, request GetChecklistSectionsRequestObject) (GetChecklistSectionsResponseObject, error) {
+	domainContext := serverutils.CreateContext(ctx)
+	sections, err := c.sectionService.FindChecklistSections(domainContext, request.ChecklistId)
+	if err == nil {
+		return GetChecklistSections200JSONResponse(c.sectionMapper.ToDTOArray(sections)), nil
+	} else if err.ResponseCode() == http.StatusNotFound {
+		return GetChecklistSections404JSONResponse{Message: err.Error()}, nil
+	} else {
+		return GetChecklistSections500JSONResponse{Message: err.Error()}, nil
+	}
+}
+
+func (c *checklistItemController) CreateChecklistSection(ctx context.Context, request CreateChecklistSectionRequestObject) (CreateChecklistSectionResponseObject, error) {
+	domainContext := serverutils.CreateContext(ctx)
+	section := domain.ChecklistSection{
+		ChecklistId: request.ChecklistId,
+		Name:        request.Body.Name,
+	}
+	if request.Body.Collapsed != nil {
+		section.Collapsed = *request.Body.Collapsed
+	}
+
+	result, err := c.sectionService.CreateChecklistSection(domainContext, section)
+	if err == nil {
+		return CreateChecklistSection201JSONResponse(c.sectionMapper.ToDTO(result)), nil
+	}
+	switch err.ResponseCode() {
+	case http.StatusBadRequest:
+		return CreateChecklistSection400JSONResponse{Message: err.Error()}, nil
+	case http.StatusNotFound:
+		return CreateChecklistSection404JSONResponse{Message: err.Error()}, nil
+	default:
+		return CreateChecklistSection500JSONResponse{Message: err.Error()}, nil
+	}
+}
+
+func (c *checklistItemController) UpdateChecklistSection(ctx context.Context, request UpdateChecklistSectionRequestObject) (UpdateChecklistSectionResponseObject, error) {
+	domainContext := serverutils.CreateContext(ctx)
+	result, err := c.sectionService.UpdateChecklistSection(domainContext, domain.ChecklistSection{
+		Id:          request.SectionId,
+		ChecklistId: request.ChecklistId,
+		Name:        request.Body.Name,
+		Collapsed:   request.Body.Collapsed,
+	})
+	if err == nil {
+		return UpdateChecklistSection200JSONResponse(c.sectionMapper.ToDTO(result)), nil
+	}
+	switch err.ResponseCode() {
+	case http.StatusBadRequest:
+		return UpdateChecklistSection400JSONResponse{Message: err.Error()}, nil
+	case http.StatusNotFound:
+		return UpdateChecklistSection404JSONResponse{Message: err.Error()}, nil
+	default:
+		return UpdateChecklistSection500JSONResponse{Message: err.Error()}, nil
+	}
+}
+
+func (c *checklistItemController) DeleteChecklistSection(ctx context.Context, request DeleteChecklistSectionRequestObject) (DeleteChecklistSectionResponseObject, error) {
+	domainContext := serverutils.CreateContext(ctx)
+	result, err := c.sectionService.DeleteChecklistSection(domainContext, request.ChecklistId, request.SectionId)
+	if err == nil {
+		return DeleteChecklistSection200JSONResponse(c.sectionMapper.ToDeletionDTO(result)), nil
+	} else if err.ResponseCode() == http.StatusBadRequest {
+		return DeleteChecklistSection400JSONResponse{Message: err.Error()}, nil
+	} else if err.ResponseCode() == http.StatusNotFound {
+		return DeleteChecklistSection404JSONResponse{Message: err.Error()}, nil
+	} else {
+		return DeleteChecklistSection500JSONResponse{Message: err.Error()}, nil
+	}
+}
+
+func (c *checklistItemController) ChangeChecklistSectionOrderNumber(ctx context.Context, request ChangeChecklistSectionOrderNumberRequestObject) (ChangeChecklistSectionOrderNumberResponseObject, error) {
+	domainContext := serverutils.CreateContext(ctx)
+	result, err := c.sectionService.ChangeChecklistSectionOrder(domainContext, domain.ChangeSectionOrderRequest{
+		ChecklistId:    request.ChecklistId,
+		SectionId:      request.SectionId,
+		NewOrderNumber: request.Body.NewOrderNumber,
+	})
+	if err == nil {
+		return ChangeChecklistSectionOrderNumber200JSONResponse(c.sectionMapper.ToDTO(result)), nil
+	}
+	switch err.ResponseCode() {
+	case http.StatusBadRequest:
+		return ChangeChecklistSectionOrderNumber400JSONResponse{Message: err.Error()}, nil
+	case http.StatusNotFound:
+		return ChangeChecklistSectionOrderNumber404JSONResponse{Message: err.Error()}, nil
+	default:
+		return ChangeChecklistSectionOrderNumber500JSONResponse{Message: err.Error()}, nil
+	}
+}
+
+func (c *checklistItemController) GetChecklistItemComments(ctx context.Context, request GetChecklistItemCommentsRequestObject) (GetChecklistItemCommentsResponseObject, error) {
+	domainContext := serverutils.CreateContext(ctx)
+	comments, err := c.commentService.FindItemComments(domainContext, request.ChecklistId, request.ItemId)
+	if err == nil {
+		return GetChecklistItemComments200JSONResponse(c.commentMapper.ToDTOArray(comments)), nil
+	} else if err.ResponseCode() == http.StatusNotFound {
+		return GetChecklistItemComments404JSONResponse{Message: err.Error()}, nil
+	} else {
+		return GetChecklistItemComments500JSONResponse{Message: err.Error()}, nil
+	}
+}
+
+func (c *checklistItemController) CreateChecklistItemComment(ctx context.Context, request CreateChecklistItemCommentRequestObject) (CreateChecklistItemCommentResponseObject, error) {
+	domainContext := serverutils.CreateContext(ctx)
+	result, err := c.commentService.CreateItemComment(domainContext, domain.ChecklistItemComment{
+		ChecklistId: request.ChecklistId,
+		ItemId:      request.ItemId,
+		ParentId:    request.Body.ParentId,
+		Content:     request.Body.Content,
+		Mentions:    c.commentMapper.ToMentions(request.Body.MentionedUserIds),
+	})
+	if err == nil {
+		return CreateChecklistItemComment201JSONResponse(c.commentMapper.ToDTO(result)), nil
+	}
+	switch err.ResponseCode() {
+	case http.StatusBadRequest:
+		return CreateChecklistItemComment400JSONResponse{Message: err.Error()}, nil
+	case http.StatusNotFound:
+		return CreateChecklistItemComment404JSONResponse{Message: err.Error()}, nil
+	default:
+		return CreateChecklistItemComment500JSONResponse{Message: err.Error()}, nil
+	}
+}
+
+func (c *checklistItemController) UpdateChecklistItemComment(ctx context.Context, request UpdateChecklistItemCommentRequestObject) (UpdateChecklistItemCommentResponseObject, error) {
+	domainContext := serverutils.CreateContext(ctx)
+	result, err := c.commentService.UpdateItemComment(domainContext, domain.ChecklistItemComment{
+		Id:          request.CommentId,
+		ChecklistId: request.ChecklistId,
+		ItemId:      request.ItemId,
+		Content:     request.Body.Content,
+		Mentions:    c.commentMapper.ToMentions(request.Body.MentionedUserIds),
+	})
+	if err == nil {
+		return UpdateChecklistItemComment200JSONResponse(c.commentMapper.ToDTO(result)), nil
+	}
+	switch err.ResponseCode() {
+	case http.StatusBadRequest:
+		return UpdateChecklistItemComment400JSONResponse{Message: err.Error()}, nil
+	case http.StatusForbidden:
+		return UpdateChecklistItemComment403JSONResponse{Message: err.Error()}, nil
+	case http.StatusNotFound:
+		return UpdateChecklistItemComment404JSONResponse{Message: err.Error()}, nil
+	default:
+		return UpdateChecklistItemComment500JSONResponse{Message: err.Error()}, nil
+	}
+}
+
+func (c *checklistItemController) DeleteChecklistItemComment(ctx context.Context, request DeleteChecklistItemCommentRequestObject) (DeleteChecklistItemCommentResponseObject, error) {
+	domainContext := serverutils.CreateContext(ctx)
+	err := c.commentService.DeleteItemComment(domainContext, request.ChecklistId, request.ItemId, request.CommentId)
+	if err == nil {
+		return DeleteChecklistItemComment204Response{}, nil
+	}
+	switch err.ResponseCode() {
+	case http.StatusBadRequest:
+		return DeleteChecklistItemComment400JSONResponse{Message: err.Error()}, nil
+	case http.StatusForbidden:
+		return DeleteChecklistItemComment403JSONResponse{Message: err.Error()}, nil
+	case http.StatusNotFound:
+		return DeleteChecklistItemComment404JSONResponse{Message: err.Error()}, nil
+	default:
+		return DeleteChecklistItemComment500JSONResponse{Message: err.Error()}, nil
+	}
+}
+
 func NewChecklistItemController(
 	service service.IChecklistItemsService,
+	sectionService service.IChecklistSectionService,
+	commentService service.IChecklistItemCommentService,
 ) IChecklistItemController {
 	return &checklistItemController{
-		service: service,
-		mapper:  NewChecklistItemMapper(),
+		service:        service,
+		sectionService: sectionService,
+		commentService: commentService,
+		mapper:         NewChecklistItemMapper(),
+		sectionMapper:  NewChecklistSectionDtoMapper(),
+		commentMapper:  NewChecklistItemCommentDtoMapper(),
 	}
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"com.raunlo.checklist/internal/core/domain"
 	service "com.raunlo.checklist/internal/core/service"
@@ -14,6 +15,9 @@ import (
 // Ensure mockChecklistItemsService implements the interface.
 var _ service.IChecklistItemsService = (*mockChecklistItemsService)(nil)
 
+// Ensure mockChecklistSectionService implements the interface.
+var _ service.IChecklistSectionService = (*mockChecklistSectionService)(nil)
+
 type mockChecklistItemsService struct {
 	mock.Mock
 }
@@ -56,7 +60,12 @@ func (m *mockChecklistItemsService) FindAllChecklistItems(ctx context.Context, c
 }
 
 func (m *mockChecklistItemsService) ChangeChecklistItemOrder(ctx context.Context, request domain.ChangeOrderRequest) (domain.ChangeOrderResponse, domain.Error) {
-	return domain.ChangeOrderResponse{}, nil
+	args := m.Called(ctx, request)
+	var err domain.Error
+	if arg := args.Get(1); arg != nil {
+		err = arg.(domain.Error)
+	}
+	return args.Get(0).(domain.ChangeOrderResponse), err
 }
 
 func (m *mockChecklistItemsService) ToggleCompleted(ctx context.Context, checklistId uint, itemId uint, completed bool) (domain.ChecklistItem, domain.Error) {
@@ -68,6 +77,69 @@ func (m *mockChecklistItemsService) ToggleCompleted(ctx context.Context, checkli
 	return args.Get(0).(domain.ChecklistItem), err
 }
 
+func (m *mockChecklistItemsService) MoveChecklistItem(ctx context.Context, request domain.ChecklistItemTransferRequest) (domain.ChecklistItem, domain.Error) {
+	args := m.Called(ctx, request)
+	var err domain.Error
+	if arg := args.Get(1); arg != nil {
+		err = arg.(domain.Error)
+	}
+	return args.Get(0).(domain.ChecklistItem), err
+}
+
+func (m *mockChecklistItemsService) CopyChecklistItem(ctx context.Context, request domain.ChecklistItemTransferRequest) (domain.ChecklistItem, domain.Error) {
+	args := m.Called(ctx, request)
+	var err domain.Error
+	if arg := args.Get(1); arg != nil {
+		err = arg.(domain.Error)
+	}
+	return args.Get(0).(domain.ChecklistItem), err
+}
+
+func (m *mockChecklistItemsService) ApplyBatch(ctx context.Context, checklistId uint, operations []domain.ChecklistItemBatchOperation) (domain.ChecklistItemBatchResult, domain.Error) {
+	args := m.Called(ctx, checklistId, operations)
+	var err domain.Error
+	if arg := args.Get(1); arg != nil {
+		err = arg.(domain.Error)
+	}
+	return args.Get(0).(domain.ChecklistItemBatchResult), err
+}
+
+func (m *mockChecklistItemsService) ClearCompletedItems(ctx context.Context, checklistId uint) (domain.ChecklistItemDeletionGroup, domain.Error) {
+	args := m.Called(ctx, checklistId)
+	var err domain.Error
+	if arg := args.Get(1); arg != nil {
+		err = arg.(domain.Error)
+	}
+	return args.Get(0).(domain.ChecklistItemDeletionGroup), err
+}
+
+func (m *mockChecklistItemsService) RestoreDeletionGroup(ctx context.Context, checklistId uint, groupId uint) (domain.ChecklistItemBatchResult, domain.Error) {
+	args := m.Called(ctx, checklistId, groupId)
+	var err domain.Error
+	if arg := args.Get(1); arg != nil {
+		err = arg.(domain.Error)
+	}
+	return args.Get(0).(domain.ChecklistItemBatchResult), err
+}
+
+func (m *mockChecklistItemsService) ResetChecklistItems(ctx context.Context, checklistId uint) (domain.ChecklistItemBatchResult, domain.Error) {
+	args := m.Called(ctx, checklistId)
+	var err domain.Error
+	if arg := args.Get(1); arg != nil {
+		err = arg.(domain.Error)
+	}
+	return args.Get(0).(domain.ChecklistItemBatchResult), err
+}
+
+func (m *mockChecklistItemsService) SortChecklistItems(ctx context.Context, request domain.ChecklistItemSortRequest) ([]domain.ChecklistItem, domain.Error) {
+	args := m.Called(ctx, request)
+	var err domain.Error
+	if arg := args.Get(1); arg != nil {
+		err = arg.(domain.Error)
+	}
+	return args.Get(0).([]domain.ChecklistItem), err
+}
+
 func (m *mockChecklistItemsService) RestoreChecklistItem(ctx context.Context, checklistId uint, itemId uint) (domain.ChecklistItem, domain.Error) {
 	args := m.Called(ctx, checklistId, itemId)
 	var err domain.Error
@@ -163,7 +235,7 @@ func TestChecklistItemController_RestoreChecklistItem_Success(t *testing.T) {
 		Id:        5,
 		Name:      "Restored Item",
 		Completed: false,
-		Position:  1.0,
+		Position:  "a0",
 		Rows: []domain.ChecklistItemRow{
 			{Id: 10, Name: "Subitem", Completed: false},
 		},
@@ -224,3 +296,273 @@ func TestChecklistItemController_RestoreChecklistItem_ServerError(t *testing.T)
 	}
 	svc.AssertExpectations(t)
 }
+
+func TestChecklistItemController_ToggleChecklistItemComplete_IncludesCompletionAudit(t *testing.T) {
+	completedBy := "user-1"
+	completedAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	svc := new(mockChecklistItemsService)
+	svc.On("ToggleCompleted", uint(1), uint(5), true).Return(domain.ChecklistItem{
+		Id:          5,
+		Name:        "Item",
+		Completed:   true,
+		CompletedBy: &completedBy,
+		CompletedAt: &completedAt,
+	}, nil)
+
+	controller := &checklistItemController{service: svc, mapper: NewChecklistItemMapper()}
+	req := ToggleChecklistItemCompleteRequestObject{ChecklistId: 1, ItemId: 5, Body: &ToggleChecklistItemCompleteJSONRequestBody{Completed: true}}
+	res, err := controller.ToggleChecklistItemComplete(createTestGinContext(), req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	dto, ok := res.(ToggleChecklistItemComplete200JSONResponse)
+	if !ok {
+		t.Fatalf("expected ToggleChecklistItemComplete200JSONResponse got %T", res)
+	}
+	if dto.CompletedBy == nil || *dto.CompletedBy != completedBy {
+		t.Fatalf("expected completedBy %s got %v", completedBy, dto.CompletedBy)
+	}
+	if dto.CompletedAt == nil || !dto.CompletedAt.Equal(completedAt) {
+		t.Fatalf("expected completedAt %v got %v", completedAt, dto.CompletedAt)
+	}
+	svc.AssertExpectations(t)
+}
+
+func TestChecklistItemController_BatchUpdateChecklistItems(t *testing.T) {
+	completed := true
+	operations := []domain.ChecklistItemBatchOperation{
+		{Type: domain.BatchOperationToggle, ItemId: 5, Completed: &completed},
+		{Type: domain.BatchOperationDelete, ItemId: 6},
+	}
+	svc := new(mockChecklistItemsService)
+	svc.On("ApplyBatch", mock.Anything, uint(1), operations).Return(domain.ChecklistItemBatchResult{
+		ChecklistId:    1,
+		Items:          []domain.ChecklistItem{{Id: 5, Name: "Item", Completed: true, OrderNumber: 1}},
+		DeletedItemIds: []uint{6},
+	}, nil)
+
+	controller := &checklistItemController{service: svc, mapper: NewChecklistItemMapper()}
+	req := BatchUpdateChecklistItemsRequestObject{ChecklistId: 1, Body: &BatchUpdateChecklistItemsJSONRequestBody{
+		Operations: []ChecklistItemBatchOperation{
+			{Type: "toggle", ItemId: 5, Completed: &completed},
+			{Type: DELETE, ItemId: 6},
+		},
+	}}
+	res, err := controller.BatchUpdateChecklistItems(createTestGinContext(), req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	dto, ok := res.(BatchUpdateChecklistItems200JSONResponse)
+	if !ok {
+		t.Fatalf("expected BatchUpdateChecklistItems200JSONResponse got %T", res)
+	}
+	if len(dto.Items) != 1 || dto.Items[0].Id != 5 || !dto.Items[0].Completed {
+		t.Fatalf("unexpected items %v", dto.Items)
+	}
+	if len(dto.DeletedItemIds) != 1 || dto.DeletedItemIds[0] != 6 {
+		t.Fatalf("unexpected deleted item ids %v", dto.DeletedItemIds)
+	}
+	svc.AssertExpectations(t)
+}
+
+func TestChecklistItemController_BatchUpdateChecklistItems_UnknownType(t *testing.T) {
+	svc := new(mockChecklistItemsService)
+
+	controller := &checklistItemController{service: svc, mapper: NewChecklistItemMapper()}
+	req := BatchUpdateChecklistItemsRequestObject{ChecklistId: 1, Body: &BatchUpdateChecklistItemsJSONRequestBody{
+		Operations: []ChecklistItemBatchOperation{{Type: "archive", ItemId: 5}},
+	}}
+	res, err := controller.BatchUpdateChecklistItems(createTestGinContext(), req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := res.(BatchUpdateChecklistItems400JSONResponse); !ok {
+		t.Fatalf("expected BatchUpdateChecklistItems400JSONResponse got %T", res)
+	}
+	svc.AssertNotCalled(t, "ApplyBatch", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestChecklistItemController_ClearCompletedChecklistItems(t *testing.T) {
+	svc := new(mockChecklistItemsService)
+	svc.On("ClearCompletedItems", mock.Anything, uint(1)).
+		Return(domain.ChecklistItemDeletionGroup{Id: 7, ChecklistId: 1, ItemIds: []uint{5, 6}}, nil)
+
+	controller := &checklistItemController{service: svc, mapper: NewChecklistItemMapper()}
+	res, err := controller.ClearCompletedChecklistItems(createTestGinContext(), ClearCompletedChecklistItemsRequestObject{ChecklistId: 1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	dto, ok := res.(ClearCompletedChecklistItems200JSONResponse)
+	if !ok {
+		t.Fatalf("expected ClearCompletedChecklistItems200JSONResponse got %T", res)
+	}
+	if dto.DeletionGroupId == nil || *dto.DeletionGroupId != 7 || len(dto.DeletedItemIds) != 2 {
+		t.Fatalf("unexpected response %+v", dto)
+	}
+	svc.AssertExpectations(t)
+}
+
+func TestChecklistItemController_ClearCompletedChecklistItems_NothingToClear(t *testing.T) {
+	svc := new(mockChecklistItemsService)
+	svc.On("ClearCompletedItems", mock.Anything, uint(1)).
+		Return(domain.ChecklistItemDeletionGroup{ChecklistId: 1, ItemIds: []uint{}}, nil)
+
+	controller := &checklistItemController{service: svc, mapper: NewChecklistItemMapper()}
+	res, err := controller.ClearCompletedChecklistItems(createTestGinContext(), ClearCompletedChecklistItemsRequestObject{ChecklistId: 1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	dto, ok := res.(ClearCompletedChecklistItems200JSONResponse)
+	if !ok {
+		t.Fatalf("expected ClearCompletedChecklistItems200JSONResponse got %T", res)
+	}
+	if dto.DeletionGroupId != nil || len(dto.DeletedItemIds) != 0 {
+		t.Fatalf("expected nothing to be cleared, got %+v", dto)
+	}
+}
+
+func TestChecklistItemController_RestoreChecklistItemDeletionGroup_NotFound(t *testing.T) {
+	svc := new(mockChecklistItemsService)
+	svc.On("RestoreDeletionGroup", mock.Anything, uint(1), uint(7)).
+		Return(domain.ChecklistItemBatchResult{}, domain.NewError("nothing left to restore", 404))
+
+	controller := &checklistItemController{service: svc, mapper: NewChecklistItemMapper()}
+	res, err := controller.RestoreChecklistItemDeletionGroup(createTestGinContext(), RestoreChecklistItemDeletionGroupRequestObject{ChecklistId: 1, GroupId: 7})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := res.(RestoreChecklistItemDeletionGroup404JSONResponse); !ok {
+		t.Fatalf("expected RestoreChecklistItemDeletionGroup404JSONResponse got %T", res)
+	}
+}
+
+func TestChecklistItemController_SortChecklistItems(t *testing.T) {
+	svc := new(mockChecklistItemsService)
+	expected := domain.ChecklistItemSortRequest{ChecklistId: 1, SortBy: domain.ItemSortByCreatedAt, SortOrder: domain.DescSort}
+	svc.On("SortChecklistItems", mock.Anything, expected).
+		Return([]domain.ChecklistItem{{Id: 3}, {Id: 2}, {Id: 1}}, nil)
+
+	direction := SortChecklistItemsRequestDirectionDesc
+	controller := &checklistItemController{service: svc, mapper: NewChecklistItemMapper()}
+	res, err := controller.SortChecklistItems(createTestGinContext(), SortChecklistItemsRequestObject{
+		ChecklistId: 1,
+		Body:        &SortChecklistItemsJSONRequestBody{SortBy: CREATEDAT, Direction: &direction},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	dto, ok := res.(SortChecklistItems200JSONResponse)
+	if !ok {
+		t.Fatalf("expected SortChecklistItems200JSONResponse got %T", res)
+	}
+	if len(dto) != 3 || dto[0].Id != 3 {
+		t.Fatalf("unexpected response %+v", dto)
+	}
+	svc.AssertExpectations(t)
+}
+
+type mockChecklistSectionService struct {
+	mock.Mock
+}
+
+func (m *mockChecklistSectionService) FindChecklistSections(ctx context.Context, checklistId uint) ([]domain.ChecklistSection, domain.Error) {
+	return nil, nil
+}
+
+func (m *mockChecklistSectionService) CreateChecklistSection(ctx context.Context, section domain.ChecklistSection) (domain.ChecklistSection, domain.Error) {
+	args := m.Called(section)
+	var err domain.Error
+	if arg := args.Get(1); arg != nil {
+		err = arg.(domain.Error)
+	}
+	return args.Get(0).(domain.ChecklistSection), err
+}
+
+func (m *mockChecklistSectionService) UpdateChecklistSection(ctx context.Context, section domain.ChecklistSection) (domain.ChecklistSection, domain.Error) {
+	return domain.ChecklistSection{}, nil
+}
+
+func (m *mockChecklistSectionService) DeleteChecklistSection(ctx context.Context, checklistId uint, sectionId uint) (domain.ChecklistSectionDeletionResult, domain.Error) {
+	args := m.Called(checklistId, sectionId)
+	var err domain.Error
+	if arg := args.Get(1); arg != nil {
+		err = arg.(domain.Error)
+	}
+	return args.Get(0).(domain.ChecklistSectionDeletionResult), err
+}
+
+func (m *mockChecklistSectionService) ChangeChecklistSectionOrder(ctx context.Context, request domain.ChangeSectionOrderRequest) (domain.ChecklistSection, domain.Error) {
+	return domain.ChecklistSection{}, nil
+}
+
+func TestChecklistItemController_ChangeChecklistItemOrderNumber_MovesBetweenSections(t *testing.T) {
+	targetSectionId := uint(4)
+	svc := new(mockChecklistItemsService)
+	svc.On("ChangeChecklistItemOrder", mock.Anything, domain.ChangeOrderRequest{
+		NewOrderNumber:  2,
+		ChecklistId:     1,
+		ChecklistItemId: 5,
+		SortOrder:       domain.AscSort,
+		SectionId:       &targetSectionId,
+	}).Return(domain.ChangeOrderResponse{OrderNumber: 2, ChecklistItemId: 5, ChecklistId: 1, SectionId: &targetSectionId}, nil)
+
+	controller := &checklistItemController{service: svc, mapper: NewChecklistItemMapper()}
+	req := ChangeChecklistItemOrderNumberRequestObject{
+		ChecklistId: 1,
+		ItemId:      5,
+		Body:        &ChangeChecklistItemOrderNumberJSONRequestBody{NewOrderNumber: 2, SectionId: &targetSectionId},
+	}
+	res, err := controller.ChangeChecklistItemOrderNumber(createTestGinContext(), req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	dto, ok := res.(ChangeChecklistItemOrderNumber200JSONResponse)
+	if !ok {
+		t.Fatalf("expected ChangeChecklistItemOrderNumber200JSONResponse got %T", res)
+	}
+	if dto.SectionId == nil || *dto.SectionId != targetSectionId {
+		t.Fatalf("expected section %d got %v", targetSectionId, dto.SectionId)
+	}
+	svc.AssertExpectations(t)
+}
+
+func TestChecklistItemController_CreateChecklistSection(t *testing.T) {
+	collapsed := true
+	sectionService := new(mockChecklistSectionService)
+	sectionService.On("CreateChecklistSection", domain.ChecklistSection{ChecklistId: 1, Name: "Dairy", Collapsed: true}).
+		Return(domain.ChecklistSection{Id: 3, ChecklistId: 1, Name: "Dairy", OrderNumber: 2, Collapsed: true}, nil)
+
+	controller := &checklistItemController{sectionService: sectionService, sectionMapper: NewChecklistSectionDtoMapper()}
+	req := CreateChecklistSectionRequestObject{
+		ChecklistId: 1,
+		Body:        &CreateChecklistSectionJSONRequestBody{Name: "Dairy", Collapsed: &collapsed},
+	}
+	res, err := controller.CreateChecklistSection(createTestGinContext(), req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	dto, ok := res.(CreateChecklistSection201JSONResponse)
+	if !ok {
+		t.Fatalf("expected CreateChecklistSection201JSONResponse got %T", res)
+	}
+	if dto.Id != 3 || dto.OrderNumber != 2 || !dto.Collapsed {
+		t.Fatalf("unexpected section %+v", dto)
+	}
+	sectionService.AssertExpectations(t)
+}
+
+func TestChecklistItemController_DeleteChecklistSection_NotFound(t *testing.T) {
+	sectionService := new(mockChecklistSectionService)
+	sectionService.On("DeleteChecklistSection", uint(1), uint(3)).
+		Return(domain.ChecklistSectionDeletionResult{}, domain.NewError("Checklist section(id=3) not found", 404))
+
+	controller := &checklistItemController{sectionService: sectionService, sectionMapper: NewChecklistSectionDtoMapper()}
+	res, err := controller.DeleteChecklistSection(createTestGinContext(), DeleteChecklistSectionRequestObject{ChecklistId: 1, SectionId: 3})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := res.(DeleteChecklistSection404JSONResponse); !ok {
+		t.Fatalf("expected DeleteChecklistSection404JSONResponse got %T", res)
+	}
+	sectionService.AssertExpectations(t)
+}
@@ -12,6 +12,9 @@ type IChecklistItemDtoMapper interface {
 	MapDomainListToDtoList(checklistItems []domain.ChecklistItem) []ChecklistItemResponse
 	MapCreateChecklistItemRowRequestToDomain(request CreateChecklistItemRowRequest) domain.ChecklistItemRow
 	MapChecklistItemRowDomainToDto(row domain.ChecklistItemRow) ChecklistItemRowResponse
+	MapBatchRequestToDomain(request ChecklistItemBatchRequest) ([]domain.ChecklistItemBatchOperation, domain.Error)
+	MapBatchResultToDto(result domain.ChecklistItemBatchResult) ChecklistItemBatchResponse
+	MapDeletionGroupToDto(group domain.ChecklistItemDeletionGroup) ClearCompletedChecklistItemsResponse
 }
 
 type checklistItemMapper struct{}
@@ -55,6 +58,41 @@ func (mapper *checklistItemMapper) MapChecklistItemRowDomainToDto(row domain.Che
 	return dto
 }
 
+func (mapper *checklistItemMapper) MapBatchRequestToDomain(request ChecklistItemBatchRequest) ([]domain.ChecklistItemBatchOperation, domain.Error) {
+	operations := make([]domain.ChecklistItemBatchOperation, len(request.Operations))
+	for index, operation := range request.Operations {
+		operationType, err := domain.NewChecklistItemBatchOperationType(string(operation.Type))
+		if err != nil {
+			return nil, err
+		}
+		operations[index] = domain.ChecklistItemBatchOperation{
+			Type:        operationType,
+			ItemId:      operation.ItemId,
+			Completed:   operation.Completed,
+			Name:        operation.Name,
+			OrderNumber: operation.OrderNumber,
+		}
+	}
+	return operations, nil
+}
+
+func (mapper *checklistItemMapper) MapBatchResultToDto(result domain.ChecklistItemBatchResult) ChecklistItemBatchResponse {
+	return ChecklistItemBatchResponse{
+		Items:          mapper.MapDomainListToDtoList(result.Items),
+		DeletedItemIds: append([]uint{}, result.DeletedItemIds...),
+	}
+}
+
+func (mapper *checklistItemMapper) MapDeletionGroupToDto(group domain.ChecklistItemDeletionGroup) ClearCompletedChecklistItemsResponse {
+	response := ClearCompletedChecklistItemsResponse{
+		DeletedItemIds: append([]uint{}, group.ItemIds...),
+	}
+	if group.Id != 0 {
+		response.DeletionGroupId = &group.Id
+	}
+	return response
+}
+
 func NewChecklistItemMapper() IChecklistItemDtoMapper {
 	return &checklistItemMapper{}
 }
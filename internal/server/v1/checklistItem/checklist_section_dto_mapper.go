@@ -0,0 +1,41 @@
+package checklistItem
+
+import (
+	"com.raunlo.checklist/internal/core/domain"
+)
+
+type IChecklistSectionDtoMapper interface {
+	ToDTO(section domain.ChecklistSection) ChecklistSectionResponse
+	ToDTOArray(sections []domain.ChecklistSection) []ChecklistSectionResponse
+	ToDeletionDTO(result domain.ChecklistSectionDeletionResult) ChecklistSectionDeletionResponse
+}
+
+type checklistSectionDtoMapper struct{}
+
+func NewChecklistSectionDtoMapper() IChecklistSectionDtoMapper {
+	return &checklistSectionDtoMapper{}
+}
+
+func (m *checklistSectionDtoMapper) ToDTO(section domain.ChecklistSection) ChecklistSectionResponse {
+	return ChecklistSectionResponse{
+		Id:          section.Id,
+		Name:        section.Name,
+		OrderNumber: section.OrderNumber,
+		Collapsed:   section.Collapsed,
+	}
+}
+
+func (m *checklistSectionDtoMapper) ToDTOArray(sections []domain.ChecklistSection) []ChecklistSectionResponse {
+	result := make([]ChecklistSectionResponse, 0, len(sections))
+	for _, section := range sections {
+		result = append(result, m.ToDTO(section))
+	}
+	return result
+}
+
+func (m *checklistSectionDtoMapper) ToDeletionDTO(result domain.ChecklistSectionDeletionResult) ChecklistSectionDeletionResponse {
+	return ChecklistSectionDeletionResponse{
+		SectionId: result.SectionId,
+		ItemIds:   append([]uint{}, result.ItemIds...),
+	}
+}
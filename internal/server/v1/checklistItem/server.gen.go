@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/oapi-codegen/runtime"
@@ -18,6 +19,27 @@ const (
 	CookieAuthScopes = "CookieAuth.Scopes"
 )
 
+// Defines values for ChecklistItemBatchOperationType.
+const (
+	DELETE  ChecklistItemBatchOperationType = "DELETE"
+	MOVE    ChecklistItemBatchOperationType = "MOVE"
+	RENAME  ChecklistItemBatchOperationType = "RENAME"
+	RESTORE ChecklistItemBatchOperationType = "RESTORE"
+	TOGGLE  ChecklistItemBatchOperationType = "TOGGLE"
+)
+
+// Defines values for SortChecklistItemsRequestDirection.
+const (
+	SortChecklistItemsRequestDirectionAsc  SortChecklistItemsRequestDirection = "asc"
+	SortChecklistItemsRequestDirectionDesc SortChecklistItemsRequestDirection = "desc"
+)
+
+// Defines values for SortChecklistItemsRequestSortBy.
+const (
+	CREATEDAT SortChecklistItemsRequestSortBy = "CREATED_AT"
+	NAME      SortChecklistItemsRequestSortBy = "NAME"
+)
+
 // Defines values for GetAllChecklistItemsParamsSort.
 const (
 	GetAllChecklistItemsParamsSortAsc  GetAllChecklistItemsParamsSort = "asc"
@@ -26,24 +48,149 @@ const (
 
 // Defines values for ChangeChecklistItemOrderNumberParamsSortOrder.
 const (
-	ChangeChecklistItemOrderNumberParamsSortOrderAsc  ChangeChecklistItemOrderNumberParamsSortOrder = "asc"
-	ChangeChecklistItemOrderNumberParamsSortOrderDesc ChangeChecklistItemOrderNumberParamsSortOrder = "desc"
+	Asc  ChangeChecklistItemOrderNumberParamsSortOrder = "asc"
+	Desc ChangeChecklistItemOrderNumberParamsSortOrder = "desc"
 )
 
+// ChangeChecklistSectionOrderRequest defines model for ChangeChecklistSectionOrderRequest.
+type ChangeChecklistSectionOrderRequest struct {
+	// NewOrderNumber New order number (1-10000)
+	NewOrderNumber uint `json:"newOrderNumber"`
+}
+
+// ChecklistItemBatchOperation defines model for ChecklistItemBatchOperation.
+type ChecklistItemBatchOperation struct {
+	// Completed New completion status, required for TOGGLE
+	Completed *bool `json:"completed,omitempty"`
+	ItemId    uint  `json:"itemId"`
+
+	// Name New item name, required for RENAME
+	Name *string `json:"name,omitempty"`
+
+	// OrderNumber Target order number, required for MOVE
+	OrderNumber *uint                           `json:"orderNumber,omitempty"`
+	Type        ChecklistItemBatchOperationType `json:"type"`
+}
+
+// ChecklistItemBatchOperationType defines model for ChecklistItemBatchOperationType.
+type ChecklistItemBatchOperationType string
+
+// ChecklistItemBatchRequest defines model for ChecklistItemBatchRequest.
+type ChecklistItemBatchRequest struct {
+	Operations []ChecklistItemBatchOperation `json:"operations"`
+}
+
+// ChecklistItemBatchResponse defines model for ChecklistItemBatchResponse.
+type ChecklistItemBatchResponse struct {
+	DeletedItemIds []uint                  `json:"deletedItemIds"`
+	Items          []ChecklistItemResponse `json:"items"`
+}
+
+// ChecklistItemCommentMentionResponse defines model for ChecklistItemCommentMentionResponse.
+type ChecklistItemCommentMentionResponse struct {
+	Name   *string `json:"name"`
+	UserId string  `json:"userId"`
+}
+
+// ChecklistItemCommentResponse defines model for ChecklistItemCommentResponse.
+type ChecklistItemCommentResponse struct {
+	// Author User id of the author
+	Author     string  `json:"author"`
+	AuthorName *string `json:"authorName"`
+
+	// Content Text of the comment (empty when deleted)
+	Content   string    `json:"content"`
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Deleted True for a deleted comment that is kept because it still has replies
+	Deleted bool `json:"deleted"`
+
+	// EditedAt When the author last edited the comment (null when never edited)
+	EditedAt *time.Time                            `json:"editedAt"`
+	Id       uint                                  `json:"id"`
+	ItemId   uint                                  `json:"itemId"`
+	Mentions []ChecklistItemCommentMentionResponse `json:"mentions"`
+
+	// ParentId Comment this comment replies to (null when it starts a thread)
+	ParentId *uint `json:"parentId"`
+}
+
 // ChecklistItemResponse defines model for ChecklistItemResponse.
 type ChecklistItemResponse struct {
-	Completed   bool                       `json:"completed"`
-	Id          uint                       `json:"id"`
-	Name        string                     `json:"name"`
+	Completed bool `json:"completed"`
+
+	// CompletedAt When the item was marked as completed (null when not completed)
+	CompletedAt *time.Time `json:"completedAt"`
+
+	// CompletedBy User who marked the item as completed (null when not completed)
+	CompletedBy *string `json:"completedBy"`
+	Id          uint    `json:"id"`
+	Name        string  `json:"name"`
+
+	// Notes Markdown notes of the item (null when the item has no notes)
+	Notes       *string                    `json:"notes"`
 	OrderNumber uint                       `json:"orderNumber"`
 	Rows        []ChecklistItemRowResponse `json:"rows"`
+
+	// SectionId Section the item belongs to (null when it belongs to no section). Order numbers count within the section.
+	SectionId *uint `json:"sectionId"`
 }
 
 // ChecklistItemRowResponse defines model for ChecklistItemRowResponse.
 type ChecklistItemRowResponse struct {
-	Completed *bool  `json:"completed"`
-	Id        uint   `json:"id"`
-	Name      string `json:"name"`
+	Completed *bool `json:"completed"`
+
+	// CompletedAt When the row was marked as completed (null when not completed)
+	CompletedAt *time.Time `json:"completedAt"`
+
+	// CompletedBy User who marked the row as completed (null when not completed)
+	CompletedBy *string `json:"completedBy"`
+	Id          uint    `json:"id"`
+	Name        string  `json:"name"`
+}
+
+// ChecklistItemTransferRequest defines model for ChecklistItemTransferRequest.
+type ChecklistItemTransferRequest struct {
+	// OrderNumber Position within the item's completion section in the target checklist (null = front)
+	OrderNumber *uint `json:"orderNumber"`
+
+	// TargetChecklistId Checklist the item is moved or copied into
+	TargetChecklistId uint `json:"targetChecklistId"`
+}
+
+// ChecklistSectionDeletionResponse defines model for ChecklistSectionDeletionResponse.
+type ChecklistSectionDeletionResponse struct {
+	// ItemIds Items that were moved out of the section and now belong to no section
+	ItemIds   []uint `json:"itemIds"`
+	SectionId uint   `json:"sectionId"`
+}
+
+// ChecklistSectionResponse defines model for ChecklistSectionResponse.
+type ChecklistSectionResponse struct {
+	Collapsed   bool   `json:"collapsed"`
+	Id          uint   `json:"id"`
+	Name        string `json:"name"`
+	OrderNumber uint   `json:"orderNumber"`
+}
+
+// ClearCompletedChecklistItemsResponse defines model for ClearCompletedChecklistItemsResponse.
+type ClearCompletedChecklistItemsResponse struct {
+	DeletedItemIds []uint `json:"deletedItemIds"`
+
+	// DeletionGroupId Group to restore to undo the clear (null when there was nothing to clear)
+	DeletionGroupId *uint `json:"deletionGroupId"`
+}
+
+// CreateChecklistItemCommentRequest defines model for CreateChecklistItemCommentRequest.
+type CreateChecklistItemCommentRequest struct {
+	// Content Text of the comment (1-2000 characters)
+	Content string `json:"content"`
+
+	// MentionedUserIds Users with access to the checklist that are mentioned in the comment
+	MentionedUserIds *[]string `json:"mentionedUserIds,omitempty"`
+
+	// ParentId Comment to reply to (starts a new thread when omitted)
+	ParentId *uint `json:"parentId"`
 }
 
 // CreateChecklistItemRequest defines model for CreateChecklistItemRequest.
@@ -51,13 +198,27 @@ type CreateChecklistItemRequest struct {
 	// Name Checklist item name (1-500 characters)
 	Name string `json:"name"`
 
+	// Notes Optional markdown notes of the item (up to 10000 characters)
+	Notes *string `json:"notes"`
+
 	// Rows Checklist item rows (max 100 rows per item)
 	Rows *[]CreateOrUpdateChecklistItemRowRequest `json:"rows,omitempty"`
+
+	// SectionId Section to add the item to (no section when omitted)
+	SectionId *uint `json:"sectionId"`
 }
 
 // CreateChecklistItemRowRequest defines model for CreateChecklistItemRowRequest.
 type CreateChecklistItemRowRequest = CreateOrUpdateChecklistItemRowRequest
 
+// CreateChecklistSectionRequest defines model for CreateChecklistSectionRequest.
+type CreateChecklistSectionRequest struct {
+	Collapsed *bool `json:"collapsed,omitempty"`
+
+	// Name Section name (1-200 characters)
+	Name string `json:"name"`
+}
+
 // CreateOrUpdateChecklistItemRowRequest defines model for CreateOrUpdateChecklistItemRowRequest.
 type CreateOrUpdateChecklistItemRowRequest struct {
 	Completed *bool `json:"completed"`
@@ -71,6 +232,40 @@ type Error struct {
 	Message string `json:"message"`
 }
 
+// SortChecklistItemsRequest defines model for SortChecklistItemsRequest.
+type SortChecklistItemsRequest struct {
+	Direction *SortChecklistItemsRequestDirection `json:"direction,omitempty"`
+
+	// Locale BCP 47 language tag, such as "en" or "de-AT", whose collation NAME sorts with
+	Locale *string `json:"locale,omitempty"`
+
+	// SortBy NAME sorts with the ICU collation of `locale`, or of its language when the server has no collation
+	// for the full tag. Without a locale, or when neither is available, the ICU root collation
+	// (`und-x-icu`) is used; a database server built without ICU falls back to its default collation.
+	// CREATED_AT sorts by item id, which follows the order items were created in; items moved or merged
+	// from another checklist keep their original id and so their original place in that order.
+	SortBy SortChecklistItemsRequestSortBy `json:"sortBy"`
+}
+
+// SortChecklistItemsRequestDirection defines model for SortChecklistItemsRequest.Direction.
+type SortChecklistItemsRequestDirection string
+
+// SortChecklistItemsRequestSortBy NAME sorts with the ICU collation of `locale`, or of its language when the server has no collation
+// for the full tag. Without a locale, or when neither is available, the ICU root collation
+// (`und-x-icu`) is used; a database server built without ICU falls back to its default collation.
+// CREATED_AT sorts by item id, which follows the order items were created in; items moved or merged
+// from another checklist keep their original id and so their original place in that order.
+type SortChecklistItemsRequestSortBy string
+
+// UpdateChecklistItemCommentRequest defines model for UpdateChecklistItemCommentRequest.
+type UpdateChecklistItemCommentRequest struct {
+	// Content Text of the comment (1-2000 characters)
+	Content string `json:"content"`
+
+	// MentionedUserIds Users with access to the checklist that are mentioned in the comment. Replaces the previous mentions.
+	MentionedUserIds *[]string `json:"mentionedUserIds,omitempty"`
+}
+
 // UpdateChecklistItemRequest defines model for UpdateChecklistItemRequest.
 type UpdateChecklistItemRequest struct {
 	Completed bool `json:"completed"`
@@ -79,6 +274,9 @@ type UpdateChecklistItemRequest struct {
 	// Name Checklist item name (1-500 characters)
 	Name string `json:"name"`
 
+	// Notes Optional markdown notes of the item (up to 10000 characters). Omitting them keeps the notes, an empty string clears them.
+	Notes *string `json:"notes"`
+
 	// Rows Checklist item rows (max 100 rows per item)
 	Rows []struct {
 		Completed *bool `json:"completed"`
@@ -89,6 +287,14 @@ type UpdateChecklistItemRequest struct {
 	} `json:"rows"`
 }
 
+// UpdateChecklistSectionRequest defines model for UpdateChecklistSectionRequest.
+type UpdateChecklistSectionRequest struct {
+	Collapsed bool `json:"collapsed"`
+
+	// Name Section name (1-200 characters)
+	Name string `json:"name"`
+}
+
 // XClientId defines model for X-Client-Id.
 type XClientId = string
 
@@ -113,6 +319,36 @@ type CreateChecklistItemParams struct {
 	XClientId *XClientId `json:"X-Client-Id,omitempty"`
 }
 
+// BatchUpdateChecklistItemsParams defines parameters for BatchUpdateChecklistItems.
+type BatchUpdateChecklistItemsParams struct {
+	// XClientId Client identifier sent by frontend in headers
+	XClientId *XClientId `json:"X-Client-Id,omitempty"`
+}
+
+// ClearCompletedChecklistItemsParams defines parameters for ClearCompletedChecklistItems.
+type ClearCompletedChecklistItemsParams struct {
+	// XClientId Client identifier sent by frontend in headers
+	XClientId *XClientId `json:"X-Client-Id,omitempty"`
+}
+
+// RestoreChecklistItemDeletionGroupParams defines parameters for RestoreChecklistItemDeletionGroup.
+type RestoreChecklistItemDeletionGroupParams struct {
+	// XClientId Client identifier sent by frontend in headers
+	XClientId *XClientId `json:"X-Client-Id,omitempty"`
+}
+
+// ResetChecklistItemsParams defines parameters for ResetChecklistItems.
+type ResetChecklistItemsParams struct {
+	// XClientId Client identifier sent by frontend in headers
+	XClientId *XClientId `json:"X-Client-Id,omitempty"`
+}
+
+// SortChecklistItemsParams defines parameters for SortChecklistItems.
+type SortChecklistItemsParams struct {
+	// XClientId Client identifier sent by frontend in headers
+	XClientId *XClientId `json:"X-Client-Id,omitempty"`
+}
+
 // DeleteChecklistItemByIdParams defines parameters for DeleteChecklistItemById.
 type DeleteChecklistItemByIdParams struct {
 	// XClientId Client identifier sent by frontend in headers
@@ -133,8 +369,11 @@ type UpdateChecklistItemBychecklistIdAndItemIdParams struct {
 
 // ChangeChecklistItemOrderNumberJSONBody defines parameters for ChangeChecklistItemOrderNumber.
 type ChangeChecklistItemOrderNumberJSONBody struct {
-	// NewOrderNumber New order number (1-10000)
+	// NewOrderNumber New order number (1-10000) within the section the item ends up in
 	NewOrderNumber uint `json:"newOrderNumber"`
+
+	// SectionId Section to move the item to. Omit to keep the current section, 0 moves the item out of all sections.
+	SectionId *uint `json:"sectionId"`
 }
 
 // ChangeChecklistItemOrderNumberParams defines parameters for ChangeChecklistItemOrderNumber.
@@ -148,6 +387,42 @@ type ChangeChecklistItemOrderNumberParams struct {
 // ChangeChecklistItemOrderNumberParamsSortOrder defines parameters for ChangeChecklistItemOrderNumber.
 type ChangeChecklistItemOrderNumberParamsSortOrder string
 
+// GetChecklistItemCommentsParams defines parameters for GetChecklistItemComments.
+type GetChecklistItemCommentsParams struct {
+	// XClientId Client identifier sent by frontend in headers
+	XClientId *XClientId `json:"X-Client-Id,omitempty"`
+}
+
+// CreateChecklistItemCommentParams defines parameters for CreateChecklistItemComment.
+type CreateChecklistItemCommentParams struct {
+	// XClientId Client identifier sent by frontend in headers
+	XClientId *XClientId `json:"X-Client-Id,omitempty"`
+}
+
+// DeleteChecklistItemCommentParams defines parameters for DeleteChecklistItemComment.
+type DeleteChecklistItemCommentParams struct {
+	// XClientId Client identifier sent by frontend in headers
+	XClientId *XClientId `json:"X-Client-Id,omitempty"`
+}
+
+// UpdateChecklistItemCommentParams defines parameters for UpdateChecklistItemComment.
+type UpdateChecklistItemCommentParams struct {
+	// XClientId Client identifier sent by frontend in headers
+	XClientId *XClientId `json:"X-Client-Id,omitempty"`
+}
+
+// CopyChecklistItemParams defines parameters for CopyChecklistItem.
+type CopyChecklistItemParams struct {
+	// XClientId Client identifier sent by frontend in headers
+	XClientId *XClientId `json:"X-Client-Id,omitempty"`
+}
+
+// MoveChecklistItemParams defines parameters for MoveChecklistItem.
+type MoveChecklistItemParams struct {
+	// XClientId Client identifier sent by frontend in headers
+	XClientId *XClientId `json:"X-Client-Id,omitempty"`
+}
+
 // RestoreChecklistItemParams defines parameters for RestoreChecklistItem.
 type RestoreChecklistItemParams struct {
 	// XClientId Client identifier sent by frontend in headers
@@ -178,21 +453,78 @@ type ToggleChecklistItemCompleteParams struct {
 	XClientId *XClientId `json:"X-Client-Id,omitempty"`
 }
 
+// GetChecklistSectionsParams defines parameters for GetChecklistSections.
+type GetChecklistSectionsParams struct {
+	// XClientId Client identifier sent by frontend in headers
+	XClientId *XClientId `json:"X-Client-Id,omitempty"`
+}
+
+// CreateChecklistSectionParams defines parameters for CreateChecklistSection.
+type CreateChecklistSectionParams struct {
+	// XClientId Client identifier sent by frontend in headers
+	XClientId *XClientId `json:"X-Client-Id,omitempty"`
+}
+
+// DeleteChecklistSectionParams defines parameters for DeleteChecklistSection.
+type DeleteChecklistSectionParams struct {
+	// XClientId Client identifier sent by frontend in headers
+	XClientId *XClientId `json:"X-Client-Id,omitempty"`
+}
+
+// UpdateChecklistSectionParams defines parameters for UpdateChecklistSection.
+type UpdateChecklistSectionParams struct {
+	// XClientId Client identifier sent by frontend in headers
+	XClientId *XClientId `json:"X-Client-Id,omitempty"`
+}
+
+// ChangeChecklistSectionOrderNumberParams defines parameters for ChangeChecklistSectionOrderNumber.
+type ChangeChecklistSectionOrderNumberParams struct {
+	// XClientId Client identifier sent by frontend in headers
+	XClientId *XClientId `json:"X-Client-Id,omitempty"`
+}
+
 // CreateChecklistItemJSONRequestBody defines body for CreateChecklistItem for application/json ContentType.
 type CreateChecklistItemJSONRequestBody = CreateChecklistItemRequest
 
+// BatchUpdateChecklistItemsJSONRequestBody defines body for BatchUpdateChecklistItems for application/json ContentType.
+type BatchUpdateChecklistItemsJSONRequestBody = ChecklistItemBatchRequest
+
+// SortChecklistItemsJSONRequestBody defines body for SortChecklistItems for application/json ContentType.
+type SortChecklistItemsJSONRequestBody = SortChecklistItemsRequest
+
 // UpdateChecklistItemBychecklistIdAndItemIdJSONRequestBody defines body for UpdateChecklistItemBychecklistIdAndItemId for application/json ContentType.
 type UpdateChecklistItemBychecklistIdAndItemIdJSONRequestBody = UpdateChecklistItemRequest
 
 // ChangeChecklistItemOrderNumberJSONRequestBody defines body for ChangeChecklistItemOrderNumber for application/json ContentType.
 type ChangeChecklistItemOrderNumberJSONRequestBody ChangeChecklistItemOrderNumberJSONBody
 
+// CreateChecklistItemCommentJSONRequestBody defines body for CreateChecklistItemComment for application/json ContentType.
+type CreateChecklistItemCommentJSONRequestBody = CreateChecklistItemCommentRequest
+
+// UpdateChecklistItemCommentJSONRequestBody defines body for UpdateChecklistItemComment for application/json ContentType.
+type UpdateChecklistItemCommentJSONRequestBody = UpdateChecklistItemCommentRequest
+
+// CopyChecklistItemJSONRequestBody defines body for CopyChecklistItem for application/json ContentType.
+type CopyChecklistItemJSONRequestBody = ChecklistItemTransferRequest
+
+// MoveChecklistItemJSONRequestBody defines body for MoveChecklistItem for application/json ContentType.
+type MoveChecklistItemJSONRequestBody = ChecklistItemTransferRequest
+
 // CreateChecklistItemRowJSONRequestBody defines body for CreateChecklistItemRow for application/json ContentType.
 type CreateChecklistItemRowJSONRequestBody = CreateChecklistItemRowRequest
 
 // ToggleChecklistItemCompleteJSONRequestBody defines body for ToggleChecklistItemComplete for application/json ContentType.
 type ToggleChecklistItemCompleteJSONRequestBody ToggleChecklistItemCompleteJSONBody
 
+// CreateChecklistSectionJSONRequestBody defines body for CreateChecklistSection for application/json ContentType.
+type CreateChecklistSectionJSONRequestBody = CreateChecklistSectionRequest
+
+// UpdateChecklistSectionJSONRequestBody defines body for UpdateChecklistSection for application/json ContentType.
+type UpdateChecklistSectionJSONRequestBody = UpdateChecklistSectionRequest
+
+// ChangeChecklistSectionOrderNumberJSONRequestBody defines body for ChangeChecklistSectionOrderNumber for application/json ContentType.
+type ChangeChecklistSectionOrderNumberJSONRequestBody = ChangeChecklistSectionOrderRequest
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 	// Get all checklist items by checklist ID
@@ -201,6 +533,21 @@ type ServerInterface interface {
 	// Create a new checklist item
 	// (POST /api/v1/checklists/{checklistId}/items)
 	CreateChecklistItem(c *gin.Context, checklistId uint, params CreateChecklistItemParams)
+	// Apply several checklist item operations at once
+	// (POST /api/v1/checklists/{checklistId}/items/batch)
+	BatchUpdateChecklistItems(c *gin.Context, checklistId uint, params BatchUpdateChecklistItemsParams)
+	// Clear all completed checklist items
+	// (POST /api/v1/checklists/{checklistId}/items/clear-completed)
+	ClearCompletedChecklistItems(c *gin.Context, checklistId uint, params ClearCompletedChecklistItemsParams)
+	// Restore a group of cleared checklist items
+	// (POST /api/v1/checklists/{checklistId}/items/deletion-groups/{groupId}/restore)
+	RestoreChecklistItemDeletionGroup(c *gin.Context, checklistId uint, groupId uint, params RestoreChecklistItemDeletionGroupParams)
+	// Reset all checklist items
+	// (POST /api/v1/checklists/{checklistId}/items/reset)
+	ResetChecklistItems(c *gin.Context, checklistId uint, params ResetChecklistItemsParams)
+	// Sort all checklist items
+	// (POST /api/v1/checklists/{checklistId}/items/sort)
+	SortChecklistItems(c *gin.Context, checklistId uint, params SortChecklistItemsParams)
 	// Delete checklist item by checklistId and checklistItemId
 	// (DELETE /api/v1/checklists/{checklistId}/items/{itemId})
 	DeleteChecklistItemById(c *gin.Context, checklistId uint, itemId uint, params DeleteChecklistItemByIdParams)
@@ -213,6 +560,24 @@ type ServerInterface interface {
 	// Change checklist item order number
 	// (PATCH /api/v1/checklists/{checklistId}/items/{itemId}/change-order)
 	ChangeChecklistItemOrderNumber(c *gin.Context, checklistId uint, itemId uint, params ChangeChecklistItemOrderNumberParams)
+	// Get the comments of a checklist item
+	// (GET /api/v1/checklists/{checklistId}/items/{itemId}/comments)
+	GetChecklistItemComments(c *gin.Context, checklistId uint, itemId uint, params GetChecklistItemCommentsParams)
+	// Comment on a checklist item or reply to a comment
+	// (POST /api/v1/checklists/{checklistId}/items/{itemId}/comments)
+	CreateChecklistItemComment(c *gin.Context, checklistId uint, itemId uint, params CreateChecklistItemCommentParams)
+	// Delete a comment
+	// (DELETE /api/v1/checklists/{checklistId}/items/{itemId}/comments/{commentId})
+	DeleteChecklistItemComment(c *gin.Context, checklistId uint, itemId uint, commentId uint, params DeleteChecklistItemCommentParams)
+	// Edit a comment
+	// (PUT /api/v1/checklists/{checklistId}/items/{itemId}/comments/{commentId})
+	UpdateChecklistItemComment(c *gin.Context, checklistId uint, itemId uint, commentId uint, params UpdateChecklistItemCommentParams)
+	// Copy checklist item to another checklist
+	// (POST /api/v1/checklists/{checklistId}/items/{itemId}/copy)
+	CopyChecklistItem(c *gin.Context, checklistId uint, itemId uint, params CopyChecklistItemParams)
+	// Move checklist item to another checklist
+	// (POST /api/v1/checklists/{checklistId}/items/{itemId}/move)
+	MoveChecklistItem(c *gin.Context, checklistId uint, itemId uint, params MoveChecklistItemParams)
 	// Restore a soft-deleted checklist item (undo delete)
 	// (POST /api/v1/checklists/{checklistId}/items/{itemId}/restore)
 	RestoreChecklistItem(c *gin.Context, checklistId uint, itemId uint, params RestoreChecklistItemParams)
@@ -225,6 +590,21 @@ type ServerInterface interface {
 	// Toggle checklist item completion status
 	// (PATCH /api/v1/checklists/{checklistId}/items/{itemId}/toggle-complete)
 	ToggleChecklistItemComplete(c *gin.Context, checklistId uint, itemId uint, params ToggleChecklistItemCompleteParams)
+	// Get the sections of a checklist
+	// (GET /api/v1/checklists/{checklistId}/sections)
+	GetChecklistSections(c *gin.Context, checklistId uint, params GetChecklistSectionsParams)
+	// Create a checklist section
+	// (POST /api/v1/checklists/{checklistId}/sections)
+	CreateChecklistSection(c *gin.Context, checklistId uint, params CreateChecklistSectionParams)
+	// Delete a checklist section
+	// (DELETE /api/v1/checklists/{checklistId}/sections/{sectionId})
+	DeleteChecklistSection(c *gin.Context, checklistId uint, sectionId uint, params DeleteChecklistSectionParams)
+	// Rename, collapse or expand a checklist section
+	// (PUT /api/v1/checklists/{checklistId}/sections/{sectionId})
+	UpdateChecklistSection(c *gin.Context, checklistId uint, sectionId uint, params UpdateChecklistSectionParams)
+	// Change checklist section order number
+	// (PATCH /api/v1/checklists/{checklistId}/sections/{sectionId}/change-order)
+	ChangeChecklistSectionOrderNumber(c *gin.Context, checklistId uint, sectionId uint, params ChangeChecklistSectionOrderNumberParams)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -352,8 +732,8 @@ func (siw *ServerInterfaceWrapper) CreateChecklistItem(c *gin.Context) {
 	siw.Handler.CreateChecklistItem(c, checklistId, params)
 }
 
-// DeleteChecklistItemById operation middleware
-func (siw *ServerInterfaceWrapper) DeleteChecklistItemById(c *gin.Context) {
+// BatchUpdateChecklistItems operation middleware
+func (siw *ServerInterfaceWrapper) BatchUpdateChecklistItems(c *gin.Context) {
 
 	var err error
 
@@ -366,19 +746,10 @@ func (siw *ServerInterfaceWrapper) DeleteChecklistItemById(c *gin.Context) {
 		return
 	}
 
-	// ------------- Path parameter "itemId" -------------
-	var itemId uint
-
-	err = runtime.BindStyledParameterWithOptions("simple", "itemId", c.Param("itemId"), &itemId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter itemId: %w", err), http.StatusBadRequest)
-		return
-	}
-
 	c.Set(CookieAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params DeleteChecklistItemByIdParams
+	var params BatchUpdateChecklistItemsParams
 
 	headers := c.Request.Header
 
@@ -408,11 +779,11 @@ func (siw *ServerInterfaceWrapper) DeleteChecklistItemById(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.DeleteChecklistItemById(c, checklistId, itemId, params)
+	siw.Handler.BatchUpdateChecklistItems(c, checklistId, params)
 }
 
-// GetChecklistItemBychecklistIdAndItemId operation middleware
-func (siw *ServerInterfaceWrapper) GetChecklistItemBychecklistIdAndItemId(c *gin.Context) {
+// ClearCompletedChecklistItems operation middleware
+func (siw *ServerInterfaceWrapper) ClearCompletedChecklistItems(c *gin.Context) {
 
 	var err error
 
@@ -425,19 +796,10 @@ func (siw *ServerInterfaceWrapper) GetChecklistItemBychecklistIdAndItemId(c *gin
 		return
 	}
 
-	// ------------- Path parameter "itemId" -------------
-	var itemId uint
-
-	err = runtime.BindStyledParameterWithOptions("simple", "itemId", c.Param("itemId"), &itemId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter itemId: %w", err), http.StatusBadRequest)
-		return
-	}
-
 	c.Set(CookieAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetChecklistItemBychecklistIdAndItemIdParams
+	var params ClearCompletedChecklistItemsParams
 
 	headers := c.Request.Header
 
@@ -467,11 +829,11 @@ func (siw *ServerInterfaceWrapper) GetChecklistItemBychecklistIdAndItemId(c *gin
 		}
 	}
 
-	siw.Handler.GetChecklistItemBychecklistIdAndItemId(c, checklistId, itemId, params)
+	siw.Handler.ClearCompletedChecklistItems(c, checklistId, params)
 }
 
-// UpdateChecklistItemBychecklistIdAndItemId operation middleware
-func (siw *ServerInterfaceWrapper) UpdateChecklistItemBychecklistIdAndItemId(c *gin.Context) {
+// RestoreChecklistItemDeletionGroup operation middleware
+func (siw *ServerInterfaceWrapper) RestoreChecklistItemDeletionGroup(c *gin.Context) {
 
 	var err error
 
@@ -484,19 +846,19 @@ func (siw *ServerInterfaceWrapper) UpdateChecklistItemBychecklistIdAndItemId(c *
 		return
 	}
 
-	// ------------- Path parameter "itemId" -------------
-	var itemId uint
+	// ------------- Path parameter "groupId" -------------
+	var groupId uint
 
-	err = runtime.BindStyledParameterWithOptions("simple", "itemId", c.Param("itemId"), &itemId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "groupId", c.Param("groupId"), &groupId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter itemId: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter groupId: %w", err), http.StatusBadRequest)
 		return
 	}
 
 	c.Set(CookieAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params UpdateChecklistItemBychecklistIdAndItemIdParams
+	var params RestoreChecklistItemDeletionGroupParams
 
 	headers := c.Request.Header
 
@@ -526,11 +888,11 @@ func (siw *ServerInterfaceWrapper) UpdateChecklistItemBychecklistIdAndItemId(c *
 		}
 	}
 
-	siw.Handler.UpdateChecklistItemBychecklistIdAndItemId(c, checklistId, itemId, params)
+	siw.Handler.RestoreChecklistItemDeletionGroup(c, checklistId, groupId, params)
 }
 
-// ChangeChecklistItemOrderNumber operation middleware
-func (siw *ServerInterfaceWrapper) ChangeChecklistItemOrderNumber(c *gin.Context) {
+// ResetChecklistItems operation middleware
+func (siw *ServerInterfaceWrapper) ResetChecklistItems(c *gin.Context) {
 
 	var err error
 
@@ -543,27 +905,10 @@ func (siw *ServerInterfaceWrapper) ChangeChecklistItemOrderNumber(c *gin.Context
 		return
 	}
 
-	// ------------- Path parameter "itemId" -------------
-	var itemId uint
-
-	err = runtime.BindStyledParameterWithOptions("simple", "itemId", c.Param("itemId"), &itemId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter itemId: %w", err), http.StatusBadRequest)
-		return
-	}
-
 	c.Set(CookieAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ChangeChecklistItemOrderNumberParams
-
-	// ------------- Optional query parameter "sortOrder" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "sortOrder", c.Request.URL.Query(), &params.SortOrder)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sortOrder: %w", err), http.StatusBadRequest)
-		return
-	}
+	var params ResetChecklistItemsParams
 
 	headers := c.Request.Header
 
@@ -593,11 +938,11 @@ func (siw *ServerInterfaceWrapper) ChangeChecklistItemOrderNumber(c *gin.Context
 		}
 	}
 
-	siw.Handler.ChangeChecklistItemOrderNumber(c, checklistId, itemId, params)
+	siw.Handler.ResetChecklistItems(c, checklistId, params)
 }
 
-// RestoreChecklistItem operation middleware
-func (siw *ServerInterfaceWrapper) RestoreChecklistItem(c *gin.Context) {
+// SortChecklistItems operation middleware
+func (siw *ServerInterfaceWrapper) SortChecklistItems(c *gin.Context) {
 
 	var err error
 
@@ -610,19 +955,10 @@ func (siw *ServerInterfaceWrapper) RestoreChecklistItem(c *gin.Context) {
 		return
 	}
 
-	// ------------- Path parameter "itemId" -------------
-	var itemId uint
-
-	err = runtime.BindStyledParameterWithOptions("simple", "itemId", c.Param("itemId"), &itemId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter itemId: %w", err), http.StatusBadRequest)
-		return
-	}
-
 	c.Set(CookieAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params RestoreChecklistItemParams
+	var params SortChecklistItemsParams
 
 	headers := c.Request.Header
 
@@ -652,11 +988,11 @@ func (siw *ServerInterfaceWrapper) RestoreChecklistItem(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.RestoreChecklistItem(c, checklistId, itemId, params)
+	siw.Handler.SortChecklistItems(c, checklistId, params)
 }
 
-// CreateChecklistItemRow operation middleware
-func (siw *ServerInterfaceWrapper) CreateChecklistItemRow(c *gin.Context) {
+// DeleteChecklistItemById operation middleware
+func (siw *ServerInterfaceWrapper) DeleteChecklistItemById(c *gin.Context) {
 
 	var err error
 
@@ -681,7 +1017,7 @@ func (siw *ServerInterfaceWrapper) CreateChecklistItemRow(c *gin.Context) {
 	c.Set(CookieAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params CreateChecklistItemRowParams
+	var params DeleteChecklistItemByIdParams
 
 	headers := c.Request.Header
 
@@ -711,11 +1047,11 @@ func (siw *ServerInterfaceWrapper) CreateChecklistItemRow(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.CreateChecklistItemRow(c, checklistId, itemId, params)
+	siw.Handler.DeleteChecklistItemById(c, checklistId, itemId, params)
 }
 
-// DeleteChecklistItemRow operation middleware
-func (siw *ServerInterfaceWrapper) DeleteChecklistItemRow(c *gin.Context) {
+// GetChecklistItemBychecklistIdAndItemId operation middleware
+func (siw *ServerInterfaceWrapper) GetChecklistItemBychecklistIdAndItemId(c *gin.Context) {
 
 	var err error
 
@@ -737,19 +1073,10 @@ func (siw *ServerInterfaceWrapper) DeleteChecklistItemRow(c *gin.Context) {
 		return
 	}
 
-	// ------------- Path parameter "rowId" -------------
-	var rowId uint
-
-	err = runtime.BindStyledParameterWithOptions("simple", "rowId", c.Param("rowId"), &rowId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter rowId: %w", err), http.StatusBadRequest)
-		return
-	}
-
 	c.Set(CookieAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params DeleteChecklistItemRowParams
+	var params GetChecklistItemBychecklistIdAndItemIdParams
 
 	headers := c.Request.Header
 
@@ -779,11 +1106,11 @@ func (siw *ServerInterfaceWrapper) DeleteChecklistItemRow(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.DeleteChecklistItemRow(c, checklistId, itemId, rowId, params)
+	siw.Handler.GetChecklistItemBychecklistIdAndItemId(c, checklistId, itemId, params)
 }
 
-// ToggleChecklistItemComplete operation middleware
-func (siw *ServerInterfaceWrapper) ToggleChecklistItemComplete(c *gin.Context) {
+// UpdateChecklistItemBychecklistIdAndItemId operation middleware
+func (siw *ServerInterfaceWrapper) UpdateChecklistItemBychecklistIdAndItemId(c *gin.Context) {
 
 	var err error
 
@@ -808,7 +1135,7 @@ func (siw *ServerInterfaceWrapper) ToggleChecklistItemComplete(c *gin.Context) {
 	c.Set(CookieAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ToggleChecklistItemCompleteParams
+	var params UpdateChecklistItemBychecklistIdAndItemIdParams
 
 	headers := c.Request.Header
 
@@ -838,509 +1165,2667 @@ func (siw *ServerInterfaceWrapper) ToggleChecklistItemComplete(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ToggleChecklistItemComplete(c, checklistId, itemId, params)
+	siw.Handler.UpdateChecklistItemBychecklistIdAndItemId(c, checklistId, itemId, params)
 }
 
-// GinServerOptions provides options for the Gin server.
-type GinServerOptions struct {
-	BaseURL      string
-	Middlewares  []MiddlewareFunc
-	ErrorHandler func(*gin.Context, error, int)
-}
+// ChangeChecklistItemOrderNumber operation middleware
+func (siw *ServerInterfaceWrapper) ChangeChecklistItemOrderNumber(c *gin.Context) {
 
-// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
-func RegisterHandlers(router gin.IRouter, si ServerInterface) {
-	RegisterHandlersWithOptions(router, si, GinServerOptions{})
-}
+	var err error
 
-// RegisterHandlersWithOptions creates http.Handler with additional options
-func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
-	errorHandler := options.ErrorHandler
-	if errorHandler == nil {
-		errorHandler = func(c *gin.Context, err error, statusCode int) {
-			c.JSON(statusCode, gin.H{"msg": err.Error()})
-		}
-	}
+	// ------------- Path parameter "checklistId" -------------
+	var checklistId uint
 
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandler:       errorHandler,
+	err = runtime.BindStyledParameterWithOptions("simple", "checklistId", c.Param("checklistId"), &checklistId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter checklistId: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	router.GET(options.BaseURL+"/api/v1/checklists/:checklistId/items", wrapper.GetAllChecklistItems)
-	router.POST(options.BaseURL+"/api/v1/checklists/:checklistId/items", wrapper.CreateChecklistItem)
-	router.DELETE(options.BaseURL+"/api/v1/checklists/:checklistId/items/:itemId", wrapper.DeleteChecklistItemById)
-	router.GET(options.BaseURL+"/api/v1/checklists/:checklistId/items/:itemId", wrapper.GetChecklistItemBychecklistIdAndItemId)
-	router.PUT(options.BaseURL+"/api/v1/checklists/:checklistId/items/:itemId", wrapper.UpdateChecklistItemBychecklistIdAndItemId)
-	router.PATCH(options.BaseURL+"/api/v1/checklists/:checklistId/items/:itemId/change-order", wrapper.ChangeChecklistItemOrderNumber)
-	router.POST(options.BaseURL+"/api/v1/checklists/:checklistId/items/:itemId/restore", wrapper.RestoreChecklistItem)
-	router.POST(options.BaseURL+"/api/v1/checklists/:checklistId/items/:itemId/rows", wrapper.CreateChecklistItemRow)
-	router.DELETE(options.BaseURL+"/api/v1/checklists/:checklistId/items/:itemId/rows/:rowId", wrapper.DeleteChecklistItemRow)
-	router.PATCH(options.BaseURL+"/api/v1/checklists/:checklistId/items/:itemId/toggle-complete", wrapper.ToggleChecklistItemComplete)
-}
+	// ------------- Path parameter "itemId" -------------
+	var itemId uint
 
-type GetAllChecklistItemsRequestObject struct {
-	ChecklistId uint `json:"checklistId"`
-	Params      GetAllChecklistItemsParams
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "itemId", c.Param("itemId"), &itemId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter itemId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type GetAllChecklistItemsResponseObject interface {
-	VisitGetAllChecklistItemsResponse(w http.ResponseWriter) error
-}
+	c.Set(CookieAuthScopes, []string{})
 
-type GetAllChecklistItems200JSONResponse []ChecklistItemResponse
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ChangeChecklistItemOrderNumberParams
 
-func (response GetAllChecklistItems200JSONResponse) VisitGetAllChecklistItemsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Optional query parameter "sortOrder" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "sortOrder", c.Request.URL.Query(), &params.SortOrder)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sortOrder: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type GetAllChecklistItems400JSONResponse Error
+	headers := c.Request.Header
 
-func (response GetAllChecklistItems400JSONResponse) VisitGetAllChecklistItemsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// ------------- Optional header parameter "X-Client-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Client-Id")]; found {
+		var XClientId XClientId
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Client-Id, got %d", n), http.StatusBadRequest)
+			return
+		}
 
-	return json.NewEncoder(w).Encode(response)
-}
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Client-Id", valueList[0], &XClientId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Client-Id: %w", err), http.StatusBadRequest)
+			return
+		}
 
-type GetAllChecklistItems500JSONResponse Error
+		params.XClientId = &XClientId
 
-func (response GetAllChecklistItems500JSONResponse) VisitGetAllChecklistItemsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type CreateChecklistItemRequestObject struct {
-	ChecklistId uint `json:"checklistId"`
-	Params      CreateChecklistItemParams
-	Body        *CreateChecklistItemJSONRequestBody
+	siw.Handler.ChangeChecklistItemOrderNumber(c, checklistId, itemId, params)
 }
 
-type CreateChecklistItemResponseObject interface {
-	VisitCreateChecklistItemResponse(w http.ResponseWriter) error
-}
+// GetChecklistItemComments operation middleware
+func (siw *ServerInterfaceWrapper) GetChecklistItemComments(c *gin.Context) {
 
-type CreateChecklistItem201JSONResponse ChecklistItemResponse
+	var err error
 
-func (response CreateChecklistItem201JSONResponse) VisitCreateChecklistItemResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	// ------------- Path parameter "checklistId" -------------
+	var checklistId uint
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "checklistId", c.Param("checklistId"), &checklistId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter checklistId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type CreateChecklistItem400JSONResponse Error
+	// ------------- Path parameter "itemId" -------------
+	var itemId uint
 
-func (response CreateChecklistItem400JSONResponse) VisitCreateChecklistItemResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	err = runtime.BindStyledParameterWithOptions("simple", "itemId", c.Param("itemId"), &itemId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter itemId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	c.Set(CookieAuthScopes, []string{})
 
-type CreateChecklistItem500JSONResponse Error
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetChecklistItemCommentsParams
 
-func (response CreateChecklistItem500JSONResponse) VisitCreateChecklistItemResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	headers := c.Request.Header
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional header parameter "X-Client-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Client-Id")]; found {
+		var XClientId XClientId
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Client-Id, got %d", n), http.StatusBadRequest)
+			return
+		}
 
-type DeleteChecklistItemByIdRequestObject struct {
-	ChecklistId uint `json:"checklistId"`
-	ItemId      uint `json:"itemId"`
-	Params      DeleteChecklistItemByIdParams
-}
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Client-Id", valueList[0], &XClientId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Client-Id: %w", err), http.StatusBadRequest)
+			return
+		}
 
-type DeleteChecklistItemByIdResponseObject interface {
-	VisitDeleteChecklistItemByIdResponse(w http.ResponseWriter) error
-}
+		params.XClientId = &XClientId
 
-type DeleteChecklistItemById204JSONResponse ChecklistItemResponse
+	}
 
-func (response DeleteChecklistItemById204JSONResponse) VisitDeleteChecklistItemByIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(204)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.GetChecklistItemComments(c, checklistId, itemId, params)
 }
 
-type DeleteChecklistItemById404JSONResponse Error
+// CreateChecklistItemComment operation middleware
+func (siw *ServerInterfaceWrapper) CreateChecklistItemComment(c *gin.Context) {
 
-func (response DeleteChecklistItemById404JSONResponse) VisitDeleteChecklistItemByIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "checklistId" -------------
+	var checklistId uint
 
-type DeleteChecklistItemById500JSONResponse Error
+	err = runtime.BindStyledParameterWithOptions("simple", "checklistId", c.Param("checklistId"), &checklistId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter checklistId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response DeleteChecklistItemById500JSONResponse) VisitDeleteChecklistItemByIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// ------------- Path parameter "itemId" -------------
+	var itemId uint
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "itemId", c.Param("itemId"), &itemId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter itemId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type GetChecklistItemBychecklistIdAndItemIdRequestObject struct {
-	ChecklistId uint `json:"checklistId"`
-	ItemId      uint `json:"itemId"`
-	Params      GetChecklistItemBychecklistIdAndItemIdParams
-}
+	c.Set(CookieAuthScopes, []string{})
 
-type GetChecklistItemBychecklistIdAndItemIdResponseObject interface {
-	VisitGetChecklistItemBychecklistIdAndItemIdResponse(w http.ResponseWriter) error
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateChecklistItemCommentParams
 
-type GetChecklistItemBychecklistIdAndItemId200JSONResponse ChecklistItemResponse
+	headers := c.Request.Header
 
-func (response GetChecklistItemBychecklistIdAndItemId200JSONResponse) VisitGetChecklistItemBychecklistIdAndItemIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Optional header parameter "X-Client-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Client-Id")]; found {
+		var XClientId XClientId
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Client-Id, got %d", n), http.StatusBadRequest)
+			return
+		}
 
-	return json.NewEncoder(w).Encode(response)
-}
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Client-Id", valueList[0], &XClientId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Client-Id: %w", err), http.StatusBadRequest)
+			return
+		}
 
-type GetChecklistItemBychecklistIdAndItemId404JSONResponse Error
+		params.XClientId = &XClientId
 
-func (response GetChecklistItemBychecklistIdAndItemId404JSONResponse) VisitGetChecklistItemBychecklistIdAndItemIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateChecklistItemComment(c, checklistId, itemId, params)
 }
 
-type GetChecklistItemBychecklistIdAndItemId500JSONResponse Error
+// DeleteChecklistItemComment operation middleware
+func (siw *ServerInterfaceWrapper) DeleteChecklistItemComment(c *gin.Context) {
 
-func (response GetChecklistItemBychecklistIdAndItemId500JSONResponse) VisitGetChecklistItemBychecklistIdAndItemIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "checklistId" -------------
+	var checklistId uint
 
-type UpdateChecklistItemBychecklistIdAndItemIdRequestObject struct {
-	ChecklistId uint `json:"checklistId"`
-	ItemId      uint `json:"itemId"`
-	Params      UpdateChecklistItemBychecklistIdAndItemIdParams
-	Body        *UpdateChecklistItemBychecklistIdAndItemIdJSONRequestBody
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "checklistId", c.Param("checklistId"), &checklistId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter checklistId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type UpdateChecklistItemBychecklistIdAndItemIdResponseObject interface {
-	VisitUpdateChecklistItemBychecklistIdAndItemIdResponse(w http.ResponseWriter) error
-}
+	// ------------- Path parameter "itemId" -------------
+	var itemId uint
 
-type UpdateChecklistItemBychecklistIdAndItemId200JSONResponse ChecklistItemResponse
+	err = runtime.BindStyledParameterWithOptions("simple", "itemId", c.Param("itemId"), &itemId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter itemId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response UpdateChecklistItemBychecklistIdAndItemId200JSONResponse) VisitUpdateChecklistItemBychecklistIdAndItemIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Path parameter "commentId" -------------
+	var commentId uint
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "commentId", c.Param("commentId"), &commentId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter commentId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type UpdateChecklistItemBychecklistIdAndItemId404JSONResponse Error
+	c.Set(CookieAuthScopes, []string{})
 
-func (response UpdateChecklistItemBychecklistIdAndItemId404JSONResponse) VisitUpdateChecklistItemBychecklistIdAndItemIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteChecklistItemCommentParams
 
-	return json.NewEncoder(w).Encode(response)
-}
+	headers := c.Request.Header
 
-type UpdateChecklistItemBychecklistIdAndItemId500JSONResponse Error
+	// ------------- Optional header parameter "X-Client-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Client-Id")]; found {
+		var XClientId XClientId
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Client-Id, got %d", n), http.StatusBadRequest)
+			return
+		}
 
-func (response UpdateChecklistItemBychecklistIdAndItemId500JSONResponse) VisitUpdateChecklistItemBychecklistIdAndItemIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Client-Id", valueList[0], &XClientId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Client-Id: %w", err), http.StatusBadRequest)
+			return
+		}
 
-	return json.NewEncoder(w).Encode(response)
-}
+		params.XClientId = &XClientId
 
-type ChangeChecklistItemOrderNumberRequestObject struct {
-	ChecklistId uint `json:"checklistId"`
-	ItemId      uint `json:"itemId"`
-	Params      ChangeChecklistItemOrderNumberParams
-	Body        *ChangeChecklistItemOrderNumberJSONRequestBody
-}
+	}
 
-type ChangeChecklistItemOrderNumberResponseObject interface {
-	VisitChangeChecklistItemOrderNumberResponse(w http.ResponseWriter) error
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type ChangeChecklistItemOrderNumber200JSONResponse struct {
-	NewOrderNumber *uint `json:"newOrderNumber,omitempty"`
-	OldOrderNumber *uint `json:"oldOrderNumber,omitempty"`
+	siw.Handler.DeleteChecklistItemComment(c, checklistId, itemId, commentId, params)
 }
 
-func (response ChangeChecklistItemOrderNumber200JSONResponse) VisitChangeChecklistItemOrderNumberResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// UpdateChecklistItemComment operation middleware
+func (siw *ServerInterfaceWrapper) UpdateChecklistItemComment(c *gin.Context) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type ChangeChecklistItemOrderNumber400JSONResponse Error
+	// ------------- Path parameter "checklistId" -------------
+	var checklistId uint
 
-func (response ChangeChecklistItemOrderNumber400JSONResponse) VisitChangeChecklistItemOrderNumberResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	err = runtime.BindStyledParameterWithOptions("simple", "checklistId", c.Param("checklistId"), &checklistId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter checklistId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "itemId" -------------
+	var itemId uint
 
-type ChangeChecklistItemOrderNumber404JSONResponse Error
+	err = runtime.BindStyledParameterWithOptions("simple", "itemId", c.Param("itemId"), &itemId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter itemId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response ChangeChecklistItemOrderNumber404JSONResponse) VisitChangeChecklistItemOrderNumberResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	// ------------- Path parameter "commentId" -------------
+	var commentId uint
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "commentId", c.Param("commentId"), &commentId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter commentId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type ChangeChecklistItemOrderNumber500JSONResponse Error
+	c.Set(CookieAuthScopes, []string{})
 
-func (response ChangeChecklistItemOrderNumber500JSONResponse) VisitChangeChecklistItemOrderNumberResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateChecklistItemCommentParams
 
-	return json.NewEncoder(w).Encode(response)
-}
+	headers := c.Request.Header
 
-type RestoreChecklistItemRequestObject struct {
-	ChecklistId uint `json:"checklistId"`
-	ItemId      uint `json:"itemId"`
-	Params      RestoreChecklistItemParams
-}
+	// ------------- Optional header parameter "X-Client-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Client-Id")]; found {
+		var XClientId XClientId
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Client-Id, got %d", n), http.StatusBadRequest)
+			return
+		}
 
-type RestoreChecklistItemResponseObject interface {
-	VisitRestoreChecklistItemResponse(w http.ResponseWriter) error
-}
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Client-Id", valueList[0], &XClientId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Client-Id: %w", err), http.StatusBadRequest)
+			return
+		}
 
-type RestoreChecklistItem200JSONResponse ChecklistItemResponse
+		params.XClientId = &XClientId
 
-func (response RestoreChecklistItem200JSONResponse) VisitRestoreChecklistItemResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateChecklistItemComment(c, checklistId, itemId, commentId, params)
 }
 
-type RestoreChecklistItem404JSONResponse Error
+// CopyChecklistItem operation middleware
+func (siw *ServerInterfaceWrapper) CopyChecklistItem(c *gin.Context) {
 
-func (response RestoreChecklistItem404JSONResponse) VisitRestoreChecklistItemResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "checklistId" -------------
+	var checklistId uint
 
-type RestoreChecklistItem500JSONResponse Error
+	err = runtime.BindStyledParameterWithOptions("simple", "checklistId", c.Param("checklistId"), &checklistId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter checklistId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response RestoreChecklistItem500JSONResponse) VisitRestoreChecklistItemResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// ------------- Path parameter "itemId" -------------
+	var itemId uint
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "itemId", c.Param("itemId"), &itemId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter itemId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type CreateChecklistItemRowRequestObject struct {
-	ChecklistId uint `json:"checklistId"`
-	ItemId      uint `json:"itemId"`
-	Params      CreateChecklistItemRowParams
-	Body        *CreateChecklistItemRowJSONRequestBody
-}
+	c.Set(CookieAuthScopes, []string{})
 
-type CreateChecklistItemRowResponseObject interface {
-	VisitCreateChecklistItemRowResponse(w http.ResponseWriter) error
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CopyChecklistItemParams
 
-type CreateChecklistItemRow201JSONResponse ChecklistItemRowResponse
+	headers := c.Request.Header
 
-func (response CreateChecklistItemRow201JSONResponse) VisitCreateChecklistItemRowResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	// ------------- Optional header parameter "X-Client-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Client-Id")]; found {
+		var XClientId XClientId
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Client-Id, got %d", n), http.StatusBadRequest)
+			return
+		}
 
-	return json.NewEncoder(w).Encode(response)
-}
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Client-Id", valueList[0], &XClientId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Client-Id: %w", err), http.StatusBadRequest)
+			return
+		}
 
-type CreateChecklistItemRow400JSONResponse Error
+		params.XClientId = &XClientId
 
-func (response CreateChecklistItemRow400JSONResponse) VisitCreateChecklistItemRowResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CopyChecklistItem(c, checklistId, itemId, params)
 }
 
-type CreateChecklistItemRow404JSONResponse Error
+// MoveChecklistItem operation middleware
+func (siw *ServerInterfaceWrapper) MoveChecklistItem(c *gin.Context) {
 
-func (response CreateChecklistItemRow404JSONResponse) VisitCreateChecklistItemRowResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "checklistId" -------------
+	var checklistId uint
 
-type CreateChecklistItemRow500JSONResponse Error
+	err = runtime.BindStyledParameterWithOptions("simple", "checklistId", c.Param("checklistId"), &checklistId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter checklistId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response CreateChecklistItemRow500JSONResponse) VisitCreateChecklistItemRowResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// ------------- Path parameter "itemId" -------------
+	var itemId uint
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "itemId", c.Param("itemId"), &itemId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter itemId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type DeleteChecklistItemRowRequestObject struct {
+	c.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params MoveChecklistItemParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "X-Client-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Client-Id")]; found {
+		var XClientId XClientId
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Client-Id, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Client-Id", valueList[0], &XClientId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Client-Id: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XClientId = &XClientId
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.MoveChecklistItem(c, checklistId, itemId, params)
+}
+
+// RestoreChecklistItem operation middleware
+func (siw *ServerInterfaceWrapper) RestoreChecklistItem(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "checklistId" -------------
+	var checklistId uint
+
+	err = runtime.BindStyledParameterWithOptions("simple", "checklistId", c.Param("checklistId"), &checklistId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter checklistId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "itemId" -------------
+	var itemId uint
+
+	err = runtime.BindStyledParameterWithOptions("simple", "itemId", c.Param("itemId"), &itemId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter itemId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RestoreChecklistItemParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "X-Client-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Client-Id")]; found {
+		var XClientId XClientId
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Client-Id, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Client-Id", valueList[0], &XClientId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Client-Id: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XClientId = &XClientId
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.RestoreChecklistItem(c, checklistId, itemId, params)
+}
+
+// CreateChecklistItemRow operation middleware
+func (siw *ServerInterfaceWrapper) CreateChecklistItemRow(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "checklistId" -------------
+	var checklistId uint
+
+	err = runtime.BindStyledParameterWithOptions("simple", "checklistId", c.Param("checklistId"), &checklistId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter checklistId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "itemId" -------------
+	var itemId uint
+
+	err = runtime.BindStyledParameterWithOptions("simple", "itemId", c.Param("itemId"), &itemId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter itemId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateChecklistItemRowParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "X-Client-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Client-Id")]; found {
+		var XClientId XClientId
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Client-Id, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Client-Id", valueList[0], &XClientId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Client-Id: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XClientId = &XClientId
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateChecklistItemRow(c, checklistId, itemId, params)
+}
+
+// DeleteChecklistItemRow operation middleware
+func (siw *ServerInterfaceWrapper) DeleteChecklistItemRow(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "checklistId" -------------
+	var checklistId uint
+
+	err = runtime.BindStyledParameterWithOptions("simple", "checklistId", c.Param("checklistId"), &checklistId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter checklistId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "itemId" -------------
+	var itemId uint
+
+	err = runtime.BindStyledParameterWithOptions("simple", "itemId", c.Param("itemId"), &itemId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter itemId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "rowId" -------------
+	var rowId uint
+
+	err = runtime.BindStyledParameterWithOptions("simple", "rowId", c.Param("rowId"), &rowId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter rowId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteChecklistItemRowParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "X-Client-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Client-Id")]; found {
+		var XClientId XClientId
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Client-Id, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Client-Id", valueList[0], &XClientId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Client-Id: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XClientId = &XClientId
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteChecklistItemRow(c, checklistId, itemId, rowId, params)
+}
+
+// ToggleChecklistItemComplete operation middleware
+func (siw *ServerInterfaceWrapper) ToggleChecklistItemComplete(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "checklistId" -------------
+	var checklistId uint
+
+	err = runtime.BindStyledParameterWithOptions("simple", "checklistId", c.Param("checklistId"), &checklistId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter checklistId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "itemId" -------------
+	var itemId uint
+
+	err = runtime.BindStyledParameterWithOptions("simple", "itemId", c.Param("itemId"), &itemId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter itemId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ToggleChecklistItemCompleteParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "X-Client-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Client-Id")]; found {
+		var XClientId XClientId
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Client-Id, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Client-Id", valueList[0], &XClientId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Client-Id: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XClientId = &XClientId
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ToggleChecklistItemComplete(c, checklistId, itemId, params)
+}
+
+// GetChecklistSections operation middleware
+func (siw *ServerInterfaceWrapper) GetChecklistSections(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "checklistId" -------------
+	var checklistId uint
+
+	err = runtime.BindStyledParameterWithOptions("simple", "checklistId", c.Param("checklistId"), &checklistId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter checklistId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetChecklistSectionsParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "X-Client-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Client-Id")]; found {
+		var XClientId XClientId
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Client-Id, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Client-Id", valueList[0], &XClientId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Client-Id: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XClientId = &XClientId
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetChecklistSections(c, checklistId, params)
+}
+
+// CreateChecklistSection operation middleware
+func (siw *ServerInterfaceWrapper) CreateChecklistSection(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "checklistId" -------------
+	var checklistId uint
+
+	err = runtime.BindStyledParameterWithOptions("simple", "checklistId", c.Param("checklistId"), &checklistId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter checklistId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateChecklistSectionParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "X-Client-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Client-Id")]; found {
+		var XClientId XClientId
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Client-Id, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Client-Id", valueList[0], &XClientId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Client-Id: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XClientId = &XClientId
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateChecklistSection(c, checklistId, params)
+}
+
+// DeleteChecklistSection operation middleware
+func (siw *ServerInterfaceWrapper) DeleteChecklistSection(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "checklistId" -------------
+	var checklistId uint
+
+	err = runtime.BindStyledParameterWithOptions("simple", "checklistId", c.Param("checklistId"), &checklistId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter checklistId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "sectionId" -------------
+	var sectionId uint
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sectionId", c.Param("sectionId"), &sectionId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sectionId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteChecklistSectionParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "X-Client-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Client-Id")]; found {
+		var XClientId XClientId
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Client-Id, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Client-Id", valueList[0], &XClientId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Client-Id: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XClientId = &XClientId
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteChecklistSection(c, checklistId, sectionId, params)
+}
+
+// UpdateChecklistSection operation middleware
+func (siw *ServerInterfaceWrapper) UpdateChecklistSection(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "checklistId" -------------
+	var checklistId uint
+
+	err = runtime.BindStyledParameterWithOptions("simple", "checklistId", c.Param("checklistId"), &checklistId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter checklistId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "sectionId" -------------
+	var sectionId uint
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sectionId", c.Param("sectionId"), &sectionId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sectionId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateChecklistSectionParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "X-Client-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Client-Id")]; found {
+		var XClientId XClientId
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Client-Id, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Client-Id", valueList[0], &XClientId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Client-Id: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XClientId = &XClientId
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateChecklistSection(c, checklistId, sectionId, params)
+}
+
+// ChangeChecklistSectionOrderNumber operation middleware
+func (siw *ServerInterfaceWrapper) ChangeChecklistSectionOrderNumber(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "checklistId" -------------
+	var checklistId uint
+
+	err = runtime.BindStyledParameterWithOptions("simple", "checklistId", c.Param("checklistId"), &checklistId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter checklistId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "sectionId" -------------
+	var sectionId uint
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sectionId", c.Param("sectionId"), &sectionId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sectionId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ChangeChecklistSectionOrderNumberParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "X-Client-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Client-Id")]; found {
+		var XClientId XClientId
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Client-Id, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Client-Id", valueList[0], &XClientId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Client-Id: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XClientId = &XClientId
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ChangeChecklistSectionOrderNumber(c, checklistId, sectionId, params)
+}
+
+// GinServerOptions provides options for the Gin server.
+type GinServerOptions struct {
+	BaseURL      string
+	Middlewares  []MiddlewareFunc
+	ErrorHandler func(*gin.Context, error, int)
+}
+
+// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
+func RegisterHandlers(router gin.IRouter, si ServerInterface) {
+	RegisterHandlersWithOptions(router, si, GinServerOptions{})
+}
+
+// RegisterHandlersWithOptions creates http.Handler with additional options
+func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
+	errorHandler := options.ErrorHandler
+	if errorHandler == nil {
+		errorHandler = func(c *gin.Context, err error, statusCode int) {
+			c.JSON(statusCode, gin.H{"msg": err.Error()})
+		}
+	}
+
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandler:       errorHandler,
+	}
+
+	router.GET(options.BaseURL+"/api/v1/checklists/:checklistId/items", wrapper.GetAllChecklistItems)
+	router.POST(options.BaseURL+"/api/v1/checklists/:checklistId/items", wrapper.CreateChecklistItem)
+	router.POST(options.BaseURL+"/api/v1/checklists/:checklistId/items/batch", wrapper.BatchUpdateChecklistItems)
+	router.POST(options.BaseURL+"/api/v1/checklists/:checklistId/items/clear-completed", wrapper.ClearCompletedChecklistItems)
+	router.POST(options.BaseURL+"/api/v1/checklists/:checklistId/items/deletion-groups/:groupId/restore", wrapper.RestoreChecklistItemDeletionGroup)
+	router.POST(options.BaseURL+"/api/v1/checklists/:checklistId/items/reset", wrapper.ResetChecklistItems)
+	router.POST(options.BaseURL+"/api/v1/checklists/:checklistId/items/sort", wrapper.SortChecklistItems)
+	router.DELETE(options.BaseURL+"/api/v1/checklists/:checklistId/items/:itemId", wrapper.DeleteChecklistItemById)
+	router.GET(options.BaseURL+"/api/v1/checklists/:checklistId/items/:itemId", wrapper.GetChecklistItemBychecklistIdAndItemId)
+	router.PUT(options.BaseURL+"/api/v1/checklists/:checklistId/items/:itemId", wrapper.UpdateChecklistItemBychecklistIdAndItemId)
+	router.PATCH(options.BaseURL+"/api/v1/checklists/:checklistId/items/:itemId/change-order", wrapper.ChangeChecklistItemOrderNumber)
+	router.GET(options.BaseURL+"/api/v1/checklists/:checklistId/items/:itemId/comments", wrapper.GetChecklistItemComments)
+	router.POST(options.BaseURL+"/api/v1/checklists/:checklistId/items/:itemId/comments", wrapper.CreateChecklistItemComment)
+	router.DELETE(options.BaseURL+"/api/v1/checklists/:checklistId/items/:itemId/comments/:commentId", wrapper.DeleteChecklistItemComment)
+	router.PUT(options.BaseURL+"/api/v1/checklists/:checklistId/items/:itemId/comments/:commentId", wrapper.UpdateChecklistItemComment)
+	router.POST(options.BaseURL+"/api/v1/checklists/:checklistId/items/:itemId/copy", wrapper.CopyChecklistItem)
+	router.POST(options.BaseURL+"/api/v1/checklists/:checklistId/items/:itemId/move", wrapper.MoveChecklistItem)
+	router.POST(options.BaseURL+"/api/v1/checklists/:checklistId/items/:itemId/restore", wrapper.RestoreChecklistItem)
+	router.POST(options.BaseURL+"/api/v1/checklists/:checklistId/items/:itemId/rows", wrapper.CreateChecklistItemRow)
+	router.DELETE(options.BaseURL+"/api/v1/checklists/:checklistId/items/:itemId/rows/:rowId", wrapper.DeleteChecklistItemRow)
+	router.PATCH(options.BaseURL+"/api/v1/checklists/:checklistId/items/:itemId/toggle-complete", wrapper.ToggleChecklistItemComplete)
+	router.GET(options.BaseURL+"/api/v1/checklists/:checklistId/sections", wrapper.GetChecklistSections)
+	router.POST(options.BaseURL+"/api/v1/checklists/:checklistId/sections", wrapper.CreateChecklistSection)
+	router.DELETE(options.BaseURL+"/api/v1/checklists/:checklistId/sections/:sectionId", wrapper.DeleteChecklistSection)
+	router.PUT(options.BaseURL+"/api/v1/checklists/:checklistId/sections/:sectionId", wrapper.UpdateChecklistSection)
+	router.PATCH(options.BaseURL+"/api/v1/checklists/:checklistId/sections/:sectionId/change-order", wrapper.ChangeChecklistSectionOrderNumber)
+}
+
+type GetAllChecklistItemsRequestObject struct {
+	ChecklistId uint `json:"checklistId"`
+	Params      GetAllChecklistItemsParams
+}
+
+type GetAllChecklistItemsResponseObject interface {
+	VisitGetAllChecklistItemsResponse(w http.ResponseWriter) error
+}
+
+type GetAllChecklistItems200JSONResponse []ChecklistItemResponse
+
+func (response GetAllChecklistItems200JSONResponse) VisitGetAllChecklistItemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAllChecklistItems400JSONResponse Error
+
+func (response GetAllChecklistItems400JSONResponse) VisitGetAllChecklistItemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAllChecklistItems500JSONResponse Error
+
+func (response GetAllChecklistItems500JSONResponse) VisitGetAllChecklistItemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateChecklistItemRequestObject struct {
+	ChecklistId uint `json:"checklistId"`
+	Params      CreateChecklistItemParams
+	Body        *CreateChecklistItemJSONRequestBody
+}
+
+type CreateChecklistItemResponseObject interface {
+	VisitCreateChecklistItemResponse(w http.ResponseWriter) error
+}
+
+type CreateChecklistItem201JSONResponse ChecklistItemResponse
+
+func (response CreateChecklistItem201JSONResponse) VisitCreateChecklistItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateChecklistItem400JSONResponse Error
+
+func (response CreateChecklistItem400JSONResponse) VisitCreateChecklistItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateChecklistItem500JSONResponse Error
+
+func (response CreateChecklistItem500JSONResponse) VisitCreateChecklistItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BatchUpdateChecklistItemsRequestObject struct {
+	ChecklistId uint `json:"checklistId"`
+	Params      BatchUpdateChecklistItemsParams
+	Body        *BatchUpdateChecklistItemsJSONRequestBody
+}
+
+type BatchUpdateChecklistItemsResponseObject interface {
+	VisitBatchUpdateChecklistItemsResponse(w http.ResponseWriter) error
+}
+
+type BatchUpdateChecklistItems200JSONResponse ChecklistItemBatchResponse
+
+func (response BatchUpdateChecklistItems200JSONResponse) VisitBatchUpdateChecklistItemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BatchUpdateChecklistItems400JSONResponse Error
+
+func (response BatchUpdateChecklistItems400JSONResponse) VisitBatchUpdateChecklistItemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BatchUpdateChecklistItems404JSONResponse Error
+
+func (response BatchUpdateChecklistItems404JSONResponse) VisitBatchUpdateChecklistItemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BatchUpdateChecklistItems500JSONResponse Error
+
+func (response BatchUpdateChecklistItems500JSONResponse) VisitBatchUpdateChecklistItemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ClearCompletedChecklistItemsRequestObject struct {
+	ChecklistId uint `json:"checklistId"`
+	Params      ClearCompletedChecklistItemsParams
+}
+
+type ClearCompletedChecklistItemsResponseObject interface {
+	VisitClearCompletedChecklistItemsResponse(w http.ResponseWriter) error
+}
+
+type ClearCompletedChecklistItems200JSONResponse ClearCompletedChecklistItemsResponse
+
+func (response ClearCompletedChecklistItems200JSONResponse) VisitClearCompletedChecklistItemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ClearCompletedChecklistItems400JSONResponse Error
+
+func (response ClearCompletedChecklistItems400JSONResponse) VisitClearCompletedChecklistItemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ClearCompletedChecklistItems404JSONResponse Error
+
+func (response ClearCompletedChecklistItems404JSONResponse) VisitClearCompletedChecklistItemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ClearCompletedChecklistItems500JSONResponse Error
+
+func (response ClearCompletedChecklistItems500JSONResponse) VisitClearCompletedChecklistItemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreChecklistItemDeletionGroupRequestObject struct {
+	ChecklistId uint `json:"checklistId"`
+	GroupId     uint `json:"groupId"`
+	Params      RestoreChecklistItemDeletionGroupParams
+}
+
+type RestoreChecklistItemDeletionGroupResponseObject interface {
+	VisitRestoreChecklistItemDeletionGroupResponse(w http.ResponseWriter) error
+}
+
+type RestoreChecklistItemDeletionGroup200JSONResponse ChecklistItemBatchResponse
+
+func (response RestoreChecklistItemDeletionGroup200JSONResponse) VisitRestoreChecklistItemDeletionGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreChecklistItemDeletionGroup400JSONResponse Error
+
+func (response RestoreChecklistItemDeletionGroup400JSONResponse) VisitRestoreChecklistItemDeletionGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreChecklistItemDeletionGroup404JSONResponse Error
+
+func (response RestoreChecklistItemDeletionGroup404JSONResponse) VisitRestoreChecklistItemDeletionGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreChecklistItemDeletionGroup500JSONResponse Error
+
+func (response RestoreChecklistItemDeletionGroup500JSONResponse) VisitRestoreChecklistItemDeletionGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResetChecklistItemsRequestObject struct {
+	ChecklistId uint `json:"checklistId"`
+	Params      ResetChecklistItemsParams
+}
+
+type ResetChecklistItemsResponseObject interface {
+	VisitResetChecklistItemsResponse(w http.ResponseWriter) error
+}
+
+type ResetChecklistItems200JSONResponse ChecklistItemBatchResponse
+
+func (response ResetChecklistItems200JSONResponse) VisitResetChecklistItemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResetChecklistItems400JSONResponse Error
+
+func (response ResetChecklistItems400JSONResponse) VisitResetChecklistItemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResetChecklistItems404JSONResponse Error
+
+func (response ResetChecklistItems404JSONResponse) VisitResetChecklistItemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResetChecklistItems500JSONResponse Error
+
+func (response ResetChecklistItems500JSONResponse) VisitResetChecklistItemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SortChecklistItemsRequestObject struct {
+	ChecklistId uint `json:"checklistId"`
+	Params      SortChecklistItemsParams
+	Body        *SortChecklistItemsJSONRequestBody
+}
+
+type SortChecklistItemsResponseObject interface {
+	VisitSortChecklistItemsResponse(w http.ResponseWriter) error
+}
+
+type SortChecklistItems200JSONResponse []ChecklistItemResponse
+
+func (response SortChecklistItems200JSONResponse) VisitSortChecklistItemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SortChecklistItems400JSONResponse Error
+
+func (response SortChecklistItems400JSONResponse) VisitSortChecklistItemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SortChecklistItems404JSONResponse Error
+
+func (response SortChecklistItems404JSONResponse) VisitSortChecklistItemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SortChecklistItems500JSONResponse Error
+
+func (response SortChecklistItems500JSONResponse) VisitSortChecklistItemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteChecklistItemByIdRequestObject struct {
+	ChecklistId uint `json:"checklistId"`
+	ItemId      uint `json:"itemId"`
+	Params      DeleteChecklistItemByIdParams
+}
+
+type DeleteChecklistItemByIdResponseObject interface {
+	VisitDeleteChecklistItemByIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteChecklistItemById204JSONResponse ChecklistItemResponse
+
+func (response DeleteChecklistItemById204JSONResponse) VisitDeleteChecklistItemByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(204)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteChecklistItemById400JSONResponse Error
+
+func (response DeleteChecklistItemById400JSONResponse) VisitDeleteChecklistItemByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteChecklistItemById404JSONResponse Error
+
+func (response DeleteChecklistItemById404JSONResponse) VisitDeleteChecklistItemByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteChecklistItemById500JSONResponse Error
+
+func (response DeleteChecklistItemById500JSONResponse) VisitDeleteChecklistItemByIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetChecklistItemBychecklistIdAndItemIdRequestObject struct {
+	ChecklistId uint `json:"checklistId"`
+	ItemId      uint `json:"itemId"`
+	Params      GetChecklistItemBychecklistIdAndItemIdParams
+}
+
+type GetChecklistItemBychecklistIdAndItemIdResponseObject interface {
+	VisitGetChecklistItemBychecklistIdAndItemIdResponse(w http.ResponseWriter) error
+}
+
+type GetChecklistItemBychecklistIdAndItemId200JSONResponse ChecklistItemResponse
+
+func (response GetChecklistItemBychecklistIdAndItemId200JSONResponse) VisitGetChecklistItemBychecklistIdAndItemIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetChecklistItemBychecklistIdAndItemId404JSONResponse Error
+
+func (response GetChecklistItemBychecklistIdAndItemId404JSONResponse) VisitGetChecklistItemBychecklistIdAndItemIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetChecklistItemBychecklistIdAndItemId500JSONResponse Error
+
+func (response GetChecklistItemBychecklistIdAndItemId500JSONResponse) VisitGetChecklistItemBychecklistIdAndItemIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateChecklistItemBychecklistIdAndItemIdRequestObject struct {
+	ChecklistId uint `json:"checklistId"`
+	ItemId      uint `json:"itemId"`
+	Params      UpdateChecklistItemBychecklistIdAndItemIdParams
+	Body        *UpdateChecklistItemBychecklistIdAndItemIdJSONRequestBody
+}
+
+type UpdateChecklistItemBychecklistIdAndItemIdResponseObject interface {
+	VisitUpdateChecklistItemBychecklistIdAndItemIdResponse(w http.ResponseWriter) error
+}
+
+type UpdateChecklistItemBychecklistIdAndItemId200JSONResponse ChecklistItemResponse
+
+func (response UpdateChecklistItemBychecklistIdAndItemId200JSONResponse) VisitUpdateChecklistItemBychecklistIdAndItemIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateChecklistItemBychecklistIdAndItemId400JSONResponse Error
+
+func (response UpdateChecklistItemBychecklistIdAndItemId400JSONResponse) VisitUpdateChecklistItemBychecklistIdAndItemIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateChecklistItemBychecklistIdAndItemId404JSONResponse Error
+
+func (response UpdateChecklistItemBychecklistIdAndItemId404JSONResponse) VisitUpdateChecklistItemBychecklistIdAndItemIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateChecklistItemBychecklistIdAndItemId500JSONResponse Error
+
+func (response UpdateChecklistItemBychecklistIdAndItemId500JSONResponse) VisitUpdateChecklistItemBychecklistIdAndItemIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ChangeChecklistItemOrderNumberRequestObject struct {
+	ChecklistId uint `json:"checklistId"`
+	ItemId      uint `json:"itemId"`
+	Params      ChangeChecklistItemOrderNumberParams
+	Body        *ChangeChecklistItemOrderNumberJSONRequestBody
+}
+
+type ChangeChecklistItemOrderNumberResponseObject interface {
+	VisitChangeChecklistItemOrderNumberResponse(w http.ResponseWriter) error
+}
+
+type ChangeChecklistItemOrderNumber200JSONResponse struct {
+	NewOrderNumber *uint `json:"newOrderNumber,omitempty"`
+	OldOrderNumber *uint `json:"oldOrderNumber,omitempty"`
+
+	// SectionId Section the item belongs to after the move (null when it belongs to no section)
+	SectionId *uint `json:"sectionId"`
+}
+
+func (response ChangeChecklistItemOrderNumber200JSONResponse) VisitChangeChecklistItemOrderNumberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ChangeChecklistItemOrderNumber400JSONResponse Error
+
+func (response ChangeChecklistItemOrderNumber400JSONResponse) VisitChangeChecklistItemOrderNumberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ChangeChecklistItemOrderNumber404JSONResponse Error
+
+func (response ChangeChecklistItemOrderNumber404JSONResponse) VisitChangeChecklistItemOrderNumberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ChangeChecklistItemOrderNumber500JSONResponse Error
+
+func (response ChangeChecklistItemOrderNumber500JSONResponse) VisitChangeChecklistItemOrderNumberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetChecklistItemCommentsRequestObject struct {
+	ChecklistId uint `json:"checklistId"`
+	ItemId      uint `json:"itemId"`
+	Params      GetChecklistItemCommentsParams
+}
+
+type GetChecklistItemCommentsResponseObject interface {
+	VisitGetChecklistItemCommentsResponse(w http.ResponseWriter) error
+}
+
+type GetChecklistItemComments200JSONResponse []ChecklistItemCommentResponse
+
+func (response GetChecklistItemComments200JSONResponse) VisitGetChecklistItemCommentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetChecklistItemComments404JSONResponse Error
+
+func (response GetChecklistItemComments404JSONResponse) VisitGetChecklistItemCommentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetChecklistItemComments500JSONResponse Error
+
+func (response GetChecklistItemComments500JSONResponse) VisitGetChecklistItemCommentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateChecklistItemCommentRequestObject struct {
+	ChecklistId uint `json:"checklistId"`
+	ItemId      uint `json:"itemId"`
+	Params      CreateChecklistItemCommentParams
+	Body        *CreateChecklistItemCommentJSONRequestBody
+}
+
+type CreateChecklistItemCommentResponseObject interface {
+	VisitCreateChecklistItemCommentResponse(w http.ResponseWriter) error
+}
+
+type CreateChecklistItemComment201JSONResponse ChecklistItemCommentResponse
+
+func (response CreateChecklistItemComment201JSONResponse) VisitCreateChecklistItemCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateChecklistItemComment400JSONResponse Error
+
+func (response CreateChecklistItemComment400JSONResponse) VisitCreateChecklistItemCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateChecklistItemComment404JSONResponse Error
+
+func (response CreateChecklistItemComment404JSONResponse) VisitCreateChecklistItemCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateChecklistItemComment500JSONResponse Error
+
+func (response CreateChecklistItemComment500JSONResponse) VisitCreateChecklistItemCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteChecklistItemCommentRequestObject struct {
+	ChecklistId uint `json:"checklistId"`
+	ItemId      uint `json:"itemId"`
+	CommentId   uint `json:"commentId"`
+	Params      DeleteChecklistItemCommentParams
+}
+
+type DeleteChecklistItemCommentResponseObject interface {
+	VisitDeleteChecklistItemCommentResponse(w http.ResponseWriter) error
+}
+
+type DeleteChecklistItemComment204Response struct {
+}
+
+func (response DeleteChecklistItemComment204Response) VisitDeleteChecklistItemCommentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteChecklistItemComment400JSONResponse Error
+
+func (response DeleteChecklistItemComment400JSONResponse) VisitDeleteChecklistItemCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteChecklistItemComment403JSONResponse Error
+
+func (response DeleteChecklistItemComment403JSONResponse) VisitDeleteChecklistItemCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteChecklistItemComment404JSONResponse Error
+
+func (response DeleteChecklistItemComment404JSONResponse) VisitDeleteChecklistItemCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteChecklistItemComment500JSONResponse Error
+
+func (response DeleteChecklistItemComment500JSONResponse) VisitDeleteChecklistItemCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateChecklistItemCommentRequestObject struct {
+	ChecklistId uint `json:"checklistId"`
+	ItemId      uint `json:"itemId"`
+	CommentId   uint `json:"commentId"`
+	Params      UpdateChecklistItemCommentParams
+	Body        *UpdateChecklistItemCommentJSONRequestBody
+}
+
+type UpdateChecklistItemCommentResponseObject interface {
+	VisitUpdateChecklistItemCommentResponse(w http.ResponseWriter) error
+}
+
+type UpdateChecklistItemComment200JSONResponse ChecklistItemCommentResponse
+
+func (response UpdateChecklistItemComment200JSONResponse) VisitUpdateChecklistItemCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateChecklistItemComment400JSONResponse Error
+
+func (response UpdateChecklistItemComment400JSONResponse) VisitUpdateChecklistItemCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateChecklistItemComment403JSONResponse Error
+
+func (response UpdateChecklistItemComment403JSONResponse) VisitUpdateChecklistItemCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateChecklistItemComment404JSONResponse Error
+
+func (response UpdateChecklistItemComment404JSONResponse) VisitUpdateChecklistItemCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateChecklistItemComment500JSONResponse Error
+
+func (response UpdateChecklistItemComment500JSONResponse) VisitUpdateChecklistItemCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CopyChecklistItemRequestObject struct {
+	ChecklistId uint `json:"checklistId"`
+	ItemId      uint `json:"itemId"`
+	Params      CopyChecklistItemParams
+	Body        *CopyChecklistItemJSONRequestBody
+}
+
+type CopyChecklistItemResponseObject interface {
+	VisitCopyChecklistItemResponse(w http.ResponseWriter) error
+}
+
+type CopyChecklistItem201JSONResponse ChecklistItemResponse
+
+func (response CopyChecklistItem201JSONResponse) VisitCopyChecklistItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CopyChecklistItem400JSONResponse Error
+
+func (response CopyChecklistItem400JSONResponse) VisitCopyChecklistItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CopyChecklistItem404JSONResponse Error
+
+func (response CopyChecklistItem404JSONResponse) VisitCopyChecklistItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CopyChecklistItem500JSONResponse Error
+
+func (response CopyChecklistItem500JSONResponse) VisitCopyChecklistItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MoveChecklistItemRequestObject struct {
+	ChecklistId uint `json:"checklistId"`
+	ItemId      uint `json:"itemId"`
+	Params      MoveChecklistItemParams
+	Body        *MoveChecklistItemJSONRequestBody
+}
+
+type MoveChecklistItemResponseObject interface {
+	VisitMoveChecklistItemResponse(w http.ResponseWriter) error
+}
+
+type MoveChecklistItem200JSONResponse ChecklistItemResponse
+
+func (response MoveChecklistItem200JSONResponse) VisitMoveChecklistItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MoveChecklistItem400JSONResponse Error
+
+func (response MoveChecklistItem400JSONResponse) VisitMoveChecklistItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MoveChecklistItem404JSONResponse Error
+
+func (response MoveChecklistItem404JSONResponse) VisitMoveChecklistItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MoveChecklistItem500JSONResponse Error
+
+func (response MoveChecklistItem500JSONResponse) VisitMoveChecklistItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreChecklistItemRequestObject struct {
+	ChecklistId uint `json:"checklistId"`
+	ItemId      uint `json:"itemId"`
+	Params      RestoreChecklistItemParams
+}
+
+type RestoreChecklistItemResponseObject interface {
+	VisitRestoreChecklistItemResponse(w http.ResponseWriter) error
+}
+
+type RestoreChecklistItem200JSONResponse ChecklistItemResponse
+
+func (response RestoreChecklistItem200JSONResponse) VisitRestoreChecklistItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreChecklistItem400JSONResponse Error
+
+func (response RestoreChecklistItem400JSONResponse) VisitRestoreChecklistItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreChecklistItem404JSONResponse Error
+
+func (response RestoreChecklistItem404JSONResponse) VisitRestoreChecklistItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreChecklistItem500JSONResponse Error
+
+func (response RestoreChecklistItem500JSONResponse) VisitRestoreChecklistItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateChecklistItemRowRequestObject struct {
+	ChecklistId uint `json:"checklistId"`
+	ItemId      uint `json:"itemId"`
+	Params      CreateChecklistItemRowParams
+	Body        *CreateChecklistItemRowJSONRequestBody
+}
+
+type CreateChecklistItemRowResponseObject interface {
+	VisitCreateChecklistItemRowResponse(w http.ResponseWriter) error
+}
+
+type CreateChecklistItemRow201JSONResponse ChecklistItemRowResponse
+
+func (response CreateChecklistItemRow201JSONResponse) VisitCreateChecklistItemRowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateChecklistItemRow400JSONResponse Error
+
+func (response CreateChecklistItemRow400JSONResponse) VisitCreateChecklistItemRowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateChecklistItemRow404JSONResponse Error
+
+func (response CreateChecklistItemRow404JSONResponse) VisitCreateChecklistItemRowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateChecklistItemRow500JSONResponse Error
+
+func (response CreateChecklistItemRow500JSONResponse) VisitCreateChecklistItemRowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteChecklistItemRowRequestObject struct {
+	ChecklistId uint `json:"checklistId"`
+	ItemId      uint `json:"itemId"`
+	RowId       uint `json:"rowId"`
+	Params      DeleteChecklistItemRowParams
+}
+
+type DeleteChecklistItemRowResponseObject interface {
+	VisitDeleteChecklistItemRowResponse(w http.ResponseWriter) error
+}
+
+type DeleteChecklistItemRow204Response struct {
+}
+
+func (response DeleteChecklistItemRow204Response) VisitDeleteChecklistItemRowResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteChecklistItemRow400JSONResponse Error
+
+func (response DeleteChecklistItemRow400JSONResponse) VisitDeleteChecklistItemRowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteChecklistItemRow404JSONResponse Error
+
+func (response DeleteChecklistItemRow404JSONResponse) VisitDeleteChecklistItemRowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteChecklistItemRow500JSONResponse Error
+
+func (response DeleteChecklistItemRow500JSONResponse) VisitDeleteChecklistItemRowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ToggleChecklistItemCompleteRequestObject struct {
+	ChecklistId uint `json:"checklistId"`
+	ItemId      uint `json:"itemId"`
+	Params      ToggleChecklistItemCompleteParams
+	Body        *ToggleChecklistItemCompleteJSONRequestBody
+}
+
+type ToggleChecklistItemCompleteResponseObject interface {
+	VisitToggleChecklistItemCompleteResponse(w http.ResponseWriter) error
+}
+
+type ToggleChecklistItemComplete200JSONResponse ChecklistItemResponse
+
+func (response ToggleChecklistItemComplete200JSONResponse) VisitToggleChecklistItemCompleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ToggleChecklistItemComplete400JSONResponse Error
+
+func (response ToggleChecklistItemComplete400JSONResponse) VisitToggleChecklistItemCompleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ToggleChecklistItemComplete404JSONResponse Error
+
+func (response ToggleChecklistItemComplete404JSONResponse) VisitToggleChecklistItemCompleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ToggleChecklistItemComplete500JSONResponse Error
+
+func (response ToggleChecklistItemComplete500JSONResponse) VisitToggleChecklistItemCompleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetChecklistSectionsRequestObject struct {
 	ChecklistId uint `json:"checklistId"`
-	ItemId      uint `json:"itemId"`
-	RowId       uint `json:"rowId"`
-	Params      DeleteChecklistItemRowParams
+	Params      GetChecklistSectionsParams
+}
+
+type GetChecklistSectionsResponseObject interface {
+	VisitGetChecklistSectionsResponse(w http.ResponseWriter) error
+}
+
+type GetChecklistSections200JSONResponse []ChecklistSectionResponse
+
+func (response GetChecklistSections200JSONResponse) VisitGetChecklistSectionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetChecklistSections404JSONResponse Error
+
+func (response GetChecklistSections404JSONResponse) VisitGetChecklistSectionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetChecklistSections500JSONResponse Error
+
+func (response GetChecklistSections500JSONResponse) VisitGetChecklistSectionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateChecklistSectionRequestObject struct {
+	ChecklistId uint `json:"checklistId"`
+	Params      CreateChecklistSectionParams
+	Body        *CreateChecklistSectionJSONRequestBody
+}
+
+type CreateChecklistSectionResponseObject interface {
+	VisitCreateChecklistSectionResponse(w http.ResponseWriter) error
+}
+
+type CreateChecklistSection201JSONResponse ChecklistSectionResponse
+
+func (response CreateChecklistSection201JSONResponse) VisitCreateChecklistSectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateChecklistSection400JSONResponse Error
+
+func (response CreateChecklistSection400JSONResponse) VisitCreateChecklistSectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateChecklistSection404JSONResponse Error
+
+func (response CreateChecklistSection404JSONResponse) VisitCreateChecklistSectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateChecklistSection500JSONResponse Error
+
+func (response CreateChecklistSection500JSONResponse) VisitCreateChecklistSectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteChecklistSectionRequestObject struct {
+	ChecklistId uint `json:"checklistId"`
+	SectionId   uint `json:"sectionId"`
+	Params      DeleteChecklistSectionParams
+}
+
+type DeleteChecklistSectionResponseObject interface {
+	VisitDeleteChecklistSectionResponse(w http.ResponseWriter) error
+}
+
+type DeleteChecklistSection200JSONResponse ChecklistSectionDeletionResponse
+
+func (response DeleteChecklistSection200JSONResponse) VisitDeleteChecklistSectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteChecklistSection400JSONResponse Error
+
+func (response DeleteChecklistSection400JSONResponse) VisitDeleteChecklistSectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteChecklistSection404JSONResponse Error
+
+func (response DeleteChecklistSection404JSONResponse) VisitDeleteChecklistSectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteChecklistSection500JSONResponse Error
+
+func (response DeleteChecklistSection500JSONResponse) VisitDeleteChecklistSectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateChecklistSectionRequestObject struct {
+	ChecklistId uint `json:"checklistId"`
+	SectionId   uint `json:"sectionId"`
+	Params      UpdateChecklistSectionParams
+	Body        *UpdateChecklistSectionJSONRequestBody
+}
+
+type UpdateChecklistSectionResponseObject interface {
+	VisitUpdateChecklistSectionResponse(w http.ResponseWriter) error
+}
+
+type UpdateChecklistSection200JSONResponse ChecklistSectionResponse
+
+func (response UpdateChecklistSection200JSONResponse) VisitUpdateChecklistSectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateChecklistSection400JSONResponse Error
+
+func (response UpdateChecklistSection400JSONResponse) VisitUpdateChecklistSectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateChecklistSection404JSONResponse Error
+
+func (response UpdateChecklistSection404JSONResponse) VisitUpdateChecklistSectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateChecklistSection500JSONResponse Error
+
+func (response UpdateChecklistSection500JSONResponse) VisitUpdateChecklistSectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ChangeChecklistSectionOrderNumberRequestObject struct {
+	ChecklistId uint `json:"checklistId"`
+	SectionId   uint `json:"sectionId"`
+	Params      ChangeChecklistSectionOrderNumberParams
+	Body        *ChangeChecklistSectionOrderNumberJSONRequestBody
+}
+
+type ChangeChecklistSectionOrderNumberResponseObject interface {
+	VisitChangeChecklistSectionOrderNumberResponse(w http.ResponseWriter) error
+}
+
+type ChangeChecklistSectionOrderNumber200JSONResponse ChecklistSectionResponse
+
+func (response ChangeChecklistSectionOrderNumber200JSONResponse) VisitChangeChecklistSectionOrderNumberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ChangeChecklistSectionOrderNumber400JSONResponse Error
+
+func (response ChangeChecklistSectionOrderNumber400JSONResponse) VisitChangeChecklistSectionOrderNumberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ChangeChecklistSectionOrderNumber404JSONResponse Error
+
+func (response ChangeChecklistSectionOrderNumber404JSONResponse) VisitChangeChecklistSectionOrderNumberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ChangeChecklistSectionOrderNumber500JSONResponse Error
+
+func (response ChangeChecklistSectionOrderNumber500JSONResponse) VisitChangeChecklistSectionOrderNumberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Get all checklist items by checklist ID
+	// (GET /api/v1/checklists/{checklistId}/items)
+	GetAllChecklistItems(ctx context.Context, request GetAllChecklistItemsRequestObject) (GetAllChecklistItemsResponseObject, error)
+	// Create a new checklist item
+	// (POST /api/v1/checklists/{checklistId}/items)
+	CreateChecklistItem(ctx context.Context, request CreateChecklistItemRequestObject) (CreateChecklistItemResponseObject, error)
+	// Apply several checklist item operations at once
+	// (POST /api/v1/checklists/{checklistId}/items/batch)
+	BatchUpdateChecklistItems(ctx context.Context, request BatchUpdateChecklistItemsRequestObject) (BatchUpdateChecklistItemsResponseObject, error)
+	// Clear all completed checklist items
+	// (POST /api/v1/checklists/{checklistId}/items/clear-completed)
+	ClearCompletedChecklistItems(ctx context.Context, request ClearCompletedChecklistItemsRequestObject) (ClearCompletedChecklistItemsResponseObject, error)
+	// Restore a group of cleared checklist items
+	// (POST /api/v1/checklists/{checklistId}/items/deletion-groups/{groupId}/restore)
+	RestoreChecklistItemDeletionGroup(ctx context.Context, request RestoreChecklistItemDeletionGroupRequestObject) (RestoreChecklistItemDeletionGroupResponseObject, error)
+	// Reset all checklist items
+	// (POST /api/v1/checklists/{checklistId}/items/reset)
+	ResetChecklistItems(ctx context.Context, request ResetChecklistItemsRequestObject) (ResetChecklistItemsResponseObject, error)
+	// Sort all checklist items
+	// (POST /api/v1/checklists/{checklistId}/items/sort)
+	SortChecklistItems(ctx context.Context, request SortChecklistItemsRequestObject) (SortChecklistItemsResponseObject, error)
+	// Delete checklist item by checklistId and checklistItemId
+	// (DELETE /api/v1/checklists/{checklistId}/items/{itemId})
+	DeleteChecklistItemById(ctx context.Context, request DeleteChecklistItemByIdRequestObject) (DeleteChecklistItemByIdResponseObject, error)
+	// Get checklist item by checklist id and item id
+	// (GET /api/v1/checklists/{checklistId}/items/{itemId})
+	GetChecklistItemBychecklistIdAndItemId(ctx context.Context, request GetChecklistItemBychecklistIdAndItemIdRequestObject) (GetChecklistItemBychecklistIdAndItemIdResponseObject, error)
+	// Update checklist item by checklist id and item id
+	// (PUT /api/v1/checklists/{checklistId}/items/{itemId})
+	UpdateChecklistItemBychecklistIdAndItemId(ctx context.Context, request UpdateChecklistItemBychecklistIdAndItemIdRequestObject) (UpdateChecklistItemBychecklistIdAndItemIdResponseObject, error)
+	// Change checklist item order number
+	// (PATCH /api/v1/checklists/{checklistId}/items/{itemId}/change-order)
+	ChangeChecklistItemOrderNumber(ctx context.Context, request ChangeChecklistItemOrderNumberRequestObject) (ChangeChecklistItemOrderNumberResponseObject, error)
+	// Get the comments of a checklist item
+	// (GET /api/v1/checklists/{checklistId}/items/{itemId}/comments)
+	GetChecklistItemComments(ctx context.Context, request GetChecklistItemCommentsRequestObject) (GetChecklistItemCommentsResponseObject, error)
+	// Comment on a checklist item or reply to a comment
+	// (POST /api/v1/checklists/{checklistId}/items/{itemId}/comments)
+	CreateChecklistItemComment(ctx context.Context, request CreateChecklistItemCommentRequestObject) (CreateChecklistItemCommentResponseObject, error)
+	// Delete a comment
+	// (DELETE /api/v1/checklists/{checklistId}/items/{itemId}/comments/{commentId})
+	DeleteChecklistItemComment(ctx context.Context, request DeleteChecklistItemCommentRequestObject) (DeleteChecklistItemCommentResponseObject, error)
+	// Edit a comment
+	// (PUT /api/v1/checklists/{checklistId}/items/{itemId}/comments/{commentId})
+	UpdateChecklistItemComment(ctx context.Context, request UpdateChecklistItemCommentRequestObject) (UpdateChecklistItemCommentResponseObject, error)
+	// Copy checklist item to another checklist
+	// (POST /api/v1/checklists/{checklistId}/items/{itemId}/copy)
+	CopyChecklistItem(ctx context.Context, request CopyChecklistItemRequestObject) (CopyChecklistItemResponseObject, error)
+	// Move checklist item to another checklist
+	// (POST /api/v1/checklists/{checklistId}/items/{itemId}/move)
+	MoveChecklistItem(ctx context.Context, request MoveChecklistItemRequestObject) (MoveChecklistItemResponseObject, error)
+	// Restore a soft-deleted checklist item (undo delete)
+	// (POST /api/v1/checklists/{checklistId}/items/{itemId}/restore)
+	RestoreChecklistItem(ctx context.Context, request RestoreChecklistItemRequestObject) (RestoreChecklistItemResponseObject, error)
+	// Create checklist item row
+	// (POST /api/v1/checklists/{checklistId}/items/{itemId}/rows)
+	CreateChecklistItemRow(ctx context.Context, request CreateChecklistItemRowRequestObject) (CreateChecklistItemRowResponseObject, error)
+	// Delete checklist item row by checklistId, itemId and rowId
+	// (DELETE /api/v1/checklists/{checklistId}/items/{itemId}/rows/{rowId})
+	DeleteChecklistItemRow(ctx context.Context, request DeleteChecklistItemRowRequestObject) (DeleteChecklistItemRowResponseObject, error)
+	// Toggle checklist item completion status
+	// (PATCH /api/v1/checklists/{checklistId}/items/{itemId}/toggle-complete)
+	ToggleChecklistItemComplete(ctx context.Context, request ToggleChecklistItemCompleteRequestObject) (ToggleChecklistItemCompleteResponseObject, error)
+	// Get the sections of a checklist
+	// (GET /api/v1/checklists/{checklistId}/sections)
+	GetChecklistSections(ctx context.Context, request GetChecklistSectionsRequestObject) (GetChecklistSectionsResponseObject, error)
+	// Create a checklist section
+	// (POST /api/v1/checklists/{checklistId}/sections)
+	CreateChecklistSection(ctx context.Context, request CreateChecklistSectionRequestObject) (CreateChecklistSectionResponseObject, error)
+	// Delete a checklist section
+	// (DELETE /api/v1/checklists/{checklistId}/sections/{sectionId})
+	DeleteChecklistSection(ctx context.Context, request DeleteChecklistSectionRequestObject) (DeleteChecklistSectionResponseObject, error)
+	// Rename, collapse or expand a checklist section
+	// (PUT /api/v1/checklists/{checklistId}/sections/{sectionId})
+	UpdateChecklistSection(ctx context.Context, request UpdateChecklistSectionRequestObject) (UpdateChecklistSectionResponseObject, error)
+	// Change checklist section order number
+	// (PATCH /api/v1/checklists/{checklistId}/sections/{sectionId}/change-order)
+	ChangeChecklistSectionOrderNumber(ctx context.Context, request ChangeChecklistSectionOrderNumberRequestObject) (ChangeChecklistSectionOrderNumberResponseObject, error)
+}
+
+type StrictHandlerFunc = strictgin.StrictGinHandlerFunc
+type StrictMiddlewareFunc = strictgin.StrictGinMiddlewareFunc
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+}
+
+// GetAllChecklistItems operation middleware
+func (sh *strictHandler) GetAllChecklistItems(ctx *gin.Context, checklistId uint, params GetAllChecklistItemsParams) {
+	var request GetAllChecklistItemsRequestObject
+
+	request.ChecklistId = checklistId
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAllChecklistItems(ctx, request.(GetAllChecklistItemsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAllChecklistItems")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetAllChecklistItemsResponseObject); ok {
+		if err := validResponse.VisitGetAllChecklistItemsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DeleteChecklistItemRowResponseObject interface {
-	VisitDeleteChecklistItemRowResponse(w http.ResponseWriter) error
-}
+// CreateChecklistItem operation middleware
+func (sh *strictHandler) CreateChecklistItem(ctx *gin.Context, checklistId uint, params CreateChecklistItemParams) {
+	var request CreateChecklistItemRequestObject
+
+	request.ChecklistId = checklistId
+	request.Params = params
+
+	var body CreateChecklistItemJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
-type DeleteChecklistItemRow204Response struct {
-}
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateChecklistItem(ctx, request.(CreateChecklistItemRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateChecklistItem")
+	}
 
-func (response DeleteChecklistItemRow204Response) VisitDeleteChecklistItemRowResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(CreateChecklistItemResponseObject); ok {
+		if err := validResponse.VisitCreateChecklistItemResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DeleteChecklistItemRow404JSONResponse Error
+// BatchUpdateChecklistItems operation middleware
+func (sh *strictHandler) BatchUpdateChecklistItems(ctx *gin.Context, checklistId uint, params BatchUpdateChecklistItemsParams) {
+	var request BatchUpdateChecklistItemsRequestObject
 
-func (response DeleteChecklistItemRow404JSONResponse) VisitDeleteChecklistItemRowResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	request.ChecklistId = checklistId
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
+	var body BatchUpdateChecklistItemsJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.BatchUpdateChecklistItems(ctx, request.(BatchUpdateChecklistItemsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "BatchUpdateChecklistItems")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(BatchUpdateChecklistItemsResponseObject); ok {
+		if err := validResponse.VisitBatchUpdateChecklistItemsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DeleteChecklistItemRow500JSONResponse Error
+// ClearCompletedChecklistItems operation middleware
+func (sh *strictHandler) ClearCompletedChecklistItems(ctx *gin.Context, checklistId uint, params ClearCompletedChecklistItemsParams) {
+	var request ClearCompletedChecklistItemsRequestObject
 
-func (response DeleteChecklistItemRow500JSONResponse) VisitDeleteChecklistItemRowResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.ChecklistId = checklistId
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ClearCompletedChecklistItems(ctx, request.(ClearCompletedChecklistItemsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ClearCompletedChecklistItems")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ClearCompletedChecklistItemsResponseObject); ok {
+		if err := validResponse.VisitClearCompletedChecklistItemsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ToggleChecklistItemCompleteRequestObject struct {
-	ChecklistId uint `json:"checklistId"`
-	ItemId      uint `json:"itemId"`
-	Params      ToggleChecklistItemCompleteParams
-	Body        *ToggleChecklistItemCompleteJSONRequestBody
+// RestoreChecklistItemDeletionGroup operation middleware
+func (sh *strictHandler) RestoreChecklistItemDeletionGroup(ctx *gin.Context, checklistId uint, groupId uint, params RestoreChecklistItemDeletionGroupParams) {
+	var request RestoreChecklistItemDeletionGroupRequestObject
+
+	request.ChecklistId = checklistId
+	request.GroupId = groupId
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.RestoreChecklistItemDeletionGroup(ctx, request.(RestoreChecklistItemDeletionGroupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RestoreChecklistItemDeletionGroup")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(RestoreChecklistItemDeletionGroupResponseObject); ok {
+		if err := validResponse.VisitRestoreChecklistItemDeletionGroupResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ToggleChecklistItemCompleteResponseObject interface {
-	VisitToggleChecklistItemCompleteResponse(w http.ResponseWriter) error
+// ResetChecklistItems operation middleware
+func (sh *strictHandler) ResetChecklistItems(ctx *gin.Context, checklistId uint, params ResetChecklistItemsParams) {
+	var request ResetChecklistItemsRequestObject
+
+	request.ChecklistId = checklistId
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ResetChecklistItems(ctx, request.(ResetChecklistItemsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ResetChecklistItems")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ResetChecklistItemsResponseObject); ok {
+		if err := validResponse.VisitResetChecklistItemsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ToggleChecklistItemComplete200JSONResponse ChecklistItemResponse
+// SortChecklistItems operation middleware
+func (sh *strictHandler) SortChecklistItems(ctx *gin.Context, checklistId uint, params SortChecklistItemsParams) {
+	var request SortChecklistItemsRequestObject
 
-func (response ToggleChecklistItemComplete200JSONResponse) VisitToggleChecklistItemCompleteResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	request.ChecklistId = checklistId
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
+	var body SortChecklistItemsJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.SortChecklistItems(ctx, request.(SortChecklistItemsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SortChecklistItems")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(SortChecklistItemsResponseObject); ok {
+		if err := validResponse.VisitSortChecklistItemsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ToggleChecklistItemComplete400JSONResponse Error
+// DeleteChecklistItemById operation middleware
+func (sh *strictHandler) DeleteChecklistItemById(ctx *gin.Context, checklistId uint, itemId uint, params DeleteChecklistItemByIdParams) {
+	var request DeleteChecklistItemByIdRequestObject
 
-func (response ToggleChecklistItemComplete400JSONResponse) VisitToggleChecklistItemCompleteResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.ChecklistId = checklistId
+	request.ItemId = itemId
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteChecklistItemById(ctx, request.(DeleteChecklistItemByIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteChecklistItemById")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeleteChecklistItemByIdResponseObject); ok {
+		if err := validResponse.VisitDeleteChecklistItemByIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ToggleChecklistItemComplete404JSONResponse Error
+// GetChecklistItemBychecklistIdAndItemId operation middleware
+func (sh *strictHandler) GetChecklistItemBychecklistIdAndItemId(ctx *gin.Context, checklistId uint, itemId uint, params GetChecklistItemBychecklistIdAndItemIdParams) {
+	var request GetChecklistItemBychecklistIdAndItemIdRequestObject
 
-func (response ToggleChecklistItemComplete404JSONResponse) VisitToggleChecklistItemCompleteResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	request.ChecklistId = checklistId
+	request.ItemId = itemId
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetChecklistItemBychecklistIdAndItemId(ctx, request.(GetChecklistItemBychecklistIdAndItemIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetChecklistItemBychecklistIdAndItemId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetChecklistItemBychecklistIdAndItemIdResponseObject); ok {
+		if err := validResponse.VisitGetChecklistItemBychecklistIdAndItemIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ToggleChecklistItemComplete500JSONResponse Error
+// UpdateChecklistItemBychecklistIdAndItemId operation middleware
+func (sh *strictHandler) UpdateChecklistItemBychecklistIdAndItemId(ctx *gin.Context, checklistId uint, itemId uint, params UpdateChecklistItemBychecklistIdAndItemIdParams) {
+	var request UpdateChecklistItemBychecklistIdAndItemIdRequestObject
+
+	request.ChecklistId = checklistId
+	request.ItemId = itemId
+	request.Params = params
+
+	var body UpdateChecklistItemBychecklistIdAndItemIdJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateChecklistItemBychecklistIdAndItemId(ctx, request.(UpdateChecklistItemBychecklistIdAndItemIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateChecklistItemBychecklistIdAndItemId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(UpdateChecklistItemBychecklistIdAndItemIdResponseObject); ok {
+		if err := validResponse.VisitUpdateChecklistItemBychecklistIdAndItemIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-func (response ToggleChecklistItemComplete500JSONResponse) VisitToggleChecklistItemCompleteResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// ChangeChecklistItemOrderNumber operation middleware
+func (sh *strictHandler) ChangeChecklistItemOrderNumber(ctx *gin.Context, checklistId uint, itemId uint, params ChangeChecklistItemOrderNumberParams) {
+	var request ChangeChecklistItemOrderNumberRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.ChecklistId = checklistId
+	request.ItemId = itemId
+	request.Params = params
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Get all checklist items by checklist ID
-	// (GET /api/v1/checklists/{checklistId}/items)
-	GetAllChecklistItems(ctx context.Context, request GetAllChecklistItemsRequestObject) (GetAllChecklistItemsResponseObject, error)
-	// Create a new checklist item
-	// (POST /api/v1/checklists/{checklistId}/items)
-	CreateChecklistItem(ctx context.Context, request CreateChecklistItemRequestObject) (CreateChecklistItemResponseObject, error)
-	// Delete checklist item by checklistId and checklistItemId
-	// (DELETE /api/v1/checklists/{checklistId}/items/{itemId})
-	DeleteChecklistItemById(ctx context.Context, request DeleteChecklistItemByIdRequestObject) (DeleteChecklistItemByIdResponseObject, error)
-	// Get checklist item by checklist id and item id
-	// (GET /api/v1/checklists/{checklistId}/items/{itemId})
-	GetChecklistItemBychecklistIdAndItemId(ctx context.Context, request GetChecklistItemBychecklistIdAndItemIdRequestObject) (GetChecklistItemBychecklistIdAndItemIdResponseObject, error)
-	// Update checklist item by checklist id and item id
-	// (PUT /api/v1/checklists/{checklistId}/items/{itemId})
-	UpdateChecklistItemBychecklistIdAndItemId(ctx context.Context, request UpdateChecklistItemBychecklistIdAndItemIdRequestObject) (UpdateChecklistItemBychecklistIdAndItemIdResponseObject, error)
-	// Change checklist item order number
-	// (PATCH /api/v1/checklists/{checklistId}/items/{itemId}/change-order)
-	ChangeChecklistItemOrderNumber(ctx context.Context, request ChangeChecklistItemOrderNumberRequestObject) (ChangeChecklistItemOrderNumberResponseObject, error)
-	// Restore a soft-deleted checklist item (undo delete)
-	// (POST /api/v1/checklists/{checklistId}/items/{itemId}/restore)
-	RestoreChecklistItem(ctx context.Context, request RestoreChecklistItemRequestObject) (RestoreChecklistItemResponseObject, error)
-	// Create checklist item row
-	// (POST /api/v1/checklists/{checklistId}/items/{itemId}/rows)
-	CreateChecklistItemRow(ctx context.Context, request CreateChecklistItemRowRequestObject) (CreateChecklistItemRowResponseObject, error)
-	// Delete checklist item row by checklistId, itemId and rowId
-	// (DELETE /api/v1/checklists/{checklistId}/items/{itemId}/rows/{rowId})
-	DeleteChecklistItemRow(ctx context.Context, request DeleteChecklistItemRowRequestObject) (DeleteChecklistItemRowResponseObject, error)
-	// Toggle checklist item completion status
-	// (PATCH /api/v1/checklists/{checklistId}/items/{itemId}/toggle-complete)
-	ToggleChecklistItemComplete(ctx context.Context, request ToggleChecklistItemCompleteRequestObject) (ToggleChecklistItemCompleteResponseObject, error)
-}
+	var body ChangeChecklistItemOrderNumberJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
-type StrictHandlerFunc = strictgin.StrictGinHandlerFunc
-type StrictMiddlewareFunc = strictgin.StrictGinMiddlewareFunc
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ChangeChecklistItemOrderNumber(ctx, request.(ChangeChecklistItemOrderNumberRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ChangeChecklistItemOrderNumber")
+	}
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares}
-}
+	response, err := handler(ctx, request)
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ChangeChecklistItemOrderNumberResponseObject); ok {
+		if err := validResponse.VisitChangeChecklistItemOrderNumberResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// GetAllChecklistItems operation middleware
-func (sh *strictHandler) GetAllChecklistItems(ctx *gin.Context, checklistId uint, params GetAllChecklistItemsParams) {
-	var request GetAllChecklistItemsRequestObject
+// GetChecklistItemComments operation middleware
+func (sh *strictHandler) GetChecklistItemComments(ctx *gin.Context, checklistId uint, itemId uint, params GetChecklistItemCommentsParams) {
+	var request GetChecklistItemCommentsRequestObject
 
 	request.ChecklistId = checklistId
+	request.ItemId = itemId
 	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetAllChecklistItems(ctx, request.(GetAllChecklistItemsRequestObject))
+		return sh.ssi.GetChecklistItemComments(ctx, request.(GetChecklistItemCommentsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetAllChecklistItems")
+		handler = middleware(handler, "GetChecklistItemComments")
 	}
 
 	response, err := handler(ctx, request)
@@ -1348,8 +3833,8 @@ func (sh *strictHandler) GetAllChecklistItems(ctx *gin.Context, checklistId uint
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetAllChecklistItemsResponseObject); ok {
-		if err := validResponse.VisitGetAllChecklistItemsResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetChecklistItemCommentsResponseObject); ok {
+		if err := validResponse.VisitGetChecklistItemCommentsResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -1357,14 +3842,15 @@ func (sh *strictHandler) GetAllChecklistItems(ctx *gin.Context, checklistId uint
 	}
 }
 
-// CreateChecklistItem operation middleware
-func (sh *strictHandler) CreateChecklistItem(ctx *gin.Context, checklistId uint, params CreateChecklistItemParams) {
-	var request CreateChecklistItemRequestObject
+// CreateChecklistItemComment operation middleware
+func (sh *strictHandler) CreateChecklistItemComment(ctx *gin.Context, checklistId uint, itemId uint, params CreateChecklistItemCommentParams) {
+	var request CreateChecklistItemCommentRequestObject
 
 	request.ChecklistId = checklistId
+	request.ItemId = itemId
 	request.Params = params
 
-	var body CreateChecklistItemJSONRequestBody
+	var body CreateChecklistItemCommentJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -1373,10 +3859,10 @@ func (sh *strictHandler) CreateChecklistItem(ctx *gin.Context, checklistId uint,
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateChecklistItem(ctx, request.(CreateChecklistItemRequestObject))
+		return sh.ssi.CreateChecklistItemComment(ctx, request.(CreateChecklistItemCommentRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateChecklistItem")
+		handler = middleware(handler, "CreateChecklistItemComment")
 	}
 
 	response, err := handler(ctx, request)
@@ -1384,8 +3870,8 @@ func (sh *strictHandler) CreateChecklistItem(ctx *gin.Context, checklistId uint,
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(CreateChecklistItemResponseObject); ok {
-		if err := validResponse.VisitCreateChecklistItemResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(CreateChecklistItemCommentResponseObject); ok {
+		if err := validResponse.VisitCreateChecklistItemCommentResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -1393,19 +3879,20 @@ func (sh *strictHandler) CreateChecklistItem(ctx *gin.Context, checklistId uint,
 	}
 }
 
-// DeleteChecklistItemById operation middleware
-func (sh *strictHandler) DeleteChecklistItemById(ctx *gin.Context, checklistId uint, itemId uint, params DeleteChecklistItemByIdParams) {
-	var request DeleteChecklistItemByIdRequestObject
+// DeleteChecklistItemComment operation middleware
+func (sh *strictHandler) DeleteChecklistItemComment(ctx *gin.Context, checklistId uint, itemId uint, commentId uint, params DeleteChecklistItemCommentParams) {
+	var request DeleteChecklistItemCommentRequestObject
 
 	request.ChecklistId = checklistId
 	request.ItemId = itemId
+	request.CommentId = commentId
 	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteChecklistItemById(ctx, request.(DeleteChecklistItemByIdRequestObject))
+		return sh.ssi.DeleteChecklistItemComment(ctx, request.(DeleteChecklistItemCommentRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteChecklistItemById")
+		handler = middleware(handler, "DeleteChecklistItemComment")
 	}
 
 	response, err := handler(ctx, request)
@@ -1413,8 +3900,8 @@ func (sh *strictHandler) DeleteChecklistItemById(ctx *gin.Context, checklistId u
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(DeleteChecklistItemByIdResponseObject); ok {
-		if err := validResponse.VisitDeleteChecklistItemByIdResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(DeleteChecklistItemCommentResponseObject); ok {
+		if err := validResponse.VisitDeleteChecklistItemCommentResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -1422,19 +3909,28 @@ func (sh *strictHandler) DeleteChecklistItemById(ctx *gin.Context, checklistId u
 	}
 }
 
-// GetChecklistItemBychecklistIdAndItemId operation middleware
-func (sh *strictHandler) GetChecklistItemBychecklistIdAndItemId(ctx *gin.Context, checklistId uint, itemId uint, params GetChecklistItemBychecklistIdAndItemIdParams) {
-	var request GetChecklistItemBychecklistIdAndItemIdRequestObject
+// UpdateChecklistItemComment operation middleware
+func (sh *strictHandler) UpdateChecklistItemComment(ctx *gin.Context, checklistId uint, itemId uint, commentId uint, params UpdateChecklistItemCommentParams) {
+	var request UpdateChecklistItemCommentRequestObject
 
 	request.ChecklistId = checklistId
 	request.ItemId = itemId
+	request.CommentId = commentId
 	request.Params = params
 
+	var body UpdateChecklistItemCommentJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetChecklistItemBychecklistIdAndItemId(ctx, request.(GetChecklistItemBychecklistIdAndItemIdRequestObject))
+		return sh.ssi.UpdateChecklistItemComment(ctx, request.(UpdateChecklistItemCommentRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetChecklistItemBychecklistIdAndItemId")
+		handler = middleware(handler, "UpdateChecklistItemComment")
 	}
 
 	response, err := handler(ctx, request)
@@ -1442,8 +3938,8 @@ func (sh *strictHandler) GetChecklistItemBychecklistIdAndItemId(ctx *gin.Context
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetChecklistItemBychecklistIdAndItemIdResponseObject); ok {
-		if err := validResponse.VisitGetChecklistItemBychecklistIdAndItemIdResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(UpdateChecklistItemCommentResponseObject); ok {
+		if err := validResponse.VisitUpdateChecklistItemCommentResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -1451,15 +3947,15 @@ func (sh *strictHandler) GetChecklistItemBychecklistIdAndItemId(ctx *gin.Context
 	}
 }
 
-// UpdateChecklistItemBychecklistIdAndItemId operation middleware
-func (sh *strictHandler) UpdateChecklistItemBychecklistIdAndItemId(ctx *gin.Context, checklistId uint, itemId uint, params UpdateChecklistItemBychecklistIdAndItemIdParams) {
-	var request UpdateChecklistItemBychecklistIdAndItemIdRequestObject
+// CopyChecklistItem operation middleware
+func (sh *strictHandler) CopyChecklistItem(ctx *gin.Context, checklistId uint, itemId uint, params CopyChecklistItemParams) {
+	var request CopyChecklistItemRequestObject
 
 	request.ChecklistId = checklistId
 	request.ItemId = itemId
 	request.Params = params
 
-	var body UpdateChecklistItemBychecklistIdAndItemIdJSONRequestBody
+	var body CopyChecklistItemJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -1468,10 +3964,10 @@ func (sh *strictHandler) UpdateChecklistItemBychecklistIdAndItemId(ctx *gin.Cont
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateChecklistItemBychecklistIdAndItemId(ctx, request.(UpdateChecklistItemBychecklistIdAndItemIdRequestObject))
+		return sh.ssi.CopyChecklistItem(ctx, request.(CopyChecklistItemRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateChecklistItemBychecklistIdAndItemId")
+		handler = middleware(handler, "CopyChecklistItem")
 	}
 
 	response, err := handler(ctx, request)
@@ -1479,8 +3975,8 @@ func (sh *strictHandler) UpdateChecklistItemBychecklistIdAndItemId(ctx *gin.Cont
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(UpdateChecklistItemBychecklistIdAndItemIdResponseObject); ok {
-		if err := validResponse.VisitUpdateChecklistItemBychecklistIdAndItemIdResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(CopyChecklistItemResponseObject); ok {
+		if err := validResponse.VisitCopyChecklistItemResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -1488,15 +3984,15 @@ func (sh *strictHandler) UpdateChecklistItemBychecklistIdAndItemId(ctx *gin.Cont
 	}
 }
 
-// ChangeChecklistItemOrderNumber operation middleware
-func (sh *strictHandler) ChangeChecklistItemOrderNumber(ctx *gin.Context, checklistId uint, itemId uint, params ChangeChecklistItemOrderNumberParams) {
-	var request ChangeChecklistItemOrderNumberRequestObject
+// MoveChecklistItem operation middleware
+func (sh *strictHandler) MoveChecklistItem(ctx *gin.Context, checklistId uint, itemId uint, params MoveChecklistItemParams) {
+	var request MoveChecklistItemRequestObject
 
 	request.ChecklistId = checklistId
 	request.ItemId = itemId
 	request.Params = params
 
-	var body ChangeChecklistItemOrderNumberJSONRequestBody
+	var body MoveChecklistItemJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -1505,10 +4001,10 @@ func (sh *strictHandler) ChangeChecklistItemOrderNumber(ctx *gin.Context, checkl
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.ChangeChecklistItemOrderNumber(ctx, request.(ChangeChecklistItemOrderNumberRequestObject))
+		return sh.ssi.MoveChecklistItem(ctx, request.(MoveChecklistItemRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ChangeChecklistItemOrderNumber")
+		handler = middleware(handler, "MoveChecklistItem")
 	}
 
 	response, err := handler(ctx, request)
@@ -1516,8 +4012,8 @@ func (sh *strictHandler) ChangeChecklistItemOrderNumber(ctx *gin.Context, checkl
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(ChangeChecklistItemOrderNumberResponseObject); ok {
-		if err := validResponse.VisitChangeChecklistItemOrderNumberResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(MoveChecklistItemResponseObject); ok {
+		if err := validResponse.VisitMoveChecklistItemResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -1657,3 +4153,170 @@ func (sh *strictHandler) ToggleChecklistItemComplete(ctx *gin.Context, checklist
 		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
 	}
 }
+
+// GetChecklistSections operation middleware
+func (sh *strictHandler) GetChecklistSections(ctx *gin.Context, checklistId uint, params GetChecklistSectionsParams) {
+	var request GetChecklistSectionsRequestObject
+
+	request.ChecklistId = checklistId
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetChecklistSections(ctx, request.(GetChecklistSectionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetChecklistSections")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetChecklistSectionsResponseObject); ok {
+		if err := validResponse.VisitGetChecklistSectionsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateChecklistSection operation middleware
+func (sh *strictHandler) CreateChecklistSection(ctx *gin.Context, checklistId uint, params CreateChecklistSectionParams) {
+	var request CreateChecklistSectionRequestObject
+
+	request.ChecklistId = checklistId
+	request.Params = params
+
+	var body CreateChecklistSectionJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateChecklistSection(ctx, request.(CreateChecklistSectionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateChecklistSection")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(CreateChecklistSectionResponseObject); ok {
+		if err := validResponse.VisitCreateChecklistSectionResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteChecklistSection operation middleware
+func (sh *strictHandler) DeleteChecklistSection(ctx *gin.Context, checklistId uint, sectionId uint, params DeleteChecklistSectionParams) {
+	var request DeleteChecklistSectionRequestObject
+
+	request.ChecklistId = checklistId
+	request.SectionId = sectionId
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteChecklistSection(ctx, request.(DeleteChecklistSectionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteChecklistSection")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeleteChecklistSectionResponseObject); ok {
+		if err := validResponse.VisitDeleteChecklistSectionResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateChecklistSection operation middleware
+func (sh *strictHandler) UpdateChecklistSection(ctx *gin.Context, checklistId uint, sectionId uint, params UpdateChecklistSectionParams) {
+	var request UpdateChecklistSectionRequestObject
+
+	request.ChecklistId = checklistId
+	request.SectionId = sectionId
+	request.Params = params
+
+	var body UpdateChecklistSectionJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateChecklistSection(ctx, request.(UpdateChecklistSectionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateChecklistSection")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(UpdateChecklistSectionResponseObject); ok {
+		if err := validResponse.VisitUpdateChecklistSectionResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ChangeChecklistSectionOrderNumber operation middleware
+func (sh *strictHandler) ChangeChecklistSectionOrderNumber(ctx *gin.Context, checklistId uint, sectionId uint, params ChangeChecklistSectionOrderNumberParams) {
+	var request ChangeChecklistSectionOrderNumberRequestObject
+
+	request.ChecklistId = checklistId
+	request.SectionId = sectionId
+	request.Params = params
+
+	var body ChangeChecklistSectionOrderNumberJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ChangeChecklistSectionOrderNumber(ctx, request.(ChangeChecklistSectionOrderNumberRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ChangeChecklistSectionOrderNumber")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ChangeChecklistSectionOrderNumberResponseObject); ok {
+		if err := validResponse.VisitChangeChecklistSectionOrderNumberResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
@@ -3,6 +3,7 @@ package sse
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
 
 	"com.raunlo.checklist/internal/core/domain"
 	"github.com/rendis/structsconv"
@@ -20,7 +21,8 @@ func (mapper *checklistItemUpdatesMapper) Map(event domain.ChecklistItemUpdatesE
 		panic(error)
 	}
 	return EventEnvelope{
-		Type: EventEnvelopeType(event.EventType),
+		ChecklistId: event.ChecklistId,
+		Type:        EventEnvelopeType(event.EventType),
 		Payload: &EventEnvelope_Payload{
 			union: payload,
 		},
@@ -98,14 +100,210 @@ func mapPayload(event domain.ChecklistItemUpdatesEvent) (json.RawMessage, error)
 		}
 		b, _ := json.Marshal(restoredPayload)
 		return json.RawMessage(b), nil
-	case domain.EventTypeBufferOverflow:
-		casted, ok := source.(domain.BufferOverflowEventPayload)
+	case domain.EventTypeChecklistItemsBatchUpdated:
+		casted, ok := source.(domain.ChecklistItemsBatchUpdatedEventPayload)
 		if !ok {
 			return nil, fmt.Errorf("invalid payload type")
 		}
-		// Simply marshal the message
-		b, _ := json.Marshal(map[string]string{
-			"message": casted.Message,
+		batchPayload := ChecklistItemsBatchUpdatedEventPayload{
+			Items:          make([]ChecklistItemResponse, len(casted.Items)),
+			DeletedItemIds: append([]uint{}, casted.DeletedItemIds...),
+		}
+		for index, item := range casted.Items {
+			structsconv.Map(&item, &batchPayload.Items[index])
+		}
+		b, _ := json.Marshal(batchPayload)
+		return json.RawMessage(b), nil
+	case domain.EventTypeChecklistMerged:
+		casted, ok := source.(domain.ChecklistMergedEventPayload)
+		if !ok {
+			return nil, fmt.Errorf("invalid payload type")
+		}
+		b, _ := json.Marshal(ChecklistMergedEventPayload{TargetChecklistId: casted.TargetChecklistId})
+		return json.RawMessage(b), nil
+	case domain.EventTypeChecklistItemsSorted:
+		casted, ok := source.(domain.ChecklistItemsSortedEventPayload)
+		if !ok {
+			return nil, fmt.Errorf("invalid payload type")
+		}
+		b, _ := json.Marshal(ChecklistItemsSortedEventPayload{ItemIds: append([]uint{}, casted.ItemIds...)})
+		return json.RawMessage(b), nil
+	case domain.EventTypeChecklistSplit:
+		casted, ok := source.(domain.ChecklistSplitEventPayload)
+		if !ok {
+			return nil, fmt.Errorf("invalid payload type")
+		}
+		b, _ := json.Marshal(ChecklistSplitEventPayload{
+			NewChecklistId: casted.NewChecklistId,
+			ItemIds:        append([]uint{}, casted.ItemIds...),
+		})
+		return json.RawMessage(b), nil
+	case domain.EventTypeChecklistSectionCreated, domain.EventTypeChecklistSectionUpdated:
+		casted, ok := source.(domain.ChecklistSection)
+		if !ok {
+			return nil, fmt.Errorf("invalid payload type")
+		}
+		var sectionPayload ChecklistSectionResponse
+		structsconv.Map(&casted, &sectionPayload)
+		b, _ := json.Marshal(sectionPayload)
+		return json.RawMessage(b), nil
+	case domain.EventTypeChecklistSectionDeleted:
+		casted, ok := source.(domain.ChecklistSectionDeletedEventPayload)
+		if !ok {
+			return nil, fmt.Errorf("invalid payload type")
+		}
+		b, _ := json.Marshal(ChecklistSectionDeletedEventPayload{
+			SectionId: casted.SectionId,
+			ItemIds:   append([]uint{}, casted.ItemIds...),
+		})
+		return json.RawMessage(b), nil
+	case domain.EventTypeChecklistSectionReordered:
+		casted, ok := source.(domain.ChecklistSectionReorderedEventPayload)
+		if !ok {
+			return nil, fmt.Errorf("invalid payload type")
+		}
+		b, _ := json.Marshal(ChecklistSectionReorderedEventPayload{
+			SectionId:      casted.SectionId,
+			NewOrderNumber: casted.NewOrderNumber,
+		})
+		return json.RawMessage(b), nil
+	case domain.EventTypeChecklistItemCommentCreated, domain.EventTypeChecklistItemCommentUpdated:
+		casted, ok := source.(domain.ChecklistItemComment)
+		if !ok {
+			return nil, fmt.Errorf("invalid payload type")
+		}
+		mentions := make([]ChecklistItemCommentMentionResponse, 0, len(casted.Mentions))
+		for _, mention := range casted.Mentions {
+			mentions = append(mentions, ChecklistItemCommentMentionResponse{UserId: mention.UserId, Name: mention.Name})
+		}
+		b, _ := json.Marshal(ChecklistItemCommentResponse{
+			Id:         casted.Id,
+			ItemId:     casted.ItemId,
+			ParentId:   casted.ParentId,
+			Author:     casted.Author,
+			AuthorName: casted.AuthorName,
+			Content:    casted.Content,
+			Mentions:   mentions,
+			CreatedAt:  casted.CreatedAt,
+			EditedAt:   casted.EditedAt,
+			Deleted:    casted.Deleted,
+		})
+		return json.RawMessage(b), nil
+	case domain.EventTypeChecklistItemCommentDeleted:
+		casted, ok := source.(domain.ChecklistItemCommentDeletedEventPayload)
+		if !ok {
+			return nil, fmt.Errorf("invalid payload type")
+		}
+		b, _ := json.Marshal(ChecklistItemCommentDeletedEventPayload{
+			ItemId:    casted.ItemId,
+			CommentId: casted.CommentId,
+		})
+		return json.RawMessage(b), nil
+	case domain.EventTypeResyncRequired:
+		casted, ok := source.(domain.ResyncRequiredEventPayload)
+		if !ok {
+			return nil, fmt.Errorf("invalid payload type")
+		}
+		b, _ := json.Marshal(ResyncRequiredEventPayload{
+			Message: casted.Message,
+		})
+		return json.RawMessage(b), nil
+	case domain.EventTypeChecklistCreated, domain.EventTypeChecklistUpdated, domain.EventTypeChecklistMoved:
+		casted, ok := source.(domain.ChecklistEventPayload)
+		if !ok {
+			return nil, fmt.Errorf("invalid payload type")
+		}
+		b, _ := json.Marshal(ChecklistEventPayload{
+			Name:        casted.Name,
+			WorkspaceId: casted.WorkspaceId,
+		})
+		return json.RawMessage(b), nil
+	case domain.EventTypeChecklistDeleted:
+		casted, ok := source.(domain.ChecklistDeletedEventPayload)
+		if !ok {
+			return nil, fmt.Errorf("invalid payload type")
+		}
+		b, _ := json.Marshal(ChecklistDeletedEventPayload{
+			ChecklistId: casted.ChecklistId,
+		})
+		return json.RawMessage(b), nil
+	case domain.EventTypeChecklistStatsChanged:
+		casted, ok := source.(domain.ChecklistStatsChangedEventPayload)
+		if !ok {
+			return nil, fmt.Errorf("invalid payload type")
+		}
+		b, _ := json.Marshal(ChecklistStatsChangedEventPayload{
+			TotalItems:     casted.TotalItems,
+			CompletedItems: casted.CompletedItems,
+		})
+		return json.RawMessage(b), nil
+	case domain.EventTypeChecklistAccessGranted, domain.EventTypeChecklistAccessRevoked:
+		casted, ok := source.(domain.ChecklistAccessEventPayload)
+		if !ok {
+			return nil, fmt.Errorf("invalid payload type")
+		}
+		b, _ := json.Marshal(ChecklistAccessEventPayload{
+			ChecklistId: casted.ChecklistId,
+		})
+		return json.RawMessage(b), nil
+	case domain.EventTypePresenceJoined:
+		casted, ok := source.(domain.ChecklistViewer)
+		if !ok {
+			return nil, fmt.Errorf("invalid payload type")
+		}
+		b, _ := json.Marshal(ChecklistViewerResponse{
+			UserId:        casted.UserId,
+			Name:          casted.Name,
+			EditingItemId: casted.EditingItemId,
+		})
+		return json.RawMessage(b), nil
+	case domain.EventTypePresenceLeft:
+		casted, ok := source.(domain.PresenceLeftEventPayload)
+		if !ok {
+			return nil, fmt.Errorf("invalid payload type")
+		}
+		b, _ := json.Marshal(PresenceLeftEventPayload{
+			UserId: casted.UserId,
+		})
+		return json.RawMessage(b), nil
+	case domain.EventTypePresenceEditing:
+		casted, ok := source.(domain.PresenceEditingEventPayload)
+		if !ok {
+			return nil, fmt.Errorf("invalid payload type")
+		}
+		b, _ := json.Marshal(PresenceEditingEventPayload{
+			UserId: casted.UserId,
+			ItemId: casted.ItemId,
+		})
+		return json.RawMessage(b), nil
+	case domain.EventTypeChecklistItemPositionsRebalanced:
+		casted, ok := source.(domain.ChecklistItemPositionsRebalancedEventPayload)
+		if !ok {
+			return nil, fmt.Errorf("invalid payload type")
+		}
+		positions := make(map[string]string, len(casted.Positions))
+		for itemId, position := range casted.Positions {
+			positions[strconv.FormatUint(uint64(itemId), 10)] = position
+		}
+		b, _ := json.Marshal(ChecklistItemPositionsRebalancedEventPayload{
+			Positions: positions,
+		})
+		return json.RawMessage(b), nil
+	case domain.EventTypeChecklistItemsOrderSnapshot:
+		casted, ok := source.(domain.ChecklistItemsOrderSnapshotEventPayload)
+		if !ok {
+			return nil, fmt.Errorf("invalid payload type")
+		}
+		items := make([]ChecklistItemOrder, 0, len(casted.Items))
+		for _, item := range casted.Items {
+			items = append(items, ChecklistItemOrder{
+				ItemId:      item.ItemId,
+				SectionId:   item.SectionId,
+				OrderNumber: item.OrderNumber,
+			})
+		}
+		b, _ := json.Marshal(ChecklistItemsOrderSnapshotEventPayload{
+			Items: items,
 		})
 		return json.RawMessage(b), nil
 	default:
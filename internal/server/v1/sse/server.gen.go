@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/oapi-codegen/runtime"
@@ -21,22 +22,115 @@ const (
 
 // Defines values for EventEnvelopeType.
 const (
-	ChecklistItemCreated     EventEnvelopeType = "checklistItemCreated"
-	ChecklistItemDeleted     EventEnvelopeType = "checklistItemDeleted"
-	ChecklistItemReordered   EventEnvelopeType = "checklistItemReordered"
-	ChecklistItemRestored    EventEnvelopeType = "checklistItemRestored"
-	ChecklistItemRowAdded    EventEnvelopeType = "checklistItemRowAdded"
-	ChecklistItemRowDeleted  EventEnvelopeType = "checklistItemRowDeleted"
-	ChecklistItemRowUpdated  EventEnvelopeType = "checklistItemRowUpdated"
-	ChecklistItemSoftDeleted EventEnvelopeType = "checklistItemSoftDeleted"
-	ChecklistItemUpdated     EventEnvelopeType = "checklistItemUpdated"
+	ChecklistAccessGranted           EventEnvelopeType = "checklistAccessGranted"
+	ChecklistAccessRevoked           EventEnvelopeType = "checklistAccessRevoked"
+	ChecklistCreated                 EventEnvelopeType = "checklistCreated"
+	ChecklistDeleted                 EventEnvelopeType = "checklistDeleted"
+	ChecklistItemCommentCreated      EventEnvelopeType = "checklistItemCommentCreated"
+	ChecklistItemCommentDeleted      EventEnvelopeType = "checklistItemCommentDeleted"
+	ChecklistItemCommentUpdated      EventEnvelopeType = "checklistItemCommentUpdated"
+	ChecklistItemCreated             EventEnvelopeType = "checklistItemCreated"
+	ChecklistItemDeleted             EventEnvelopeType = "checklistItemDeleted"
+	ChecklistItemPositionsRebalanced EventEnvelopeType = "checklistItemPositionsRebalanced"
+	ChecklistItemReordered           EventEnvelopeType = "checklistItemReordered"
+	ChecklistItemRestored            EventEnvelopeType = "checklistItemRestored"
+	ChecklistItemRowAdded            EventEnvelopeType = "checklistItemRowAdded"
+	ChecklistItemRowDeleted          EventEnvelopeType = "checklistItemRowDeleted"
+	ChecklistItemRowUpdated          EventEnvelopeType = "checklistItemRowUpdated"
+	ChecklistItemSoftDeleted         EventEnvelopeType = "checklistItemSoftDeleted"
+	ChecklistItemUpdated             EventEnvelopeType = "checklistItemUpdated"
+	ChecklistItemsBatchUpdated       EventEnvelopeType = "checklistItemsBatchUpdated"
+	ChecklistItemsOrderSnapshot      EventEnvelopeType = "checklistItemsOrderSnapshot"
+	ChecklistItemsSorted             EventEnvelopeType = "checklistItemsSorted"
+	ChecklistMerged                  EventEnvelopeType = "checklistMerged"
+	ChecklistMoved                   EventEnvelopeType = "checklistMoved"
+	ChecklistSectionCreated          EventEnvelopeType = "checklistSectionCreated"
+	ChecklistSectionDeleted          EventEnvelopeType = "checklistSectionDeleted"
+	ChecklistSectionReordered        EventEnvelopeType = "checklistSectionReordered"
+	ChecklistSectionUpdated          EventEnvelopeType = "checklistSectionUpdated"
+	ChecklistSplit                   EventEnvelopeType = "checklistSplit"
+	ChecklistStatsChanged            EventEnvelopeType = "checklistStatsChanged"
+	ChecklistUpdated                 EventEnvelopeType = "checklistUpdated"
+	PresenceEditing                  EventEnvelopeType = "presenceEditing"
+	PresenceJoined                   EventEnvelopeType = "presenceJoined"
+	PresenceLeft                     EventEnvelopeType = "presenceLeft"
+	ResyncRequired                   EventEnvelopeType = "resyncRequired"
 )
 
+// ChecklistAccessEventPayload Sent on user streams when the user gains or loses access to a checklist, and as the last event of a checklist stream when the user loses access
+type ChecklistAccessEventPayload struct {
+	ChecklistId uint `json:"checklistId"`
+}
+
+// ChecklistDeletedEventPayload defines model for ChecklistDeletedEventPayload.
+type ChecklistDeletedEventPayload struct {
+	ChecklistId uint `json:"checklistId"`
+}
+
+// ChecklistEventPayload Sent when a checklist is created, renamed or moved to another workspace
+type ChecklistEventPayload struct {
+	Name string `json:"name"`
+
+	// WorkspaceId Workspace of the checklist (null = no workspace)
+	WorkspaceId *uint `json:"workspaceId"`
+}
+
+// ChecklistItemCommentDeletedEventPayload defines model for ChecklistItemCommentDeletedEventPayload.
+type ChecklistItemCommentDeletedEventPayload struct {
+	CommentId uint `json:"commentId"`
+	ItemId    uint `json:"itemId"`
+}
+
+// ChecklistItemCommentMentionResponse defines model for ChecklistItemCommentMentionResponse.
+type ChecklistItemCommentMentionResponse struct {
+	Name   *string `json:"name"`
+	UserId string  `json:"userId"`
+}
+
+// ChecklistItemCommentResponse defines model for ChecklistItemCommentResponse.
+type ChecklistItemCommentResponse struct {
+	// Author User id of the author
+	Author     string  `json:"author"`
+	AuthorName *string `json:"authorName"`
+
+	// Content Text of the comment (empty when deleted)
+	Content   string    `json:"content"`
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Deleted True for a deleted comment that is kept because it still has replies
+	Deleted bool `json:"deleted"`
+
+	// EditedAt When the author last edited the comment (null when never edited)
+	EditedAt *time.Time                            `json:"editedAt"`
+	Id       uint                                  `json:"id"`
+	ItemId   uint                                  `json:"itemId"`
+	Mentions []ChecklistItemCommentMentionResponse `json:"mentions"`
+
+	// ParentId Comment this comment replies to (null when it starts a thread)
+	ParentId *uint `json:"parentId"`
+}
+
 // ChecklistItemDeletedEventPayload defines model for ChecklistItemDeletedEventPayload.
 type ChecklistItemDeletedEventPayload struct {
 	ItemId uint `json:"itemId"`
 }
 
+// ChecklistItemOrder defines model for ChecklistItemOrder.
+type ChecklistItemOrder struct {
+	ItemId      uint  `json:"itemId"`
+	OrderNumber uint  `json:"orderNumber"`
+	SectionId   *uint `json:"sectionId"`
+}
+
+// ChecklistItemPositionsRebalancedEventPayload Sent to every client, including the one whose change caused it, after the positions of the items were
+// rewritten to short keys. The order of the items is unchanged; clients that compute positions for optimistic
+// reorders replace their cached positions.
+type ChecklistItemPositionsRebalancedEventPayload struct {
+	// Positions New position of every active item, keyed by item id. Positions are fractional keys that sort in item
+	// order when compared byte by byte.
+	Positions map[string]string `json:"positions"`
+}
+
 // ChecklistItemReorderedEventPayload defines model for ChecklistItemReorderedEventPayload.
 type ChecklistItemReorderedEventPayload struct {
 	ItemId         uint `json:"itemId"`
@@ -44,15 +138,30 @@ type ChecklistItemReorderedEventPayload struct {
 
 	// OrderChanged Indicates if the order number was changed
 	OrderChanged bool `json:"orderChanged"`
+
+	// SectionId Section the item belongs to after the move (null when it belongs to no section)
+	SectionId *uint `json:"sectionId"`
 }
 
 // ChecklistItemResponse defines model for ChecklistItemResponse.
 type ChecklistItemResponse struct {
-	Completed   bool                       `json:"completed"`
-	Id          uint                       `json:"id"`
-	Name        string                     `json:"name"`
+	Completed bool `json:"completed"`
+
+	// CompletedAt When the item was marked as completed (null when not completed)
+	CompletedAt *time.Time `json:"completedAt"`
+
+	// CompletedBy User who marked the item as completed (null when not completed)
+	CompletedBy *string `json:"completedBy"`
+	Id          uint    `json:"id"`
+	Name        string  `json:"name"`
+
+	// Notes Markdown notes of the item (null when the item has no notes)
+	Notes       *string                    `json:"notes"`
 	OrderNumber uint                       `json:"orderNumber"`
 	Rows        []ChecklistItemRowResponse `json:"rows"`
+
+	// SectionId Section the item belongs to (null when it belongs to no section). Order numbers count within the section.
+	SectionId *uint `json:"sectionId"`
 }
 
 // ChecklistItemRestoredEventPayload Sent when a soft-deleted item is restored (undo delete)
@@ -74,9 +183,15 @@ type ChecklistItemRowDeletedEventPayload struct {
 
 // ChecklistItemRowResponse defines model for ChecklistItemRowResponse.
 type ChecklistItemRowResponse struct {
-	Completed *bool  `json:"completed"`
-	Id        uint   `json:"id"`
-	Name      string `json:"name"`
+	Completed *bool `json:"completed"`
+
+	// CompletedAt When the row was marked as completed (null when not completed)
+	CompletedAt *time.Time `json:"completedAt"`
+
+	// CompletedBy User who marked the row as completed (null when not completed)
+	CompletedBy *string `json:"completedBy"`
+	Id          uint    `json:"id"`
+	Name        string  `json:"name"`
 }
 
 // ChecklistItemSoftDeletedEventPayload Sent when an item is soft-deleted (can be undone via restore)
@@ -84,6 +199,90 @@ type ChecklistItemSoftDeletedEventPayload struct {
 	ItemId uint `json:"itemId"`
 }
 
+// ChecklistItemsBatchUpdatedEventPayload Sent once when a batch of item operations has been applied
+type ChecklistItemsBatchUpdatedEventPayload struct {
+	// DeletedItemIds Touched items that are soft-deleted after the batch
+	DeletedItemIds []uint `json:"deletedItemIds"`
+
+	// Items Final state of the touched items that are not deleted
+	Items []ChecklistItemResponse `json:"items"`
+}
+
+// ChecklistItemsOrderSnapshotEventPayload Sent instead of several reorders that the client had not received yet. Carries the current order number
+// of every active item within its section.
+type ChecklistItemsOrderSnapshotEventPayload struct {
+	Items []ChecklistItemOrder `json:"items"`
+}
+
+// ChecklistItemsSortedEventPayload Sent once after the items of a checklist were sorted
+type ChecklistItemsSortedEventPayload struct {
+	// ItemIds All active items in their new display order
+	ItemIds []uint `json:"itemIds"`
+}
+
+// ChecklistMergedEventPayload Sent to a checklist that was merged into another checklist and archived
+type ChecklistMergedEventPayload struct {
+	TargetChecklistId uint `json:"targetChecklistId"`
+}
+
+// ChecklistPresenceRequest defines model for ChecklistPresenceRequest.
+type ChecklistPresenceRequest struct {
+	// EditingItemId Item the user is editing, null when the user stopped editing
+	EditingItemId *uint `json:"editingItemId"`
+}
+
+// ChecklistPresenceResponse defines model for ChecklistPresenceResponse.
+type ChecklistPresenceResponse struct {
+	Viewers []ChecklistViewerResponse `json:"viewers"`
+}
+
+// ChecklistSectionDeletedEventPayload Sent when a section was deleted; its items now belong to no section
+type ChecklistSectionDeletedEventPayload struct {
+	// ItemIds Items that were moved to the end of the items without a section
+	ItemIds   []uint `json:"itemIds"`
+	SectionId uint   `json:"sectionId"`
+}
+
+// ChecklistSectionReorderedEventPayload defines model for ChecklistSectionReorderedEventPayload.
+type ChecklistSectionReorderedEventPayload struct {
+	NewOrderNumber uint `json:"newOrderNumber"`
+	SectionId      uint `json:"sectionId"`
+}
+
+// ChecklistSectionResponse defines model for ChecklistSectionResponse.
+type ChecklistSectionResponse struct {
+	Collapsed   bool   `json:"collapsed"`
+	Id          uint   `json:"id"`
+	Name        string `json:"name"`
+	OrderNumber uint   `json:"orderNumber"`
+}
+
+// ChecklistSplitEventPayload Sent to a checklist when some of its items were split out into a new checklist
+type ChecklistSplitEventPayload struct {
+	// ItemIds Items that left the checklist
+	ItemIds        []uint `json:"itemIds"`
+	NewChecklistId uint   `json:"newChecklistId"`
+}
+
+// ChecklistStatsChangedEventPayload Sent on user streams with the new item counts after items of a checklist changed
+type ChecklistStatsChangedEventPayload struct {
+	CompletedItems uint `json:"completedItems"`
+	TotalItems     uint `json:"totalItems"`
+}
+
+// ChecklistViewerResponse A user viewing a checklist; also the payload of presenceJoined
+type ChecklistViewerResponse struct {
+	// EditingItemId Item the user is editing (null when none)
+	EditingItemId *uint   `json:"editingItemId"`
+	Name          *string `json:"name"`
+	UserId        string  `json:"userId"`
+}
+
+// Error defines model for Error.
+type Error struct {
+	Message string `json:"message"`
+}
+
 // EventEnvelope Envelope for SSE events; sent as JSON in the SSE data field.
 // The `type` field indicates the event type, and the `payload` field contains the event data.
 // The expected structure of `payload` for each `type` is as follows:
@@ -97,9 +296,36 @@ type ChecklistItemSoftDeletedEventPayload struct {
 //   - checklistItemRowDeleted: ChecklistItemRowDeletedEventPayload
 //   - checklistItemRowAdded: ChecklistItemRowAddedEventPayload
 //   - checklistItemReordered: ChecklistItemReorderedEventPayload
+//   - checklistItemsBatchUpdated: ChecklistItemsBatchUpdatedEventPayload
+//   - checklistItemsSorted: ChecklistItemsSortedEventPayload
+//   - checklistMerged: ChecklistMergedEventPayload
+//   - checklistSplit: ChecklistSplitEventPayload
+//   - checklistSectionCreated: ChecklistSectionResponse
+//   - checklistSectionUpdated: ChecklistSectionResponse
+//   - checklistSectionDeleted: ChecklistSectionDeletedEventPayload
+//   - checklistSectionReordered: ChecklistSectionReorderedEventPayload
+//   - checklistItemCommentCreated: ChecklistItemCommentResponse
+//   - checklistItemCommentUpdated: ChecklistItemCommentResponse
+//   - checklistItemCommentDeleted: ChecklistItemCommentDeletedEventPayload
+//   - resyncRequired: ResyncRequiredEventPayload
+//   - checklistCreated: ChecklistEventPayload
+//   - checklistUpdated: ChecklistEventPayload
+//   - checklistMoved: ChecklistEventPayload
+//   - checklistDeleted: ChecklistDeletedEventPayload
+//   - checklistStatsChanged: ChecklistStatsChangedEventPayload
+//   - checklistAccessGranted: ChecklistAccessEventPayload
+//   - checklistAccessRevoked: ChecklistAccessEventPayload
+//   - presenceJoined: ChecklistViewerResponse
+//   - presenceLeft: PresenceLeftEventPayload
+//   - presenceEditing: PresenceEditingEventPayload
+//   - checklistItemsOrderSnapshot: ChecklistItemsOrderSnapshotEventPayload
+//   - checklistItemPositionsRebalanced: ChecklistItemPositionsRebalancedEventPayload
 //
 // For event types not listed above, `payload` may be null or a free-form object.
 type EventEnvelope struct {
+	// ChecklistId Checklist the event belongs to
+	ChecklistId uint `json:"checklistId"`
+
 	// Payload Payload structure depends on event type:
 	//   - checklistItemCreated, checklistItemUpdated: ChecklistItemResponse
 	//   - checklistItemDeleted, checklistItemSoftDeleted: ChecklistItemDeletedEventPayload
@@ -107,6 +333,25 @@ type EventEnvelope struct {
 	//   - checklistItemRowAdded, checklistItemRowUpdated: ChecklistItemRowResponse
 	//   - checklistItemRowDeleted: ChecklistItemRowDeletedEventPayload
 	//   - checklistItemReordered: ChecklistItemReorderedEventPayload
+	//   - checklistItemsBatchUpdated: ChecklistItemsBatchUpdatedEventPayload
+	//   - checklistItemsSorted: ChecklistItemsSortedEventPayload
+	//   - checklistMerged: ChecklistMergedEventPayload
+	//   - checklistSplit: ChecklistSplitEventPayload
+	//   - checklistSectionCreated, checklistSectionUpdated: ChecklistSectionResponse
+	//   - checklistSectionDeleted: ChecklistSectionDeletedEventPayload
+	//   - checklistSectionReordered: ChecklistSectionReorderedEventPayload
+	//   - checklistItemCommentCreated, checklistItemCommentUpdated: ChecklistItemCommentResponse
+	//   - checklistItemCommentDeleted: ChecklistItemCommentDeletedEventPayload
+	//   - resyncRequired: ResyncRequiredEventPayload
+	//   - checklistCreated, checklistUpdated, checklistMoved: ChecklistEventPayload
+	//   - checklistDeleted: ChecklistDeletedEventPayload
+	//   - checklistStatsChanged: ChecklistStatsChangedEventPayload
+	//   - checklistAccessGranted, checklistAccessRevoked: ChecklistAccessEventPayload
+	//   - presenceJoined: ChecklistViewerResponse
+	//   - presenceLeft: PresenceLeftEventPayload
+	//   - presenceEditing: PresenceEditingEventPayload
+	//   - checklistItemsOrderSnapshot: ChecklistItemsOrderSnapshotEventPayload
+	//   - checklistItemPositionsRebalanced: ChecklistItemPositionsRebalancedEventPayload
 	Payload *EventEnvelope_Payload `json:"payload,omitempty"`
 
 	// Type Event type identifier
@@ -120,6 +365,25 @@ type EventEnvelope struct {
 //   - checklistItemRowAdded, checklistItemRowUpdated: ChecklistItemRowResponse
 //   - checklistItemRowDeleted: ChecklistItemRowDeletedEventPayload
 //   - checklistItemReordered: ChecklistItemReorderedEventPayload
+//   - checklistItemsBatchUpdated: ChecklistItemsBatchUpdatedEventPayload
+//   - checklistItemsSorted: ChecklistItemsSortedEventPayload
+//   - checklistMerged: ChecklistMergedEventPayload
+//   - checklistSplit: ChecklistSplitEventPayload
+//   - checklistSectionCreated, checklistSectionUpdated: ChecklistSectionResponse
+//   - checklistSectionDeleted: ChecklistSectionDeletedEventPayload
+//   - checklistSectionReordered: ChecklistSectionReorderedEventPayload
+//   - checklistItemCommentCreated, checklistItemCommentUpdated: ChecklistItemCommentResponse
+//   - checklistItemCommentDeleted: ChecklistItemCommentDeletedEventPayload
+//   - resyncRequired: ResyncRequiredEventPayload
+//   - checklistCreated, checklistUpdated, checklistMoved: ChecklistEventPayload
+//   - checklistDeleted: ChecklistDeletedEventPayload
+//   - checklistStatsChanged: ChecklistStatsChangedEventPayload
+//   - checklistAccessGranted, checklistAccessRevoked: ChecklistAccessEventPayload
+//   - presenceJoined: ChecklistViewerResponse
+//   - presenceLeft: PresenceLeftEventPayload
+//   - presenceEditing: PresenceEditingEventPayload
+//   - checklistItemsOrderSnapshot: ChecklistItemsOrderSnapshotEventPayload
+//   - checklistItemPositionsRebalanced: ChecklistItemPositionsRebalancedEventPayload
 type EventEnvelope_Payload struct {
 	union json.RawMessage
 }
@@ -127,6 +391,23 @@ type EventEnvelope_Payload struct {
 // EventEnvelopeType Event type identifier
 type EventEnvelopeType string
 
+// PresenceEditingEventPayload Sent when a user starts editing an item, or with a null itemId when they stop or the hint expires
+type PresenceEditingEventPayload struct {
+	ItemId *uint  `json:"itemId"`
+	UserId string `json:"userId"`
+}
+
+// PresenceLeftEventPayload Sent when the last client of a user closes its stream of the checklist
+type PresenceLeftEventPayload struct {
+	UserId string `json:"userId"`
+}
+
+// ResyncRequiredEventPayload Sent after reconnecting when the events missed since Last-Event-ID are no longer in the replay log, or
+// instead of the queued events when the client fell too far behind. The client has to reload the checklist.
+type ResyncRequiredEventPayload struct {
+	Message string `json:"message"`
+}
+
 // XClientId defines model for X-Client-Id.
 type XClientId = string
 
@@ -134,8 +415,32 @@ type XClientId = string
 type GetEventsStreamForChecklistItemsParams struct {
 	// ClientId Client identifier passed by frontend
 	ClientId *string `form:"clientId,omitempty" json:"clientId,omitempty"`
+
+	// LastEventID Id of the last event received before reconnecting
+	LastEventID *string `json:"Last-Event-ID,omitempty"`
+}
+
+// GetChecklistPresenceParams defines parameters for GetChecklistPresence.
+type GetChecklistPresenceParams struct {
+	// XClientId Client identifier sent by frontend in headers
+	XClientId *XClientId `json:"X-Client-Id,omitempty"`
+}
+
+// UpdateChecklistPresenceParams defines parameters for UpdateChecklistPresence.
+type UpdateChecklistPresenceParams struct {
+	// XClientId Client identifier sent by frontend in headers
+	XClientId *XClientId `json:"X-Client-Id,omitempty"`
+}
+
+// GetEventsStreamForUserParams defines parameters for GetEventsStreamForUser.
+type GetEventsStreamForUserParams struct {
+	// ClientId Client identifier passed by frontend
+	ClientId *string `form:"clientId,omitempty" json:"clientId,omitempty"`
 }
 
+// UpdateChecklistPresenceJSONRequestBody defines body for UpdateChecklistPresence for application/json ContentType.
+type UpdateChecklistPresenceJSONRequestBody = ChecklistPresenceRequest
+
 // AsChecklistItemResponse returns the union data inside the EventEnvelope_Payload as a ChecklistItemResponse
 func (t EventEnvelope_Payload) AsChecklistItemResponse() (ChecklistItemResponse, error) {
 	var body ChecklistItemResponse
@@ -344,147 +649,919 @@ func (t *EventEnvelope_Payload) MergeChecklistItemReorderedEventPayload(v Checkl
 	return err
 }
 
-func (t EventEnvelope_Payload) MarshalJSON() ([]byte, error) {
-	b, err := t.union.MarshalJSON()
-	return b, err
+// AsChecklistItemsBatchUpdatedEventPayload returns the union data inside the EventEnvelope_Payload as a ChecklistItemsBatchUpdatedEventPayload
+func (t EventEnvelope_Payload) AsChecklistItemsBatchUpdatedEventPayload() (ChecklistItemsBatchUpdatedEventPayload, error) {
+	var body ChecklistItemsBatchUpdatedEventPayload
+	err := json.Unmarshal(t.union, &body)
+	return body, err
 }
 
-func (t *EventEnvelope_Payload) UnmarshalJSON(b []byte) error {
-	err := t.union.UnmarshalJSON(b)
+// FromChecklistItemsBatchUpdatedEventPayload overwrites any union data inside the EventEnvelope_Payload as the provided ChecklistItemsBatchUpdatedEventPayload
+func (t *EventEnvelope_Payload) FromChecklistItemsBatchUpdatedEventPayload(v ChecklistItemsBatchUpdatedEventPayload) error {
+	b, err := json.Marshal(v)
+	t.union = b
 	return err
 }
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Server-Sent Events stream for real-time updates for checklist items, filtered by checklistId
-	// (GET /v1/events/checklist-item-updates/{checklistId})
-	GetEventsStreamForChecklistItems(c *gin.Context, checklistId uint, params GetEventsStreamForChecklistItemsParams)
-}
+// MergeChecklistItemsBatchUpdatedEventPayload performs a merge with any union data inside the EventEnvelope_Payload, using the provided ChecklistItemsBatchUpdatedEventPayload
+func (t *EventEnvelope_Payload) MergeChecklistItemsBatchUpdatedEventPayload(v ChecklistItemsBatchUpdatedEventPayload) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandler       func(*gin.Context, error, int)
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
 }
 
-type MiddlewareFunc func(c *gin.Context)
-
-// GetEventsStreamForChecklistItems operation middleware
-func (siw *ServerInterfaceWrapper) GetEventsStreamForChecklistItems(c *gin.Context) {
-
-	var err error
+// AsChecklistItemsSortedEventPayload returns the union data inside the EventEnvelope_Payload as a ChecklistItemsSortedEventPayload
+func (t EventEnvelope_Payload) AsChecklistItemsSortedEventPayload() (ChecklistItemsSortedEventPayload, error) {
+	var body ChecklistItemsSortedEventPayload
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
 
-	// ------------- Path parameter "checklistId" -------------
-	var checklistId uint
+// FromChecklistItemsSortedEventPayload overwrites any union data inside the EventEnvelope_Payload as the provided ChecklistItemsSortedEventPayload
+func (t *EventEnvelope_Payload) FromChecklistItemsSortedEventPayload(v ChecklistItemsSortedEventPayload) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "checklistId", c.Param("checklistId"), &checklistId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+// MergeChecklistItemsSortedEventPayload performs a merge with any union data inside the EventEnvelope_Payload, using the provided ChecklistItemsSortedEventPayload
+func (t *EventEnvelope_Payload) MergeChecklistItemsSortedEventPayload(v ChecklistItemsSortedEventPayload) error {
+	b, err := json.Marshal(v)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter checklistId: %w", err), http.StatusBadRequest)
-		return
+		return err
 	}
 
-	c.Set(CookieAuthScopes, []string{})
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetEventsStreamForChecklistItemsParams
+// AsChecklistMergedEventPayload returns the union data inside the EventEnvelope_Payload as a ChecklistMergedEventPayload
+func (t EventEnvelope_Payload) AsChecklistMergedEventPayload() (ChecklistMergedEventPayload, error) {
+	var body ChecklistMergedEventPayload
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
 
-	// ------------- Optional query parameter "clientId" -------------
+// FromChecklistMergedEventPayload overwrites any union data inside the EventEnvelope_Payload as the provided ChecklistMergedEventPayload
+func (t *EventEnvelope_Payload) FromChecklistMergedEventPayload(v ChecklistMergedEventPayload) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "clientId", c.Request.URL.Query(), &params.ClientId)
+// MergeChecklistMergedEventPayload performs a merge with any union data inside the EventEnvelope_Payload, using the provided ChecklistMergedEventPayload
+func (t *EventEnvelope_Payload) MergeChecklistMergedEventPayload(v ChecklistMergedEventPayload) error {
+	b, err := json.Marshal(v)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter clientId: %w", err), http.StatusBadRequest)
-		return
-	}
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
+		return err
 	}
 
-	siw.Handler.GetEventsStreamForChecklistItems(c, checklistId, params)
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
 }
 
-// GinServerOptions provides options for the Gin server.
-type GinServerOptions struct {
-	BaseURL      string
-	Middlewares  []MiddlewareFunc
-	ErrorHandler func(*gin.Context, error, int)
+// AsChecklistSplitEventPayload returns the union data inside the EventEnvelope_Payload as a ChecklistSplitEventPayload
+func (t EventEnvelope_Payload) AsChecklistSplitEventPayload() (ChecklistSplitEventPayload, error) {
+	var body ChecklistSplitEventPayload
+	err := json.Unmarshal(t.union, &body)
+	return body, err
 }
 
-// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
-func RegisterHandlers(router gin.IRouter, si ServerInterface) {
-	RegisterHandlersWithOptions(router, si, GinServerOptions{})
+// FromChecklistSplitEventPayload overwrites any union data inside the EventEnvelope_Payload as the provided ChecklistSplitEventPayload
+func (t *EventEnvelope_Payload) FromChecklistSplitEventPayload(v ChecklistSplitEventPayload) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
 }
 
-// RegisterHandlersWithOptions creates http.Handler with additional options
-func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
-	errorHandler := options.ErrorHandler
-	if errorHandler == nil {
-		errorHandler = func(c *gin.Context, err error, statusCode int) {
-			c.JSON(statusCode, gin.H{"msg": err.Error()})
-		}
+// MergeChecklistSplitEventPayload performs a merge with any union data inside the EventEnvelope_Payload, using the provided ChecklistSplitEventPayload
+func (t *EventEnvelope_Payload) MergeChecklistSplitEventPayload(v ChecklistSplitEventPayload) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
 	}
 
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandler:       errorHandler,
-	}
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
 
-	router.GET(options.BaseURL+"/v1/events/checklist-item-updates/:checklistId", wrapper.GetEventsStreamForChecklistItems)
+// AsChecklistSectionResponse returns the union data inside the EventEnvelope_Payload as a ChecklistSectionResponse
+func (t EventEnvelope_Payload) AsChecklistSectionResponse() (ChecklistSectionResponse, error) {
+	var body ChecklistSectionResponse
+	err := json.Unmarshal(t.union, &body)
+	return body, err
 }
 
-type GetEventsStreamForChecklistItemsRequestObject struct {
-	ChecklistId uint `json:"checklistId"`
-	Params      GetEventsStreamForChecklistItemsParams
+// FromChecklistSectionResponse overwrites any union data inside the EventEnvelope_Payload as the provided ChecklistSectionResponse
+func (t *EventEnvelope_Payload) FromChecklistSectionResponse(v ChecklistSectionResponse) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
 }
 
-type GetEventsStreamForChecklistItemsResponseObject interface {
-	VisitGetEventsStreamForChecklistItemsResponse(w http.ResponseWriter) error
+// MergeChecklistSectionResponse performs a merge with any union data inside the EventEnvelope_Payload, using the provided ChecklistSectionResponse
+func (t *EventEnvelope_Payload) MergeChecklistSectionResponse(v ChecklistSectionResponse) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
 }
 
-type GetEventsStreamForChecklistItems200TexteventStreamResponse struct {
-	Body          io.Reader
-	ContentLength int64
+// AsChecklistSectionDeletedEventPayload returns the union data inside the EventEnvelope_Payload as a ChecklistSectionDeletedEventPayload
+func (t EventEnvelope_Payload) AsChecklistSectionDeletedEventPayload() (ChecklistSectionDeletedEventPayload, error) {
+	var body ChecklistSectionDeletedEventPayload
+	err := json.Unmarshal(t.union, &body)
+	return body, err
 }
 
-func (response GetEventsStreamForChecklistItems200TexteventStreamResponse) VisitGetEventsStreamForChecklistItemsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "text/event-stream")
-	if response.ContentLength != 0 {
-		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
-	}
-	w.WriteHeader(200)
+// FromChecklistSectionDeletedEventPayload overwrites any union data inside the EventEnvelope_Payload as the provided ChecklistSectionDeletedEventPayload
+func (t *EventEnvelope_Payload) FromChecklistSectionDeletedEventPayload(v ChecklistSectionDeletedEventPayload) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
 
-	if closer, ok := response.Body.(io.ReadCloser); ok {
-		defer closer.Close()
+// MergeChecklistSectionDeletedEventPayload performs a merge with any union data inside the EventEnvelope_Payload, using the provided ChecklistSectionDeletedEventPayload
+func (t *EventEnvelope_Payload) MergeChecklistSectionDeletedEventPayload(v ChecklistSectionDeletedEventPayload) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
 	}
-	_, err := io.Copy(w, response.Body)
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
 	return err
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Server-Sent Events stream for real-time updates for checklist items, filtered by checklistId
-	// (GET /v1/events/checklist-item-updates/{checklistId})
-	GetEventsStreamForChecklistItems(ctx context.Context, request GetEventsStreamForChecklistItemsRequestObject) (GetEventsStreamForChecklistItemsResponseObject, error)
+// AsChecklistSectionReorderedEventPayload returns the union data inside the EventEnvelope_Payload as a ChecklistSectionReorderedEventPayload
+func (t EventEnvelope_Payload) AsChecklistSectionReorderedEventPayload() (ChecklistSectionReorderedEventPayload, error) {
+	var body ChecklistSectionReorderedEventPayload
+	err := json.Unmarshal(t.union, &body)
+	return body, err
 }
 
-type StrictHandlerFunc = strictgin.StrictGinHandlerFunc
-type StrictMiddlewareFunc = strictgin.StrictGinMiddlewareFunc
-
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares}
+// FromChecklistSectionReorderedEventPayload overwrites any union data inside the EventEnvelope_Payload as the provided ChecklistSectionReorderedEventPayload
+func (t *EventEnvelope_Payload) FromChecklistSectionReorderedEventPayload(v ChecklistSectionReorderedEventPayload) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
 }
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
+// MergeChecklistSectionReorderedEventPayload performs a merge with any union data inside the EventEnvelope_Payload, using the provided ChecklistSectionReorderedEventPayload
+func (t *EventEnvelope_Payload) MergeChecklistSectionReorderedEventPayload(v ChecklistSectionReorderedEventPayload) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
 }
 
-// GetEventsStreamForChecklistItems operation middleware
+// AsChecklistItemCommentResponse returns the union data inside the EventEnvelope_Payload as a ChecklistItemCommentResponse
+func (t EventEnvelope_Payload) AsChecklistItemCommentResponse() (ChecklistItemCommentResponse, error) {
+	var body ChecklistItemCommentResponse
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromChecklistItemCommentResponse overwrites any union data inside the EventEnvelope_Payload as the provided ChecklistItemCommentResponse
+func (t *EventEnvelope_Payload) FromChecklistItemCommentResponse(v ChecklistItemCommentResponse) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeChecklistItemCommentResponse performs a merge with any union data inside the EventEnvelope_Payload, using the provided ChecklistItemCommentResponse
+func (t *EventEnvelope_Payload) MergeChecklistItemCommentResponse(v ChecklistItemCommentResponse) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsChecklistItemCommentDeletedEventPayload returns the union data inside the EventEnvelope_Payload as a ChecklistItemCommentDeletedEventPayload
+func (t EventEnvelope_Payload) AsChecklistItemCommentDeletedEventPayload() (ChecklistItemCommentDeletedEventPayload, error) {
+	var body ChecklistItemCommentDeletedEventPayload
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromChecklistItemCommentDeletedEventPayload overwrites any union data inside the EventEnvelope_Payload as the provided ChecklistItemCommentDeletedEventPayload
+func (t *EventEnvelope_Payload) FromChecklistItemCommentDeletedEventPayload(v ChecklistItemCommentDeletedEventPayload) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeChecklistItemCommentDeletedEventPayload performs a merge with any union data inside the EventEnvelope_Payload, using the provided ChecklistItemCommentDeletedEventPayload
+func (t *EventEnvelope_Payload) MergeChecklistItemCommentDeletedEventPayload(v ChecklistItemCommentDeletedEventPayload) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsResyncRequiredEventPayload returns the union data inside the EventEnvelope_Payload as a ResyncRequiredEventPayload
+func (t EventEnvelope_Payload) AsResyncRequiredEventPayload() (ResyncRequiredEventPayload, error) {
+	var body ResyncRequiredEventPayload
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromResyncRequiredEventPayload overwrites any union data inside the EventEnvelope_Payload as the provided ResyncRequiredEventPayload
+func (t *EventEnvelope_Payload) FromResyncRequiredEventPayload(v ResyncRequiredEventPayload) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeResyncRequiredEventPayload performs a merge with any union data inside the EventEnvelope_Payload, using the provided ResyncRequiredEventPayload
+func (t *EventEnvelope_Payload) MergeResyncRequiredEventPayload(v ResyncRequiredEventPayload) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsChecklistEventPayload returns the union data inside the EventEnvelope_Payload as a ChecklistEventPayload
+func (t EventEnvelope_Payload) AsChecklistEventPayload() (ChecklistEventPayload, error) {
+	var body ChecklistEventPayload
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromChecklistEventPayload overwrites any union data inside the EventEnvelope_Payload as the provided ChecklistEventPayload
+func (t *EventEnvelope_Payload) FromChecklistEventPayload(v ChecklistEventPayload) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeChecklistEventPayload performs a merge with any union data inside the EventEnvelope_Payload, using the provided ChecklistEventPayload
+func (t *EventEnvelope_Payload) MergeChecklistEventPayload(v ChecklistEventPayload) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsChecklistDeletedEventPayload returns the union data inside the EventEnvelope_Payload as a ChecklistDeletedEventPayload
+func (t EventEnvelope_Payload) AsChecklistDeletedEventPayload() (ChecklistDeletedEventPayload, error) {
+	var body ChecklistDeletedEventPayload
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromChecklistDeletedEventPayload overwrites any union data inside the EventEnvelope_Payload as the provided ChecklistDeletedEventPayload
+func (t *EventEnvelope_Payload) FromChecklistDeletedEventPayload(v ChecklistDeletedEventPayload) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeChecklistDeletedEventPayload performs a merge with any union data inside the EventEnvelope_Payload, using the provided ChecklistDeletedEventPayload
+func (t *EventEnvelope_Payload) MergeChecklistDeletedEventPayload(v ChecklistDeletedEventPayload) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsChecklistStatsChangedEventPayload returns the union data inside the EventEnvelope_Payload as a ChecklistStatsChangedEventPayload
+func (t EventEnvelope_Payload) AsChecklistStatsChangedEventPayload() (ChecklistStatsChangedEventPayload, error) {
+	var body ChecklistStatsChangedEventPayload
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromChecklistStatsChangedEventPayload overwrites any union data inside the EventEnvelope_Payload as the provided ChecklistStatsChangedEventPayload
+func (t *EventEnvelope_Payload) FromChecklistStatsChangedEventPayload(v ChecklistStatsChangedEventPayload) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeChecklistStatsChangedEventPayload performs a merge with any union data inside the EventEnvelope_Payload, using the provided ChecklistStatsChangedEventPayload
+func (t *EventEnvelope_Payload) MergeChecklistStatsChangedEventPayload(v ChecklistStatsChangedEventPayload) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsChecklistAccessEventPayload returns the union data inside the EventEnvelope_Payload as a ChecklistAccessEventPayload
+func (t EventEnvelope_Payload) AsChecklistAccessEventPayload() (ChecklistAccessEventPayload, error) {
+	var body ChecklistAccessEventPayload
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromChecklistAccessEventPayload overwrites any union data inside the EventEnvelope_Payload as the provided ChecklistAccessEventPayload
+func (t *EventEnvelope_Payload) FromChecklistAccessEventPayload(v ChecklistAccessEventPayload) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeChecklistAccessEventPayload performs a merge with any union data inside the EventEnvelope_Payload, using the provided ChecklistAccessEventPayload
+func (t *EventEnvelope_Payload) MergeChecklistAccessEventPayload(v ChecklistAccessEventPayload) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsChecklistViewerResponse returns the union data inside the EventEnvelope_Payload as a ChecklistViewerResponse
+func (t EventEnvelope_Payload) AsChecklistViewerResponse() (ChecklistViewerResponse, error) {
+	var body ChecklistViewerResponse
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromChecklistViewerResponse overwrites any union data inside the EventEnvelope_Payload as the provided ChecklistViewerResponse
+func (t *EventEnvelope_Payload) FromChecklistViewerResponse(v ChecklistViewerResponse) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeChecklistViewerResponse performs a merge with any union data inside the EventEnvelope_Payload, using the provided ChecklistViewerResponse
+func (t *EventEnvelope_Payload) MergeChecklistViewerResponse(v ChecklistViewerResponse) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsPresenceLeftEventPayload returns the union data inside the EventEnvelope_Payload as a PresenceLeftEventPayload
+func (t EventEnvelope_Payload) AsPresenceLeftEventPayload() (PresenceLeftEventPayload, error) {
+	var body PresenceLeftEventPayload
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromPresenceLeftEventPayload overwrites any union data inside the EventEnvelope_Payload as the provided PresenceLeftEventPayload
+func (t *EventEnvelope_Payload) FromPresenceLeftEventPayload(v PresenceLeftEventPayload) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergePresenceLeftEventPayload performs a merge with any union data inside the EventEnvelope_Payload, using the provided PresenceLeftEventPayload
+func (t *EventEnvelope_Payload) MergePresenceLeftEventPayload(v PresenceLeftEventPayload) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsPresenceEditingEventPayload returns the union data inside the EventEnvelope_Payload as a PresenceEditingEventPayload
+func (t EventEnvelope_Payload) AsPresenceEditingEventPayload() (PresenceEditingEventPayload, error) {
+	var body PresenceEditingEventPayload
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromPresenceEditingEventPayload overwrites any union data inside the EventEnvelope_Payload as the provided PresenceEditingEventPayload
+func (t *EventEnvelope_Payload) FromPresenceEditingEventPayload(v PresenceEditingEventPayload) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergePresenceEditingEventPayload performs a merge with any union data inside the EventEnvelope_Payload, using the provided PresenceEditingEventPayload
+func (t *EventEnvelope_Payload) MergePresenceEditingEventPayload(v PresenceEditingEventPayload) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsChecklistItemsOrderSnapshotEventPayload returns the union data inside the EventEnvelope_Payload as a ChecklistItemsOrderSnapshotEventPayload
+func (t EventEnvelope_Payload) AsChecklistItemsOrderSnapshotEventPayload() (ChecklistItemsOrderSnapshotEventPayload, error) {
+	var body ChecklistItemsOrderSnapshotEventPayload
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromChecklistItemsOrderSnapshotEventPayload overwrites any union data inside the EventEnvelope_Payload as the provided ChecklistItemsOrderSnapshotEventPayload
+func (t *EventEnvelope_Payload) FromChecklistItemsOrderSnapshotEventPayload(v ChecklistItemsOrderSnapshotEventPayload) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeChecklistItemsOrderSnapshotEventPayload performs a merge with any union data inside the EventEnvelope_Payload, using the provided ChecklistItemsOrderSnapshotEventPayload
+func (t *EventEnvelope_Payload) MergeChecklistItemsOrderSnapshotEventPayload(v ChecklistItemsOrderSnapshotEventPayload) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsChecklistItemPositionsRebalancedEventPayload returns the union data inside the EventEnvelope_Payload as a ChecklistItemPositionsRebalancedEventPayload
+func (t EventEnvelope_Payload) AsChecklistItemPositionsRebalancedEventPayload() (ChecklistItemPositionsRebalancedEventPayload, error) {
+	var body ChecklistItemPositionsRebalancedEventPayload
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromChecklistItemPositionsRebalancedEventPayload overwrites any union data inside the EventEnvelope_Payload as the provided ChecklistItemPositionsRebalancedEventPayload
+func (t *EventEnvelope_Payload) FromChecklistItemPositionsRebalancedEventPayload(v ChecklistItemPositionsRebalancedEventPayload) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeChecklistItemPositionsRebalancedEventPayload performs a merge with any union data inside the EventEnvelope_Payload, using the provided ChecklistItemPositionsRebalancedEventPayload
+func (t *EventEnvelope_Payload) MergeChecklistItemPositionsRebalancedEventPayload(v ChecklistItemPositionsRebalancedEventPayload) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t EventEnvelope_Payload) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *EventEnvelope_Payload) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Server-Sent Events stream for real-time updates for checklist items, filtered by checklistId
+	// (GET /v1/events/checklist-item-updates/{checklistId})
+	GetEventsStreamForChecklistItems(c *gin.Context, checklistId uint, params GetEventsStreamForChecklistItemsParams)
+	// Get the users currently viewing a checklist
+	// (GET /v1/events/presence/{checklistId})
+	GetChecklistPresence(c *gin.Context, checklistId uint, params GetChecklistPresenceParams)
+	// Tell the other viewers which item the user is editing
+	// (PUT /v1/events/presence/{checklistId})
+	UpdateChecklistPresence(c *gin.Context, checklistId uint, params UpdateChecklistPresenceParams)
+	// Server-Sent Events stream of checklist-level events for every checklist the user can access
+	// (GET /v1/events/user)
+	GetEventsStreamForUser(c *gin.Context, params GetEventsStreamForUserParams)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandler       func(*gin.Context, error, int)
+}
+
+type MiddlewareFunc func(c *gin.Context)
+
+// GetEventsStreamForChecklistItems operation middleware
+func (siw *ServerInterfaceWrapper) GetEventsStreamForChecklistItems(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "checklistId" -------------
+	var checklistId uint
+
+	err = runtime.BindStyledParameterWithOptions("simple", "checklistId", c.Param("checklistId"), &checklistId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter checklistId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetEventsStreamForChecklistItemsParams
+
+	// ------------- Optional query parameter "clientId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "clientId", c.Request.URL.Query(), &params.ClientId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter clientId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Last-Event-ID, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Last-Event-ID", valueList[0], &LastEventID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Last-Event-ID: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.LastEventID = &LastEventID
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetEventsStreamForChecklistItems(c, checklistId, params)
+}
+
+// GetChecklistPresence operation middleware
+func (siw *ServerInterfaceWrapper) GetChecklistPresence(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "checklistId" -------------
+	var checklistId uint
+
+	err = runtime.BindStyledParameterWithOptions("simple", "checklistId", c.Param("checklistId"), &checklistId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter checklistId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetChecklistPresenceParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "X-Client-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Client-Id")]; found {
+		var XClientId XClientId
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Client-Id, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Client-Id", valueList[0], &XClientId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Client-Id: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XClientId = &XClientId
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetChecklistPresence(c, checklistId, params)
+}
+
+// UpdateChecklistPresence operation middleware
+func (siw *ServerInterfaceWrapper) UpdateChecklistPresence(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "checklistId" -------------
+	var checklistId uint
+
+	err = runtime.BindStyledParameterWithOptions("simple", "checklistId", c.Param("checklistId"), &checklistId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter checklistId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateChecklistPresenceParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "X-Client-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Client-Id")]; found {
+		var XClientId XClientId
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Client-Id, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Client-Id", valueList[0], &XClientId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Client-Id: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XClientId = &XClientId
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateChecklistPresence(c, checklistId, params)
+}
+
+// GetEventsStreamForUser operation middleware
+func (siw *ServerInterfaceWrapper) GetEventsStreamForUser(c *gin.Context) {
+
+	var err error
+
+	c.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetEventsStreamForUserParams
+
+	// ------------- Optional query parameter "clientId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "clientId", c.Request.URL.Query(), &params.ClientId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter clientId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetEventsStreamForUser(c, params)
+}
+
+// GinServerOptions provides options for the Gin server.
+type GinServerOptions struct {
+	BaseURL      string
+	Middlewares  []MiddlewareFunc
+	ErrorHandler func(*gin.Context, error, int)
+}
+
+// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
+func RegisterHandlers(router gin.IRouter, si ServerInterface) {
+	RegisterHandlersWithOptions(router, si, GinServerOptions{})
+}
+
+// RegisterHandlersWithOptions creates http.Handler with additional options
+func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
+	errorHandler := options.ErrorHandler
+	if errorHandler == nil {
+		errorHandler = func(c *gin.Context, err error, statusCode int) {
+			c.JSON(statusCode, gin.H{"msg": err.Error()})
+		}
+	}
+
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandler:       errorHandler,
+	}
+
+	router.GET(options.BaseURL+"/v1/events/checklist-item-updates/:checklistId", wrapper.GetEventsStreamForChecklistItems)
+	router.GET(options.BaseURL+"/v1/events/presence/:checklistId", wrapper.GetChecklistPresence)
+	router.PUT(options.BaseURL+"/v1/events/presence/:checklistId", wrapper.UpdateChecklistPresence)
+	router.GET(options.BaseURL+"/v1/events/user", wrapper.GetEventsStreamForUser)
+}
+
+type GetEventsStreamForChecklistItemsRequestObject struct {
+	ChecklistId uint `json:"checklistId"`
+	Params      GetEventsStreamForChecklistItemsParams
+}
+
+type GetEventsStreamForChecklistItemsResponseObject interface {
+	VisitGetEventsStreamForChecklistItemsResponse(w http.ResponseWriter) error
+}
+
+type GetEventsStreamForChecklistItems200TexteventStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetEventsStreamForChecklistItems200TexteventStreamResponse) VisitGetEventsStreamForChecklistItemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetChecklistPresenceRequestObject struct {
+	ChecklistId uint `json:"checklistId"`
+	Params      GetChecklistPresenceParams
+}
+
+type GetChecklistPresenceResponseObject interface {
+	VisitGetChecklistPresenceResponse(w http.ResponseWriter) error
+}
+
+type GetChecklistPresence200JSONResponse ChecklistPresenceResponse
+
+func (response GetChecklistPresence200JSONResponse) VisitGetChecklistPresenceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetChecklistPresence404JSONResponse Error
+
+func (response GetChecklistPresence404JSONResponse) VisitGetChecklistPresenceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetChecklistPresence500JSONResponse Error
+
+func (response GetChecklistPresence500JSONResponse) VisitGetChecklistPresenceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateChecklistPresenceRequestObject struct {
+	ChecklistId uint `json:"checklistId"`
+	Params      UpdateChecklistPresenceParams
+	Body        *UpdateChecklistPresenceJSONRequestBody
+}
+
+type UpdateChecklistPresenceResponseObject interface {
+	VisitUpdateChecklistPresenceResponse(w http.ResponseWriter) error
+}
+
+type UpdateChecklistPresence204Response struct {
+}
+
+func (response UpdateChecklistPresence204Response) VisitUpdateChecklistPresenceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type UpdateChecklistPresence404JSONResponse Error
+
+func (response UpdateChecklistPresence404JSONResponse) VisitUpdateChecklistPresenceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateChecklistPresence409JSONResponse Error
+
+func (response UpdateChecklistPresence409JSONResponse) VisitUpdateChecklistPresenceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateChecklistPresence500JSONResponse Error
+
+func (response UpdateChecklistPresence500JSONResponse) VisitUpdateChecklistPresenceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEventsStreamForUserRequestObject struct {
+	Params GetEventsStreamForUserParams
+}
+
+type GetEventsStreamForUserResponseObject interface {
+	VisitGetEventsStreamForUserResponse(w http.ResponseWriter) error
+}
+
+type GetEventsStreamForUser200TexteventStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetEventsStreamForUser200TexteventStreamResponse) VisitGetEventsStreamForUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Server-Sent Events stream for real-time updates for checklist items, filtered by checklistId
+	// (GET /v1/events/checklist-item-updates/{checklistId})
+	GetEventsStreamForChecklistItems(ctx context.Context, request GetEventsStreamForChecklistItemsRequestObject) (GetEventsStreamForChecklistItemsResponseObject, error)
+	// Get the users currently viewing a checklist
+	// (GET /v1/events/presence/{checklistId})
+	GetChecklistPresence(ctx context.Context, request GetChecklistPresenceRequestObject) (GetChecklistPresenceResponseObject, error)
+	// Tell the other viewers which item the user is editing
+	// (PUT /v1/events/presence/{checklistId})
+	UpdateChecklistPresence(ctx context.Context, request UpdateChecklistPresenceRequestObject) (UpdateChecklistPresenceResponseObject, error)
+	// Server-Sent Events stream of checklist-level events for every checklist the user can access
+	// (GET /v1/events/user)
+	GetEventsStreamForUser(ctx context.Context, request GetEventsStreamForUserRequestObject) (GetEventsStreamForUserResponseObject, error)
+}
+
+type StrictHandlerFunc = strictgin.StrictGinHandlerFunc
+type StrictMiddlewareFunc = strictgin.StrictGinMiddlewareFunc
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+}
+
+// GetEventsStreamForChecklistItems operation middleware
 func (sh *strictHandler) GetEventsStreamForChecklistItems(ctx *gin.Context, checklistId uint, params GetEventsStreamForChecklistItemsParams) {
 	var request GetEventsStreamForChecklistItemsRequestObject
 
@@ -511,3 +1588,94 @@ func (sh *strictHandler) GetEventsStreamForChecklistItems(ctx *gin.Context, chec
 		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
 	}
 }
+
+// GetChecklistPresence operation middleware
+func (sh *strictHandler) GetChecklistPresence(ctx *gin.Context, checklistId uint, params GetChecklistPresenceParams) {
+	var request GetChecklistPresenceRequestObject
+
+	request.ChecklistId = checklistId
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetChecklistPresence(ctx, request.(GetChecklistPresenceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetChecklistPresence")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetChecklistPresenceResponseObject); ok {
+		if err := validResponse.VisitGetChecklistPresenceResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateChecklistPresence operation middleware
+func (sh *strictHandler) UpdateChecklistPresence(ctx *gin.Context, checklistId uint, params UpdateChecklistPresenceParams) {
+	var request UpdateChecklistPresenceRequestObject
+
+	request.ChecklistId = checklistId
+	request.Params = params
+
+	var body UpdateChecklistPresenceJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateChecklistPresence(ctx, request.(UpdateChecklistPresenceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateChecklistPresence")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(UpdateChecklistPresenceResponseObject); ok {
+		if err := validResponse.VisitUpdateChecklistPresenceResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetEventsStreamForUser operation middleware
+func (sh *strictHandler) GetEventsStreamForUser(ctx *gin.Context, params GetEventsStreamForUserParams) {
+	var request GetEventsStreamForUserRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetEventsStreamForUser(ctx, request.(GetEventsStreamForUserRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetEventsStreamForUser")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetEventsStreamForUserResponseObject); ok {
+		if err := validResponse.VisitGetEventsStreamForUserResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
@@ -3,10 +3,15 @@ package sse
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
+	"com.raunlo.checklist/internal/core/domain"
 	notification "com.raunlo.checklist/internal/core/notification"
 	serverutils "com.raunlo.checklist/internal/server/server_utils"
 	"github.com/gin-gonic/gin"
@@ -35,73 +40,208 @@ func (s *sseControllerImpl) GetEventsStreamForChecklistItems(ctx context.Context
 	domainContext := serverutils.CreateContext(ctx) // to ensure any middleware has run
 
 	w := gctx.Writer
-	r := gctx.Request
+	flusher, ok := startStream(gctx)
+	if !ok {
+		return nil, nil
+	}
 
-	if r.Method != http.MethodGet {
-		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	ch, err := s.broker.Subscribe(domainContext, request.ChecklistId)
+	if err != nil {
+		http.Error(w, "Failed to subscribe to events", http.StatusInternalServerError)
 		return nil, nil
 	}
+	defer s.broker.Unsubscribe(domainContext, request.ChecklistId)
 
-	flusher, ok := w.(http.Flusher)
+	// Send a comment to establish the stream
+	_, _ = w.Write([]byte(":ok\n\n"))
+	flusher.Flush()
+
+	// Replay the events missed since the last event the client received. Events that are also delivered
+	// through the subscription are skipped by their id.
+	var lastSentEventId uint64
+	if lastEventId, ok := parseLastEventId(request.Params.LastEventID); ok {
+		events, complete, err := s.broker.Replay(domainContext, request.ChecklistId, lastEventId)
+		if err != nil || !complete {
+			s.writeEvent(w, domain.ChecklistItemUpdatesEvent{
+				ChecklistId: request.ChecklistId,
+				EventType:   domain.EventTypeResyncRequired,
+				Payload: domain.ResyncRequiredEventPayload{
+					Message: "Missed events can no longer be replayed, please reload the checklist",
+				},
+			})
+		} else {
+			lastSentEventId = lastEventId
+			for _, event := range events {
+				s.writeEvent(w, event)
+				lastSentEventId = event.Id
+			}
+		}
+		flusher.Flush()
+	}
+
+	s.streamEvents(gctx, flusher, ch, lastSentEventId)
+	return nil, nil
+}
+
+// GetEventsStreamForUser streams the checklist-level events of every checklist the user can access. The
+// events are not replayed, a reconnecting client reloads its checklists instead.
+func (s *sseControllerImpl) GetEventsStreamForUser(ctx context.Context, request GetEventsStreamForUserRequestObject) (GetEventsStreamForUserResponseObject, error) {
+	gctx, ok := ctx.(*gin.Context)
 	if !ok {
-		http.Error(w, "Streaming unsupported", http.StatusInternalServerError)
-		return nil, nil
+		return nil, fmt.Errorf("expected *gin.Context in StrictServerInterface, got %T", ctx)
 	}
 
-	w.Header().Set("Content-Type", "text/event-stream")
-	w.Header().Set("Cache-Control", "no-cache")
-	w.Header().Set("Connection", "keep-alive")
+	domainContext := serverutils.CreateContext(ctx)
 
-	// attempt to read client id from Gin context (set by middleware)
-	clientId := gctx.GetString("clientId")
-	// middleware sets Gin context key "clientId"; use that value if present
+	w := gctx.Writer
+	flusher, ok := startStream(gctx)
+	if !ok {
+		return nil, nil
+	}
 
-	ch, err := s.broker.Subscribe(domainContext, request.ChecklistId)
+	ch, err := s.broker.SubscribeUser(domainContext)
 	if err != nil {
 		http.Error(w, "Failed to subscribe to events", http.StatusInternalServerError)
 		return nil, nil
 	}
-	defer s.broker.Unsubscribe(domainContext, request.ChecklistId)
-
-	// keep clientId variable to avoid compiler unused var error (for future use)
-	_ = clientId
+	defer s.broker.UnsubscribeUser(domainContext)
 
-	// Send a comment to establish the stream
 	_, _ = w.Write([]byte(":ok\n\n"))
 	flusher.Flush()
 
+	s.streamEvents(gctx, flusher, ch, 0)
+	return nil, nil
+}
+
+// GetChecklistPresence lists the users with an open stream of the checklist
+func (s *sseControllerImpl) GetChecklistPresence(ctx context.Context, request GetChecklistPresenceRequestObject) (GetChecklistPresenceResponseObject, error) {
+	domainContext := serverutils.CreateContext(ctx)
+	viewers, err := s.broker.Viewers(domainContext, request.ChecklistId)
+	if err != nil {
+		if responseCode(err) == http.StatusNotFound {
+			return GetChecklistPresence404JSONResponse{Message: err.Error()}, nil
+		}
+		return GetChecklistPresence500JSONResponse{Message: err.Error()}, nil
+	}
+
+	response := GetChecklistPresence200JSONResponse{Viewers: make([]ChecklistViewerResponse, 0, len(viewers))}
+	for _, viewer := range viewers {
+		response.Viewers = append(response.Viewers, ChecklistViewerResponse{
+			UserId:        viewer.UserId,
+			Name:          viewer.Name,
+			EditingItemId: viewer.EditingItemId,
+		})
+	}
+	return response, nil
+}
+
+// UpdateChecklistPresence sets or clears the item the user is editing
+func (s *sseControllerImpl) UpdateChecklistPresence(ctx context.Context, request UpdateChecklistPresenceRequestObject) (UpdateChecklistPresenceResponseObject, error) {
+	domainContext := serverutils.CreateContext(ctx)
+	if err := s.broker.SetEditingItem(domainContext, request.ChecklistId, request.Body.EditingItemId); err != nil {
+		switch responseCode(err) {
+		case http.StatusNotFound:
+			return UpdateChecklistPresence404JSONResponse{Message: err.Error()}, nil
+		case http.StatusConflict:
+			return UpdateChecklistPresence409JSONResponse{Message: err.Error()}, nil
+		default:
+			return UpdateChecklistPresence500JSONResponse{Message: err.Error()}, nil
+		}
+	}
+	return UpdateChecklistPresence204Response{}, nil
+}
+
+// responseCode returns the status of a domain error returned by the broker
+func responseCode(err error) int {
+	var domainErr domain.Error
+	if errors.As(err, &domainErr) {
+		return domainErr.ResponseCode()
+	}
+	return http.StatusInternalServerError
+}
+
+// startStream checks that the response can be streamed and writes the SSE headers
+func startStream(gctx *gin.Context) (http.Flusher, bool) {
+	w := gctx.Writer
+	if gctx.Request.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return nil, false
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "Streaming unsupported", http.StatusInternalServerError)
+		return nil, false
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	return flusher, true
+}
+
+// streamEvents writes the events of the subscription until the client disconnects or the broker closes the
+// channel. Events with an id up to lastSentEventId were already replayed and are skipped.
+func (s *sseControllerImpl) streamEvents(gctx *gin.Context, flusher http.Flusher, ch chan domain.ChecklistItemUpdatesEvent, lastSentEventId uint64) {
+	w := gctx.Writer
+
 	// Heartbeat to keep connection alive and detect dropped clients
 	heartbeat := time.NewTicker(30 * time.Second)
 	defer heartbeat.Stop()
 
-	notify := r.Context().Done()
+	notify := gctx.Request.Context().Done()
 
 	for {
 		select {
 		case <-notify:
-			return nil, nil
+			return
 		case <-heartbeat.C:
 			// Send heartbeat comment to keep connection alive
 			_, _ = w.Write([]byte(":heartbeat\n\n"))
 			flusher.Flush()
 		case msg, ok := <-ch:
 			if !ok {
-				return nil, nil
+				return
 			}
-
-			// marshal mapped event envelope to JSON and write as SSE data field
-			mapped := s.mapper.Map(msg)
-			b, err := json.Marshal(mapped)
-			if err != nil {
-				// on marshal error, send a comment and continue
-				_, _ = w.Write([]byte(":error\n\n"))
-				flusher.Flush()
+			if msg.Id != 0 && msg.Id <= lastSentEventId {
 				continue
 			}
 
-			_, _ = w.Write(append([]byte("data:"), b...))
-			_, _ = w.Write([]byte("\n\n"))
+			s.writeEvent(w, msg)
 			flusher.Flush()
+			if msg.Id != 0 {
+				lastSentEventId = msg.Id
+			}
 		}
 	}
 }
+
+// writeEvent writes the event with its id, so that the client can send it as Last-Event-ID when reconnecting
+func (s *sseControllerImpl) writeEvent(w io.Writer, event domain.ChecklistItemUpdatesEvent) {
+	// marshal mapped event envelope to JSON and write as SSE data field
+	mapped := s.mapper.Map(event)
+	b, err := json.Marshal(mapped)
+	if err != nil {
+		// on marshal error, send a comment and continue
+		_, _ = w.Write([]byte(":error\n\n"))
+		return
+	}
+
+	if event.Id != 0 {
+		_, _ = fmt.Fprintf(w, "id:%d\n", event.Id)
+	}
+	_, _ = w.Write(append([]byte("data:"), b...))
+	_, _ = w.Write([]byte("\n\n"))
+}
+
+// parseLastEventId returns false when the client did not send a valid Last-Event-ID header
+func parseLastEventId(header *string) (uint64, bool) {
+	if header == nil {
+		return 0, false
+	}
+	lastEventId, err := strconv.ParseUint(strings.TrimSpace(*header), 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return lastEventId, true
+}
@@ -27,18 +27,36 @@ type AssignTemplateToWorkspaceRequest struct {
 
 // ChecklistItemResponse defines model for ChecklistItemResponse.
 type ChecklistItemResponse struct {
-	Completed   bool                       `json:"completed"`
-	Id          uint                       `json:"id"`
-	Name        string                     `json:"name"`
+	Completed bool `json:"completed"`
+
+	// CompletedAt When the item was marked as completed (null when not completed)
+	CompletedAt *time.Time `json:"completedAt"`
+
+	// CompletedBy User who marked the item as completed (null when not completed)
+	CompletedBy *string `json:"completedBy"`
+	Id          uint    `json:"id"`
+	Name        string  `json:"name"`
+
+	// Notes Markdown notes of the item (null when the item has no notes)
+	Notes       *string                    `json:"notes"`
 	OrderNumber uint                       `json:"orderNumber"`
 	Rows        []ChecklistItemRowResponse `json:"rows"`
+
+	// SectionId Section the item belongs to (null when it belongs to no section). Order numbers count within the section.
+	SectionId *uint `json:"sectionId"`
 }
 
 // ChecklistItemRowResponse defines model for ChecklistItemRowResponse.
 type ChecklistItemRowResponse struct {
-	Completed *bool  `json:"completed"`
-	Id        uint   `json:"id"`
-	Name      string `json:"name"`
+	Completed *bool `json:"completed"`
+
+	// CompletedAt When the row was marked as completed (null when not completed)
+	CompletedAt *time.Time `json:"completedAt"`
+
+	// CompletedBy User who marked the row as completed (null when not completed)
+	CompletedBy *string `json:"completedBy"`
+	Id          uint    `json:"id"`
+	Name        string  `json:"name"`
 }
 
 // CreateTemplateFromItemRequest defines model for CreateTemplateFromItemRequest.
@@ -54,15 +72,21 @@ type CreateTemplateFromItemRequest struct {
 
 // CreateTemplateRequest defines model for CreateTemplateRequest.
 type CreateTemplateRequest struct {
-	Description *string                     `json:"description"`
-	Name        string                      `json:"name"`
-	Rows        *[]CreateTemplateRowRequest `json:"rows,omitempty"`
+	Description *string `json:"description"`
+	Name        string  `json:"name"`
+
+	// Notes Markdown notes given to the item created from the template (up to 10000 characters)
+	Notes *string                     `json:"notes"`
+	Rows  *[]CreateTemplateRowRequest `json:"rows,omitempty"`
 }
 
 // CreateTemplateRowRequest defines model for CreateTemplateRowRequest.
 type CreateTemplateRowRequest struct {
-	Name     string  `json:"name"`
-	Position float64 `json:"position"`
+	Name string `json:"name"`
+
+	// Position Ignored; rows keep the order in which they are listed
+	// Deprecated:
+	Position *float64 `json:"position,omitempty"`
 }
 
 // Error defines model for Error.
@@ -77,8 +101,11 @@ type TemplateResponse struct {
 	Id          uint      `json:"id"`
 
 	// IsOwner True if the current user owns this template, false if shared
-	IsOwner   bool                  `json:"isOwner"`
-	Name      string                `json:"name"`
+	IsOwner bool   `json:"isOwner"`
+	Name    string `json:"name"`
+
+	// Notes Markdown notes given to the item created from the template
+	Notes     *string               `json:"notes"`
 	Rows      []TemplateRowResponse `json:"rows"`
 	UpdatedAt time.Time             `json:"updatedAt"`
 	UserId    string                `json:"userId"`
@@ -89,10 +116,12 @@ type TemplateResponse struct {
 
 // TemplateRowResponse defines model for TemplateRowResponse.
 type TemplateRowResponse struct {
-	CreatedAt  time.Time `json:"createdAt"`
-	Id         uint      `json:"id"`
-	Name       string    `json:"name"`
-	Position   float64   `json:"position"`
+	CreatedAt time.Time `json:"createdAt"`
+	Id        uint      `json:"id"`
+	Name      string    `json:"name"`
+
+	// Position Fractional key; rows are listed in ascending byte order of their positions
+	Position   string    `json:"position"`
 	TemplateId uint      `json:"templateId"`
 	UpdatedAt  time.Time `json:"updatedAt"`
 }
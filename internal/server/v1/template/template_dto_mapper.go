@@ -24,12 +24,10 @@ func (*templateDtoMapper) ToDomain(source CreateTemplateRequest) domain.Template
 	structsconv.Map(&source, &target)
 
 	if source.Rows != nil {
+		// Rows keep the order of the request, their positions are assigned when they are saved
 		rows := make([]domain.TemplateRow, len(*source.Rows))
 		for i, r := range *source.Rows {
-			rows[i] = domain.TemplateRow{
-				Name:     r.Name,
-				Position: r.Position,
-			}
+			rows[i] = domain.TemplateRow{Name: r.Name}
 		}
 		target.Rows = rows
 	}
@@ -35,14 +35,19 @@ type UserDataExport struct {
 		CreatedAt *time.Time `json:"createdAt,omitempty"`
 		Id        *uint      `json:"id,omitempty"`
 		Items     *[]struct {
-			Completed   *bool   `json:"completed,omitempty"`
-			Id          *uint   `json:"id,omitempty"`
-			Name        *string `json:"name,omitempty"`
-			OrderNumber *int    `json:"orderNumber,omitempty"`
+			Completed   *bool      `json:"completed,omitempty"`
+			CompletedAt *time.Time `json:"completedAt"`
+			CompletedBy *string    `json:"completedBy"`
+			Id          *uint      `json:"id,omitempty"`
+			Name        *string    `json:"name,omitempty"`
+			Notes       *string    `json:"notes"`
+			OrderNumber *int       `json:"orderNumber,omitempty"`
 			Rows        *[]struct {
-				Completed *bool   `json:"completed,omitempty"`
-				Id        *uint   `json:"id,omitempty"`
-				Name      *string `json:"name,omitempty"`
+				Completed   *bool      `json:"completed,omitempty"`
+				CompletedAt *time.Time `json:"completedAt"`
+				CompletedBy *string    `json:"completedBy"`
+				Id          *uint      `json:"id,omitempty"`
+				Name        *string    `json:"name,omitempty"`
 			} `json:"rows,omitempty"`
 		} `json:"items,omitempty"`
 		Name *string `json:"name,omitempty"`
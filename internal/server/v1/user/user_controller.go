@@ -82,14 +82,19 @@ func convertToUserDataExport(export *domain.UserDataExport) UserDataExport {
 			CreatedAt *time.Time `json:"createdAt,omitempty"`
 			Id        *uint      `json:"id,omitempty"`
 			Items     *[]struct {
-				Completed   *bool   `json:"completed,omitempty"`
-				Id          *uint   `json:"id,omitempty"`
-				Name        *string `json:"name,omitempty"`
-				OrderNumber *int    `json:"orderNumber,omitempty"`
+				Completed   *bool      `json:"completed,omitempty"`
+				CompletedAt *time.Time `json:"completedAt"`
+				CompletedBy *string    `json:"completedBy"`
+				Id          *uint      `json:"id,omitempty"`
+				Name        *string    `json:"name,omitempty"`
+				Notes       *string    `json:"notes"`
+				OrderNumber *int       `json:"orderNumber,omitempty"`
 				Rows        *[]struct {
-					Completed *bool   `json:"completed,omitempty"`
-					Id        *uint   `json:"id,omitempty"`
-					Name      *string `json:"name,omitempty"`
+					Completed   *bool      `json:"completed,omitempty"`
+					CompletedAt *time.Time `json:"completedAt"`
+					CompletedBy *string    `json:"completedBy"`
+					Id          *uint      `json:"id,omitempty"`
+					Name        *string    `json:"name,omitempty"`
 				} `json:"rows,omitempty"`
 			} `json:"items,omitempty"`
 			Name   *string `json:"name,omitempty"`
@@ -109,14 +114,19 @@ func convertToUserDataExport(export *domain.UserDataExport) UserDataExport {
 		// Convert items
 		if len(checklist.Items) > 0 {
 			items := make([]struct {
-				Completed   *bool   `json:"completed,omitempty"`
-				Id          *uint   `json:"id,omitempty"`
-				Name        *string `json:"name,omitempty"`
-				OrderNumber *int    `json:"orderNumber,omitempty"`
+				Completed   *bool      `json:"completed,omitempty"`
+				CompletedAt *time.Time `json:"completedAt"`
+				CompletedBy *string    `json:"completedBy"`
+				Id          *uint      `json:"id,omitempty"`
+				Name        *string    `json:"name,omitempty"`
+				Notes       *string    `json:"notes"`
+				OrderNumber *int       `json:"orderNumber,omitempty"`
 				Rows        *[]struct {
-					Completed *bool   `json:"completed,omitempty"`
-					Id        *uint   `json:"id,omitempty"`
-					Name      *string `json:"name,omitempty"`
+					Completed   *bool      `json:"completed,omitempty"`
+					CompletedAt *time.Time `json:"completedAt"`
+					CompletedBy *string    `json:"completedBy"`
+					Id          *uint      `json:"id,omitempty"`
+					Name        *string    `json:"name,omitempty"`
 				} `json:"rows,omitempty"`
 			}, len(checklist.Items))
 
@@ -124,20 +134,27 @@ func convertToUserDataExport(export *domain.UserDataExport) UserDataExport {
 				items[j].Id = &item.Id
 				items[j].Name = &item.Name
 				items[j].Completed = &item.Completed
+				items[j].CompletedBy = item.CompletedBy
+				items[j].CompletedAt = item.CompletedAt
+				items[j].Notes = item.Notes
 				items[j].OrderNumber = &item.OrderNumber
 
 				// Convert rows
 				if len(item.Rows) > 0 {
 					rows := make([]struct {
-						Completed *bool   `json:"completed,omitempty"`
-						Id        *uint   `json:"id,omitempty"`
-						Name      *string `json:"name,omitempty"`
+						Completed   *bool      `json:"completed,omitempty"`
+						CompletedAt *time.Time `json:"completedAt"`
+						CompletedBy *string    `json:"completedBy"`
+						Id          *uint      `json:"id,omitempty"`
+						Name        *string    `json:"name,omitempty"`
 					}, len(item.Rows))
 
 					for k, row := range item.Rows {
 						rows[k].Id = &row.Id
 						rows[k].Name = &row.Name
 						rows[k].Completed = &row.Completed
+						rows[k].CompletedBy = row.CompletedBy
+						rows[k].CompletedAt = row.CompletedAt
 					}
 					items[j].Rows = &rows
 				}
@@ -19,6 +19,29 @@ const (
 	CookieAuthScopes = "CookieAuth.Scopes"
 )
 
+// Defines values for ChecklistOrderingMode.
+const (
+	KEEPINPLACE   ChecklistOrderingMode = "KEEP_IN_PLACE"
+	SINKCOMPLETED ChecklistOrderingMode = "SINK_COMPLETED"
+)
+
+// Defines values for WorkspaceActivityEventType.
+const (
+	CHECKLISTMOVEDIN   WorkspaceActivityEventType = "CHECKLIST_MOVED_IN"
+	CHECKLISTMOVEDOUT  WorkspaceActivityEventType = "CHECKLIST_MOVED_OUT"
+	INVITECLAIMED      WorkspaceActivityEventType = "INVITE_CLAIMED"
+	INVITECREATED      WorkspaceActivityEventType = "INVITE_CREATED"
+	MEMBERADDED        WorkspaceActivityEventType = "MEMBER_ADDED"
+	MEMBERLEFT         WorkspaceActivityEventType = "MEMBER_LEFT"
+	MEMBERREMOVED      WorkspaceActivityEventType = "MEMBER_REMOVED"
+	TEMPLATEASSIGNED   WorkspaceActivityEventType = "TEMPLATE_ASSIGNED"
+	TEMPLATEUNASSIGNED WorkspaceActivityEventType = "TEMPLATE_UNASSIGNED"
+)
+
+// ChecklistOrderingMode SINK_COMPLETED moves completed items below the open ones and reopened items back to the end of the open section.
+// KEEP_IN_PLACE leaves items where they are, for procedural lists where the order must not change.
+type ChecklistOrderingMode string
+
 // ChecklistWithStats defines model for ChecklistWithStats.
 type ChecklistWithStats struct {
 	Id uint `json:"id"`
@@ -33,6 +56,10 @@ type ChecklistWithStats struct {
 	// NumberOfSharedUsers Number of users this checklist is shared with (only included for owners)
 	NumberOfSharedUsers *float32 `json:"numberOfSharedUsers,omitempty"`
 
+	// OrderingMode SINK_COMPLETED moves completed items below the open ones and reopened items back to the end of the open section.
+	// KEEP_IN_PLACE leaves items where they are, for procedural lists where the order must not change.
+	OrderingMode ChecklistOrderingMode `json:"orderingMode"`
+
 	// Stats Statistics about checklist items
 	Stats struct {
 		// CompletedItems Number of completed items
@@ -82,8 +109,11 @@ type TemplateResponse struct {
 	Id          uint      `json:"id"`
 
 	// IsOwner True if the current user owns this template, false if shared
-	IsOwner   bool                  `json:"isOwner"`
-	Name      string                `json:"name"`
+	IsOwner bool   `json:"isOwner"`
+	Name    string `json:"name"`
+
+	// Notes Markdown notes given to the item created from the template
+	Notes     *string               `json:"notes"`
 	Rows      []TemplateRowResponse `json:"rows"`
 	UpdatedAt time.Time             `json:"updatedAt"`
 	UserId    string                `json:"userId"`
@@ -94,14 +124,52 @@ type TemplateResponse struct {
 
 // TemplateRowResponse defines model for TemplateRowResponse.
 type TemplateRowResponse struct {
-	CreatedAt  time.Time `json:"createdAt"`
-	Id         uint      `json:"id"`
-	Name       string    `json:"name"`
-	Position   float64   `json:"position"`
+	CreatedAt time.Time `json:"createdAt"`
+	Id        uint      `json:"id"`
+	Name      string    `json:"name"`
+
+	// Position Fractional key; rows are listed in ascending byte order of their positions
+	Position   string    `json:"position"`
 	TemplateId uint      `json:"templateId"`
 	UpdatedAt  time.Time `json:"updatedAt"`
 }
 
+// WorkspaceActivityEventType defines model for WorkspaceActivityEventType.
+type WorkspaceActivityEventType string
+
+// WorkspaceActivityPageResponse defines model for WorkspaceActivityPageResponse.
+type WorkspaceActivityPageResponse struct {
+	Entries []WorkspaceActivityResponse `json:"entries"`
+
+	// NextCursor Pass as `before` to fetch the next page (null when there are no more entries)
+	NextCursor *uint `json:"nextCursor"`
+}
+
+// WorkspaceActivityResponse defines model for WorkspaceActivityResponse.
+type WorkspaceActivityResponse struct {
+	// Actor User who performed the change
+	Actor string `json:"actor"`
+
+	// ChecklistId Checklist the entry refers to (checklist move events)
+	ChecklistId *uint                      `json:"checklistId"`
+	CreatedAt   time.Time                  `json:"createdAt"`
+	EventType   WorkspaceActivityEventType `json:"eventType"`
+	Id          uint                       `json:"id"`
+
+	// InviteId Invite the entry refers to (invite events)
+	InviteId *uint `json:"inviteId"`
+
+	// SubjectUserId Member the entry refers to (member and invite claim events)
+	SubjectUserId *string `json:"subjectUserId"`
+
+	// Summary Name of the referenced checklist, template or invite at the time of the event
+	Summary *string `json:"summary"`
+
+	// TemplateId Template the entry refers to (template assignment events)
+	TemplateId  *uint `json:"templateId"`
+	WorkspaceId uint  `json:"workspaceId"`
+}
+
 // WorkspaceInviteResponse defines model for WorkspaceInviteResponse.
 type WorkspaceInviteResponse struct {
 	ClaimedAt   *time.Time `json:"claimedAt"`
@@ -177,6 +245,24 @@ type UpdateWorkspaceParams struct {
 	XClientId *XClientId `json:"X-Client-Id,omitempty"`
 }
 
+// GetWorkspaceActivityParams defines parameters for GetWorkspaceActivity.
+type GetWorkspaceActivityParams struct {
+	// EventType Only return entries of this event type
+	EventType *WorkspaceActivityEventType `form:"eventType,omitempty" json:"eventType,omitempty"`
+
+	// Actor Only return entries performed by this user
+	Actor *string `form:"actor,omitempty" json:"actor,omitempty"`
+
+	// Before Only return entries older than this entry id
+	Before *uint `form:"before,omitempty" json:"before,omitempty"`
+
+	// Limit Maximum number of entries to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// XClientId Client identifier sent by frontend in headers
+	XClientId *XClientId `json:"X-Client-Id,omitempty"`
+}
+
 // GetWorkspaceChecklistsParams defines parameters for GetWorkspaceChecklists.
 type GetWorkspaceChecklistsParams struct {
 	// XClientId Client identifier sent by frontend in headers
@@ -254,6 +340,9 @@ type ServerInterface interface {
 	// Update workspace
 	// (PUT /api/v1/workspaces/{workspaceId})
 	UpdateWorkspace(c *gin.Context, workspaceId uint, params UpdateWorkspaceParams)
+	// Get the activity feed of a workspace
+	// (GET /api/v1/workspaces/{workspaceId}/activity)
+	GetWorkspaceActivity(c *gin.Context, workspaceId uint, params GetWorkspaceActivityParams)
 	// Get checklists in a workspace
 	// (GET /api/v1/workspaces/{workspaceId}/checklists)
 	GetWorkspaceChecklists(c *gin.Context, workspaceId uint, params GetWorkspaceChecklistsParams)
@@ -571,6 +660,88 @@ func (siw *ServerInterfaceWrapper) UpdateWorkspace(c *gin.Context) {
 	siw.Handler.UpdateWorkspace(c, workspaceId, params)
 }
 
+// GetWorkspaceActivity operation middleware
+func (siw *ServerInterfaceWrapper) GetWorkspaceActivity(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "workspaceId" -------------
+	var workspaceId uint
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workspaceId", c.Param("workspaceId"), &workspaceId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter workspaceId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetWorkspaceActivityParams
+
+	// ------------- Optional query parameter "eventType" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "eventType", c.Request.URL.Query(), &params.EventType)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter eventType: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "actor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "actor", c.Request.URL.Query(), &params.Actor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter actor: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "before" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "before", c.Request.URL.Query(), &params.Before)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter before: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "X-Client-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Client-Id")]; found {
+		var XClientId XClientId
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Client-Id, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Client-Id", valueList[0], &XClientId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Client-Id: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XClientId = &XClientId
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetWorkspaceActivity(c, workspaceId, params)
+}
+
 // GetWorkspaceChecklists operation middleware
 func (siw *ServerInterfaceWrapper) GetWorkspaceChecklists(c *gin.Context) {
 
@@ -1022,6 +1193,7 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 	router.DELETE(options.BaseURL+"/api/v1/workspaces/:workspaceId", wrapper.DeleteWorkspace)
 	router.GET(options.BaseURL+"/api/v1/workspaces/:workspaceId", wrapper.GetWorkspaceById)
 	router.PUT(options.BaseURL+"/api/v1/workspaces/:workspaceId", wrapper.UpdateWorkspace)
+	router.GET(options.BaseURL+"/api/v1/workspaces/:workspaceId/activity", wrapper.GetWorkspaceActivity)
 	router.GET(options.BaseURL+"/api/v1/workspaces/:workspaceId/checklists", wrapper.GetWorkspaceChecklists)
 	router.GET(options.BaseURL+"/api/v1/workspaces/:workspaceId/invites", wrapper.GetWorkspaceInvites)
 	router.POST(options.BaseURL+"/api/v1/workspaces/:workspaceId/invites", wrapper.CreateWorkspaceInvite)
@@ -1285,6 +1457,51 @@ func (response UpdateWorkspace500JSONResponse) VisitUpdateWorkspaceResponse(w ht
 	return json.NewEncoder(w).Encode(response)
 }
 
+type GetWorkspaceActivityRequestObject struct {
+	WorkspaceId uint `json:"workspaceId"`
+	Params      GetWorkspaceActivityParams
+}
+
+type GetWorkspaceActivityResponseObject interface {
+	VisitGetWorkspaceActivityResponse(w http.ResponseWriter) error
+}
+
+type GetWorkspaceActivity200JSONResponse WorkspaceActivityPageResponse
+
+func (response GetWorkspaceActivity200JSONResponse) VisitGetWorkspaceActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkspaceActivity400JSONResponse struct{ ErrorResponseJSONResponse }
+
+func (response GetWorkspaceActivity400JSONResponse) VisitGetWorkspaceActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkspaceActivity404JSONResponse Error
+
+func (response GetWorkspaceActivity404JSONResponse) VisitGetWorkspaceActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkspaceActivity500JSONResponse Error
+
+func (response GetWorkspaceActivity500JSONResponse) VisitGetWorkspaceActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type GetWorkspaceChecklistsRequestObject struct {
 	WorkspaceId uint `json:"workspaceId"`
 	Params      GetWorkspaceChecklistsParams
@@ -1674,6 +1891,9 @@ type StrictServerInterface interface {
 	// Update workspace
 	// (PUT /api/v1/workspaces/{workspaceId})
 	UpdateWorkspace(ctx context.Context, request UpdateWorkspaceRequestObject) (UpdateWorkspaceResponseObject, error)
+	// Get the activity feed of a workspace
+	// (GET /api/v1/workspaces/{workspaceId}/activity)
+	GetWorkspaceActivity(ctx context.Context, request GetWorkspaceActivityRequestObject) (GetWorkspaceActivityResponseObject, error)
 	// Get checklists in a workspace
 	// (GET /api/v1/workspaces/{workspaceId}/checklists)
 	GetWorkspaceChecklists(ctx context.Context, request GetWorkspaceChecklistsRequestObject) (GetWorkspaceChecklistsResponseObject, error)
@@ -1894,6 +2114,34 @@ func (sh *strictHandler) UpdateWorkspace(ctx *gin.Context, workspaceId uint, par
 	}
 }
 
+// GetWorkspaceActivity operation middleware
+func (sh *strictHandler) GetWorkspaceActivity(ctx *gin.Context, workspaceId uint, params GetWorkspaceActivityParams) {
+	var request GetWorkspaceActivityRequestObject
+
+	request.WorkspaceId = workspaceId
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetWorkspaceActivity(ctx, request.(GetWorkspaceActivityRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetWorkspaceActivity")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetWorkspaceActivityResponseObject); ok {
+		if err := validResponse.VisitGetWorkspaceActivityResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // GetWorkspaceChecklists operation middleware
 func (sh *strictHandler) GetWorkspaceChecklists(ctx *gin.Context, workspaceId uint, params GetWorkspaceChecklistsParams) {
 	var request GetWorkspaceChecklistsRequestObject
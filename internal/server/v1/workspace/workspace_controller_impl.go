@@ -15,20 +15,23 @@ import (
 type IWorkspaceController = StrictServerInterface
 
 type workspaceController struct {
-	service       service.IWorkspaceService
-	inviteService service.IWorkspaceInviteService
-	baseUrl       serverAuth.BaseUrl
+	service         service.IWorkspaceService
+	inviteService   service.IWorkspaceInviteService
+	activityService service.IWorkspaceActivityService
+	baseUrl         serverAuth.BaseUrl
 }
 
 func NewWorkspaceController(
 	service service.IWorkspaceService,
 	inviteService service.IWorkspaceInviteService,
+	activityService service.IWorkspaceActivityService,
 	baseUrl serverAuth.BaseUrl,
 ) IWorkspaceController {
 	return &workspaceController{
-		service:       service,
-		inviteService: inviteService,
-		baseUrl:       baseUrl,
+		service:         service,
+		inviteService:   inviteService,
+		activityService: activityService,
+		baseUrl:         baseUrl,
 	}
 }
 
@@ -277,6 +280,7 @@ func (c *workspaceController) GetWorkspaceTemplates(ctx context.Context, request
 				Id:           t.Id,
 				Name:         t.Name,
 				Description:  t.Description,
+				Notes:        t.Notes,
 				UserId:       t.UserId,
 				IsOwner:      t.IsOwner,
 				Rows:         rows,
@@ -303,10 +307,11 @@ func (c *workspaceController) GetWorkspaceChecklists(ctx context.Context, reques
 			isOwner := cl.Owner == currentUserId
 			isShared := len(cl.SharedWith) > 0
 			dto := ChecklistWithStats{
-				Id:       cl.Id,
-				Name:     cl.Name,
-				IsOwner:  isOwner,
-				IsShared: isShared,
+				Id:           cl.Id,
+				Name:         cl.Name,
+				IsOwner:      isOwner,
+				IsShared:     isShared,
+				OrderingMode: ChecklistOrderingMode(cl.OrderingMode),
 				Stats: struct {
 					CompletedItems uint `json:"completedItems"`
 					TotalItems     uint `json:"totalItems"`
@@ -328,3 +333,44 @@ func (c *workspaceController) GetWorkspaceChecklists(ctx context.Context, reques
 		return GetWorkspaceChecklists500JSONResponse{Message: err.Error()}, nil
 	}
 }
+
+func toWorkspaceActivityResponse(activity domain.WorkspaceActivity) WorkspaceActivityResponse {
+	return WorkspaceActivityResponse{
+		Id:            activity.Id,
+		WorkspaceId:   activity.WorkspaceId,
+		EventType:     WorkspaceActivityEventType(activity.EventType),
+		Actor:         activity.Actor,
+		SubjectUserId: activity.SubjectUserId,
+		ChecklistId:   activity.ChecklistId,
+		TemplateId:    activity.TemplateId,
+		InviteId:      activity.InviteId,
+		Summary:       activity.Summary,
+		CreatedAt:     activity.CreatedAt,
+	}
+}
+
+func (c *workspaceController) GetWorkspaceActivity(ctx context.Context, request GetWorkspaceActivityRequestObject) (GetWorkspaceActivityResponseObject, error) {
+	domainCtx := serverutils.CreateContext(ctx)
+
+	filter := domain.WorkspaceActivityFilter{Actor: request.Params.Actor}
+	if request.Params.EventType != nil {
+		eventType, eventTypeErr := domain.NewWorkspaceActivityEventType(string(*request.Params.EventType))
+		if eventTypeErr != nil {
+			return GetWorkspaceActivity400JSONResponse{ErrorResponseJSONResponse: ErrorResponseJSONResponse{Message: eventTypeErr.Error()}}, nil
+		}
+		filter.EventType = &eventType
+	}
+
+	page, err := c.activityService.FindWorkspaceActivity(domainCtx, request.WorkspaceId, filter, request.Params.Before, request.Params.Limit)
+	if err == nil {
+		entries := make([]WorkspaceActivityResponse, 0, len(page.Entries))
+		for _, activity := range page.Entries {
+			entries = append(entries, toWorkspaceActivityResponse(activity))
+		}
+		return GetWorkspaceActivity200JSONResponse{Entries: entries, NextCursor: page.NextCursor}, nil
+	} else if err.ResponseCode() == http.StatusNotFound {
+		return GetWorkspaceActivity404JSONResponse{Message: err.Error()}, nil
+	} else {
+		return GetWorkspaceActivity500JSONResponse{Message: err.Error()}, nil
+	}
+}
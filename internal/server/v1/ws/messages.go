@@ -0,0 +1,70 @@
+package ws
+
+import (
+	"encoding/json"
+
+	"com.raunlo.checklist/internal/core/domain"
+	"com.raunlo.checklist/internal/server/v1/sse"
+)
+
+// Types of the messages a client sends
+const (
+	MessageTypeRequest         = "request"         // Runs Method and Path against the checklist item endpoints
+	MessageTypeSubscribe       = "subscribe"       // Starts the events of ChecklistId, replaying from LastEventId
+	MessageTypeUnsubscribe     = "unsubscribe"     // Stops the events of ChecklistId
+	MessageTypeSubscribeUser   = "subscribeUser"   // Starts the user stream
+	MessageTypeUnsubscribeUser = "unsubscribeUser" // Stops the user stream
+	MessageTypeEditing         = "editing"         // Sets the item the user edits in ChecklistId (ItemId nil = none)
+)
+
+// Types of the messages the server sends
+const (
+	MessageTypeAck   = "ack"   // The operation succeeded
+	MessageTypeError = "error" // The operation failed
+	MessageTypeEvent = "event" // An event of a subscription
+	// MessageTypeSubscriptionClosed is sent when the server ends a subscription, e.g. because the client fell
+	// behind. The client subscribes again with the id of the last event it received.
+	MessageTypeSubscriptionClosed = "subscriptionClosed"
+)
+
+// clientMessage is an operation of the client. OpId is chosen by the client and returned in the reply.
+type clientMessage struct {
+	Type        string          `json:"type"`
+	OpId        string          `json:"opId"`
+	Method      string          `json:"method,omitempty"`
+	Path        string          `json:"path,omitempty"`
+	Body        json.RawMessage `json:"body,omitempty"`
+	ChecklistId uint            `json:"checklistId,omitempty"`
+	LastEventId *uint64         `json:"lastEventId,omitempty"`
+	ItemId      *uint           `json:"itemId,omitempty"`
+}
+
+// serverMessage is the reply to an operation or an event pushed to the client. Replies to requests carry the
+// status and body of the matching REST response.
+type serverMessage struct {
+	Type        string             `json:"type"`
+	OpId        string             `json:"opId,omitempty"`
+	Status      int                `json:"status,omitempty"`
+	Body        json.RawMessage    `json:"body,omitempty"`
+	ChecklistId uint               `json:"checklistId,omitempty"`
+	EventId     uint64             `json:"eventId,omitempty"`
+	Event       *sse.EventEnvelope `json:"event,omitempty"`
+}
+
+func newReply(opId string, status int, body json.RawMessage) serverMessage {
+	messageType := MessageTypeAck
+	if status >= 400 {
+		messageType = MessageTypeError
+	}
+	return serverMessage{Type: messageType, OpId: opId, Status: status, Body: body}
+}
+
+func newErrorReply(opId string, status int, message string) serverMessage {
+	body, _ := json.Marshal(map[string]string{"message": message})
+	return newReply(opId, status, body)
+}
+
+func newEventMessage(mapper sse.IChecklistItemUpdatesMapper, event domain.ChecklistItemUpdatesEvent) serverMessage {
+	envelope := mapper.Map(event)
+	return serverMessage{Type: MessageTypeEvent, EventId: event.Id, Event: &envelope}
+}
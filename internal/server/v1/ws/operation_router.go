@@ -0,0 +1,197 @@
+package ws
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"com.raunlo.checklist/internal/server/auth"
+	"com.raunlo.checklist/internal/server/v1/checklistItem"
+	"github.com/gin-gonic/gin"
+)
+
+// operationUserIdKey carries the user of a socket from the operation to the Gin context of its request
+type operationUserIdKey struct{}
+
+// operationRouter runs socket operations through the checklist item endpoints, so that they are validated,
+// authorized and answered exactly like REST requests. The session and CSRF middleware are not run again, the
+// socket was authenticated when it was opened.
+type operationRouter struct {
+	engine *gin.Engine
+}
+
+func newOperationRouter(checklistItemController checklistItem.IChecklistItemController, apiRateLimit auth.APIRateLimit) *operationRouter {
+	engine := gin.New()
+	engine.Use(gin.HandlerFunc(apiRateLimit))
+	engine.Use(func(c *gin.Context) {
+		if userId, ok := c.Request.Context().Value(operationUserIdKey{}).(string); ok {
+			auth.SetUserIdInGinContext(c, userId)
+		}
+		c.Next()
+	})
+	checklistItem.RegisterHandlers(engine, checklistItem.NewStrictHandler(checklistItemController, nil))
+	return &operationRouter{engine: engine}
+}
+
+// operation is a request of a socket client
+type operation struct {
+	userId       string
+	clientId     string
+	remoteAddr   string
+	forwardedFor string // rate limiting uses the client IP of the socket
+	method       string
+	path         string
+	body         json.RawMessage
+}
+
+// serve returns the status and body of the response. A body that is not JSON is returned as a JSON string.
+func (r *operationRouter) serve(op operation) (int, json.RawMessage) {
+	ctx := context.WithValue(context.Background(), operationUserIdKey{}, op.userId)
+	request, err := http.NewRequestWithContext(ctx, strings.ToUpper(op.method), op.path, bytes.NewReader(op.body))
+	if err != nil {
+		body, _ := json.Marshal(map[string]string{"message": "Invalid request path"})
+		return http.StatusBadRequest, body
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("X-Client-Id", op.clientId)
+	request.RemoteAddr = op.remoteAddr
+	if op.forwardedFor != "" {
+		request.Header.Set("X-Forwarded-For", op.forwardedFor)
+	}
+
+	response := &responseRecorder{header: http.Header{}}
+	r.engine.ServeHTTP(response, request)
+
+	response.WriteHeader(http.StatusOK)
+	body := response.body.Bytes()
+	if len(body) == 0 {
+		return response.status, nil
+	} else if !json.Valid(body) {
+		body, _ = json.Marshal(string(body))
+	}
+	return response.status, body
+}
+
+const (
+	// recentOperationTTL is how long the reply of a request is kept for a client that sends it again
+	recentOperationTTL = 5 * time.Minute
+	// recentOperationsPerUser bounds the kept replies of a user, the oldest are dropped first
+	recentOperationsPerUser = 256
+)
+
+// recentOperations remembers the replies of the recent requests of every user by opId. A client that lost its
+// socket before the reply arrived does not know whether the request was applied; when it sends the request
+// again, on any socket, it gets the first reply instead of running the request twice.
+type recentOperations struct {
+	mu        sync.Mutex
+	users     map[string]map[string]*recentOperation // userId -> opId -> operation
+	swept     time.Time
+	ttl       time.Duration
+	maxByUser int
+}
+
+// recentOperation is a request that is running or was answered; done is closed once the reply is known
+type recentOperation struct {
+	done      chan struct{}
+	startedAt time.Time
+	status    int
+	body      json.RawMessage
+}
+
+func newRecentOperations() *recentOperations {
+	return &recentOperations{
+		users:     map[string]map[string]*recentOperation{},
+		ttl:       recentOperationTTL,
+		maxByUser: recentOperationsPerUser,
+	}
+}
+
+// run serves the request once per user and opId and returns its reply. Requests that were rejected by the rate
+// limit or failed on the server were not applied, so they are forgotten and run again when they are repeated.
+func (r *recentOperations) run(userId string, opId string, serve func() (int, json.RawMessage)) (int, json.RawMessage) {
+	r.mu.Lock()
+	now := time.Now()
+	r.sweepLocked(now)
+	operations := r.users[userId]
+	if previous, ok := operations[opId]; ok && now.Sub(previous.startedAt) < r.ttl {
+		r.mu.Unlock()
+		<-previous.done
+		return previous.status, previous.body
+	}
+	if operations == nil {
+		operations = map[string]*recentOperation{}
+		r.users[userId] = operations
+	}
+	if len(operations) >= r.maxByUser {
+		dropOldestOperation(operations)
+	}
+	op := &recentOperation{done: make(chan struct{}), startedAt: now}
+	operations[opId] = op
+	r.mu.Unlock()
+
+	op.status, op.body = serve()
+	close(op.done)
+	if op.status == http.StatusTooManyRequests || op.status >= http.StatusInternalServerError {
+		r.mu.Lock()
+		if r.users[userId][opId] == op {
+			delete(r.users[userId], opId)
+		}
+		r.mu.Unlock()
+	}
+	return op.status, op.body
+}
+
+// sweepLocked drops expired replies, at most once per ttl. Must be called with mu held.
+func (r *recentOperations) sweepLocked(now time.Time) {
+	if now.Sub(r.swept) < r.ttl {
+		return
+	}
+	r.swept = now
+	for userId, operations := range r.users {
+		for opId, op := range operations {
+			if now.Sub(op.startedAt) >= r.ttl {
+				delete(operations, opId)
+			}
+		}
+		if len(operations) == 0 {
+			delete(r.users, userId)
+		}
+	}
+}
+
+func dropOldestOperation(operations map[string]*recentOperation) {
+	var oldestOpId string
+	var oldest *recentOperation
+	for opId, op := range operations {
+		if oldest == nil || op.startedAt.Before(oldest.startedAt) {
+			oldestOpId, oldest = opId, op
+		}
+	}
+	delete(operations, oldestOpId)
+}
+
+// responseRecorder keeps the response of an operation in memory
+type responseRecorder struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (r *responseRecorder) Header() http.Header {
+	return r.header
+}
+
+func (r *responseRecorder) WriteHeader(status int) {
+	if r.status == 0 {
+		r.status = status
+	}
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	r.WriteHeader(http.StatusOK)
+	return r.body.Write(b)
+}
@@ -0,0 +1,355 @@
+package ws
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"com.raunlo.checklist/internal/core/domain"
+	"com.raunlo.checklist/internal/core/notification"
+	"com.raunlo.checklist/internal/server/auth"
+	serverutils "com.raunlo.checklist/internal/server/server_utils"
+	"com.raunlo.checklist/internal/server/v1/checklistItem"
+	"com.raunlo.checklist/internal/server/v1/sse"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+)
+
+const (
+	pingInterval   = 30 * time.Second
+	pongTimeout    = 60 * time.Second
+	writeTimeout   = 10 * time.Second
+	maxMessageSize = 64 * 1024
+	// outboxSize is the number of replies and events waiting to be written; a client that falls further
+	// behind is disconnected
+	outboxSize = 64
+)
+
+type IWebSocketController interface {
+	// Connect upgrades an authenticated request to a socket and serves it until it is closed
+	Connect(c *gin.Context)
+}
+
+type webSocketController struct {
+	broker           notification.IBroker
+	mapper           sse.IChecklistItemUpdatesMapper
+	operations       *operationRouter
+	recentOperations *recentOperations
+	sessions         auth.SessionValidator
+	upgrader         websocket.Upgrader
+}
+
+func NewWebSocketController(broker notification.IBroker, checklistItemController checklistItem.IChecklistItemController,
+	sessions auth.SessionValidator, apiRateLimit auth.APIRateLimit) IWebSocketController {
+	return &webSocketController{
+		broker:           broker,
+		mapper:           sse.NewChecklistItemUpdatesMapper(),
+		operations:       newOperationRouter(checklistItemController, apiRateLimit),
+		recentOperations: newRecentOperations(),
+		sessions:         sessions,
+		upgrader: websocket.Upgrader{
+			// The CORS middleware already rejected requests from origins that are not allowed
+			CheckOrigin: func(r *http.Request) bool { return true },
+		},
+	}
+}
+
+func (controller *webSocketController) Connect(c *gin.Context) {
+	ctx := serverutils.CreateContext(c)
+	userId, err := domain.GetUserIdFromContext(ctx)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+	clientId, _ := ctx.Value(domain.ClientIdContextKey).(string)
+	if clientId == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"message": "clientId query parameter is required"})
+		return
+	}
+
+	// the session middleware already validated the cookie, it is validated again while the socket is open
+	sessionId, _ := c.Cookie(auth.SessionCookieName)
+
+	conn, upgradeErr := controller.upgrader.Upgrade(c.Writer, c.Request, nil)
+	if upgradeErr != nil {
+		// the upgrader already replied with an error
+		return
+	}
+	s := &session{
+		controller:    controller,
+		conn:          conn,
+		userId:        userId,
+		sessionId:     sessionId,
+		clientId:      clientId,
+		remoteAddr:    c.Request.RemoteAddr,
+		forwardedFor:  c.GetHeader("X-Forwarded-For"),
+		outbox:        make(chan serverMessage, outboxSize),
+		done:          make(chan struct{}),
+		subscriptions: map[uint]*subscription{},
+	}
+	s.run(ctx)
+}
+
+// session is one open socket. Operations are handled one at a time in the order the client sent them; replies
+// and events are written by a single writer.
+type session struct {
+	controller   *webSocketController
+	conn         *websocket.Conn
+	userId       string
+	sessionId    string
+	clientId     string
+	remoteAddr   string
+	forwardedFor string
+	outbox       chan serverMessage
+	done         chan struct{}
+	closeOnce    sync.Once
+	// subscriptions and userSubscription are only used by the reading goroutine
+	subscriptions    map[uint]*subscription
+	userSubscription *subscription
+}
+
+// subscription forwards the events of a broker channel to the socket
+type subscription struct {
+	ch chan domain.ChecklistItemUpdatesEvent
+	// ended is set when the client unsubscribed, so that the closed channel is not reported back to it
+	ended atomic.Bool
+}
+
+func (s *session) run(ctx context.Context) {
+	go s.writeLoop()
+	defer s.cleanup(ctx)
+
+	s.conn.SetReadLimit(maxMessageSize)
+	_ = s.conn.SetReadDeadline(time.Now().Add(pongTimeout))
+	s.conn.SetPongHandler(func(string) error {
+		return s.conn.SetReadDeadline(time.Now().Add(pongTimeout))
+	})
+
+	for {
+		_, data, err := s.conn.ReadMessage()
+		if err != nil {
+			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseNormalClosure) {
+				log.Printf("ws: connection of client %s lost: %v", s.clientId, err)
+			}
+			return
+		}
+		var message clientMessage
+		if err := json.Unmarshal(data, &message); err != nil {
+			s.send(newErrorReply("", http.StatusBadRequest, "Invalid message"))
+			continue
+		}
+		s.handle(ctx, message)
+	}
+}
+
+func (s *session) handle(ctx context.Context, message clientMessage) {
+	if message.OpId == "" {
+		s.send(newErrorReply("", http.StatusBadRequest, "opId is required"))
+		return
+	}
+	switch message.Type {
+	case MessageTypeRequest:
+		status, body := s.controller.recentOperations.run(s.userId, message.OpId, func() (int, json.RawMessage) {
+			return s.controller.operations.serve(operation{
+				userId:       s.userId,
+				clientId:     s.clientId,
+				remoteAddr:   s.remoteAddr,
+				forwardedFor: s.forwardedFor,
+				method:       message.Method,
+				path:         message.Path,
+				body:         message.Body,
+			})
+		})
+		s.send(newReply(message.OpId, status, body))
+	case MessageTypeSubscribe:
+		s.subscribe(ctx, message)
+	case MessageTypeUnsubscribe:
+		if sub, ok := s.subscriptions[message.ChecklistId]; ok {
+			sub.ended.Store(true)
+			delete(s.subscriptions, message.ChecklistId)
+			_ = s.controller.broker.Unsubscribe(ctx, message.ChecklistId)
+		}
+		s.send(newReply(message.OpId, http.StatusOK, nil))
+	case MessageTypeSubscribeUser:
+		if s.userSubscription != nil {
+			s.userSubscription.ended.Store(true)
+		}
+		ch, err := s.controller.broker.SubscribeUser(ctx)
+		if err != nil {
+			s.send(newErrorReply(message.OpId, errorStatus(err), "Failed to subscribe to events"))
+			return
+		}
+		s.userSubscription = &subscription{ch: ch}
+		s.send(newReply(message.OpId, http.StatusOK, nil))
+		go s.forward(s.userSubscription, 0, 0)
+	case MessageTypeUnsubscribeUser:
+		if s.userSubscription != nil {
+			s.userSubscription.ended.Store(true)
+			s.userSubscription = nil
+			_ = s.controller.broker.UnsubscribeUser(ctx)
+		}
+		s.send(newReply(message.OpId, http.StatusOK, nil))
+	case MessageTypeEditing:
+		if err := s.controller.broker.SetEditingItem(ctx, message.ChecklistId, message.ItemId); err != nil {
+			s.send(newErrorReply(message.OpId, errorStatus(err), err.Error()))
+			return
+		}
+		s.send(newReply(message.OpId, http.StatusOK, nil))
+	default:
+		s.send(newErrorReply(message.OpId, http.StatusBadRequest, "Unknown message type"))
+	}
+}
+
+// subscribe starts the events of a checklist. Like the SSE stream, events missed since LastEventId are
+// replayed first, or resyncRequired is sent when they are no longer in the replay log.
+func (s *session) subscribe(ctx context.Context, message clientMessage) {
+	if message.ChecklistId == 0 {
+		s.send(newErrorReply(message.OpId, http.StatusBadRequest, "checklistId is required"))
+		return
+	}
+	if previous, ok := s.subscriptions[message.ChecklistId]; ok {
+		previous.ended.Store(true)
+	}
+	ch, err := s.controller.broker.Subscribe(ctx, message.ChecklistId)
+	if err != nil {
+		delete(s.subscriptions, message.ChecklistId)
+		s.send(newErrorReply(message.OpId, errorStatus(err), "Failed to subscribe to events"))
+		return
+	}
+	sub := &subscription{ch: ch}
+	s.subscriptions[message.ChecklistId] = sub
+	s.send(newReply(message.OpId, http.StatusOK, nil))
+
+	var lastSentEventId uint64
+	if message.LastEventId != nil {
+		events, complete, err := s.controller.broker.Replay(ctx, message.ChecklistId, *message.LastEventId)
+		if err != nil || !complete {
+			s.send(newEventMessage(s.controller.mapper, domain.ChecklistItemUpdatesEvent{
+				ChecklistId: message.ChecklistId,
+				EventType:   domain.EventTypeResyncRequired,
+				Payload: domain.ResyncRequiredEventPayload{
+					Message: "Missed events can no longer be replayed, please reload the checklist",
+				},
+			}))
+		} else {
+			lastSentEventId = *message.LastEventId
+			for _, event := range events {
+				event.ChecklistId = message.ChecklistId
+				s.send(newEventMessage(s.controller.mapper, event))
+				lastSentEventId = event.Id
+			}
+		}
+	}
+	go s.forward(sub, message.ChecklistId, lastSentEventId)
+}
+
+// forward sends the events of a subscription until the broker closes its channel or the socket is closed.
+// Events with an id up to lastSentEventId were already replayed and are skipped.
+func (s *session) forward(sub *subscription, checklistId uint, lastSentEventId uint64) {
+	for {
+		select {
+		case <-s.done:
+			return
+		case event, ok := <-sub.ch:
+			if !ok {
+				if !sub.ended.Load() {
+					s.send(serverMessage{Type: MessageTypeSubscriptionClosed, ChecklistId: checklistId})
+				}
+				return
+			}
+			if event.Id != 0 && event.Id <= lastSentEventId {
+				continue
+			}
+			s.send(newEventMessage(s.controller.mapper, event))
+			if event.Id != 0 {
+				lastSentEventId = event.Id
+			}
+		}
+	}
+}
+
+// send queues a message for the writer and disconnects a client that does not keep up
+func (s *session) send(message serverMessage) {
+	select {
+	case <-s.done:
+	case s.outbox <- message:
+	default:
+		log.Printf("ws: outbox full for client %s, closing connection", s.clientId)
+		s.close()
+	}
+}
+
+func (s *session) writeLoop() {
+	ping := time.NewTicker(pingInterval)
+	defer ping.Stop()
+
+	for {
+		select {
+		case <-s.done:
+			return
+		case message := <-s.outbox:
+			_ = s.conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+			if err := s.conn.WriteJSON(message); err != nil {
+				s.close()
+				return
+			}
+		case <-ping.C:
+			if !s.sessionValid() {
+				_ = s.conn.WriteControl(websocket.CloseMessage,
+					websocket.FormatCloseMessage(websocket.ClosePolicyViolation, "Session expired"), time.Now().Add(writeTimeout))
+				s.close()
+				return
+			}
+			if err := s.conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(writeTimeout)); err != nil {
+				s.close()
+				return
+			}
+		}
+	}
+}
+
+// sessionValid reports whether the login session the socket was opened with is still valid, so that a socket
+// does not outlive a logout or an expired session
+func (s *session) sessionValid() bool {
+	ctx, cancel := context.WithTimeout(context.Background(), writeTimeout)
+	defer cancel()
+	loginSession, err := s.controller.sessions.ValidateSession(ctx, s.sessionId)
+	if err != nil {
+		log.Printf("ws: could not validate session of client %s: %v", s.clientId, err)
+	}
+	return err == nil && loginSession != nil
+}
+
+// close stops the writer and unblocks the reader
+func (s *session) close() {
+	s.closeOnce.Do(func() {
+		close(s.done)
+		_ = s.conn.Close()
+	})
+}
+
+func (s *session) cleanup(ctx context.Context) {
+	s.close()
+	for checklistId, sub := range s.subscriptions {
+		sub.ended.Store(true)
+		_ = s.controller.broker.Unsubscribe(ctx, checklistId)
+	}
+	if s.userSubscription != nil {
+		s.userSubscription.ended.Store(true)
+		_ = s.controller.broker.UnsubscribeUser(ctx)
+	}
+}
+
+// errorStatus returns the status of a domain error, e.g. 404 when the user cannot access the checklist
+func errorStatus(err error) int {
+	var domainErr domain.Error
+	if errors.As(err, &domainErr) {
+		return domainErr.ResponseCode()
+	}
+	return http.StatusInternalServerError
+}
@@ -0,0 +1,205 @@
+package ws
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"com.raunlo.checklist/internal/core/domain"
+	"com.raunlo.checklist/internal/core/notification"
+	"com.raunlo.checklist/internal/core/repository"
+	"com.raunlo.checklist/internal/server/auth"
+	"com.raunlo.checklist/internal/server/v1/checklistItem"
+	"com.raunlo.checklist/internal/server/v1/sse"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type allowAllGuardrail struct{}
+
+func (allowAllGuardrail) HasAccessToChecklist(ctx context.Context, checklistId uint) domain.Error {
+	return nil
+}
+
+func (allowAllGuardrail) CanModifyChecklist(ctx context.Context, checklistId uint) domain.Error {
+	return nil
+}
+
+func (allowAllGuardrail) IsChecklistOwner(ctx context.Context, checklistId uint) domain.Error {
+	return nil
+}
+
+type stubUserRepository struct {
+	repository.IUserRepository
+}
+
+func (stubUserRepository) FindUserById(ctx context.Context, userId string) (*domain.User, domain.Error) {
+	return &domain.User{UserId: userId, Name: "Anna"}, nil
+}
+
+// stubChecklistItemController deletes every item except itemId 404 and counts the deletes
+type stubChecklistItemController struct {
+	checklistItem.StrictServerInterface
+	deletes *atomic.Int32
+}
+
+func (c stubChecklistItemController) DeleteChecklistItemById(ctx context.Context, request checklistItem.DeleteChecklistItemByIdRequestObject) (checklistItem.DeleteChecklistItemByIdResponseObject, error) {
+	if request.ItemId == 404 {
+		return checklistItem.DeleteChecklistItemById404JSONResponse{Message: "Checklist item not found"}, nil
+	}
+	if c.deletes != nil {
+		c.deletes.Add(1)
+	}
+	return checklistItem.DeleteChecklistItemById204JSONResponse{}, nil
+}
+
+// stubSessionValidator accepts only the session "session-1"
+type stubSessionValidator struct {
+	auth.SessionValidator
+}
+
+func (stubSessionValidator) ValidateSession(ctx context.Context, sessionId string) (*domain.Session, domain.Error) {
+	if sessionId != "session-1" {
+		return nil, nil
+	}
+	return &domain.Session{UserId: "user-1"}, nil
+}
+
+func startServer(t *testing.T, broker notification.IBroker) *websocket.Conn {
+	return startServerWith(t, broker, stubChecklistItemController{})
+}
+
+func startServerWith(t *testing.T, broker notification.IBroker, controller checklistItem.IChecklistItemController) *websocket.Conn {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	engine.Use(func(c *gin.Context) {
+		auth.SetUserIdInGinContext(c, "user-1")
+		c.Next()
+	})
+	engine.GET("/api/v1/ws", NewWebSocketController(broker, controller, stubSessionValidator{}, auth.NewAPIRateLimit()).Connect)
+	server := httptest.NewServer(engine)
+	t.Cleanup(server.Close)
+
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/api/v1/ws?clientId=client-a"
+	conn, _, err := websocket.DefaultDialer.Dial(url, http.Header{"Cookie": {auth.SessionCookieName + "=session-1"}})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return conn
+}
+
+func roundTrip(t *testing.T, conn *websocket.Conn, message clientMessage) serverMessage {
+	t.Helper()
+	require.NoError(t, conn.WriteJSON(message))
+	var reply serverMessage
+	require.NoError(t, conn.ReadJSON(&reply))
+	return reply
+}
+
+func TestWebSocketController_Request_RepliesWithAckOrError(t *testing.T) {
+	conn := startServer(t, notification.NewBroker(allowAllGuardrail{}, nil, stubUserRepository{}, notification.ClientQueueConfiguration{}))
+
+	reply := roundTrip(t, conn, clientMessage{Type: MessageTypeRequest, OpId: "op-1", Method: http.MethodDelete, Path: "/api/v1/checklists/1/items/2"})
+	assert.Equal(t, MessageTypeAck, reply.Type)
+	assert.Equal(t, "op-1", reply.OpId)
+	assert.Equal(t, http.StatusNoContent, reply.Status)
+
+	reply = roundTrip(t, conn, clientMessage{Type: MessageTypeRequest, OpId: "op-2", Method: http.MethodDelete, Path: "/api/v1/checklists/1/items/404"})
+	assert.Equal(t, MessageTypeError, reply.Type)
+	assert.Equal(t, "op-2", reply.OpId)
+	assert.Equal(t, http.StatusNotFound, reply.Status)
+	assert.JSONEq(t, `{"message":"Checklist item not found"}`, string(reply.Body))
+
+	reply = roundTrip(t, conn, clientMessage{Type: "unknown", OpId: "op-3"})
+	assert.Equal(t, MessageTypeError, reply.Type)
+	assert.Equal(t, http.StatusBadRequest, reply.Status)
+}
+
+func TestWebSocketController_Subscribe_PushesBrokerEvents(t *testing.T) {
+	broker := notification.NewBroker(allowAllGuardrail{}, nil, stubUserRepository{}, notification.ClientQueueConfiguration{})
+	conn := startServer(t, broker)
+
+	reply := roundTrip(t, conn, clientMessage{Type: MessageTypeSubscribe, OpId: "op-1", ChecklistId: 7})
+	assert.Equal(t, MessageTypeAck, reply.Type)
+
+	otherClient := context.WithValue(context.Background(), domain.ClientIdContextKey, "client-b")
+	broker.Publish(otherClient, 7, domain.ChecklistItemUpdatesEvent{
+		EventType: domain.EventTypeChecklistItemDeleted,
+		Payload:   domain.ChecklistItemDeletedEventPayload{ItemId: 3},
+	})
+
+	var message serverMessage
+	require.NoError(t, conn.ReadJSON(&message))
+	assert.Equal(t, MessageTypeEvent, message.Type)
+	assert.Equal(t, uint64(1), message.EventId)
+	require.NotNil(t, message.Event)
+	assert.Equal(t, uint(7), message.Event.ChecklistId)
+	assert.Equal(t, sse.EventEnvelopeType(domain.EventTypeChecklistItemDeleted), message.Event.Type)
+
+	reply = roundTrip(t, conn, clientMessage{Type: MessageTypeEditing, OpId: "op-2", ChecklistId: 7, ItemId: new(uint(3))})
+	assert.Equal(t, MessageTypeAck, reply.Type)
+	viewers, err := broker.Viewers(context.Background(), 7)
+	require.NoError(t, err)
+	assert.Equal(t, []domain.ChecklistViewer{{UserId: "user-1", Name: new("Anna"), EditingItemId: new(uint(3))}}, viewers)
+
+	reply = roundTrip(t, conn, clientMessage{Type: MessageTypeUnsubscribe, OpId: "op-3", ChecklistId: 7})
+	assert.Equal(t, MessageTypeAck, reply.Type)
+	assert.Equal(t, "op-3", reply.OpId)
+}
+
+func TestWebSocketController_Request_RepeatedOpIdRunsOnce(t *testing.T) {
+	deletes := new(atomic.Int32)
+	broker := notification.NewBroker(allowAllGuardrail{}, nil, stubUserRepository{}, notification.ClientQueueConfiguration{})
+	conn := startServerWith(t, broker, stubChecklistItemController{deletes: deletes})
+
+	request := clientMessage{Type: MessageTypeRequest, OpId: "op-1", Method: http.MethodDelete, Path: "/api/v1/checklists/1/items/2"}
+	first := roundTrip(t, conn, request)
+	second := roundTrip(t, conn, request)
+
+	assert.Equal(t, http.StatusNoContent, first.Status)
+	assert.Equal(t, first, second)
+	assert.Equal(t, int32(1), deletes.Load())
+}
+
+func TestRecentOperations_Run_ForgetsRequestsThatWereNotApplied(t *testing.T) {
+	operations := newRecentOperations()
+	runs := 0
+	serve := func() (int, json.RawMessage) {
+		runs++
+		if runs == 1 {
+			return http.StatusTooManyRequests, nil
+		}
+		return http.StatusOK, nil
+	}
+
+	status, _ := operations.run("user-1", "op-1", serve)
+	assert.Equal(t, http.StatusTooManyRequests, status)
+	status, _ = operations.run("user-1", "op-1", serve)
+	assert.Equal(t, http.StatusOK, status)
+	status, _ = operations.run("user-1", "op-1", serve)
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, 2, runs)
+
+	status, _ = operations.run("user-2", "op-1", serve)
+	assert.Equal(t, 3, runs, "opIds are remembered per user")
+	assert.Equal(t, http.StatusOK, status)
+}
+
+func TestSession_SessionValid(t *testing.T) {
+	controller := &webSocketController{sessions: stubSessionValidator{}}
+
+	assert.True(t, (&session{controller: controller, sessionId: "session-1"}).sessionValid())
+	assert.False(t, (&session{controller: controller, sessionId: "logged-out"}).sessionValid())
+}
+
+func TestServerMessage_OmitsEmptyFields(t *testing.T) {
+	body, err := json.Marshal(newReply("op-1", http.StatusOK, nil))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"type":"ack","opId":"op-1","status":200}`, string(body))
+}
@@ -83,3 +83,326 @@ ALTER TABLE workspace_member ALTER COLUMN id SET DEFAULT NEXTVAL('workspace_memb
 ALTER TABLE workspace_member DROP CONSTRAINT IF EXISTS workspace_member_pkey;
 ALTER TABLE workspace_member ADD PRIMARY KEY (id);
 ALTER TABLE workspace_member ADD CONSTRAINT uq_workspace_member UNIQUE (workspace_id, user_id);
+
+-- ─────────────────────────────────────────────
+-- 8. Checklist runs (execution history)
+-- ─────────────────────────────────────────────
+CREATE SEQUENCE IF NOT EXISTS checklist_run_id_sequence START 1 INCREMENT 1;
+CREATE SEQUENCE IF NOT EXISTS checklist_run_step_id_sequence START 1 INCREMENT 1;
+CREATE SEQUENCE IF NOT EXISTS checklist_run_step_row_id_sequence START 1 INCREMENT 1;
+
+CREATE TABLE IF NOT EXISTS CHECKLIST_RUN (
+    ID                    BIGINT PRIMARY KEY DEFAULT NEXTVAL('checklist_run_id_sequence'),
+    CHECKLIST_ID          BIGINT NOT NULL REFERENCES CHECKLIST(ID) ON DELETE CASCADE,
+    STARTED_BY            VARCHAR(255) NOT NULL,
+    STARTED_AT            TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FINISHED_BY           VARCHAR(255) NULL,
+    FINISHED_AT           TIMESTAMP NULL,
+    DURATION_SECONDS      BIGINT NULL,
+    COMPLETION_PERCENTAGE DOUBLE PRECISION NULL
+);
+
+CREATE TABLE IF NOT EXISTS CHECKLIST_RUN_STEP (
+    ID             BIGINT PRIMARY KEY DEFAULT NEXTVAL('checklist_run_step_id_sequence'),
+    RUN_ID         BIGINT NOT NULL REFERENCES CHECKLIST_RUN(ID) ON DELETE CASCADE,
+    SOURCE_ITEM_ID BIGINT NULL,
+    NAME           VARCHAR(255) NOT NULL,
+    ORDER_NUMBER   INT NOT NULL,
+    STATUS         VARCHAR(20) NOT NULL DEFAULT 'PENDING' CHECK (STATUS IN ('PENDING', 'COMPLETED', 'NOT_APPLICABLE')),
+    CHECKED_BY     VARCHAR(255) NULL,
+    CHECKED_AT     TIMESTAMP NULL
+);
+
+CREATE TABLE IF NOT EXISTS CHECKLIST_RUN_STEP_ROW (
+    ID            BIGINT PRIMARY KEY DEFAULT NEXTVAL('checklist_run_step_row_id_sequence'),
+    STEP_ID       BIGINT NOT NULL REFERENCES CHECKLIST_RUN_STEP(ID) ON DELETE CASCADE,
+    SOURCE_ROW_ID BIGINT NULL,
+    NAME          VARCHAR(255) NOT NULL,
+    STATUS        VARCHAR(20) NOT NULL DEFAULT 'PENDING' CHECK (STATUS IN ('PENDING', 'COMPLETED', 'NOT_APPLICABLE')),
+    CHECKED_BY    VARCHAR(255) NULL,
+    CHECKED_AT    TIMESTAMP NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_checklist_run_checklist   ON CHECKLIST_RUN(CHECKLIST_ID, STARTED_AT DESC);
+CREATE INDEX IF NOT EXISTS idx_checklist_run_step_run    ON CHECKLIST_RUN_STEP(RUN_ID);
+CREATE INDEX IF NOT EXISTS idx_checklist_run_step_row    ON CHECKLIST_RUN_STEP_ROW(STEP_ID);
+
+-- ─────────────────────────────────────────────
+-- 9. Completion audit fields on items and rows
+-- ─────────────────────────────────────────────
+ALTER TABLE CHECKLIST_ITEM ADD COLUMN IF NOT EXISTS CHECKLIST_ITEM_COMPLETED_BY VARCHAR(255) NULL;
+ALTER TABLE CHECKLIST_ITEM ADD COLUMN IF NOT EXISTS CHECKLIST_ITEM_COMPLETED_AT TIMESTAMP NULL;
+ALTER TABLE CHECKLIST_ITEM_ROW ADD COLUMN IF NOT EXISTS CHECKLIST_ITEM_ROW_COMPLETED_BY VARCHAR(255) NULL;
+ALTER TABLE CHECKLIST_ITEM_ROW ADD COLUMN IF NOT EXISTS CHECKLIST_ITEM_ROW_COMPLETED_AT TIMESTAMP NULL;
+
+-- ─────────────────────────────────────────────
+-- 10. Checklist activity log (append-only)
+-- ─────────────────────────────────────────────
+CREATE SEQUENCE IF NOT EXISTS checklist_activity_id_sequence START 1 INCREMENT 1;
+
+CREATE TABLE IF NOT EXISTS CHECKLIST_ACTIVITY (
+    ID             BIGINT PRIMARY KEY DEFAULT NEXTVAL('checklist_activity_id_sequence'),
+    CHECKLIST_ID   BIGINT NOT NULL REFERENCES CHECKLIST(ID) ON DELETE CASCADE,
+    ITEM_ID        BIGINT NULL,
+    ACTION         VARCHAR(50) NOT NULL,
+    ACTOR          VARCHAR(255) NOT NULL,
+    CREATED_AT     TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    BEFORE_SUMMARY TEXT NULL,
+    AFTER_SUMMARY  TEXT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_checklist_activity_checklist ON CHECKLIST_ACTIVITY(CHECKLIST_ID, ID DESC);
+CREATE INDEX IF NOT EXISTS idx_checklist_activity_created   ON CHECKLIST_ACTIVITY(CREATED_AT);
+
+-- ─────────────────────────────────────────────
+-- 11. Workspace activity feed (append-only)
+-- ─────────────────────────────────────────────
+CREATE SEQUENCE IF NOT EXISTS workspace_activity_id_sequence START 1 INCREMENT 1;
+
+CREATE TABLE IF NOT EXISTS workspace_activity (
+    id              BIGINT PRIMARY KEY DEFAULT NEXTVAL('workspace_activity_id_sequence'),
+    workspace_id    BIGINT NOT NULL REFERENCES workspace(id) ON DELETE CASCADE,
+    event_type      VARCHAR(50) NOT NULL,
+    actor           VARCHAR(255) NOT NULL,
+    subject_user_id VARCHAR(255) NULL,
+    checklist_id    BIGINT NULL,
+    template_id     BIGINT NULL,
+    invite_id       BIGINT NULL,
+    summary         TEXT NULL,
+    created_at      TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_workspace_activity_workspace ON workspace_activity(workspace_id, id DESC);
+CREATE INDEX IF NOT EXISTS idx_workspace_activity_created   ON workspace_activity(created_at);
+
+-- ─────────────────────────────────────────────
+-- 12. Checklist change history (one entry per changed item, written in the change's transaction)
+-- ─────────────────────────────────────────────
+CREATE SEQUENCE IF NOT EXISTS checklist_item_history_id_sequence START 1 INCREMENT 1;
+
+CREATE TABLE IF NOT EXISTS CHECKLIST_ITEM_HISTORY (
+    ID                BIGINT PRIMARY KEY DEFAULT NEXTVAL('checklist_item_history_id_sequence'),
+    CHECKLIST_ID      BIGINT NOT NULL REFERENCES CHECKLIST(ID) ON DELETE CASCADE,
+    CHECKLIST_ITEM_ID BIGINT NOT NULL,
+    RECORDED_AT       TIMESTAMP NOT NULL DEFAULT clock_timestamp(),
+    DELETED           BOOLEAN NOT NULL,
+    NAME              TEXT NOT NULL,
+    COMPLETED         BOOLEAN NOT NULL,
+    COMPLETED_BY      VARCHAR(255) NULL,
+    COMPLETED_AT      TIMESTAMP NULL,
+    POSITION          TEXT COLLATE "C" NOT NULL,
+    SECTION_ID        BIGINT NULL,
+    ROWS              JSONB NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_checklist_item_history_checklist ON CHECKLIST_ITEM_HISTORY(CHECKLIST_ID, RECORDED_AT);
+CREATE INDEX IF NOT EXISTS idx_checklist_item_history_item      ON CHECKLIST_ITEM_HISTORY(CHECKLIST_ITEM_ID, RECORDED_AT DESC, ID DESC);
+
+-- ─────────────────────────────────────────────
+-- 13. Archived checklists (source of a merge)
+-- ─────────────────────────────────────────────
+ALTER TABLE CHECKLIST ADD COLUMN IF NOT EXISTS ARCHIVED_AT TIMESTAMP NULL;
+
+-- ─────────────────────────────────────────────
+-- 14. Deletion groups (undo of "clear completed")
+-- ─────────────────────────────────────────────
+CREATE SEQUENCE IF NOT EXISTS checklist_item_deletion_group_id_sequence START 1 INCREMENT 1;
+
+ALTER TABLE CHECKLIST_ITEM ADD COLUMN IF NOT EXISTS DELETION_GROUP_ID BIGINT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_checklist_item_deletion_group ON CHECKLIST_ITEM(CHECKLIST_ID, DELETION_GROUP_ID) WHERE DELETION_GROUP_ID IS NOT NULL;
+
+-- ─────────────────────────────────────────────
+-- 15. Per-checklist ordering mode (sink completed items or keep them in place)
+-- ─────────────────────────────────────────────
+ALTER TABLE CHECKLIST ADD COLUMN IF NOT EXISTS ORDERING_MODE VARCHAR(20) NOT NULL DEFAULT 'SINK_COMPLETED';
+
+-- CHECKLIST_ITEMS_ORDERED_VIEW honours the ordering mode; it is defined once at the end of this file
+
+-- ─────────────────────────────────────────────
+-- 16. Checklist sections (named, collapsible groups of items)
+-- ─────────────────────────────────────────────
+CREATE SEQUENCE IF NOT EXISTS checklist_section_id_sequence START 1 INCREMENT 1;
+
+CREATE TABLE IF NOT EXISTS CHECKLIST_SECTION (
+    ID           BIGINT PRIMARY KEY DEFAULT NEXTVAL('checklist_section_id_sequence'),
+    CHECKLIST_ID BIGINT NOT NULL REFERENCES CHECKLIST(ID) ON DELETE CASCADE,
+    NAME         VARCHAR(255) NOT NULL,
+    POSITION     DOUBLE PRECISION NOT NULL DEFAULT 0,
+    COLLAPSED    BOOLEAN NOT NULL DEFAULT FALSE,
+    CREATED_AT   TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_checklist_section_checklist ON CHECKLIST_SECTION(CHECKLIST_ID, POSITION);
+
+ALTER TABLE CHECKLIST_ITEM ADD COLUMN IF NOT EXISTS SECTION_ID BIGINT NULL REFERENCES CHECKLIST_SECTION(ID) ON DELETE SET NULL;
+
+-- CHECKLIST_ITEMS_ORDERED_VIEW numbers items within their section; it is defined once at the end of this file
+
+-- ─────────────────────────────────────────────
+-- 17. Item notes (optional markdown description of an item)
+-- ─────────────────────────────────────────────
+ALTER TABLE CHECKLIST_ITEM ADD COLUMN IF NOT EXISTS NOTES TEXT NULL;
+ALTER TABLE TEMPLATE ADD COLUMN IF NOT EXISTS NOTES TEXT NULL;
+
+-- ─────────────────────────────────────────────
+-- 18. Item comments (threads with @mentions)
+-- ─────────────────────────────────────────────
+CREATE SEQUENCE IF NOT EXISTS checklist_item_comment_id_sequence START 1 INCREMENT 1;
+
+CREATE TABLE IF NOT EXISTS CHECKLIST_ITEM_COMMENT (
+    ID                BIGINT PRIMARY KEY DEFAULT NEXTVAL('checklist_item_comment_id_sequence'),
+    CHECKLIST_ITEM_ID BIGINT NOT NULL REFERENCES CHECKLIST_ITEM(CHECKLIST_ITEM_ID) ON DELETE CASCADE,
+    PARENT_ID         BIGINT NULL REFERENCES CHECKLIST_ITEM_COMMENT(ID) ON DELETE CASCADE,
+    AUTHOR            VARCHAR(255) NOT NULL,
+    CONTENT           TEXT NOT NULL,
+    CREATED_AT        TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    EDITED_AT         TIMESTAMP NULL,
+    DELETED_AT        TIMESTAMP NULL
+);
+
+CREATE TABLE IF NOT EXISTS CHECKLIST_ITEM_COMMENT_MENTION (
+    COMMENT_ID BIGINT NOT NULL REFERENCES CHECKLIST_ITEM_COMMENT(ID) ON DELETE CASCADE,
+    USER_ID    VARCHAR(255) NOT NULL REFERENCES app_user(user_id) ON DELETE CASCADE,
+    PRIMARY KEY (COMMENT_ID, USER_ID)
+);
+
+CREATE INDEX IF NOT EXISTS idx_checklist_item_comment_item ON CHECKLIST_ITEM_COMMENT(CHECKLIST_ITEM_ID, CREATED_AT);
+
+-- ─────────────────────────────────────────────
+-- 19. Checklist event outbox (multi-instance SSE fan-out)
+-- ─────────────────────────────────────────────
+CREATE SEQUENCE IF NOT EXISTS checklist_event_id_sequence START 1 INCREMENT 1;
+
+-- Outbox of checklist events shared by all instances; the postgres SSE broker notifies listeners with the event id
+CREATE TABLE IF NOT EXISTS CHECKLIST_EVENT (
+    ID           BIGINT PRIMARY KEY DEFAULT NEXTVAL('checklist_event_id_sequence'),
+    CHECKLIST_ID BIGINT NOT NULL,
+    CLIENT_ID    VARCHAR(255) NOT NULL,
+    EVENT_TYPE   VARCHAR(50) NOT NULL,
+    PAYLOAD      JSONB NOT NULL,
+    CREATED_AT   TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_checklist_event_created ON CHECKLIST_EVENT(CREATED_AT);
+
+-- ─────────────────────────────────────────────
+-- 20. Event ids per checklist (SSE replay with Last-Event-ID)
+-- ─────────────────────────────────────────────
+-- Shared events are only kept for replay, so existing ones are dropped instead of numbered. Only done while the
+-- column is missing; later runs keep the events.
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM information_schema.columns
+                   WHERE table_name = 'checklist_event' AND column_name = 'event_id') THEN
+        DELETE FROM CHECKLIST_EVENT;
+        ALTER TABLE CHECKLIST_EVENT ADD COLUMN EVENT_ID BIGINT NOT NULL;
+    END IF;
+END $$;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_checklist_event_checklist ON CHECKLIST_EVENT(CHECKLIST_ID, EVENT_ID);
+
+-- Last event id of each checklist, which numbers the events of the checklist for replay
+CREATE TABLE IF NOT EXISTS CHECKLIST_EVENT_COUNTER (
+    CHECKLIST_ID  BIGINT PRIMARY KEY,
+    LAST_EVENT_ID BIGINT NOT NULL
+);
+
+-- ─────────────────────────────────────────────
+-- 21. Access changes of checklist events (SSE user streams)
+-- ─────────────────────────────────────────────
+-- Users whose accessible checklists changed with the event, so that every instance updates their user streams
+ALTER TABLE CHECKLIST_EVENT ADD COLUMN IF NOT EXISTS ACCESS_CHANGED_FOR TEXT[] NULL;
+
+-- ─────────────────────────────────────────────
+-- 22. Pending position rebalances (durable across restarts and instances)
+-- ─────────────────────────────────────────────
+-- Pending position rebalances; any instance runs them once RUN_AFTER has passed
+CREATE TABLE IF NOT EXISTS CHECKLIST_REBALANCE (
+    CHECKLIST_ID BIGINT PRIMARY KEY REFERENCES CHECKLIST(ID) ON DELETE CASCADE,
+    -- First request since the last rebalance, which bounds how long later requests can postpone it
+    REQUESTED_AT TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    RUN_AFTER    TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_checklist_rebalance_run_after ON CHECKLIST_REBALANCE(RUN_AFTER);
+
+-- ─────────────────────────────────────────────
+-- 23. Fractional-key positions for items, item rows and template rows
+-- ─────────────────────────────────────────────
+-- Positions become base-62 keys that sort byte by byte (COLLATE "C"); a key always fits between two others,
+-- so inserting or moving an item never renumbers its neighbours. Existing rows get keys in their current
+-- order: "a0", the 4-digit row number and "V", so that no key ends in the zero digit.
+CREATE OR REPLACE FUNCTION pg_temp.position_key(n BIGINT) RETURNS TEXT AS $$
+    SELECT 'a0' || string_agg(substr('0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz',
+                                     ((n / power(62, p)::BIGINT) % 62)::INT + 1, 1), '' ORDER BY p DESC) || 'V'
+    FROM generate_series(0, 3) AS p
+$$ LANGUAGE SQL IMMUTABLE;
+
+DO $$
+BEGIN
+    IF (SELECT data_type FROM information_schema.columns
+        WHERE table_name = 'checklist_item' AND column_name = 'position') = 'double precision' THEN
+        DROP VIEW IF EXISTS CHECKLIST_ITEMS_ORDERED_VIEW;
+        ALTER TABLE CHECKLIST_ITEM ADD COLUMN POSITION_KEY TEXT COLLATE "C";
+        -- One sequence per checklist keeps every section and completion section in order
+        UPDATE CHECKLIST_ITEM ci
+        SET POSITION_KEY = pg_temp.position_key(ranked.RANK)
+        FROM (
+            SELECT CHECKLIST_ITEM_ID, ROW_NUMBER() OVER (PARTITION BY CHECKLIST_ID ORDER BY POSITION, CHECKLIST_ITEM_ID) AS RANK
+            FROM CHECKLIST_ITEM
+        ) ranked
+        WHERE ci.CHECKLIST_ITEM_ID = ranked.CHECKLIST_ITEM_ID;
+        ALTER TABLE CHECKLIST_ITEM DROP COLUMN POSITION;
+        ALTER TABLE CHECKLIST_ITEM RENAME COLUMN POSITION_KEY TO POSITION;
+        ALTER TABLE CHECKLIST_ITEM ALTER COLUMN POSITION SET NOT NULL;
+    END IF;
+
+    IF (SELECT data_type FROM information_schema.columns
+        WHERE table_name = 'template_row' AND column_name = 'position') = 'double precision' THEN
+        ALTER TABLE TEMPLATE_ROW ADD COLUMN POSITION_KEY TEXT COLLATE "C";
+        UPDATE TEMPLATE_ROW tr
+        SET POSITION_KEY = pg_temp.position_key(ranked.RANK)
+        FROM (
+            SELECT ID, ROW_NUMBER() OVER (PARTITION BY TEMPLATE_ID ORDER BY POSITION, ID) AS RANK
+            FROM TEMPLATE_ROW
+        ) ranked
+        WHERE tr.ID = ranked.ID;
+        ALTER TABLE TEMPLATE_ROW DROP COLUMN POSITION;
+        ALTER TABLE TEMPLATE_ROW RENAME COLUMN POSITION_KEY TO POSITION;
+        ALTER TABLE TEMPLATE_ROW ALTER COLUMN POSITION SET NOT NULL;
+    END IF;
+END $$;
+
+-- Rows of an item had no order of their own and were listed by id
+ALTER TABLE CHECKLIST_ITEM_ROW ADD COLUMN IF NOT EXISTS CHECKLIST_ITEM_ROW_POSITION TEXT COLLATE "C";
+UPDATE CHECKLIST_ITEM_ROW r
+SET CHECKLIST_ITEM_ROW_POSITION = pg_temp.position_key(ranked.RANK)
+FROM (
+    SELECT CHECKLIST_ITEM_ROW_ID, ROW_NUMBER() OVER (PARTITION BY CHECKLIST_ITEM_ID ORDER BY CHECKLIST_ITEM_ROW_ID) AS RANK
+    FROM CHECKLIST_ITEM_ROW
+) ranked
+WHERE r.CHECKLIST_ITEM_ROW_ID = ranked.CHECKLIST_ITEM_ROW_ID AND r.CHECKLIST_ITEM_ROW_POSITION IS NULL;
+ALTER TABLE CHECKLIST_ITEM_ROW ALTER COLUMN CHECKLIST_ITEM_ROW_POSITION SET NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_checklist_item_position ON CHECKLIST_ITEM(CHECKLIST_ID, CHECKLIST_ITEM_COMPLETED, POSITION);
+CREATE INDEX IF NOT EXISTS idx_checklist_item_row_position ON CHECKLIST_ITEM_ROW(CHECKLIST_ITEM_ID, CHECKLIST_ITEM_ROW_POSITION);
+
+-- Items are numbered within their section. Completed items sink below open ones unless the checklist
+-- keeps items in place. The view is only defined here, after every column it reads exists; it is dropped first
+-- because CREATE OR REPLACE VIEW can not change the columns of an earlier definition.
+DROP VIEW IF EXISTS CHECKLIST_ITEMS_ORDERED_VIEW;
+CREATE VIEW CHECKLIST_ITEMS_ORDERED_VIEW AS
+SELECT
+    ci.CHECKLIST_ID,
+    ci.CHECKLIST_ITEM_ID,
+    ci.CHECKLIST_ITEM_NAME,
+    ci.CHECKLIST_ITEM_COMPLETED,
+    ci.POSITION,
+    ROW_NUMBER() OVER (
+        PARTITION BY ci.CHECKLIST_ID, ci.SECTION_ID
+        ORDER BY CASE WHEN c.ORDERING_MODE = 'KEEP_IN_PLACE' THEN FALSE ELSE ci.CHECKLIST_ITEM_COMPLETED END ASC,
+                 ci.POSITION ASC
+    ) AS ORDER_NUMBER,
+    ci.SECTION_ID
+FROM CHECKLIST_ITEM ci
+JOIN CHECKLIST c ON c.ID = ci.CHECKLIST_ID;